@@ -29,9 +29,13 @@ import (
 type VirtualizationV1alpha2Interface interface {
 	RESTClient() rest.Interface
 	ClusterVirtualImagesGetter
+	ClusterVirtualImageCatalogsGetter
+	IPAddressPoolsGetter
+	MACAddressPoolsGetter
 	NodeUSBDevicesGetter
 	USBDevicesGetter
 	VirtualDisksGetter
+	VirtualDiskExportsGetter
 	VirtualDiskSnapshotsGetter
 	VirtualImagesGetter
 	VirtualMachinesGetter
@@ -43,6 +47,7 @@ type VirtualizationV1alpha2Interface interface {
 	VirtualMachineMACAddressLeasesGetter
 	VirtualMachineOperationsGetter
 	VirtualMachinePoolsGetter
+	VirtualMachineSecurityGroupsGetter
 	VirtualMachineSnapshotsGetter
 	VirtualMachineSnapshotOperationsGetter
 }
@@ -56,6 +61,18 @@ func (c *VirtualizationV1alpha2Client) ClusterVirtualImages() ClusterVirtualImag
 	return newClusterVirtualImages(c)
 }
 
+func (c *VirtualizationV1alpha2Client) ClusterVirtualImageCatalogs() ClusterVirtualImageCatalogInterface {
+	return newClusterVirtualImageCatalogs(c)
+}
+
+func (c *VirtualizationV1alpha2Client) IPAddressPools() IPAddressPoolInterface {
+	return newIPAddressPools(c)
+}
+
+func (c *VirtualizationV1alpha2Client) MACAddressPools() MACAddressPoolInterface {
+	return newMACAddressPools(c)
+}
+
 func (c *VirtualizationV1alpha2Client) NodeUSBDevices() NodeUSBDeviceInterface {
 	return newNodeUSBDevices(c)
 }
@@ -68,6 +85,10 @@ func (c *VirtualizationV1alpha2Client) VirtualDisks(namespace string) VirtualDis
 	return newVirtualDisks(c, namespace)
 }
 
+func (c *VirtualizationV1alpha2Client) VirtualDiskExports(namespace string) VirtualDiskExportInterface {
+	return newVirtualDiskExports(c, namespace)
+}
+
 func (c *VirtualizationV1alpha2Client) VirtualDiskSnapshots(namespace string) VirtualDiskSnapshotInterface {
 	return newVirtualDiskSnapshots(c, namespace)
 }
@@ -112,6 +133,10 @@ func (c *VirtualizationV1alpha2Client) VirtualMachinePools(namespace string) Vir
 	return newVirtualMachinePools(c, namespace)
 }
 
+func (c *VirtualizationV1alpha2Client) VirtualMachineSecurityGroups(namespace string) VirtualMachineSecurityGroupInterface {
+	return newVirtualMachineSecurityGroups(c, namespace)
+}
+
 func (c *VirtualizationV1alpha2Client) VirtualMachineSnapshots(namespace string) VirtualMachineSnapshotInterface {
 	return newVirtualMachineSnapshots(c, namespace)
 }
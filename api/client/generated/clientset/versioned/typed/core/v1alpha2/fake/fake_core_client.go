@@ -32,6 +32,18 @@ func (c *FakeVirtualizationV1alpha2) ClusterVirtualImages() v1alpha2.ClusterVirt
 	return newFakeClusterVirtualImages(c)
 }
 
+func (c *FakeVirtualizationV1alpha2) ClusterVirtualImageCatalogs() v1alpha2.ClusterVirtualImageCatalogInterface {
+	return newFakeClusterVirtualImageCatalogs(c)
+}
+
+func (c *FakeVirtualizationV1alpha2) IPAddressPools() v1alpha2.IPAddressPoolInterface {
+	return newFakeIPAddressPools(c)
+}
+
+func (c *FakeVirtualizationV1alpha2) MACAddressPools() v1alpha2.MACAddressPoolInterface {
+	return newFakeMACAddressPools(c)
+}
+
 func (c *FakeVirtualizationV1alpha2) NodeUSBDevices() v1alpha2.NodeUSBDeviceInterface {
 	return newFakeNodeUSBDevices(c)
 }
@@ -44,6 +56,10 @@ func (c *FakeVirtualizationV1alpha2) VirtualDisks(namespace string) v1alpha2.Vir
 	return newFakeVirtualDisks(c, namespace)
 }
 
+func (c *FakeVirtualizationV1alpha2) VirtualDiskExports(namespace string) v1alpha2.VirtualDiskExportInterface {
+	return newFakeVirtualDiskExports(c, namespace)
+}
+
 func (c *FakeVirtualizationV1alpha2) VirtualDiskSnapshots(namespace string) v1alpha2.VirtualDiskSnapshotInterface {
 	return newFakeVirtualDiskSnapshots(c, namespace)
 }
@@ -88,6 +104,10 @@ func (c *FakeVirtualizationV1alpha2) VirtualMachinePools(namespace string) v1alp
 	return newFakeVirtualMachinePools(c, namespace)
 }
 
+func (c *FakeVirtualizationV1alpha2) VirtualMachineSecurityGroups(namespace string) v1alpha2.VirtualMachineSecurityGroupInterface {
+	return newFakeVirtualMachineSecurityGroups(c, namespace)
+}
+
 func (c *FakeVirtualizationV1alpha2) VirtualMachineSnapshots(namespace string) v1alpha2.VirtualMachineSnapshotInterface {
 	return newFakeVirtualMachineSnapshots(c, namespace)
 }
@@ -20,12 +20,20 @@ package v1alpha2
 
 type ClusterVirtualImageExpansion interface{}
 
+type ClusterVirtualImageCatalogExpansion interface{}
+
+type IPAddressPoolExpansion interface{}
+
+type MACAddressPoolExpansion interface{}
+
 type NodeUSBDeviceExpansion interface{}
 
 type USBDeviceExpansion interface{}
 
 type VirtualDiskExpansion interface{}
 
+type VirtualDiskExportExpansion interface{}
+
 type VirtualDiskSnapshotExpansion interface{}
 
 type VirtualImageExpansion interface{}
@@ -44,6 +52,8 @@ type VirtualMachineMACAddressLeaseExpansion interface{}
 
 type VirtualMachineOperationExpansion interface{}
 
+type VirtualMachineSecurityGroupExpansion interface{}
+
 type VirtualMachineSnapshotExpansion interface{}
 
 type VirtualMachineSnapshotOperationExpansion interface{}
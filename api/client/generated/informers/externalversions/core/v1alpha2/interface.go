@@ -26,12 +26,20 @@ import (
 type Interface interface {
 	// ClusterVirtualImages returns a ClusterVirtualImageInformer.
 	ClusterVirtualImages() ClusterVirtualImageInformer
+	// ClusterVirtualImageCatalogs returns a ClusterVirtualImageCatalogInformer.
+	ClusterVirtualImageCatalogs() ClusterVirtualImageCatalogInformer
+	// IPAddressPools returns a IPAddressPoolInformer.
+	IPAddressPools() IPAddressPoolInformer
+	// MACAddressPools returns a MACAddressPoolInformer.
+	MACAddressPools() MACAddressPoolInformer
 	// NodeUSBDevices returns a NodeUSBDeviceInformer.
 	NodeUSBDevices() NodeUSBDeviceInformer
 	// USBDevices returns a USBDeviceInformer.
 	USBDevices() USBDeviceInformer
 	// VirtualDisks returns a VirtualDiskInformer.
 	VirtualDisks() VirtualDiskInformer
+	// VirtualDiskExports returns a VirtualDiskExportInformer.
+	VirtualDiskExports() VirtualDiskExportInformer
 	// VirtualDiskSnapshots returns a VirtualDiskSnapshotInformer.
 	VirtualDiskSnapshots() VirtualDiskSnapshotInformer
 	// VirtualImages returns a VirtualImageInformer.
@@ -54,6 +62,8 @@ type Interface interface {
 	VirtualMachineOperations() VirtualMachineOperationInformer
 	// VirtualMachinePools returns a VirtualMachinePoolInformer.
 	VirtualMachinePools() VirtualMachinePoolInformer
+	// VirtualMachineSecurityGroups returns a VirtualMachineSecurityGroupInformer.
+	VirtualMachineSecurityGroups() VirtualMachineSecurityGroupInformer
 	// VirtualMachineSnapshots returns a VirtualMachineSnapshotInformer.
 	VirtualMachineSnapshots() VirtualMachineSnapshotInformer
 	// VirtualMachineSnapshotOperations returns a VirtualMachineSnapshotOperationInformer.
@@ -76,6 +86,21 @@ func (v *version) ClusterVirtualImages() ClusterVirtualImageInformer {
 	return &clusterVirtualImageInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ClusterVirtualImageCatalogs returns a ClusterVirtualImageCatalogInformer.
+func (v *version) ClusterVirtualImageCatalogs() ClusterVirtualImageCatalogInformer {
+	return &clusterVirtualImageCatalogInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// IPAddressPools returns a IPAddressPoolInformer.
+func (v *version) IPAddressPools() IPAddressPoolInformer {
+	return &iPAddressPoolInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// MACAddressPools returns a MACAddressPoolInformer.
+func (v *version) MACAddressPools() MACAddressPoolInformer {
+	return &mACAddressPoolInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // NodeUSBDevices returns a NodeUSBDeviceInformer.
 func (v *version) NodeUSBDevices() NodeUSBDeviceInformer {
 	return &nodeUSBDeviceInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -91,6 +116,11 @@ func (v *version) VirtualDisks() VirtualDiskInformer {
 	return &virtualDiskInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// VirtualDiskExports returns a VirtualDiskExportInformer.
+func (v *version) VirtualDiskExports() VirtualDiskExportInformer {
+	return &virtualDiskExportInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // VirtualDiskSnapshots returns a VirtualDiskSnapshotInformer.
 func (v *version) VirtualDiskSnapshots() VirtualDiskSnapshotInformer {
 	return &virtualDiskSnapshotInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -146,6 +176,11 @@ func (v *version) VirtualMachinePools() VirtualMachinePoolInformer {
 	return &virtualMachinePoolInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// VirtualMachineSecurityGroups returns a VirtualMachineSecurityGroupInformer.
+func (v *version) VirtualMachineSecurityGroups() VirtualMachineSecurityGroupInformer {
+	return &virtualMachineSecurityGroupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // VirtualMachineSnapshots returns a VirtualMachineSnapshotInformer.
 func (v *version) VirtualMachineSnapshots() VirtualMachineSnapshotInformer {
 	return &virtualMachineSnapshotInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
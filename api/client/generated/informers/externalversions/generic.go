@@ -56,12 +56,20 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=virtualization.deckhouse.io, Version=v1alpha2
 	case v1alpha2.SchemeGroupVersion.WithResource("clustervirtualimages"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Virtualization().V1alpha2().ClusterVirtualImages().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("clustervirtualimagecatalogs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virtualization().V1alpha2().ClusterVirtualImageCatalogs().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("ipaddresspools"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virtualization().V1alpha2().IPAddressPools().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("macaddresspools"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virtualization().V1alpha2().MACAddressPools().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("nodeusbdevices"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Virtualization().V1alpha2().NodeUSBDevices().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("usbdevices"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Virtualization().V1alpha2().USBDevices().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("virtualdisks"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Virtualization().V1alpha2().VirtualDisks().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("virtualdiskexports"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virtualization().V1alpha2().VirtualDiskExports().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("virtualdisksnapshots"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Virtualization().V1alpha2().VirtualDiskSnapshots().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("virtualimages"):
@@ -84,6 +92,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Virtualization().V1alpha2().VirtualMachineOperations().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("virtualmachinepools"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Virtualization().V1alpha2().VirtualMachinePools().Informer()}, nil
+	case v1alpha2.SchemeGroupVersion.WithResource("virtualmachinesecuritygroups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Virtualization().V1alpha2().VirtualMachineSecurityGroups().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("virtualmachinesnapshots"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Virtualization().V1alpha2().VirtualMachineSnapshots().Informer()}, nil
 	case v1alpha2.SchemeGroupVersion.WithResource("virtualmachinesnapshotoperations"):
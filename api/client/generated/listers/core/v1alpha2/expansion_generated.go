@@ -22,6 +22,18 @@ package v1alpha2
 // ClusterVirtualImageLister.
 type ClusterVirtualImageListerExpansion interface{}
 
+// ClusterVirtualImageCatalogListerExpansion allows custom methods to be added to
+// ClusterVirtualImageCatalogLister.
+type ClusterVirtualImageCatalogListerExpansion interface{}
+
+// IPAddressPoolListerExpansion allows custom methods to be added to
+// IPAddressPoolLister.
+type IPAddressPoolListerExpansion interface{}
+
+// MACAddressPoolListerExpansion allows custom methods to be added to
+// MACAddressPoolLister.
+type MACAddressPoolListerExpansion interface{}
+
 // NodeUSBDeviceListerExpansion allows custom methods to be added to
 // NodeUSBDeviceLister.
 type NodeUSBDeviceListerExpansion interface{}
@@ -42,6 +54,14 @@ type VirtualDiskListerExpansion interface{}
 // VirtualDiskNamespaceLister.
 type VirtualDiskNamespaceListerExpansion interface{}
 
+// VirtualDiskExportListerExpansion allows custom methods to be added to
+// VirtualDiskExportLister.
+type VirtualDiskExportListerExpansion interface{}
+
+// VirtualDiskExportNamespaceListerExpansion allows custom methods to be added to
+// VirtualDiskExportNamespaceLister.
+type VirtualDiskExportNamespaceListerExpansion interface{}
+
 // VirtualDiskSnapshotListerExpansion allows custom methods to be added to
 // VirtualDiskSnapshotLister.
 type VirtualDiskSnapshotListerExpansion interface{}
@@ -118,6 +138,14 @@ type VirtualMachinePoolListerExpansion interface{}
 // VirtualMachinePoolNamespaceLister.
 type VirtualMachinePoolNamespaceListerExpansion interface{}
 
+// VirtualMachineSecurityGroupListerExpansion allows custom methods to be added to
+// VirtualMachineSecurityGroupLister.
+type VirtualMachineSecurityGroupListerExpansion interface{}
+
+// VirtualMachineSecurityGroupNamespaceListerExpansion allows custom methods to be added to
+// VirtualMachineSecurityGroupNamespaceLister.
+type VirtualMachineSecurityGroupNamespaceListerExpansion interface{}
+
 // VirtualMachineSnapshotListerExpansion allows custom methods to be added to
 // VirtualMachineSnapshotLister.
 type VirtualMachineSnapshotListerExpansion interface{}
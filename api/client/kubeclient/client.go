@@ -53,11 +53,13 @@ func init() {
 type Client interface {
 	kubernetes.Interface
 	ClusterVirtualImages() virtualizationv1alpha2.ClusterVirtualImageInterface
+	ClusterVirtualImageCatalogs() virtualizationv1alpha2.ClusterVirtualImageCatalogInterface
 	VirtualMachines(namespace string) virtualizationv1alpha2.VirtualMachineInterface
 	VirtualMachinePools(namespace string) virtualizationv1alpha2.VirtualMachinePoolInterface
 	VirtualImages(namespace string) virtualizationv1alpha2.VirtualImageInterface
 	VirtualDisks(namespace string) virtualizationv1alpha2.VirtualDiskInterface
 	VirtualDiskSnapshots(namespace string) virtualizationv1alpha2.VirtualDiskSnapshotInterface
+	VirtualDiskExports(namespace string) virtualizationv1alpha2.VirtualDiskExportInterface
 	VirtualMachineSnapshots(namespace string) virtualizationv1alpha2.VirtualMachineSnapshotInterface
 	VirtualMachineSnapshotOperations(namespace string) virtualizationv1alpha2.VirtualMachineSnapshotOperationInterface
 	VirtualMachineBlockDeviceAttachments(namespace string) virtualizationv1alpha2.VirtualMachineBlockDeviceAttachmentInterface
@@ -101,6 +103,10 @@ func (c client) ClusterVirtualImages() virtualizationv1alpha2.ClusterVirtualImag
 	return c.virtClient.VirtualizationV1alpha2().ClusterVirtualImages()
 }
 
+func (c client) ClusterVirtualImageCatalogs() virtualizationv1alpha2.ClusterVirtualImageCatalogInterface {
+	return c.virtClient.VirtualizationV1alpha2().ClusterVirtualImageCatalogs()
+}
+
 func (c client) VirtualImages(namespace string) virtualizationv1alpha2.VirtualImageInterface {
 	return c.virtClient.VirtualizationV1alpha2().VirtualImages(namespace)
 }
@@ -113,6 +119,10 @@ func (c client) VirtualDiskSnapshots(namespace string) virtualizationv1alpha2.Vi
 	return c.virtClient.VirtualizationV1alpha2().VirtualDiskSnapshots(namespace)
 }
 
+func (c client) VirtualDiskExports(namespace string) virtualizationv1alpha2.VirtualDiskExportInterface {
+	return c.virtClient.VirtualizationV1alpha2().VirtualDiskExports(namespace)
+}
+
 func (c client) VirtualMachineSnapshots(namespace string) virtualizationv1alpha2.VirtualMachineSnapshotInterface {
 	return c.virtClient.VirtualizationV1alpha2().VirtualMachineSnapshots(namespace)
 }
@@ -69,8 +69,20 @@ type ClusterVirtualImageList struct {
 	Items []ClusterVirtualImage `json:"items"`
 }
 
+// +kubebuilder:validation:XValidation:rule="!has(self.customization) || self.dataSource.type == 'HTTP' || self.dataSource.type == 'ContainerImage'",message="The customization is only supported for the HTTP and ContainerImage data sources."
 type ClusterVirtualImageSpec struct {
 	DataSource ClusterVirtualImageDataSource `json:"dataSource"`
+	// Compression of the image stored in DVCR. If omitted, the `dvcr.compression` setting of the module is used.
+	// It is applied when the image is imported and does not affect images that are already stored.
+	//
+	// * `None`: The image is stored uncompressed.
+	// * `Zstd`: The image is compressed with zstd. Mostly-zero raw images take up only a fraction of their size.
+	// * `ZstdSeekable`: The image is compressed with zstd in the seekable format, which allows to read any range of the image without decompressing it whole.
+	// +optional
+	Compression ImageCompression `json:"compression,omitempty"`
+	// Offline customization of the image performed on import.
+	// +optional
+	Customization *ImageCustomization `json:"customization,omitempty"`
 }
 
 // Origin of the image.
@@ -96,6 +108,8 @@ type ClusterVirtualImageContainerImage struct {
 	// CA chain in Base64 format to verify the container registry.
 	// +kubebuilder:example:="YWFhCg=="
 	CABundle []byte `json:"caBundle,omitempty"`
+	// Signature verification policy for the image.
+	Verification *ImageSignatureVerification `json:"verification,omitempty"`
 }
 
 // Use an existing VirtualImage, ClusterVirtualImage, VirtualDisk or VirtualDiskSnapshot resource to create an image.
@@ -131,6 +145,8 @@ type ClusterVirtualImageStatus struct {
 	Format string `json:"format,omitempty"`
 	// Defines whether the image is in a format that needs to be mounted as a CD-ROM drive, such as iso and so on.
 	CDROM bool `json:"cdrom,omitempty"`
+	// Results of the image content inspection: detected operating system, partition table and boot modes.
+	Inspection *ImageStatusInspection `json:"inspection,omitempty"`
 	// Current status of the ClusterVirtualImage resource:
 	// * `Pending`: The resource has been created and is on a waiting queue.
 	// * `Provisioning`: The resource is being created: copying, downloading, or building of the image is in progress.
@@ -0,0 +1,208 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ClusterVirtualImageCatalogKind     = "ClusterVirtualImageCatalog"
+	ClusterVirtualImageCatalogResource = "clustervirtualimagecatalogs"
+
+	// ImageCatalogChannelLatest is the channel pointing to the newest ready version of the catalog.
+	ImageCatalogChannelLatest = "latest"
+)
+
+// Tracks the versions of an image published in a source and keeps a ClusterVirtualImage for each of them.
+//
+// The source is periodically checked for new versions. A ClusterVirtualImage is created for every new version, and the oldest versions are removed according to the history limit.
+// Channels point to the versions: the `latest` channel always points to the newest ready version, and other channels can be pinned to a version or follow the newest version after a delay.
+// A VirtualDisk can be created from a channel: the channel is resolved to the ClusterVirtualImage of its version when the disk is created.
+//
+// **Note:** The `metadata.name` field must comply with [Kubernetes object naming conventions](https://kubernetes.io/docs/concepts/overview/working-with-objects/names/).
+// +kubebuilder:object:root=true
+// +crd-enricher:deckhouse:documentation:examples={apiVersion: virtualization.deckhouse.io/v1alpha2, kind: ClusterVirtualImageCatalog, metadata: {name: ubuntu-noble}, spec: {source: {type: ContainerImage, containerImage: {repository: registry.example.com/images/ubuntu, tagPattern: '^24\.04\.[0-9]+$'}}, checkInterval: 6h, historyLimit: 3, channels: [{name: stable, delay: 168h}]}}
+// +kubebuilder:metadata:labels={heritage=deckhouse,module=virtualization,backup.deckhouse.io/cluster-config=true}
+// +kubebuilder:resource:categories={virtualization-cluster},scope=Cluster,shortName={cvic},singular=clustervirtualimagecatalog
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Latest",type=string,JSONPath=`.status.channels[?(@.name=="latest")].version`
+// +kubebuilder:printcolumn:name="LastCheck",type=date,JSONPath=`.status.lastCheckTime`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterVirtualImageCatalog struct {
+	metav1.TypeMeta `json:",inline"`
+
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ClusterVirtualImageCatalogSpec `json:"spec"`
+
+	Status ClusterVirtualImageCatalogStatus `json:"status,omitempty"`
+}
+
+// ClusterVirtualImageCatalogList provides the needed parameters
+// for requesting a list of ClusterVirtualImageCatalogs from the system.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterVirtualImageCatalogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// Items provides a list of ClusterVirtualImageCatalogs.
+	Items []ClusterVirtualImageCatalog `json:"items"`
+}
+
+// +kubebuilder:validation:XValidation:rule="!has(self.channels) || self.channels.all(c, c.name != 'latest')",message="The latest channel is maintained by the controller and cannot be configured."
+type ClusterVirtualImageCatalogSpec struct {
+	Source ImageCatalogSource `json:"source"`
+	// Interval between the checks of the source for new versions.
+	// +kubebuilder:default:="1h"
+	// +optional
+	CheckInterval metav1.Duration `json:"checkInterval,omitempty"`
+	// Number of the newest versions to keep. The ClusterVirtualImages of the older versions are deleted, unless a channel points to them.
+	// +kubebuilder:default:=3
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	HistoryLimit int32 `json:"historyLimit,omitempty"`
+	// Compression of the images stored in DVCR. If omitted, the `dvcr.compression` setting of the module is used.
+	// +optional
+	Compression ImageCompression `json:"compression,omitempty"`
+	// Channels pointing to the versions of the image in addition to the `latest` channel.
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Channels []ImageCatalogChannel `json:"channels,omitempty"`
+}
+
+// Source of the image versions.
+// +kubebuilder:validation:XValidation:rule="self.type == 'ContainerImage' ? has(self.containerImage) && !has(self.http) : true",message="ContainerImage requires containerImage and cannot have HTTP."
+// +kubebuilder:validation:XValidation:rule="self.type == 'HTTP' ? has(self.http) && !has(self.containerImage) : true",message="HTTP requires http and cannot have ContainerImage."
+type ImageCatalogSource struct {
+	Type           ImageCatalogSourceType      `json:"type"`
+	ContainerImage *ImageCatalogContainerImage `json:"containerImage,omitempty"`
+	HTTP           *ImageCatalogHTTPDirectory  `json:"http,omitempty"`
+}
+
+// The type of the image catalog source:
+//
+// * `ContainerImage`: The versions are the tags of a repository in a container registry.
+// * `HTTP`: The versions are the files listed on an HTTP directory index page.
+// +kubebuilder:validation:Enum:={ContainerImage,HTTP}
+type ImageCatalogSourceType string
+
+const (
+	ImageCatalogSourceTypeContainerImage ImageCatalogSourceType = "ContainerImage"
+	ImageCatalogSourceTypeHTTP           ImageCatalogSourceType = "HTTP"
+)
+
+// Use the tags of a repository in a container registry as the versions.
+type ImageCatalogContainerImage struct {
+	// Path to the repository in the container registry, without a tag.
+	// +kubebuilder:example:="registry.example.com/images/ubuntu"
+	// +kubebuilder:validation:Pattern:=`^(?:(?:(?:localhost|[\w-]+(?:\.[\w-]+)+)(?::\d+)?|[\w]+:\d+)/)?[a-z0-9_.-]+(?:/[a-z0-9_.-]+)*$`
+	Repository string `json:"repository"`
+	// Regular expression the whole tag must match to be tracked. If the expression has a capturing group, the version is the text it matches, otherwise the version is the whole tag.
+	// All tags are tracked if omitted.
+	// +kubebuilder:example:="^24\\.04\\.[0-9]+$"
+	// +optional
+	TagPattern string `json:"tagPattern,omitempty"`
+	// +optional
+	ImagePullSecret ImagePullSecret `json:"imagePullSecret,omitempty"`
+	// CA chain in Base64 format to verify the container registry.
+	// +kubebuilder:example:="YWFhCg=="
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+}
+
+// Use the files listed on an HTTP directory index page as the versions.
+type ImageCatalogHTTPDirectory struct {
+	// URL of the directory index page. The links on the page are resolved relative to it.
+	// +kubebuilder:example:="https://mirror.example.com/images/ubuntu/"
+	// +kubebuilder:validation:Pattern=`^http[s]?:\/\/(?:[a-zA-Z]|[0-9]|[$-_@.&+]|[!*\(\),]|(?:%[0-9a-fA-F][0-9a-fA-F]))+$`
+	URL string `json:"url"`
+	// Regular expression the file name of a link must match to be tracked. If the expression has a capturing group, the version is the text it matches, otherwise the version is the whole file name.
+	// +kubebuilder:example:="^ubuntu-(24\\.04\\.[0-9]+)-server-cloudimg-amd64\\.img$"
+	FilePattern string `json:"filePattern"`
+	// CA chain in Base64 format to verify the URL.
+	// +kubebuilder:example:="YWFhCg=="
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+}
+
+// A channel pointing to a version of the image.
+// +kubebuilder:validation:XValidation:rule="!(has(self.version) && has(self.delay))",message="A channel can be either pinned to a version or follow the newest version after a delay."
+type ImageCatalogChannel struct {
+	// Name of the channel.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+	// Version the channel is pinned to. The version is kept regardless of the history limit.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// Time a version must be ready before the channel moves to it. Without a version and a delay, the channel follows the `latest` channel.
+	// +optional
+	Delay *metav1.Duration `json:"delay,omitempty"`
+}
+
+type ClusterVirtualImageCatalogStatus struct {
+	// Versions found in the source, starting with the newest one.
+	// +optional
+	Versions []ImageCatalogVersion `json:"versions,omitempty"`
+	// Versions the channels point to.
+	// +optional
+	Channels []ImageCatalogChannelStatus `json:"channels,omitempty"`
+	// Time of the last successful check of the source.
+	// +optional
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+	// The latest available observations of an object's current state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Resource generation last processed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+type ImageCatalogVersion struct {
+	// Version of the image.
+	Version string `json:"version"`
+	// Container image or URL the version is imported from.
+	Source string `json:"source"`
+	// Name of the ClusterVirtualImage of the version.
+	ClusterVirtualImage string `json:"clusterVirtualImage"`
+	// Current phase of the ClusterVirtualImage.
+	// +optional
+	Phase ImagePhase `json:"phase,omitempty"`
+	// Time the version was found in the source.
+	DiscoveredAt metav1.Time `json:"discoveredAt"`
+	// Time the ClusterVirtualImage of the version became ready.
+	// +optional
+	ReadyAt *metav1.Time `json:"readyAt,omitempty"`
+}
+
+type ImageCatalogChannelStatus struct {
+	// Name of the channel.
+	Name string `json:"name"`
+	// Version the channel points to. Empty if no version matches the channel yet.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// Name of the ClusterVirtualImage of the version.
+	// +optional
+	ClusterVirtualImage string `json:"clusterVirtualImage,omitempty"`
+}
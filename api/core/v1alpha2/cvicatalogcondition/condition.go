@@ -0,0 +1,60 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cvicatalogcondition
+
+// Type represents the various condition types for the `ClusterVirtualImageCatalog`.
+type Type string
+
+func (s Type) String() string {
+	return string(s)
+}
+
+const (
+	// SyncedType indicates whether the versions of the catalog are in sync with the source.
+	SyncedType Type = "Synced"
+	// ReadyType indicates whether every channel of the catalog points to a ready version.
+	ReadyType Type = "Ready"
+)
+
+type (
+	// SyncedReason represents the various reasons for the `Synced` condition type.
+	SyncedReason string
+	// ReadyReason represents the various reasons for the `Ready` condition type.
+	ReadyReason string
+)
+
+func (s SyncedReason) String() string {
+	return string(s)
+}
+
+func (s ReadyReason) String() string {
+	return string(s)
+}
+
+const (
+	// Synced signifies that the last check of the source has succeeded.
+	Synced SyncedReason = "Synced"
+	// InvalidPattern signifies that the tag or file pattern is not a valid regular expression.
+	InvalidPattern SyncedReason = "InvalidPattern"
+	// SourceCheckFailed signifies that the source could not be checked for new versions.
+	SourceCheckFailed SyncedReason = "SourceCheckFailed"
+
+	// Ready signifies that every channel points to a ready version.
+	Ready ReadyReason = "Ready"
+	// ChannelNotResolved signifies that a channel does not point to a ready version yet.
+	ChannelNotResolved ReadyReason = "ChannelNotResolved"
+)
@@ -32,6 +32,9 @@ const (
 	InUseType Type = "InUse"
 	// TerminatingType indicates that the ClusterVirtualImage is being deleted and reports what the deletion is waiting for.
 	TerminatingType Type = "Terminating"
+	// ReplicatedType indicates whether the image of the ClusterVirtualImage is copied to the DVCR mirror registry.
+	// The condition is present only when DVCR replication is configured.
+	ReplicatedType Type = "Replicated"
 )
 
 type (
@@ -43,6 +46,8 @@ type (
 	InUseReason string
 	// TerminatingReason represents the various reasons for the Terminating condition type.
 	TerminatingReason string
+	// ReplicatedReason represents the various reasons for the Replicated condition type.
+	ReplicatedReason string
 )
 
 func (s DatasourceReadyReason) String() string {
@@ -61,6 +66,10 @@ func (s TerminatingReason) String() string {
 	return string(s)
 }
 
+func (s ReplicatedReason) String() string {
+	return string(s)
+}
+
 const (
 	// DatasourceReady indicates that the datasource is ready for use, allowing the import process to start.
 	DatasourceReady DatasourceReadyReason = "DatasourceReady"
@@ -89,6 +98,10 @@ const (
 	ProvisioningNotStarted ReadyReason = "ProvisioningNotStarted"
 	// ProvisioningFailed indicates that the provisioning process has failed.
 	ProvisioningFailed ReadyReason = "ProvisioningFailed"
+	// SignatureVerificationFailed indicates that the container image has no signature made with a trusted key, so it was not imported.
+	SignatureVerificationFailed ReadyReason = "SignatureVerificationFailed"
+	// CustomizationFailed indicates that the offline customization of the image has failed, so the image was not imported.
+	CustomizationFailed ReadyReason = "CustomizationFailed"
 	// ProvisioningFailedTerminally indicates that the provisioning process has failed permanently: retrying reproduces the same failure, so the provisioner is cleaned up and the failure is kept.
 	ProvisioningFailedTerminally ReadyReason = "ProvisioningFailedTerminally"
 	// Ready indicates that the import process is complete and the `ClusterVirtualImage` is ready for use.
@@ -104,4 +117,11 @@ const (
 	// CleanupPending indicates that the auxiliary resources of the ClusterVirtualImage are still being deleted.
 	// A deletion held by a VirtualMachine is reported by the InUse condition instead.
 	CleanupPending TerminatingReason = "CleanupPending"
+
+	// Replicated indicates that the mirror registry holds the same image as DVCR.
+	Replicated ReplicatedReason = "Replicated"
+	// Rehydrated indicates that the image was missing in DVCR and has been restored from the mirror registry.
+	Rehydrated ReplicatedReason = "Rehydrated"
+	// ReplicationFailed indicates that the image could not be copied to or from the mirror registry.
+	ReplicationFailed ReplicatedReason = "ReplicationFailed"
 )
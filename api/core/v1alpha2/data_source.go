@@ -52,6 +52,20 @@ type DataSourceUpload struct {
 	Checksum *Checksum `json:"checksum,omitempty"`
 }
 
+// Verify the container image signature before importing the image into DVCR.
+// Signatures are looked up in the source registry the way cosign stores them:
+// as the `sha256-<digest>.sig` tag next to the image.
+type ImageSignatureVerification struct {
+	// Require the image to carry a signature made with one of the trusted keys.
+	// If `false`, an unsigned image is imported, while an image with signatures that match none of the trusted keys is still rejected.
+	// +kubebuilder:default:=true
+	Required bool `json:"required"`
+	// PEM-encoded public keys or X.509 certificates trusted to sign the image.
+	// ECDSA, RSA and Ed25519 keys are supported.
+	// +kubebuilder:validation:MinItems:=1
+	TrustedKeys []string `json:"trustedKeys"`
+}
+
 type ImagePullSecret struct {
 	// Name of the secret keeping container registry credentials.
 	Name string `json:"name,omitempty"`
@@ -94,8 +94,16 @@ const (
 	// ReasonVMSOPInProgress is event reason that the operation is in progress
 	ReasonVMSOPInProgress = "VirtualMachineSnapshotOperationInProgress"
 
+	// ReasonVDExportReady is event reason that the exported disk can be downloaded.
+	ReasonVDExportReady = "VirtualDiskExportReady"
+
+	// ReasonErrVDExportFailed is event reason that the disk export is failed.
+	ReasonErrVDExportFailed = "VirtualDiskExportFailed"
+
 	// ReasonVDSpecHasBeenChanged is event reason that spec of virtual disk has been changed.
 	ReasonVDSpecHasBeenChanged = "VirtualDiskSpecHasBeenChanged"
+	// ReasonVDSourceImageChanged is event reason that the source image of virtual disk has changed and the disk is recreated.
+	ReasonVDSourceImageChanged = "VirtualDiskSourceImageChanged"
 	// ReasonVISpecHasBeenChanged is event reason that spec of virtual image has been changed.
 	ReasonVISpecHasBeenChanged = "VirtualImageSpecHasBeenChanged"
 
@@ -0,0 +1,85 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+// Offline customization of the image performed on import, before the image becomes `Ready`.
+// The image is modified without booting it, with the libguestfs tools: the packages are installed first,
+// then the image is cleaned up with sysprep, and then the files, the password and the SSH keys are set.
+// The customization is supported for the `HTTP` and `ContainerImage` data sources and not supported for ISO images.
+type ImageCustomization struct {
+	// Files to write into the image. Existing files are overwritten, missing parent directories are created.
+	// +kubebuilder:validation:MaxItems:=64
+	// +optional
+	Files []ImageCustomizationFile `json:"files,omitempty"`
+	// Password of the `root` user as a crypt(3) hash, for example, generated with `openssl passwd -6`.
+	// The hash is stored in the resource as is, so never specify a plain-text password.
+	// +kubebuilder:validation:Pattern:=`^\$[a-zA-Z0-9]+\$[a-zA-Z0-9./$=,]+$`
+	// +optional
+	RootPasswordHash string `json:"rootPasswordHash,omitempty"`
+	// SSH public keys to authorize for the users of the image.
+	// +optional
+	SSHAuthorizedKeys []ImageCustomizationSSHAuthorizedKeys `json:"sshAuthorizedKeys,omitempty"`
+	// Packages to install with the package manager of the image.
+	// +optional
+	Packages *ImageCustomizationPackages `json:"packages,omitempty"`
+	// Cleanup of the image before publishing: removal of the machine ID, SSH host keys, logs and so on.
+	// +optional
+	Sysprep *ImageCustomizationSysprep `json:"sysprep,omitempty"`
+}
+
+type ImageCustomizationFile struct {
+	// Absolute path of the file in the image.
+	// +kubebuilder:validation:Pattern:=`^/`
+	Path string `json:"path"`
+	// Content of the file.
+	Content string `json:"content"`
+	// Permissions of the file in octal notation.
+	// +kubebuilder:default:="0644"
+	// +kubebuilder:validation:Pattern:=`^0?[0-7]{3}$`
+	// +optional
+	Permissions string `json:"permissions,omitempty"`
+}
+
+type ImageCustomizationSSHAuthorizedKeys struct {
+	// Name of the user. The user must exist in the image.
+	// +kubebuilder:default:="root"
+	// +optional
+	User string `json:"user,omitempty"`
+	// SSH public keys to append to the `authorized_keys` file of the user.
+	// +kubebuilder:validation:MinItems:=1
+	Keys []string `json:"keys"`
+}
+
+type ImageCustomizationPackages struct {
+	// Names of the packages to install.
+	// +kubebuilder:validation:MinItems:=1
+	Names []string `json:"names"`
+	// URL of the repository to install the packages from, for example, a local mirror.
+	// The repository is used only for the installation and is not kept in the image.
+	// For APT, the repository must be a flat repository.
+	// If omitted, the repositories configured in the image are used.
+	// +kubebuilder:example:="http://repo.example.com/ubuntu-24.04/"
+	// +optional
+	Repository string `json:"repository,omitempty"`
+}
+
+type ImageCustomizationSysprep struct {
+	// Operations of `virt-sysprep` to perform. If omitted, the default operations are performed.
+	// +kubebuilder:example:={"machine-id","ssh-hostkeys","logfiles"}
+	// +optional
+	Operations []string `json:"operations,omitempty"`
+}
@@ -67,3 +67,35 @@ type ImageStatusSize struct {
 	// +kubebuilder:example:=1000000234
 	UnpackedBytes string `json:"unpackedBytes,omitempty"`
 }
+
+// Results of the image content inspection performed on import.
+// The image is inspected offline and read-only: only the partition table and the headers of partitions and filesystems are read.
+type ImageStatusInspection struct {
+	// Family of the operating system detected in the image.
+	// +kubebuilder:example:="Linux"
+	OSFamily ImageOSFamily `json:"osFamily,omitempty"`
+	// Version of the operating system. It is detected on a best-effort basis, from the volume label of ISO images.
+	// +kubebuilder:example:="24.04.1"
+	OSVersion string `json:"osVersion,omitempty"`
+	// Type of the partition table of the image.
+	// +kubebuilder:example:="GPT"
+	PartitionTable ImagePartitionTable `json:"partitionTable,omitempty"`
+	// Whether the image has an EFI system partition or, for ISO images, an EFI boot image.
+	EFISystemPartition bool `json:"efiSystemPartition,omitempty"`
+	// Whether the image can be booted with BIOS: it has boot code in the MBR, a BIOS boot partition or, for ISO images, a BIOS boot image.
+	BIOSBootable bool `json:"biosBootable,omitempty"`
+}
+
+type ImageOSFamily string
+
+const (
+	ImageOSFamilyLinux   ImageOSFamily = "Linux"
+	ImageOSFamilyWindows ImageOSFamily = "Windows"
+)
+
+type ImagePartitionTable string
+
+const (
+	ImagePartitionTableGPT ImagePartitionTable = "GPT"
+	ImagePartitionTableMBR ImagePartitionTable = "MBR"
+)
@@ -0,0 +1,158 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	IPAddressPoolKind     = "IPAddressPool"
+	IPAddressPoolResource = "ipaddresspools"
+)
+
+// IPAddressPool defines static addressing of the virtual machine interfaces connected to additional networks.
+//
+// The pool assigns an address from its subnets, one per IP family, to each interface connected to the networks
+// listed in `networks` of the virtual machines in the namespaces selected by `namespaceSelector`.
+// An interface with a MAC address listed in `reservations` always gets the reserved address.
+// The assigned addresses, the gateway and the DNS settings are passed to the guest OS in the cloud-init network configuration.
+//
+// If several pools apply to an interface, the pool that has already assigned an address to it is used, otherwise
+// the pool with the lexicographically smallest name. The networks with IPAM configured in the SDN module are served by SDN,
+// and the pools do not apply to them.
+// +kubebuilder:object:root=true
+// +kubebuilder:metadata:labels={heritage=deckhouse,module=virtualization,backup.deckhouse.io/cluster-config=true}
+// +kubebuilder:resource:categories={virtualization-cluster},scope=Cluster,shortName={ippool},singular=ipaddresspool
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Allocated",type="integer",JSONPath=".status.allocated",description="Number of the assigned addresses."
+// +kubebuilder:printcolumn:name="Capacity",type="integer",JSONPath=".status.capacity",description="Number of the addresses available for assignment."
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="Whether the addresses are assigned from the pool."
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time of resource creation."
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type IPAddressPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IPAddressPoolSpec   `json:"spec"`
+	Status IPAddressPoolStatus `json:"status,omitempty"`
+}
+
+// IPAddressPoolList contains a list of IPAddressPool resources.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type IPAddressPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []IPAddressPool `json:"items"`
+}
+
+type IPAddressPoolSpec struct {
+	// Additional networks the pool assigns addresses in, as referenced in `.spec.networks` of a virtual machine.
+	// +kubebuilder:validation:MinItems=1
+	Networks []IPAddressPoolNetwork `json:"networks"`
+	// Label selector of the namespaces the pool applies to. If omitted, the pool applies to all namespaces.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// Subnets the addresses are assigned from. At most one subnet per IP family.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=2
+	Subnets []IPAddressPoolSubnet `json:"subnets"`
+	// DNS settings passed to the guest OS along with the addresses.
+	DNS *IPAddressPoolDNS `json:"dns,omitempty"`
+	// Addresses reserved for the interfaces with the specified MAC addresses.
+	Reservations []IPAddressPoolReservation `json:"reservations,omitempty"`
+}
+
+// Network the pool assigns addresses in.
+type IPAddressPoolNetwork struct {
+	// Type of the network.
+	// +kubebuilder:validation:Enum=Network;ClusterNetwork
+	Type string `json:"type"`
+	// Name of the network.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// Subnet the addresses are assigned from. The first and the last addresses of the subnet are never assigned.
+type IPAddressPoolSubnet struct {
+	// Subnet in CIDR notation.
+	// +kubebuilder:example:="192.168.10.0/24"
+	CIDR string `json:"cidr"`
+	// Gateway of the subnet. It is configured in the guest OS as a default route with a lower priority than the default route of the Main network,
+	// and is never assigned to an interface.
+	// +kubebuilder:example:="192.168.10.1"
+	Gateway string `json:"gateway,omitempty"`
+	// Ranges of the subnet that are never assigned, for example, the addresses of the network equipment.
+	ExcludedRanges []IPAddressPoolRange `json:"excludedRanges,omitempty"`
+}
+
+// Range of the addresses, both ends inclusive.
+type IPAddressPoolRange struct {
+	// First address of the range.
+	// +kubebuilder:example:="192.168.10.2"
+	Start string `json:"start"`
+	// Last address of the range. If omitted, the range consists of the `start` address only.
+	// +kubebuilder:example:="192.168.10.9"
+	End string `json:"end,omitempty"`
+}
+
+// DNS settings of the interfaces.
+type IPAddressPoolDNS struct {
+	// Addresses of the DNS servers.
+	Nameservers []string `json:"nameservers,omitempty"`
+	// Search domains.
+	Search []string `json:"search,omitempty"`
+}
+
+// Address reserved for the interface with the MAC address.
+type IPAddressPoolReservation struct {
+	// MAC address of the interface.
+	// +kubebuilder:example:="00:1a:2b:10:00:01"
+	// +kubebuilder:validation:Pattern=`^([0-9a-fA-F]{2}:){5}[0-9a-fA-F]{2}$`
+	MACAddress string `json:"macAddress"`
+	// Reserved address. It must belong to one of the subnets of the pool and must not be excluded.
+	// +kubebuilder:example:="192.168.10.10"
+	Address string `json:"address"`
+}
+
+type IPAddressPoolStatus struct {
+	// Number of the addresses available for assignment.
+	Capacity int64 `json:"capacity,omitempty"`
+	// Number of the assigned addresses.
+	Allocated int64 `json:"allocated,omitempty"`
+	// Addresses assigned to the interfaces of the virtual machines.
+	Allocations []IPAddressPoolAllocation `json:"allocations,omitempty"`
+	// The latest detailed observations of the IPAddressPool resource.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Resource generation last processed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// Address assigned to an interface of a virtual machine.
+type IPAddressPoolAllocation struct {
+	// Assigned address.
+	Address string `json:"address"`
+	// MAC address of the interface.
+	MACAddress string `json:"macAddress"`
+	// Namespace of the virtual machine.
+	Namespace string `json:"namespace"`
+	// Name of the virtual machine.
+	VirtualMachine string `json:"virtualMachine"`
+	// Network the interface is connected to.
+	Network IPAddressPoolNetwork `json:"network"`
+}
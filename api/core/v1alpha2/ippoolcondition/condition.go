@@ -0,0 +1,45 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ippoolcondition
+
+// Type represents the various condition types for the `IPAddressPool`.
+type Type string
+
+func (s Type) String() string {
+	return string(s)
+}
+
+const (
+	// ReadyType indicates that the addresses are assigned from the pool.
+	ReadyType Type = "Ready"
+)
+
+// ReadyReason represents the various reasons for the `Ready` condition type.
+type ReadyReason string
+
+func (s ReadyReason) String() string {
+	return string(s)
+}
+
+const (
+	// Ready signifies that the addresses are assigned to all interfaces the pool applies to.
+	Ready ReadyReason = "Ready"
+	// Exhausted signifies that some interfaces the pool applies to have no address because all addresses of the pool are assigned.
+	Exhausted ReadyReason = "Exhausted"
+	// InvalidSpec signifies that the subnets, the DNS settings or the reservations of the pool are invalid.
+	InvalidSpec ReadyReason = "InvalidSpec"
+)
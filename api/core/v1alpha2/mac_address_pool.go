@@ -0,0 +1,109 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	MACAddressPoolKind     = "MACAddressPool"
+	MACAddressPoolResource = "macaddresspools"
+)
+
+// MACAddressPool defines the addresses allocated to the VirtualMachineMACAddress resources.
+//
+// A pool consists of an OUI (Organizationally Unique Identifier) and ranges of the device part of the address.
+// A pool applies to the VirtualMachineMACAddress resources in the namespaces selected by `namespaceSelector`,
+// and, if `networks` is specified, only to the addresses of the interfaces connected to these networks.
+// If several pools apply, the pool bound to the network takes precedence over the pool bound only to the namespaces.
+// If no pool applies, the address is allocated from the OUI derived from the cluster UUID.
+//
+// The leases of the allocated addresses are cluster-wide, so an address is never allocated twice, even if the pools overlap.
+// +kubebuilder:object:root=true
+// +kubebuilder:metadata:labels={heritage=deckhouse,module=virtualization,backup.deckhouse.io/cluster-config=true}
+// +kubebuilder:resource:categories={virtualization-cluster},scope=Cluster,shortName={macpool},singular=macaddresspool
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="OUI",type="string",JSONPath=".spec.oui",description="OUI of the addresses."
+// +kubebuilder:printcolumn:name="Allocated",type="integer",JSONPath=".status.allocated",description="Number of the allocated addresses."
+// +kubebuilder:printcolumn:name="Capacity",type="integer",JSONPath=".status.capacity",description="Number of the addresses in the pool."
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="Whether the addresses are allocated from the pool."
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time of resource creation."
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type MACAddressPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MACAddressPoolSpec   `json:"spec"`
+	Status MACAddressPoolStatus `json:"status,omitempty"`
+}
+
+// MACAddressPoolList contains a list of MACAddressPool resources.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type MACAddressPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []MACAddressPool `json:"items"`
+}
+
+type MACAddressPoolSpec struct {
+	// OUI (the first three octets) of the addresses. The address must be unicast: the least significant bit of the first octet must be zero.
+	// +kubebuilder:example:="00:1a:2b"
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{2}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}$`
+	OUI string `json:"oui"`
+	// Ranges of the device part (the last three octets) of the addresses. If omitted, the whole OUI is used.
+	Ranges []MACAddressPoolRange `json:"ranges,omitempty"`
+	// Label selector of the namespaces the pool applies to. If omitted, the pool applies to all namespaces.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// Networks the pool applies to. If omitted, the pool applies to the interfaces of all networks.
+	Networks []MACAddressPoolNetwork `json:"networks,omitempty"`
+}
+
+// Range of the device part of the addresses, both ends inclusive.
+type MACAddressPoolRange struct {
+	// First device part of the range.
+	// +kubebuilder:example:="10:00:00"
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{2}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}$`
+	Start string `json:"start"`
+	// Last device part of the range. Must not be less than `start`.
+	// +kubebuilder:example:="1f:ff:ff"
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{2}:[0-9a-fA-F]{2}:[0-9a-fA-F]{2}$`
+	End string `json:"end"`
+}
+
+// Network the pool applies to, as referenced in `.spec.networks` of a virtual machine.
+type MACAddressPoolNetwork struct {
+	// Type of the network.
+	// +kubebuilder:validation:Enum=Network;ClusterNetwork
+	Type string `json:"type"`
+	// Name of the network.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+type MACAddressPoolStatus struct {
+	// Number of the addresses in the pool.
+	Capacity int64 `json:"capacity,omitempty"`
+	// Number of the allocated addresses from the pool.
+	Allocated int64 `json:"allocated,omitempty"`
+	// The latest detailed observations of the MACAddressPool resource.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Resource generation last processed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package macpoolcondition
+
+// Type represents the various condition types for the `MACAddressPool`.
+type Type string
+
+func (s Type) String() string {
+	return string(s)
+}
+
+const (
+	// ReadyType indicates that the addresses can be allocated from the pool.
+	ReadyType Type = "Ready"
+)
+
+// ReadyReason represents the various reasons for the `Ready` condition type.
+type ReadyReason string
+
+func (s ReadyReason) String() string {
+	return string(s)
+}
+
+const (
+	// Ready signifies that the addresses can be allocated from the pool.
+	Ready ReadyReason = "Ready"
+	// Exhausted signifies that all addresses of the pool are allocated.
+	Exhausted ReadyReason = "Exhausted"
+	// InvalidSpec signifies that the OUI or the ranges of the pool are invalid.
+	InvalidSpec ReadyReason = "InvalidSpec"
+	// Overlapped signifies that the addresses of the pool overlap with another pool.
+	// The addresses are still allocated from the pool: the leases prevent allocating an address twice.
+	Overlapped ReadyReason = "Overlapped"
+)
@@ -67,6 +67,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&ClusterVirtualImage{},
 		&ClusterVirtualImageList{},
+		&ClusterVirtualImageCatalog{},
+		&ClusterVirtualImageCatalogList{},
 		&VirtualImage{},
 		&VirtualImageList{},
 		&VirtualDisk{},
@@ -87,6 +89,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&VirtualMachineOperationList{},
 		&VirtualDiskSnapshot{},
 		&VirtualDiskSnapshotList{},
+		&VirtualDiskExport{},
+		&VirtualDiskExportList{},
 		&VirtualMachineSnapshot{},
 		&VirtualMachineSnapshotList{},
 		&VirtualMachineSnapshotOperation{},
@@ -95,6 +99,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&VirtualMachineMACAddressList{},
 		&VirtualMachineMACAddressLease{},
 		&VirtualMachineMACAddressLeaseList{},
+		&MACAddressPool{},
+		&MACAddressPoolList{},
+		&IPAddressPool{},
+		&IPAddressPoolList{},
+		&VirtualMachineSecurityGroup{},
+		&VirtualMachineSecurityGroupList{},
 		&NodeUSBDevice{},
 		&NodeUSBDeviceList{},
 		&USBDevice{},
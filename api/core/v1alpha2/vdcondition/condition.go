@@ -40,6 +40,9 @@ const (
 	MigratingType Type = "Migrating"
 	// TerminatingType indicates that the VirtualDisk is being deleted and reports what the deletion is waiting for.
 	TerminatingType Type = "Terminating"
+	// SourceImageUpToDateType indicates whether the disk has been created from the current version of its source image.
+	// It is set only for disks with the `RecreateOnStop` update policy.
+	SourceImageUpToDateType Type = "SourceImageUpToDate"
 )
 
 type (
@@ -59,6 +62,8 @@ type (
 	MigratingReason string
 	// TerminatingReason represents the various reasons for the Terminating condition type.
 	TerminatingReason string
+	// SourceImageUpToDateReason represents the various reasons for the SourceImageUpToDate condition type.
+	SourceImageUpToDateReason string
 )
 
 func (s DatasourceReadyReason) String() string {
@@ -93,6 +98,10 @@ func (s TerminatingReason) String() string {
 	return string(s)
 }
 
+func (s SourceImageUpToDateReason) String() string {
+	return string(s)
+}
+
 const (
 	// DatasourceReady indicates that the datasource is ready for use, allowing the import process to start.
 	DatasourceReady DatasourceReadyReason = "DatasourceReady"
@@ -144,6 +153,8 @@ const (
 
 	// InProgress indicates that the resize request has been detected and the operation is currently in progress.
 	InProgress ResizedReason = "InProgress"
+	// WaitingForVirtualMachine indicates that the underlying PersistentVolumeClaim has been expanded, and the new size is being applied to the running virtual machine.
+	WaitingForVirtualMachine ResizedReason = "WaitingForVirtualMachine"
 	// ResizingNotAvailable indicates that the resize operation is not available for now.
 	ResizingNotAvailable SnapshottingReason = "NotAvailable"
 
@@ -213,3 +224,12 @@ const (
 	// A deletion held by a VirtualMachine is reported by the InUse condition instead.
 	CleanupPending TerminatingReason = "CleanupPending"
 )
+
+const (
+	// SourceImageUpToDate indicates that the disk has been created from the current version of its source image.
+	SourceImageUpToDate SourceImageUpToDateReason = "SourceImageUpToDate"
+	// SourceImageUpdatePending indicates that the source image has changed: the disk will be rebuilt once it is not in use.
+	SourceImageUpdatePending SourceImageUpToDateReason = "UpdatePending"
+	// SourceImageCheckFailed indicates that the digest of the source image could not be resolved.
+	SourceImageCheckFailed SourceImageUpToDateReason = "SourceImageCheckFailed"
+)
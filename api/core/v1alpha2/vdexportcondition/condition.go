@@ -0,0 +1,66 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdexportcondition
+
+// Type represents the various condition types for the `VirtualDiskExport`.
+type Type string
+
+func (s Type) String() string {
+	return string(s)
+}
+
+const (
+	// SourceReadyType indicates that the exported `VirtualDisk` or `VirtualDiskSnapshot` is ready to be served.
+	SourceReadyType Type = "SourceReady"
+	// ReadyType indicates that the exported image can be downloaded.
+	ReadyType Type = "Ready"
+)
+
+type (
+	// SourceReadyReason represents the various reasons for the `SourceReady` condition type.
+	SourceReadyReason string
+	// ReadyReason represents the various reasons for the `Ready` condition type.
+	ReadyReason string
+)
+
+func (s SourceReadyReason) String() string {
+	return string(s)
+}
+
+func (s ReadyReason) String() string {
+	return string(s)
+}
+
+const (
+	// SourceReady signifies that the source is ready and not in use, so the export can start.
+	SourceReady SourceReadyReason = "SourceReady"
+	// SourceNotFound signifies that the source resource does not exist.
+	SourceNotFound SourceReadyReason = "SourceNotFound"
+	// SourceNotReady signifies that the source resource is not ready yet.
+	SourceNotReady SourceReadyReason = "SourceNotReady"
+	// SourceInUse signifies that the virtual disk is attached to a running virtual machine and cannot be exported offline.
+	SourceInUse SourceReadyReason = "SourceInUse"
+
+	// WaitingForTheSource signifies that the export is waiting for the source to become ready.
+	WaitingForTheSource ReadyReason = "WaitingForTheSource"
+	// ExporterStarting signifies that the exporter is starting.
+	ExporterStarting ReadyReason = "ExporterStarting"
+	// Exported signifies that the exported image can be downloaded.
+	Exported ReadyReason = "Exported"
+	// ExportFailed signifies that the exporter has failed or the virtual disk has been attached to a running virtual machine during the export.
+	ExportFailed ReadyReason = "ExportFailed"
+)
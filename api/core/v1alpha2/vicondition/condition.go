@@ -34,6 +34,9 @@ const (
 	InUseType Type = "InUse"
 	// TerminatingType indicates that the VirtualImage is being deleted and reports what the deletion is waiting for.
 	TerminatingType Type = "Terminating"
+	// ReplicatedType indicates whether the image of the VirtualImage is copied to the DVCR mirror registry.
+	// The condition is present only when DVCR replication is configured.
+	ReplicatedType Type = "Replicated"
 )
 
 type (
@@ -47,6 +50,8 @@ type (
 	InUseReason string
 	// TerminatingReason represents the various reasons for the Terminating condition type.
 	TerminatingReason string
+	// ReplicatedReason represents the various reasons for the Replicated condition type.
+	ReplicatedReason string
 )
 
 func (s DatasourceReadyReason) String() string {
@@ -69,6 +74,10 @@ func (s TerminatingReason) String() string {
 	return string(s)
 }
 
+func (s ReplicatedReason) String() string {
+	return string(s)
+}
+
 const (
 	// DatasourceReady indicates that the datasource is ready for use, allowing the import process to start.
 	DatasourceReady DatasourceReadyReason = "DatasourceReady"
@@ -97,6 +106,10 @@ const (
 	ProvisioningNotStarted ReadyReason = "ProvisioningNotStarted"
 	// ProvisioningFailed indicates that the provisioning process has failed.
 	ProvisioningFailed ReadyReason = "ProvisioningFailed"
+	// SignatureVerificationFailed indicates that the container image has no signature made with a trusted key, so it was not imported.
+	SignatureVerificationFailed ReadyReason = "SignatureVerificationFailed"
+	// CustomizationFailed indicates that the offline customization of the image has failed, so the image was not imported.
+	CustomizationFailed ReadyReason = "CustomizationFailed"
 	// ProvisioningFailedTerminally indicates that the provisioning process has failed permanently: retrying reproduces the same failure, so the provisioner is cleaned up and the failure is kept.
 	ProvisioningFailedTerminally ReadyReason = "ProvisioningFailedTerminally"
 	// StorageClassNotReady indicates that the provisioning process pending because `StorageClass` not ready.
@@ -105,6 +118,8 @@ const (
 	Ready ReadyReason = "Ready"
 	// QuotaExceeded indicates that the VirtualImage is reached project quotas and can not be provisioned.
 	QuotaExceeded ReadyReason = "QuotaExceeded"
+	// DVCRSoftQuotaExceeded indicates that the namespace has used up its DVCR soft quota, so the import of the image does not start until some space is freed or the quota is raised.
+	DVCRSoftQuotaExceeded ReadyReason = "DVCRSoftQuotaExceeded"
 	// ImagePullFailed indicates that there was an issue with importing from DVCR.
 	ImagePullFailed ReadyReason = "ImagePullFailed"
 	// DatasourceNotReady indicates that the datasource is not ready, which prevents the import process from starting.
@@ -132,4 +147,11 @@ const (
 	// CleanupPending indicates that the auxiliary resources of the VirtualImage are still being deleted.
 	// A deletion held by a VirtualMachine is reported by the InUse condition instead.
 	CleanupPending TerminatingReason = "CleanupPending"
+
+	// Replicated indicates that the mirror registry holds the same image as DVCR.
+	Replicated ReplicatedReason = "Replicated"
+	// Rehydrated indicates that the image was missing in DVCR and has been restored from the mirror registry.
+	Rehydrated ReplicatedReason = "Rehydrated"
+	// ReplicationFailed indicates that the image could not be copied to or from the mirror registry.
+	ReplicationFailed ReplicatedReason = "ReplicationFailed"
 )
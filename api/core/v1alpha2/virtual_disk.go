@@ -55,11 +55,27 @@ type VirtualDisk struct {
 	Status VirtualDiskStatus `json:"status,omitempty"`
 }
 
+// +kubebuilder:validation:XValidation:rule="!has(self.updatePolicy) || self.updatePolicy == 'None' || (has(self.dataSource) && (self.dataSource.type == 'ContainerImage' || (self.dataSource.type == 'ObjectRef' && has(self.dataSource.objectRef) && self.dataSource.objectRef.kind in ['ClusterVirtualImage', 'ClusterVirtualImageCatalog'])))",message="The RecreateOnStop update policy is only supported for disks created from a container image or a ClusterVirtualImage."
 type VirtualDiskSpec struct {
 	DataSource            *VirtualDiskDataSource           `json:"dataSource,omitempty"`
 	PersistentVolumeClaim VirtualDiskPersistentVolumeClaim `json:"persistentVolumeClaim,omitempty"`
+	// Policy of updating the disk when its source image changes:
+	//
+	// * `None`: The disk is never updated.
+	// * `RecreateOnStop`: The digest of the source image is checked periodically. When it changes, the disk is rebuilt from the new image once no running virtual machine uses it, that is, the next time the virtual machine stops. All data written to the disk is lost.
+	// The policy is supported for disks created from a container image or a ClusterVirtualImage. A ClusterVirtualImage changes when it is recreated with the same name.
+	// +kubebuilder:validation:Enum=None;RecreateOnStop
+	// +optional
+	UpdatePolicy VirtualDiskUpdatePolicy `json:"updatePolicy,omitempty"`
 }
 
+type VirtualDiskUpdatePolicy string
+
+const (
+	VirtualDiskUpdatePolicyNone           VirtualDiskUpdatePolicy = "None"
+	VirtualDiskUpdatePolicyRecreateOnStop VirtualDiskUpdatePolicy = "RecreateOnStop"
+)
+
 type VirtualDiskStatus struct {
 	DownloadSpeed *StatusSpeed `json:"downloadSpeed,omitempty"`
 	// Requested PVC capacity in human-readable format.
@@ -86,6 +102,18 @@ type VirtualDiskStatus struct {
 
 	// Migration information.
 	MigrationState VirtualDiskMigrationState `json:"migrationState,omitempty"`
+	// Digests of the source image tracked for the `RecreateOnStop` update policy.
+	SourceImage *VirtualDiskSourceImage `json:"sourceImage,omitempty"`
+}
+
+// VirtualDiskSourceImage describes the versions of the source image of a disk with the `RecreateOnStop` update policy.
+type VirtualDiskSourceImage struct {
+	// Digest of the source image the disk has been created from.
+	ProvisionedDigest string `json:"provisionedDigest,omitempty"`
+	// Digest of the source image at the last check.
+	LatestDigest string `json:"latestDigest,omitempty"`
+	// Time of the last check of the source image.
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
 }
 
 // VirtualDisk statistics.
@@ -142,21 +170,28 @@ type VirtualDiskContainerImage struct {
 }
 
 // Use an existing VirtualImage, ClusterVirtualImage, or VirtualDiskSnapshot resource to create a disk.
+//
+// A ClusterVirtualImageCatalog reference is resolved when the disk is created: it is replaced with a reference to the ClusterVirtualImage the channel points to.
+// +kubebuilder:validation:XValidation:rule="!has(self.channel) || self.kind == 'ClusterVirtualImageCatalog'",message="The channel can only be specified for a ClusterVirtualImageCatalog."
 type VirtualDiskObjectRef struct {
-	// Kind of the existing VirtualImage, ClusterVirtualImage, or VirtualDiskSnapshot resource.
+	// Kind of the existing VirtualImage, ClusterVirtualImage, ClusterVirtualImageCatalog, or VirtualDiskSnapshot resource.
 	Kind VirtualDiskObjectRefKind `json:"kind"`
-	// Name of the existing VirtualImage, ClusterVirtualImage, or VirtualDiskSnapshot resource.
+	// Name of the existing VirtualImage, ClusterVirtualImage, ClusterVirtualImageCatalog, or VirtualDiskSnapshot resource.
 	// +kubebuilder:validation:MinLength=1
 	Name string `json:"name"`
+	// Channel of the ClusterVirtualImageCatalog. If omitted, the `latest` channel is used.
+	// +optional
+	Channel string `json:"channel,omitempty"`
 }
 
-// +kubebuilder:validation:Enum:={ClusterVirtualImage,VirtualImage,VirtualDiskSnapshot}
+// +kubebuilder:validation:Enum:={ClusterVirtualImage,ClusterVirtualImageCatalog,VirtualImage,VirtualDiskSnapshot}
 type VirtualDiskObjectRefKind string
 
 const (
-	VirtualDiskObjectRefKindVirtualImage        VirtualDiskObjectRefKind = "VirtualImage"
-	VirtualDiskObjectRefKindClusterVirtualImage VirtualDiskObjectRefKind = "ClusterVirtualImage"
-	VirtualDiskObjectRefKindVirtualDiskSnapshot VirtualDiskObjectRefKind = "VirtualDiskSnapshot"
+	VirtualDiskObjectRefKindVirtualImage               VirtualDiskObjectRefKind = "VirtualImage"
+	VirtualDiskObjectRefKindClusterVirtualImage        VirtualDiskObjectRefKind = "ClusterVirtualImage"
+	VirtualDiskObjectRefKindClusterVirtualImageCatalog VirtualDiskObjectRefKind = "ClusterVirtualImageCatalog"
+	VirtualDiskObjectRefKindVirtualDiskSnapshot        VirtualDiskObjectRefKind = "VirtualDiskSnapshot"
 )
 
 type DiskTarget struct {
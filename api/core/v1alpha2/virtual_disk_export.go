@@ -0,0 +1,154 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	VirtualDiskExportKind     = "VirtualDiskExport"
+	VirtualDiskExportResource = "virtualdiskexports"
+)
+
+// VirtualDiskExport publishes a temporary download link for the contents of a virtual disk or a virtual disk snapshot.
+//
+// The export is offline: a virtual disk is served only while it is not attached to a running virtual machine.
+// The resource and the link are removed once the time-to-live expires.
+// +kubebuilder:object:root=true
+// +kubebuilder:metadata:labels={heritage=deckhouse,module=virtualization}
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={virtualization},scope=Namespaced,shortName={vdexport},singular=virtualdiskexport
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="VirtualDiskExport phase."
+// +kubebuilder:printcolumn:name="Kind",type="string",JSONPath=".spec.source.kind",description="Kind of the exported resource."
+// +kubebuilder:printcolumn:name="Source",type="string",JSONPath=".spec.source.name",description="Name of the exported resource."
+// +kubebuilder:printcolumn:name="Format",type="string",JSONPath=".spec.format",description="Format of the exported image."
+// +kubebuilder:printcolumn:name="ExpiresAt",type="date",JSONPath=".status.expiresAt",description="Time when the download link expires."
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time of resource creation."
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualDiskExport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualDiskExportSpec   `json:"spec"`
+	Status VirtualDiskExportStatus `json:"status,omitempty"`
+}
+
+// VirtualDiskExportList contains a list of VirtualDiskExport resources.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualDiskExportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []VirtualDiskExport `json:"items"`
+}
+
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message=".spec is immutable"
+type VirtualDiskExportSpec struct {
+	Source VirtualDiskExportSource `json:"source"`
+	// Format of the exported image:
+	//
+	// * `Raw`: Raw disk image, byte for byte.
+	// * `Qcow2`: QCOW2 image without the unallocated and zeroed clusters of the disk.
+	// +kubebuilder:default:=Raw
+	Format VirtualDiskExportFormat `json:"format,omitempty"`
+	// Compression applied to the exported image on the fly:
+	//
+	// * `None`: The image is served as is.
+	// * `Gzip`: The image is served as a gzip stream.
+	// +kubebuilder:default:=None
+	Compression VirtualDiskExportCompression `json:"compression,omitempty"`
+	// Time the download link stays available after the exporter starts. When it expires, the resource is deleted.
+	// +kubebuilder:default:="24h"
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// Resource to export.
+type VirtualDiskExportSource struct {
+	Kind VirtualDiskExportSourceKind `json:"kind"`
+	// Name of the resource to export. The resource must be in the same namespace as the export.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+// Kind of the exported resource:
+//
+// * `VirtualDisk`: Virtual disk. The disk must not be attached to a running virtual machine.
+// * `VirtualDiskSnapshot`: Virtual disk snapshot. The snapshot is restored into a temporary volume which is removed together with the export.
+// +kubebuilder:validation:Enum=VirtualDisk;VirtualDiskSnapshot
+type VirtualDiskExportSourceKind string
+
+const (
+	VirtualDiskExportSourceKindVirtualDisk         VirtualDiskExportSourceKind = "VirtualDisk"
+	VirtualDiskExportSourceKindVirtualDiskSnapshot VirtualDiskExportSourceKind = "VirtualDiskSnapshot"
+)
+
+// +kubebuilder:validation:Enum=Raw;Qcow2
+type VirtualDiskExportFormat string
+
+const (
+	VirtualDiskExportFormatRaw   VirtualDiskExportFormat = "Raw"
+	VirtualDiskExportFormatQcow2 VirtualDiskExportFormat = "Qcow2"
+)
+
+// +kubebuilder:validation:Enum=None;Gzip
+type VirtualDiskExportCompression string
+
+const (
+	VirtualDiskExportCompressionNone VirtualDiskExportCompression = "None"
+	VirtualDiskExportCompressionGzip VirtualDiskExportCompression = "Gzip"
+)
+
+type VirtualDiskExportStatus struct {
+	Phase VirtualDiskExportPhase `json:"phase"`
+	// Links to download the exported image. Each link carries the access token of the export: treat it as a secret.
+	DownloadURLs *VirtualDiskExportURLs `json:"downloadURLs,omitempty"`
+	// Name of the file the image is served as.
+	// +kubebuilder:example:="disk.qcow2.gz"
+	FileName string `json:"fileName,omitempty"`
+	// Time when the download link expires and the resource is deleted.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+	// The latest detailed observations of the VirtualDiskExport resource.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Resource generation last processed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+type VirtualDiskExportURLs struct {
+	// Link to download the image using `Ingress` from outside the cluster.
+	External string `json:"external,omitempty"`
+	// Link to download the image using `Service` within the cluster.
+	InCluster string `json:"inCluster,omitempty"`
+}
+
+// Current phase of the resource:
+//
+// * `Pending`: The source is not ready to be exported yet.
+// * `InProgress`: The exporter is starting.
+// * `Ready`: The image can be downloaded.
+// * `Failed`: The export has failed.
+// * `Terminating`: The resource is being deleted.
+// +kubebuilder:validation:Enum=Pending;InProgress;Ready;Failed;Terminating
+type VirtualDiskExportPhase string
+
+const (
+	VirtualDiskExportPhasePending     VirtualDiskExportPhase = "Pending"
+	VirtualDiskExportPhaseInProgress  VirtualDiskExportPhase = "InProgress"
+	VirtualDiskExportPhaseReady       VirtualDiskExportPhase = "Ready"
+	VirtualDiskExportPhaseFailed      VirtualDiskExportPhase = "Failed"
+	VirtualDiskExportPhaseTerminating VirtualDiskExportPhase = "Terminating"
+)
@@ -69,11 +69,24 @@ type VirtualImageList struct {
 	Items []VirtualImage `json:"items"`
 }
 
+// +kubebuilder:validation:XValidation:rule="!has(self.customization) || self.dataSource.type == 'HTTP' || self.dataSource.type == 'ContainerImage'",message="The customization is only supported for the HTTP and ContainerImage data sources."
 type VirtualImageSpec struct {
 	// +kubebuilder:default:=ContainerRegistry
 	Storage               StorageType                       `json:"storage"`
 	PersistentVolumeClaim VirtualImagePersistentVolumeClaim `json:"persistentVolumeClaim,omitempty"`
 	DataSource            VirtualImageDataSource            `json:"dataSource"`
+	// Compression of the image stored in DVCR. If omitted, the `dvcr.compression` setting of the module is used.
+	// Ignored for the `PersistentVolumeClaim` storage type.
+	// It is applied when the image is imported and does not affect images that are already stored.
+	//
+	// * `None`: The image is stored uncompressed.
+	// * `Zstd`: The image is compressed with zstd. Mostly-zero raw images take up only a fraction of their size.
+	// * `ZstdSeekable`: The image is compressed with zstd in the seekable format, which allows to read any range of the image without decompressing it whole.
+	// +optional
+	Compression ImageCompression `json:"compression,omitempty"`
+	// Offline customization of the image performed on import.
+	// +optional
+	Customization *ImageCustomization `json:"customization,omitempty"`
 }
 
 type VirtualImageStatus struct {
@@ -85,6 +98,8 @@ type VirtualImageStatus struct {
 	Format string `json:"format,omitempty"`
 	// Whether the image is in a format that needs to be mounted as a CD-ROM drive, such as iso and so on.
 	CDROM bool `json:"cdrom,omitempty"`
+	// Results of the image content inspection: detected operating system, partition table and boot modes.
+	Inspection *ImageStatusInspection `json:"inspection,omitempty"`
 	// Current status of the ClusterVirtualImage resource:
 	// * `Pending`: The resource has been created and is on a waiting queue.
 	// * `Provisioning`: The resource is being created: copying, downloading, or building the image.
@@ -142,6 +157,8 @@ type VirtualImageContainerImage struct {
 	// CA chain in Base64 format to verify the container registry.
 	// +kubebuilder:example:="YWFhCg=="
 	CABundle []byte `json:"caBundle,omitempty"`
+	// Signature verification policy for the image.
+	Verification *ImageSignatureVerification `json:"verification,omitempty"`
 }
 
 // Use an existing VirtualImage, ClusterVirtualImage, VirtualDisk or VirtualDiskSnapshot resource to create an image.
@@ -179,6 +196,16 @@ const (
 	StorageKubernetes StorageType = "Kubernetes"
 )
 
+// Compression of the image stored in DVCR.
+// +kubebuilder:validation:Enum:={None,Zstd,ZstdSeekable}
+type ImageCompression string
+
+const (
+	ImageCompressionNone         ImageCompression = "None"
+	ImageCompressionZstd         ImageCompression = "Zstd"
+	ImageCompressionZstdSeekable ImageCompression = "ZstdSeekable"
+)
+
 // Settings for creating PVCs to store an image with the storage type `PersistentVolumeClaim`.
 type VirtualImagePersistentVolumeClaim struct {
 	// Name of the StorageClass required by the claim. For details on using StorageClass for PVC, refer to — https://kubernetes.io/docs/concepts/storage/persistent-volumes#class-1.
@@ -232,17 +232,35 @@ type Disruptions struct {
 // +kubebuilder:validation:XValidation:rule="self.type == 'UserData' ? has(self.userData) && !has(self.userDataRef) && !has(self.sysprepRef) : true",message="UserData cannot have userDataRef or sysprepRef."
 // +kubebuilder:validation:XValidation:rule="self.type == 'UserDataRef' ? has(self.userDataRef) && !has(self.userData) && !has(self.sysprepRef) : true",message="UserDataRef cannot have userData or sysprepRef."
 // +kubebuilder:validation:XValidation:rule="self.type == 'SysprepRef' ? has(self.sysprepRef) && !has(self.userData) && !has(self.userDataRef) : true",message="SysprepRef cannot have userData or userDataRef."
+// +kubebuilder:validation:XValidation:rule="has(self.networkConfig) ? self.type == 'UserData' : true",message="networkConfig is supported only by the UserData type; use the networkdata key of the secret for UserDataRef."
+// +kubebuilder:validation:XValidation:rule="has(self.networkConfigGeneration) ? self.type != 'SysprepRef' : true",message="SysprepRef cannot have networkConfigGeneration."
 type Provisioning struct {
 	Type ProvisioningType `json:"type"`
 	// Inline cloud-init userdata script.
 	UserData    string       `json:"userData,omitempty"`
 	UserDataRef *UserDataRef `json:"userDataRef,omitempty"`
 	SysprepRef  *SysprepRef  `json:"sysprepRef,omitempty"`
+	// Inline cloud-init network configuration (version 2).
+	// It is merged with the network configuration generated from `.spec.networks`.
+	NetworkConfig string `json:"networkConfig,omitempty"`
+	// NetworkConfigGeneration enables or disables the generation of the cloud-init network configuration
+	// from the network interfaces of the VM. Set to Enabled when the VM is created.
+	NetworkConfigGeneration NetworkConfigGeneration `json:"networkConfigGeneration,omitempty"`
 }
 
+// NetworkConfigGeneration defines whether the cloud-init network configuration is generated.
+// +kubebuilder:validation:Enum={Enabled,Disabled}
+type NetworkConfigGeneration string
+
+const (
+	NetworkConfigGenerationEnabled  NetworkConfigGeneration = "Enabled"
+	NetworkConfigGenerationDisabled NetworkConfigGeneration = "Disabled"
+)
+
 // UserDataRef is reference to an existing resource with a cloud-init script.
 // Resource structure for userDataRef type:
 // * `.data.userData`.
+// * `.data.networkdata` (optional): cloud-init network configuration.
 type UserDataRef struct {
 	// The kind of existing cloud-init automation resource.
 	// The following options are supported:
@@ -308,6 +326,38 @@ type NetworksSpec struct {
 	// Only applicable to additional networks (Network, ClusterNetwork) with IPAM configured (a pool bound to the network).
 	// Ignored if the network has no pool.
 	IPAddressName string `json:"ipAddressName,omitempty"`
+	// Bandwidth limits of the network interface. Only the Main network is supported, and changing the limits requires a restart of the VM.
+	//
+	// Not supported yet: limits of additional networks, which need traffic shaping of the interface tap device in virt-launcher; applying changed limits to a running VM; reporting the applied limits in the VM status.
+	// +optional
+	Bandwidth *NetworkBandwidth `json:"bandwidth,omitempty"`
+}
+
+// NetworkBandwidth defines traffic shaping of a network interface.
+// For the Main network, the limits are passed to the CNI plugin of the cluster in the
+// `kubernetes.io/ingress-bandwidth` and `kubernetes.io/egress-bandwidth` annotations of the VM pod
+// when the pod is created. The limits of additional networks are rejected: the bundled KubeVirt does
+// not shape the traffic of the tap devices of additional interfaces yet.
+// A limit that is not set is not enforced.
+type NetworkBandwidth struct {
+	// Limit of the traffic received by the VM.
+	// +optional
+	Ingress *NetworkBandwidthLimit `json:"ingress,omitempty"`
+	// Limit of the traffic sent by the VM.
+	// +optional
+	Egress *NetworkBandwidthLimit `json:"egress,omitempty"`
+}
+
+// NetworkBandwidthLimit defines the rate of traffic in one direction.
+type NetworkBandwidthLimit struct {
+	// Average rate in bits per second.
+	// +kubebuilder:example="100M"
+	Rate resource.Quantity `json:"rate"`
+	// Amount of data in bytes that can be transferred above the rate at once.
+	// Not applied to the Main network.
+	// +kubebuilder:example="1Mi"
+	// +optional
+	Burst *resource.Quantity `json:"burst,omitempty"`
 }
 
 const (
@@ -327,6 +377,8 @@ type VirtualMachineStatus struct {
 	VirtualMachineIPAddress string `json:"virtualMachineIPAddressName"`
 	// IP address of VM.
 	IPAddress string `json:"ipAddress"`
+	// IP addresses of the VM, one per IP family, the primary one first.
+	Addresses []string `json:"addresses,omitempty"`
 	// The list of attached block device attachments.
 	BlockDeviceRefs []BlockDeviceStatusRef                   `json:"blockDeviceRefs,omitempty"`
 	GuestOSInfo     virtv1.VirtualMachineInstanceGuestOSInfo `json:"guestOSInfo,omitempty"`
@@ -497,7 +549,8 @@ type NetworksStatus struct {
 	MAC                          string `json:"macAddress,omitempty"`
 	VirtualMachineMACAddressName string `json:"virtualMachineMACAddressName,omitempty"`
 	// IPAddress is the IP address allocated for this additional network interface by SDN (from the network pool).
-	// Populated from the pod's network.deckhouse.io/networks-status annotation (ipAddressConfigs[].address).
+	// Populated from the pod's network.deckhouse.io/networks-status annotation (ipAddressConfigs[].address),
+	// or from the assignments of the IPAddressPool serving the interface.
 	// Empty for the Main network or when the additional network has no pool configured.
 	IPAddress string `json:"ipAddress,omitempty"`
 }
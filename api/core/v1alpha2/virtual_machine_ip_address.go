@@ -58,8 +58,35 @@ type VirtualMachineIPAddressSpec struct {
 	Type VirtualMachineIPAddressType `json:"type"`
 	// StaticIP is the requested IP address. If omitted the next available IP address will be assigned.
 	StaticIP string `json:"staticIP,omitempty"`
+	// StaticIPs are the requested IP addresses of a dual-stack address, one per IP family, the primary one first.
+	// If `staticIP` is also specified, it must be the first of them.
+	// Not supported yet for IPv6 addresses, see `ipFamilyPolicy`.
+	// +kubebuilder:validation:MaxItems:=2
+	// +optional
+	StaticIPs []string `json:"staticIPs,omitempty"`
+	// IPFamilyPolicy defines the IP families of the automatically assigned addresses:
+	//
+	// * `SingleStack`: A single address of the primary IP family, the family of the first subnet in `virtualMachineCIDRs`.
+	// * `PreferDualStack`: An address of each IP family configured in `virtualMachineCIDRs`, the primary one first.
+	// * `RequireDualStack`: An IPv4 and an IPv6 address, the primary one first. The address is not assigned if `virtualMachineCIDRs` lack either family.
+	//
+	// For the `Static` type, the families are those of the requested addresses.
+	//
+	// IPv6 and dual-stack addresses are not supported yet: the CNI does not assign the requested IPv6 address to the virtual machine pod, so they are rejected.
+	// +kubebuilder:validation:Enum:={SingleStack,PreferDualStack,RequireDualStack}
+	// +kubebuilder:default:=SingleStack
+	// +optional
+	IPFamilyPolicy VirtualMachineIPAddressFamilyPolicy `json:"ipFamilyPolicy,omitempty"`
 }
 
+type VirtualMachineIPAddressFamilyPolicy string
+
+const (
+	VirtualMachineIPAddressFamilyPolicySingleStack      VirtualMachineIPAddressFamilyPolicy = "SingleStack"
+	VirtualMachineIPAddressFamilyPolicyPreferDualStack  VirtualMachineIPAddressFamilyPolicy = "PreferDualStack"
+	VirtualMachineIPAddressFamilyPolicyRequireDualStack VirtualMachineIPAddressFamilyPolicy = "RequireDualStack"
+)
+
 // VirtualMachineIPAddressStatus is the observed state of `VirtualMachineIPAddress`.
 type VirtualMachineIPAddressStatus struct {
 	// VirtualMachine represents the virtual machine that currently uses this IP address.
@@ -69,6 +96,10 @@ type VirtualMachineIPAddressStatus struct {
 	// Address is the assigned IP address allocated to the virtual machine.
 	Address string `json:"address,omitempty"`
 
+	// Addresses are all the IP addresses allocated to the virtual machine, one per IP family, the primary one first.
+	// Each of them is held by its own `VirtualMachineIPAddressLease`.
+	Addresses []string `json:"addresses,omitempty"`
+
 	// Phase represents the current state of the IP address.
 	// It could indicate whether the IP address is in use, available, or in any other defined state.
 	Phase VirtualMachineIPAddressPhase `json:"phase,omitempty"`
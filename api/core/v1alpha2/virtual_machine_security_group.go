@@ -0,0 +1,146 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	VirtualMachineSecurityGroupKind     = "VirtualMachineSecurityGroup"
+	VirtualMachineSecurityGroupResource = "virtualmachinesecuritygroups"
+)
+
+// VirtualMachineSecurityGroup defines firewall rules for the traffic of virtual machines on the main cluster network.
+//
+// The rules apply to the virtual machines selected by labels in the namespace of the group.
+// The group is compiled into a NetworkPolicy for the pods of the selected virtual machines.
+// Like NetworkPolicy, the groups are additive: a connection is allowed if any group applied to the virtual machine allows it.
+// +kubebuilder:object:root=true
+// +kubebuilder:metadata:labels={heritage=deckhouse,module=virtualization}
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories={virtualization},scope=Namespaced,shortName={vmsg},singular=virtualmachinesecuritygroup
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status",description="Whether the rules are applied."
+// +kubebuilder:printcolumn:name="VirtualMachines",type="string",JSONPath=".status.virtualMachines",description="Virtual machines the rules apply to.",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time of resource creation."
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSecurityGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMachineSecurityGroupSpec   `json:"spec"`
+	Status VirtualMachineSecurityGroupStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSecurityGroupList contains a list of VirtualMachineSecurityGroup resources.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSecurityGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []VirtualMachineSecurityGroup `json:"items"`
+}
+
+type VirtualMachineSecurityGroupSpec struct {
+	// Label selector of the virtual machines the rules apply to. An empty selector selects all virtual machines in the namespace.
+	VirtualMachineSelector metav1.LabelSelector `json:"virtualMachineSelector"`
+	// Directions of the traffic the group restricts:
+	//
+	// * `Ingress`: Only incoming connections allowed by the `ingress` rules are accepted.
+	// * `Egress`: Only outgoing connections allowed by the `egress` rules are accepted.
+	//
+	// If omitted, `Ingress` is restricted always and `Egress` is restricted if the `egress` rules are specified.
+	// +kubebuilder:validation:MaxItems=2
+	// +listType=set
+	PolicyTypes []SecurityGroupPolicyType `json:"policyTypes,omitempty"`
+	// Rules for the incoming connections. No rules with the `Ingress` direction restricted deny all incoming connections.
+	Ingress []SecurityGroupIngressRule `json:"ingress,omitempty"`
+	// Rules for the outgoing connections. No rules with the `Egress` direction restricted deny all outgoing connections.
+	Egress []SecurityGroupEgressRule `json:"egress,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Ingress;Egress
+type SecurityGroupPolicyType string
+
+const (
+	SecurityGroupPolicyTypeIngress SecurityGroupPolicyType = "Ingress"
+	SecurityGroupPolicyTypeEgress  SecurityGroupPolicyType = "Egress"
+)
+
+// Rule for the incoming connections. The connection is allowed if it comes from any of the peers to any of the ports.
+type SecurityGroupIngressRule struct {
+	// Sources of the connections. If omitted, connections from any source are allowed.
+	From []SecurityGroupPeer `json:"from,omitempty"`
+	// Destination ports of the connections. If omitted, connections to any port are allowed.
+	Ports []SecurityGroupPort `json:"ports,omitempty"`
+}
+
+// Rule for the outgoing connections. The connection is allowed if it goes to any of the peers to any of the ports.
+type SecurityGroupEgressRule struct {
+	// Destinations of the connections. If omitted, connections to any destination are allowed.
+	To []SecurityGroupPeer `json:"to,omitempty"`
+	// Destination ports of the connections. If omitted, connections to any port are allowed.
+	Ports []SecurityGroupPort `json:"ports,omitempty"`
+}
+
+// Peer of the connection: virtual machines and pods selected by labels or a block of IP addresses.
+// +kubebuilder:validation:XValidation:rule="!has(self.ipBlock) || (!has(self.virtualMachineSelector) && !has(self.namespaceSelector))",message="ipBlock cannot be combined with virtualMachineSelector or namespaceSelector"
+// +kubebuilder:validation:XValidation:rule="has(self.ipBlock) || has(self.virtualMachineSelector) || has(self.namespaceSelector)",message="one of virtualMachineSelector, namespaceSelector or ipBlock must be specified"
+type SecurityGroupPeer struct {
+	// Label selector of the virtual machines. Without `namespaceSelector`, selects the virtual machines in the namespace of the group.
+	VirtualMachineSelector *metav1.LabelSelector `json:"virtualMachineSelector,omitempty"`
+	// Label selector of the namespaces. Without `virtualMachineSelector`, selects all virtual machines and pods in the namespaces.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// Block of IP addresses.
+	IPBlock *SecurityGroupIPBlock `json:"ipBlock,omitempty"`
+}
+
+type SecurityGroupIPBlock struct {
+	// Block of IP addresses in CIDR notation.
+	// +kubebuilder:example:="10.10.0.0/16"
+	// +kubebuilder:validation:MinLength=1
+	CIDR string `json:"cidr"`
+	// Blocks of IP addresses in CIDR notation to exclude from `cidr`.
+	Except []string `json:"except,omitempty"`
+}
+
+// Port or range of ports of the connection.
+// +kubebuilder:validation:XValidation:rule="!has(self.endPort) || (has(self.port) && self.endPort >= self.port)",message="endPort requires port and must not be less than port"
+type SecurityGroupPort struct {
+	// Protocol of the connection.
+	// +kubebuilder:default:=TCP
+	// +kubebuilder:validation:Enum=TCP;UDP;SCTP
+	Protocol corev1.Protocol `json:"protocol,omitempty"`
+	// Port number. If omitted, all ports of the protocol are matched.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+	// Last port of the range starting at `port`.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	EndPort *int32 `json:"endPort,omitempty"`
+}
+
+type VirtualMachineSecurityGroupStatus struct {
+	// Names of the virtual machines the rules apply to.
+	VirtualMachines []string `json:"virtualMachines,omitempty"`
+	// The latest detailed observations of the VirtualMachineSecurityGroup resource.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Resource generation last processed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
@@ -281,6 +281,8 @@ const (
 	ReasonNetworkNotReady NetworkReadyReason = "NetworkNotReady"
 	// ReasonSDNModuleDisabled indicates that the SDN module is disabled, which may prevent network interfaces from becoming ready.
 	ReasonSDNModuleDisabled NetworkReadyReason = "SDNModuleDisabled"
+	// ReasonWaitingForIPAddressPool indicates that an IPAddressPool serving an additional network interface has not assigned an address to it yet.
+	ReasonWaitingForIPAddressPool NetworkReadyReason = "WaitingForIPAddressPool"
 )
 
 type MigratableReason string
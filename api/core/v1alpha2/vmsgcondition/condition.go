@@ -0,0 +1,44 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vmsgcondition
+
+// Type represents the various condition types for the `VirtualMachineSecurityGroup`.
+type Type string
+
+func (s Type) String() string {
+	return string(s)
+}
+
+const (
+	// ReadyType indicates that the rules of the group are applied to the selected virtual machines.
+	ReadyType Type = "Ready"
+)
+
+// ReadyReason represents the various reasons for the `Ready` condition type.
+type ReadyReason string
+
+func (s ReadyReason) String() string {
+	return string(s)
+}
+
+const (
+	// Applied signifies that the NetworkPolicy of the group is up to date.
+	Applied ReadyReason = "Applied"
+	// InvalidSpec signifies that a label selector, a CIDR or a peer of the group is invalid.
+	// The last applied NetworkPolicy of the group, if any, stays in effect.
+	InvalidSpec ReadyReason = "InvalidSpec"
+)
@@ -209,6 +209,131 @@ func (in *ClusterVirtualImage) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVirtualImageCatalog) DeepCopyInto(out *ClusterVirtualImageCatalog) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVirtualImageCatalog.
+func (in *ClusterVirtualImageCatalog) DeepCopy() *ClusterVirtualImageCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVirtualImageCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterVirtualImageCatalog) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVirtualImageCatalogList) DeepCopyInto(out *ClusterVirtualImageCatalogList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterVirtualImageCatalog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVirtualImageCatalogList.
+func (in *ClusterVirtualImageCatalogList) DeepCopy() *ClusterVirtualImageCatalogList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVirtualImageCatalogList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterVirtualImageCatalogList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVirtualImageCatalogSpec) DeepCopyInto(out *ClusterVirtualImageCatalogSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	out.CheckInterval = in.CheckInterval
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = make([]ImageCatalogChannel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVirtualImageCatalogSpec.
+func (in *ClusterVirtualImageCatalogSpec) DeepCopy() *ClusterVirtualImageCatalogSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVirtualImageCatalogSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterVirtualImageCatalogStatus) DeepCopyInto(out *ClusterVirtualImageCatalogStatus) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]ImageCatalogVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Channels != nil {
+		in, out := &in.Channels, &out.Channels
+		*out = make([]ImageCatalogChannelStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterVirtualImageCatalogStatus.
+func (in *ClusterVirtualImageCatalogStatus) DeepCopy() *ClusterVirtualImageCatalogStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterVirtualImageCatalogStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterVirtualImageContainerImage) DeepCopyInto(out *ClusterVirtualImageContainerImage) {
 	*out = *in
@@ -218,6 +343,11 @@ func (in *ClusterVirtualImageContainerImage) DeepCopyInto(out *ClusterVirtualIma
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ImageSignatureVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -320,6 +450,11 @@ func (in *ClusterVirtualImageObjectRef) DeepCopy() *ClusterVirtualImageObjectRef
 func (in *ClusterVirtualImageSpec) DeepCopyInto(out *ClusterVirtualImageSpec) {
 	*out = *in
 	in.DataSource.DeepCopyInto(&out.DataSource)
+	if in.Customization != nil {
+		in, out := &in.Customization, &out.Customization
+		*out = new(ImageCustomization)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -342,6 +477,11 @@ func (in *ClusterVirtualImageStatus) DeepCopyInto(out *ClusterVirtualImageStatus
 		**out = **in
 	}
 	out.Size = in.Size
+	if in.Inspection != nil {
+		in, out := &in.Inspection, &out.Inspection
+		*out = new(ImageStatusInspection)
+		**out = **in
+	}
 	if in.SourceUID != nil {
 		in, out := &in.SourceUID, &out.SourceUID
 		*out = new(types.UID)
@@ -389,209 +529,881 @@ func (in *ClusterVirtualImageStatusTarget) DeepCopy() *ClusterVirtualImageStatus
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterVirtualImageStatusTarget)
+	out := new(ClusterVirtualImageStatusTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CpuDiscovery) DeepCopyInto(out *CpuDiscovery) {
+	*out = *in
+	in.NodeSelector.DeepCopyInto(&out.NodeSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CpuDiscovery.
+func (in *CpuDiscovery) DeepCopy() *CpuDiscovery {
+	if in == nil {
+		return nil
+	}
+	out := new(CpuDiscovery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CpuFeatures) DeepCopyInto(out *CpuFeatures) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotEnabledCommon != nil {
+		in, out := &in.NotEnabledCommon, &out.NotEnabledCommon
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CpuFeatures.
+func (in *CpuFeatures) DeepCopy() *CpuFeatures {
+	if in == nil {
+		return nil
+	}
+	out := new(CpuFeatures)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataSourceHTTP) DeepCopyInto(out *DataSourceHTTP) {
+	*out = *in
+	if in.Checksum != nil {
+		in, out := &in.Checksum, &out.Checksum
+		*out = new(Checksum)
+		**out = **in
+	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSourceHTTP.
+func (in *DataSourceHTTP) DeepCopy() *DataSourceHTTP {
+	if in == nil {
+		return nil
+	}
+	out := new(DataSourceHTTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataSourceUpload) DeepCopyInto(out *DataSourceUpload) {
+	*out = *in
+	if in.Checksum != nil {
+		in, out := &in.Checksum, &out.Checksum
+		*out = new(Checksum)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSourceUpload.
+func (in *DataSourceUpload) DeepCopy() *DataSourceUpload {
+	if in == nil {
+		return nil
+	}
+	out := new(DataSourceUpload)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskTarget) DeepCopyInto(out *DiskTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskTarget.
+func (in *DiskTarget) DeepCopy() *DiskTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Disruptions) DeepCopyInto(out *Disruptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Disruptions.
+func (in *Disruptions) DeepCopy() *Disruptions {
+	if in == nil {
+		return nil
+	}
+	out := new(Disruptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPUDeviceSpec) DeepCopyInto(out *GPUDeviceSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUDeviceSpec.
+func (in *GPUDeviceSpec) DeepCopy() *GPUDeviceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GPUDeviceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressPool) DeepCopyInto(out *IPAddressPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressPool.
+func (in *IPAddressPool) DeepCopy() *IPAddressPool {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAddressPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressPoolAllocation) DeepCopyInto(out *IPAddressPoolAllocation) {
+	*out = *in
+	out.Network = in.Network
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressPoolAllocation.
+func (in *IPAddressPoolAllocation) DeepCopy() *IPAddressPoolAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressPoolAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressPoolDNS) DeepCopyInto(out *IPAddressPoolDNS) {
+	*out = *in
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Search != nil {
+		in, out := &in.Search, &out.Search
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressPoolDNS.
+func (in *IPAddressPoolDNS) DeepCopy() *IPAddressPoolDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressPoolDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressPoolList) DeepCopyInto(out *IPAddressPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPAddressPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressPoolList.
+func (in *IPAddressPoolList) DeepCopy() *IPAddressPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAddressPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressPoolNetwork) DeepCopyInto(out *IPAddressPoolNetwork) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressPoolNetwork.
+func (in *IPAddressPoolNetwork) DeepCopy() *IPAddressPoolNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressPoolNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressPoolRange) DeepCopyInto(out *IPAddressPoolRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressPoolRange.
+func (in *IPAddressPoolRange) DeepCopy() *IPAddressPoolRange {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressPoolRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressPoolReservation) DeepCopyInto(out *IPAddressPoolReservation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressPoolReservation.
+func (in *IPAddressPoolReservation) DeepCopy() *IPAddressPoolReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressPoolReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressPoolSpec) DeepCopyInto(out *IPAddressPoolSpec) {
+	*out = *in
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]IPAddressPoolNetwork, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]IPAddressPoolSubnet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(IPAddressPoolDNS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reservations != nil {
+		in, out := &in.Reservations, &out.Reservations
+		*out = make([]IPAddressPoolReservation, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressPoolSpec.
+func (in *IPAddressPoolSpec) DeepCopy() *IPAddressPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressPoolStatus) DeepCopyInto(out *IPAddressPoolStatus) {
+	*out = *in
+	if in.Allocations != nil {
+		in, out := &in.Allocations, &out.Allocations
+		*out = make([]IPAddressPoolAllocation, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressPoolStatus.
+func (in *IPAddressPoolStatus) DeepCopy() *IPAddressPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressPoolSubnet) DeepCopyInto(out *IPAddressPoolSubnet) {
+	*out = *in
+	if in.ExcludedRanges != nil {
+		in, out := &in.ExcludedRanges, &out.ExcludedRanges
+		*out = make([]IPAddressPoolRange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressPoolSubnet.
+func (in *IPAddressPoolSubnet) DeepCopy() *IPAddressPoolSubnet {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressPoolSubnet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCatalogChannel) DeepCopyInto(out *ImageCatalogChannel) {
+	*out = *in
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCatalogChannel.
+func (in *ImageCatalogChannel) DeepCopy() *ImageCatalogChannel {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCatalogChannel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCatalogChannelStatus) DeepCopyInto(out *ImageCatalogChannelStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCatalogChannelStatus.
+func (in *ImageCatalogChannelStatus) DeepCopy() *ImageCatalogChannelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCatalogChannelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCatalogContainerImage) DeepCopyInto(out *ImageCatalogContainerImage) {
+	*out = *in
+	out.ImagePullSecret = in.ImagePullSecret
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCatalogContainerImage.
+func (in *ImageCatalogContainerImage) DeepCopy() *ImageCatalogContainerImage {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCatalogContainerImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCatalogHTTPDirectory) DeepCopyInto(out *ImageCatalogHTTPDirectory) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCatalogHTTPDirectory.
+func (in *ImageCatalogHTTPDirectory) DeepCopy() *ImageCatalogHTTPDirectory {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCatalogHTTPDirectory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCatalogSource) DeepCopyInto(out *ImageCatalogSource) {
+	*out = *in
+	if in.ContainerImage != nil {
+		in, out := &in.ContainerImage, &out.ContainerImage
+		*out = new(ImageCatalogContainerImage)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(ImageCatalogHTTPDirectory)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCatalogSource.
+func (in *ImageCatalogSource) DeepCopy() *ImageCatalogSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCatalogSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCatalogVersion) DeepCopyInto(out *ImageCatalogVersion) {
+	*out = *in
+	in.DiscoveredAt.DeepCopyInto(&out.DiscoveredAt)
+	if in.ReadyAt != nil {
+		in, out := &in.ReadyAt, &out.ReadyAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCatalogVersion.
+func (in *ImageCatalogVersion) DeepCopy() *ImageCatalogVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCatalogVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCustomization) DeepCopyInto(out *ImageCustomization) {
+	*out = *in
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]ImageCustomizationFile, len(*in))
+		copy(*out, *in)
+	}
+	if in.SSHAuthorizedKeys != nil {
+		in, out := &in.SSHAuthorizedKeys, &out.SSHAuthorizedKeys
+		*out = make([]ImageCustomizationSSHAuthorizedKeys, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Packages != nil {
+		in, out := &in.Packages, &out.Packages
+		*out = new(ImageCustomizationPackages)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sysprep != nil {
+		in, out := &in.Sysprep, &out.Sysprep
+		*out = new(ImageCustomizationSysprep)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCustomization.
+func (in *ImageCustomization) DeepCopy() *ImageCustomization {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCustomization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCustomizationFile) DeepCopyInto(out *ImageCustomizationFile) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCustomizationFile.
+func (in *ImageCustomizationFile) DeepCopy() *ImageCustomizationFile {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCustomizationFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCustomizationPackages) DeepCopyInto(out *ImageCustomizationPackages) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCustomizationPackages.
+func (in *ImageCustomizationPackages) DeepCopy() *ImageCustomizationPackages {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCustomizationPackages)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCustomizationSSHAuthorizedKeys) DeepCopyInto(out *ImageCustomizationSSHAuthorizedKeys) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCustomizationSSHAuthorizedKeys.
+func (in *ImageCustomizationSSHAuthorizedKeys) DeepCopy() *ImageCustomizationSSHAuthorizedKeys {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCustomizationSSHAuthorizedKeys)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageCustomizationSysprep) DeepCopyInto(out *ImageCustomizationSysprep) {
+	*out = *in
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageCustomizationSysprep.
+func (in *ImageCustomizationSysprep) DeepCopy() *ImageCustomizationSysprep {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageCustomizationSysprep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePullSecret) DeepCopyInto(out *ImagePullSecret) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePullSecret.
+func (in *ImagePullSecret) DeepCopy() *ImagePullSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePullSecret)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CpuDiscovery) DeepCopyInto(out *CpuDiscovery) {
+func (in *ImagePullSecretName) DeepCopyInto(out *ImagePullSecretName) {
 	*out = *in
-	in.NodeSelector.DeepCopyInto(&out.NodeSelector)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CpuDiscovery.
-func (in *CpuDiscovery) DeepCopy() *CpuDiscovery {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePullSecretName.
+func (in *ImagePullSecretName) DeepCopy() *ImagePullSecretName {
 	if in == nil {
 		return nil
 	}
-	out := new(CpuDiscovery)
+	out := new(ImagePullSecretName)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CpuFeatures) DeepCopyInto(out *CpuFeatures) {
+func (in *ImageSignatureVerification) DeepCopyInto(out *ImageSignatureVerification) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.NotEnabledCommon != nil {
-		in, out := &in.NotEnabledCommon, &out.NotEnabledCommon
+	if in.TrustedKeys != nil {
+		in, out := &in.TrustedKeys, &out.TrustedKeys
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CpuFeatures.
-func (in *CpuFeatures) DeepCopy() *CpuFeatures {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSignatureVerification.
+func (in *ImageSignatureVerification) DeepCopy() *ImageSignatureVerification {
 	if in == nil {
 		return nil
 	}
-	out := new(CpuFeatures)
+	out := new(ImageSignatureVerification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataSourceHTTP) DeepCopyInto(out *DataSourceHTTP) {
+func (in *ImageStatusInspection) DeepCopyInto(out *ImageStatusInspection) {
 	*out = *in
-	if in.Checksum != nil {
-		in, out := &in.Checksum, &out.Checksum
-		*out = new(Checksum)
-		**out = **in
-	}
-	if in.CABundle != nil {
-		in, out := &in.CABundle, &out.CABundle
-		*out = make([]byte, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSourceHTTP.
-func (in *DataSourceHTTP) DeepCopy() *DataSourceHTTP {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageStatusInspection.
+func (in *ImageStatusInspection) DeepCopy() *ImageStatusInspection {
 	if in == nil {
 		return nil
 	}
-	out := new(DataSourceHTTP)
+	out := new(ImageStatusInspection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataSourceUpload) DeepCopyInto(out *DataSourceUpload) {
+func (in *ImageStatusSize) DeepCopyInto(out *ImageStatusSize) {
 	*out = *in
-	if in.Checksum != nil {
-		in, out := &in.Checksum, &out.Checksum
-		*out = new(Checksum)
-		**out = **in
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataSourceUpload.
-func (in *DataSourceUpload) DeepCopy() *DataSourceUpload {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageStatusSize.
+func (in *ImageStatusSize) DeepCopy() *ImageStatusSize {
 	if in == nil {
 		return nil
 	}
-	out := new(DataSourceUpload)
+	out := new(ImageStatusSize)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DiskTarget) DeepCopyInto(out *DiskTarget) {
+func (in *ImageUploadURLs) DeepCopyInto(out *ImageUploadURLs) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskTarget.
-func (in *DiskTarget) DeepCopy() *DiskTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageUploadURLs.
+func (in *ImageUploadURLs) DeepCopy() *ImageUploadURLs {
 	if in == nil {
 		return nil
 	}
-	out := new(DiskTarget)
+	out := new(ImageUploadURLs)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Disruptions) DeepCopyInto(out *Disruptions) {
+func (in *MACAddressPool) DeepCopyInto(out *MACAddressPool) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Disruptions.
-func (in *Disruptions) DeepCopy() *Disruptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MACAddressPool.
+func (in *MACAddressPool) DeepCopy() *MACAddressPool {
 	if in == nil {
 		return nil
 	}
-	out := new(Disruptions)
+	out := new(MACAddressPool)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MACAddressPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GPUDeviceSpec) DeepCopyInto(out *GPUDeviceSpec) {
+func (in *MACAddressPoolList) DeepCopyInto(out *MACAddressPoolList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MACAddressPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUDeviceSpec.
-func (in *GPUDeviceSpec) DeepCopy() *GPUDeviceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MACAddressPoolList.
+func (in *MACAddressPoolList) DeepCopy() *MACAddressPoolList {
 	if in == nil {
 		return nil
 	}
-	out := new(GPUDeviceSpec)
+	out := new(MACAddressPoolList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MACAddressPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImagePullSecret) DeepCopyInto(out *ImagePullSecret) {
+func (in *MACAddressPoolNetwork) DeepCopyInto(out *MACAddressPoolNetwork) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePullSecret.
-func (in *ImagePullSecret) DeepCopy() *ImagePullSecret {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MACAddressPoolNetwork.
+func (in *MACAddressPoolNetwork) DeepCopy() *MACAddressPoolNetwork {
 	if in == nil {
 		return nil
 	}
-	out := new(ImagePullSecret)
+	out := new(MACAddressPoolNetwork)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImagePullSecretName) DeepCopyInto(out *ImagePullSecretName) {
+func (in *MACAddressPoolRange) DeepCopyInto(out *MACAddressPoolRange) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePullSecretName.
-func (in *ImagePullSecretName) DeepCopy() *ImagePullSecretName {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MACAddressPoolRange.
+func (in *MACAddressPoolRange) DeepCopy() *MACAddressPoolRange {
 	if in == nil {
 		return nil
 	}
-	out := new(ImagePullSecretName)
+	out := new(MACAddressPoolRange)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageStatusSize) DeepCopyInto(out *ImageStatusSize) {
+func (in *MACAddressPoolSpec) DeepCopyInto(out *MACAddressPoolSpec) {
 	*out = *in
+	if in.Ranges != nil {
+		in, out := &in.Ranges, &out.Ranges
+		*out = make([]MACAddressPoolRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]MACAddressPoolNetwork, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageStatusSize.
-func (in *ImageStatusSize) DeepCopy() *ImageStatusSize {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MACAddressPoolSpec.
+func (in *MACAddressPoolSpec) DeepCopy() *MACAddressPoolSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageStatusSize)
+	out := new(MACAddressPoolSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ImageUploadURLs) DeepCopyInto(out *ImageUploadURLs) {
+func (in *MACAddressPoolStatus) DeepCopyInto(out *MACAddressPoolStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageUploadURLs.
-func (in *ImageUploadURLs) DeepCopy() *ImageUploadURLs {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MACAddressPoolStatus.
+func (in *MACAddressPoolStatus) DeepCopy() *MACAddressPoolStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ImageUploadURLs)
+	out := new(MACAddressPoolStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -690,6 +1502,54 @@ func (in *NameReplacementFrom) DeepCopy() *NameReplacementFrom {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkBandwidth) DeepCopyInto(out *NetworkBandwidth) {
+	*out = *in
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(NetworkBandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(NetworkBandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkBandwidth.
+func (in *NetworkBandwidth) DeepCopy() *NetworkBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkBandwidthLimit) DeepCopyInto(out *NetworkBandwidthLimit) {
+	*out = *in
+	out.Rate = in.Rate.DeepCopy()
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkBandwidthLimit.
+func (in *NetworkBandwidthLimit) DeepCopy() *NetworkBandwidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkBandwidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworksSpec) DeepCopyInto(out *NetworksSpec) {
 	*out = *in
@@ -698,6 +1558,11 @@ func (in *NetworksSpec) DeepCopyInto(out *NetworksSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(NetworkBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -934,19 +1799,157 @@ func (in *ResourceRef) DeepCopy() *ResourceRef {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourcesStatus) DeepCopyInto(out *ResourcesStatus) {
+func (in *ResourcesStatus) DeepCopyInto(out *ResourcesStatus) {
+	*out = *in
+	in.CPU.DeepCopyInto(&out.CPU)
+	in.Memory.DeepCopyInto(&out.Memory)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcesStatus.
+func (in *ResourcesStatus) DeepCopy() *ResourcesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupEgressRule) DeepCopyInto(out *SecurityGroupEgressRule) {
+	*out = *in
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]SecurityGroupPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]SecurityGroupPort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupEgressRule.
+func (in *SecurityGroupEgressRule) DeepCopy() *SecurityGroupEgressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupEgressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupIPBlock) DeepCopyInto(out *SecurityGroupIPBlock) {
+	*out = *in
+	if in.Except != nil {
+		in, out := &in.Except, &out.Except
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupIPBlock.
+func (in *SecurityGroupIPBlock) DeepCopy() *SecurityGroupIPBlock {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupIPBlock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupIngressRule) DeepCopyInto(out *SecurityGroupIngressRule) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]SecurityGroupPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]SecurityGroupPort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupIngressRule.
+func (in *SecurityGroupIngressRule) DeepCopy() *SecurityGroupIngressRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupIngressRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupPeer) DeepCopyInto(out *SecurityGroupPeer) {
+	*out = *in
+	if in.VirtualMachineSelector != nil {
+		in, out := &in.VirtualMachineSelector, &out.VirtualMachineSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPBlock != nil {
+		in, out := &in.IPBlock, &out.IPBlock
+		*out = new(SecurityGroupIPBlock)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupPeer.
+func (in *SecurityGroupPeer) DeepCopy() *SecurityGroupPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupPort) DeepCopyInto(out *SecurityGroupPort) {
 	*out = *in
-	in.CPU.DeepCopyInto(&out.CPU)
-	in.Memory.DeepCopyInto(&out.Memory)
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EndPort != nil {
+		in, out := &in.EndPort, &out.EndPort
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcesStatus.
-func (in *ResourcesStatus) DeepCopy() *ResourcesStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupPort.
+func (in *SecurityGroupPort) DeepCopy() *SecurityGroupPort {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourcesStatus)
+	out := new(SecurityGroupPort)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1460,6 +2463,153 @@ func (in *VirtualDiskDataSource) DeepCopy() *VirtualDiskDataSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualDiskExport) DeepCopyInto(out *VirtualDiskExport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualDiskExport.
+func (in *VirtualDiskExport) DeepCopy() *VirtualDiskExport {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualDiskExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualDiskExport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualDiskExportList) DeepCopyInto(out *VirtualDiskExportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualDiskExport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualDiskExportList.
+func (in *VirtualDiskExportList) DeepCopy() *VirtualDiskExportList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualDiskExportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualDiskExportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualDiskExportSource) DeepCopyInto(out *VirtualDiskExportSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualDiskExportSource.
+func (in *VirtualDiskExportSource) DeepCopy() *VirtualDiskExportSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualDiskExportSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualDiskExportSpec) DeepCopyInto(out *VirtualDiskExportSpec) {
+	*out = *in
+	out.Source = in.Source
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualDiskExportSpec.
+func (in *VirtualDiskExportSpec) DeepCopy() *VirtualDiskExportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualDiskExportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualDiskExportStatus) DeepCopyInto(out *VirtualDiskExportStatus) {
+	*out = *in
+	if in.DownloadURLs != nil {
+		in, out := &in.DownloadURLs, &out.DownloadURLs
+		*out = new(VirtualDiskExportURLs)
+		**out = **in
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualDiskExportStatus.
+func (in *VirtualDiskExportStatus) DeepCopy() *VirtualDiskExportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualDiskExportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualDiskExportURLs) DeepCopyInto(out *VirtualDiskExportURLs) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualDiskExportURLs.
+func (in *VirtualDiskExportURLs) DeepCopy() *VirtualDiskExportURLs {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualDiskExportURLs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualDiskList) DeepCopyInto(out *VirtualDiskList) {
 	*out = *in
@@ -1679,6 +2829,26 @@ func (in *VirtualDiskSnapshotStatus) DeepCopy() *VirtualDiskSnapshotStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualDiskSourceImage) DeepCopyInto(out *VirtualDiskSourceImage) {
+	*out = *in
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualDiskSourceImage.
+func (in *VirtualDiskSourceImage) DeepCopy() *VirtualDiskSourceImage {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualDiskSourceImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualDiskSpec) DeepCopyInto(out *VirtualDiskSpec) {
 	*out = *in
@@ -1787,6 +2957,11 @@ func (in *VirtualDiskStatus) DeepCopyInto(out *VirtualDiskStatus) {
 		}
 	}
 	in.MigrationState.DeepCopyInto(&out.MigrationState)
+	if in.SourceImage != nil {
+		in, out := &in.SourceImage, &out.SourceImage
+		*out = new(VirtualDiskSourceImage)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1855,6 +3030,11 @@ func (in *VirtualImageContainerImage) DeepCopyInto(out *VirtualImageContainerIma
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(ImageSignatureVerification)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1979,6 +3159,11 @@ func (in *VirtualImageSpec) DeepCopyInto(out *VirtualImageSpec) {
 	*out = *in
 	in.PersistentVolumeClaim.DeepCopyInto(&out.PersistentVolumeClaim)
 	in.DataSource.DeepCopyInto(&out.DataSource)
+	if in.Customization != nil {
+		in, out := &in.Customization, &out.Customization
+		*out = new(ImageCustomization)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2001,6 +3186,11 @@ func (in *VirtualImageStatus) DeepCopyInto(out *VirtualImageStatus) {
 		**out = **in
 	}
 	out.Size = in.Size
+	if in.Inspection != nil {
+		in, out := &in.Inspection, &out.Inspection
+		*out = new(ImageStatusInspection)
+		**out = **in
+	}
 	if in.ImageUploadURLs != nil {
 		in, out := &in.ImageUploadURLs, &out.ImageUploadURLs
 		*out = new(ImageUploadURLs)
@@ -2412,7 +3602,7 @@ func (in *VirtualMachineIPAddress) DeepCopyInto(out *VirtualMachineIPAddress) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -2592,6 +3782,11 @@ func (in *VirtualMachineIPAddressList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineIPAddressSpec) DeepCopyInto(out *VirtualMachineIPAddressSpec) {
 	*out = *in
+	if in.StaticIPs != nil {
+		in, out := &in.StaticIPs, &out.StaticIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2608,6 +3803,11 @@ func (in *VirtualMachineIPAddressSpec) DeepCopy() *VirtualMachineIPAddressSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineIPAddressStatus) DeepCopyInto(out *VirtualMachineIPAddressStatus) {
 	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -3307,6 +4507,131 @@ func (in *VirtualMachinePoolStatus) DeepCopy() *VirtualMachinePoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSecurityGroup) DeepCopyInto(out *VirtualMachineSecurityGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSecurityGroup.
+func (in *VirtualMachineSecurityGroup) DeepCopy() *VirtualMachineSecurityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSecurityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSecurityGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSecurityGroupList) DeepCopyInto(out *VirtualMachineSecurityGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineSecurityGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSecurityGroupList.
+func (in *VirtualMachineSecurityGroupList) DeepCopy() *VirtualMachineSecurityGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSecurityGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineSecurityGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSecurityGroupSpec) DeepCopyInto(out *VirtualMachineSecurityGroupSpec) {
+	*out = *in
+	in.VirtualMachineSelector.DeepCopyInto(&out.VirtualMachineSelector)
+	if in.PolicyTypes != nil {
+		in, out := &in.PolicyTypes, &out.PolicyTypes
+		*out = make([]SecurityGroupPolicyType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]SecurityGroupIngressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]SecurityGroupEgressRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSecurityGroupSpec.
+func (in *VirtualMachineSecurityGroupSpec) DeepCopy() *VirtualMachineSecurityGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSecurityGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSecurityGroupStatus) DeepCopyInto(out *VirtualMachineSecurityGroupStatus) {
+	*out = *in
+	if in.VirtualMachines != nil {
+		in, out := &in.VirtualMachines, &out.VirtualMachines
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSecurityGroupStatus.
+func (in *VirtualMachineSecurityGroupStatus) DeepCopy() *VirtualMachineSecurityGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSecurityGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineSnapshot) DeepCopyInto(out *VirtualMachineSnapshot) {
 	*out = *in
@@ -3651,6 +4976,11 @@ func (in *VirtualMachineStats) DeepCopy() *VirtualMachineStats {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.BlockDeviceRefs != nil {
 		in, out := &in.BlockDeviceRefs, &out.BlockDeviceRefs
 		*out = make([]BlockDeviceStatusRef, len(*in))
@@ -0,0 +1,29 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dvcr
+
+// Reasons the importer and the uploader put into the termination message of their pod
+// along with the error. The virtualization-controller reads them back to tell a failure
+// that retrying cannot fix from a transient one, so both sides must use the same strings.
+const (
+	// BadImageChecksumReason means the downloaded image does not match the expected checksum.
+	BadImageChecksumReason = "BadImageChecksum"
+	// SignatureVerificationFailedReason means the image has no signature made with a trusted key.
+	SignatureVerificationFailedReason = "SignatureVerificationFailed"
+	// CustomizationFailedReason means the offline customization of the image failed.
+	CustomizationFailedReason = "CustomizationFailed"
+)
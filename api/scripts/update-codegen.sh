@@ -37,12 +37,17 @@ function source::settings {
                               "VirtualMachineSnapshot"
                               "VirtualMachineOperation"
                               "VirtualMachineSnapshotOperation"
+                              "VirtualDiskExport"
+                              "VirtualMachineSecurityGroup"
                               "VirtualDisk"
                               "VirtualImage"
                               "ClusterVirtualImage"
+                              "ClusterVirtualImageCatalog"
                               "NodeUSBDevice"
                               "USBDevice"
-                              "VirtualMachinePool")
+                              "VirtualMachinePool"
+                              "MACAddressPool"
+                              "IPAddressPool")
 
     # shellcheck source=/dev/null
     source "${CODEGEN_PKG}/kube_codegen.sh"
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/cleaner/registry"
+)
+
+var (
+	CleanupCacheUnusedFor        time.Duration
+	CleanupCacheUnusedForDefault = 7 * 24 * time.Hour
+)
+
+func init() {
+	autoCleanupCmd.Flags().DurationVar(&CleanupCacheUnusedFor, "cache-unused-for", CleanupCacheUnusedForDefault, "delete pull-through cache images not pulled for this period, 0 to keep them")
+}
+
+// cleanupCacheImages deletes pull-through cache images that were not pulled for the CleanupCacheUnusedFor period.
+// A deleted image is pulled from the source registry again by the next import.
+func cleanupCacheImages(now time.Time) error {
+	images, err := registry.ListCacheImages()
+	if err != nil {
+		return err
+	}
+
+	pulls, err := registry.ReadPullLog(registry.PullsFile)
+	if err != nil {
+		return err
+	}
+
+	stale := make([]registry.Image, 0)
+	for _, image := range images {
+		usage, err := registry.GetImageUsage(image, pulls)
+		if err != nil {
+			return fmt.Errorf("get usage of image %s: %w", image.Path, err)
+		}
+
+		// Images are kept until pulls are recorded.
+		if !usage.LastPull.IsZero() && now.Sub(usage.LastPull) >= CleanupCacheUnusedFor {
+			stale = append(stale, image)
+		}
+	}
+
+	if len(stale) == 0 {
+		fmt.Printf("No pull-through cache images unused for %s.\n", CleanupCacheUnusedFor)
+		return nil
+	}
+
+	if err = registry.RemoveImages(stale); err != nil {
+		return fmt.Errorf("remove pull-through cache images: %w", err)
+	}
+
+	return nil
+}
@@ -86,7 +86,7 @@ var (
 )
 
 var autoCleanupCmd = &cobra.Command{
-	Use:           "auto-cleanup [--garbage-collection-secret-name secret] [--garbage-collection-timeout duration]",
+	Use:           "auto-cleanup [--garbage-collection-secret-name secret] [--garbage-collection-timeout duration] [--unused-for duration [--unused-action delete|archive]] [--cache-unused-for duration]",
 	Short:         "`auto-cleanup` deletes all stale images that have no corresponding resource in the cluster and then runs garbage-collect to remove underlying blobs (Note: not to be run with kubectl exec until you 100% sure what are you doing)",
 	Args:          cobra.OnlyValidArgs,
 	RunE:          autoCleanupHandler,
@@ -134,6 +134,10 @@ func Confirm() (bool, error) {
 func autoCleanupHandler(cmd *cobra.Command, args []string) error {
 	started := time.Now().UTC()
 
+	if err := validateUnusedAction(); err != nil {
+		return err
+	}
+
 	if err := ensureRepoDir(); err != nil {
 		return err
 	}
@@ -221,6 +225,20 @@ func performAutoCleanup() error {
 		}
 	}
 
+	if CleanupUnusedFor > 0 {
+		err = cleanupUnusedImages()
+		if err != nil {
+			return err
+		}
+	}
+
+	if CleanupCacheUnusedFor > 0 {
+		err = cleanupCacheImages(time.Now())
+		if err != nil {
+			return err
+		}
+	}
+
 	// Run 'registry garbage-collect' to remove unused blobs.
 	gcContext, _ := context.WithTimeoutCause(context.Background(), GCTimeout, fmt.Errorf("garbage collect command is terminated, it runs more than %s", GCTimeout.String()))
 	stdout, err := registry.ExecGarbageCollect(gcContext)
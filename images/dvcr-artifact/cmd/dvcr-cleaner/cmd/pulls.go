@@ -0,0 +1,101 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/cleaner/registry"
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/registry/replication"
+)
+
+var (
+	RecordPullsListen        string
+	RecordPullsFile          string
+	RecordPullsFlushInterval time.Duration
+)
+
+var RecordPullsCmd = &cobra.Command{
+	Use:           "record-pulls [--listen address]",
+	Short:         "`record-pulls` receives DVCR notifications and records the time of the last pull of every image for the retention policy",
+	Args:          cobra.NoArgs,
+	RunE:          recordPullsHandler,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+}
+
+func init() {
+	RecordPullsCmd.Flags().StringVar(&RecordPullsListen, "listen", "127.0.0.1:5002", "address to receive DVCR notifications on")
+	RecordPullsCmd.Flags().StringVar(&RecordPullsFile, "pulls-file", registry.PullsFile, "file to record the pulls to")
+	RecordPullsCmd.Flags().DurationVar(&RecordPullsFlushInterval, "flush-interval", time.Minute, "interval between writes of the recorded pulls to the file")
+}
+
+func recordPullsHandler(cmd *cobra.Command, _ []string) error {
+	// Pulls made by the replication do not mean that the image is used.
+	recorder, err := registry.NewPullRecorder(RecordPullsFile, replication.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/events", recorder)
+	server := &http.Server{
+		Addr:              RecordPullsListen,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.ListenAndServe()
+	}()
+
+	fmt.Printf("Recording DVCR pulls on %s to %s\n", RecordPullsListen, RecordPullsFile)
+
+	ticker := time.NewTicker(RecordPullsFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err = recorder.Flush(); err != nil {
+				fmt.Printf("Record pulls: %s\n", err)
+			}
+		case err = <-serveErr:
+			return fmt.Errorf("receive DVCR notifications: %w", err)
+		case <-ctx.Done():
+			shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer shutdownCancel()
+			if err = server.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				fmt.Printf("Stop receiving DVCR notifications: %s\n", err)
+			}
+			return recorder.Flush()
+		}
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/cleaner/kubernetes"
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/registry/replication"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2/vicondition"
+)
+
+// Credentials are passed in the environment to keep them out of the process list.
+const (
+	dvcrUsernameEnv   = "DVCR_USERNAME"
+	dvcrPasswordEnv   = "DVCR_PASSWORD"
+	mirrorUsernameEnv = "MIRROR_USERNAME"
+	mirrorPasswordEnv = "MIRROR_PASSWORD"
+)
+
+var (
+	ReplicationMirror         string
+	ReplicationMirrorInsecure bool
+	ReplicationMirrorCABundle string
+	ReplicationDVCRAddress    string
+	ReplicationInterval       time.Duration
+	ReplicationRehydrate      bool
+	ReplicationRehydrateInUse bool
+)
+
+var ReplicateCmd = &cobra.Command{
+	Use:           "replicate --mirror registry/path [--interval duration] [--rehydrate]",
+	Short:         "`replicate` periodically copies images of ClusterVirtualImages and VirtualImages to a mirror registry and restores images missing in DVCR from it",
+	Args:          cobra.NoArgs,
+	RunE:          replicateHandler,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+}
+
+func init() {
+	ReplicateCmd.Flags().StringVar(&ReplicationMirror, "mirror", "", "mirror registry address with an optional path prefix, for example mirror.example.com/dvcr")
+	ReplicateCmd.Flags().BoolVar(&ReplicationMirrorInsecure, "mirror-insecure", false, "do not verify the mirror registry certificate")
+	ReplicateCmd.Flags().StringVar(&ReplicationMirrorCABundle, "mirror-ca-bundle", "", "path to a PEM file or a directory with PEM files to verify the mirror registry certificate")
+	ReplicateCmd.Flags().StringVar(&ReplicationDVCRAddress, "dvcr-address", "127.0.0.1:5000", "address of DVCR to replicate images from")
+	ReplicateCmd.Flags().DurationVar(&ReplicationInterval, "interval", 10*time.Minute, "interval between replication passes")
+	ReplicateCmd.Flags().BoolVar(&ReplicationRehydrate, "rehydrate", true, "restore images missing in DVCR from the mirror registry")
+	ReplicateCmd.Flags().BoolVar(&ReplicationRehydrateInUse, "rehydrate-in-use-only", false, "restore only images used by virtual machines and disks, so images removed by the retention policy stay removed")
+}
+
+type replicator struct {
+	client    *kubernetes.Client
+	dvcr      replication.Endpoint
+	mirror    replication.Endpoint
+	rehydrate bool
+	// inUseOnly restricts rehydration to the images used by virtual machines and disks.
+	inUseOnly bool
+}
+
+func replicateHandler(cmd *cobra.Command, _ []string) error {
+	if ReplicationMirror == "" {
+		return errors.New("mirror registry is not specified")
+	}
+
+	virtClient, err := kubernetes.NewVirtualizationClient()
+	if err != nil {
+		return fmt.Errorf("initialize Kubernetes client: %w", err)
+	}
+
+	r := &replicator{
+		client: virtClient,
+		// DVCR is accessed over the loopback interface in the same Pod, its certificate is issued for the Service name.
+		dvcr: replication.Endpoint{
+			Username: os.Getenv(dvcrUsernameEnv),
+			Password: os.Getenv(dvcrPasswordEnv),
+			Insecure: true,
+		},
+		mirror: replication.Endpoint{
+			Username: os.Getenv(mirrorUsernameEnv),
+			Password: os.Getenv(mirrorPasswordEnv),
+			Insecure: ReplicationMirrorInsecure,
+			CABundle: ReplicationMirrorCABundle,
+		},
+		rehydrate: ReplicationRehydrate,
+		inUseOnly: ReplicationRehydrateInUse,
+	}
+
+	ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	for {
+		if err = r.replicateAll(ctx); err != nil {
+			fmt.Printf("Replication pass failed: %s\n", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(ReplicationInterval):
+		}
+	}
+}
+
+func (r *replicator) replicateAll(ctx context.Context) error {
+	images, err := r.client.ListStoredImages(ctx)
+	if err != nil {
+		return err
+	}
+
+	var refs map[kubernetes.ImageRef]struct{}
+	if r.rehydrate && r.inUseOnly {
+		refs, err = r.client.ListImageReferences(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	var replicated, rehydrated, failed int
+	for _, image := range images {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		rehydrate := r.rehydrate
+		if refs != nil {
+			_, rehydrate = refs[image.Ref()]
+		}
+
+		reason, message, err := r.replicate(ctx, image, rehydrate)
+		switch {
+		case err != nil:
+			failed++
+			fmt.Printf("Replicate %s: %s\n", image, err)
+			err = r.client.SetReplicatedCondition(ctx, image, metav1.ConditionFalse, vicondition.ReplicationFailed.String(), message)
+		case reason == "":
+			// DVCR is not available, keep the condition as is until the next pass.
+			continue
+		default:
+			if reason == vicondition.Rehydrated.String() {
+				rehydrated++
+			} else {
+				replicated++
+			}
+			err = r.client.SetReplicatedCondition(ctx, image, metav1.ConditionTrue, reason, message)
+		}
+		if err != nil {
+			fmt.Printf("Set Replicated condition for %s: %s\n", image, err)
+		}
+	}
+
+	fmt.Printf("Replication pass finished: %d replicated, %d rehydrated, %d failed\n", replicated, rehydrated, failed)
+	return nil
+}
+
+// replicate pushes the image to the mirror, or restores it from the mirror if it is missing in DVCR.
+// It returns the reason and the message of the Replicated condition, or an empty reason if DVCR is not available.
+func (r *replicator) replicate(ctx context.Context, image kubernetes.StoredImage, rehydrate bool) (reason, message string, err error) {
+	repoPath := imagePath(image.RegistryURL)
+	local := ReplicationDVCRAddress + "/" + repoPath
+	mirror := strings.TrimSuffix(ReplicationMirror, "/") + "/" + repoPath
+
+	_, found, err := replication.ImageDigest(ctx, local, r.dvcr)
+	if err != nil {
+		fmt.Printf("Check %s in DVCR: %s\n", image, err)
+		return "", "", nil
+	}
+
+	if !found {
+		if !rehydrate {
+			return "", "The image is missing in DVCR.", errors.New("image is missing in DVCR")
+		}
+
+		digest, _, err := replication.Replicate(ctx, mirror, r.mirror, local, r.dvcr)
+		if err != nil {
+			return "", fmt.Sprintf("The image is missing in DVCR and cannot be restored from the mirror registry: %s.", err), err
+		}
+
+		fmt.Printf("Rehydrated %s from %s@%s\n", image, mirror, digest)
+		return vicondition.Rehydrated.String(), fmt.Sprintf("The image has been restored from %s.", mirror), nil
+	}
+
+	digest, copied, err := replication.Replicate(ctx, local, r.dvcr, mirror, r.mirror)
+	if err != nil {
+		return "", fmt.Sprintf("Failed to copy the image to the mirror registry: %s.", err), err
+	}
+
+	if copied {
+		fmt.Printf("Replicated %s to %s@%s\n", image, mirror, digest)
+	}
+	return vicondition.Replicated.String(), fmt.Sprintf("The image is replicated to %s.", mirror), nil
+}
+
+// imagePath strips the DVCR host from the image reference, leaving the repository path and the tag.
+func imagePath(registryURL string) string {
+	_, repoPath, _ := strings.Cut(strings.TrimPrefix(registryURL, "docker://"), "/")
+	return repoPath
+}
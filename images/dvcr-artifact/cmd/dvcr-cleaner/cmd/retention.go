@@ -0,0 +1,247 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/cleaner/kubernetes"
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/cleaner/registry"
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/humanize"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+const (
+	UnusedActionDelete  = "delete"
+	UnusedActionArchive = "archive"
+)
+
+// Image statuses in the retention report.
+const (
+	// imageStatusNoResource means that the resource of the image is deleted from the cluster.
+	imageStatusNoResource = "NoResource"
+	// imageStatusUnused means that no VirtualMachine or VirtualDisk uses the image and it was not pulled for the retention period.
+	imageStatusUnused = "Unused"
+	// imageStatusIdle means that no VirtualMachine or VirtualDisk uses the image, but it was pulled within the retention period
+	// or pulls are not recorded.
+	imageStatusIdle = "Idle"
+	// imageStatusInUse means that a VirtualMachine, a not yet provisioned VirtualDisk, a disk template of a VirtualMachinePool
+	// or a channel of a ClusterVirtualImageCatalog uses the image.
+	imageStatusInUse = "InUse"
+)
+
+var (
+	ReportUnusedFor        time.Duration
+	ReportUnusedForDefault = 30 * 24 * time.Hour
+
+	CleanupUnusedFor time.Duration
+	UnusedAction     string
+	UnusedDryRun     bool
+	ArchiveDir       string
+)
+
+var reportCmd = &cobra.Command{
+	Use:           "report [--unused-for duration]",
+	Short:         "`report` lists images with their size, last pull time and whether they are used by virtual machines and disks",
+	Args:          cobra.OnlyValidArgs,
+	RunE:          reportHandler,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+}
+
+func init() {
+	GcCmd.AddCommand(reportCmd)
+	reportCmd.Flags().DurationVar(&ReportUnusedFor, "unused-for", ReportUnusedForDefault, "report images not used and not pulled for this period as eligible for cleanup")
+
+	autoCleanupCmd.Flags().DurationVar(&CleanupUnusedFor, "unused-for", 0, "also clean up images not used and not pulled for this period, 0 to keep them")
+	autoCleanupCmd.Flags().StringVar(&UnusedAction, "unused-action", UnusedActionDelete, "what to do with unused images: delete or archive")
+	autoCleanupCmd.Flags().BoolVar(&UnusedDryRun, "unused-dry-run", true, "only list unused images instead of cleaning them up")
+	autoCleanupCmd.Flags().StringVar(&ArchiveDir, "archive-dir", registry.ArchiveDir, "directory to move archived images to")
+}
+
+type imageReport struct {
+	registry.Image
+	registry.ImageUsage
+	Status string
+}
+
+func reportHandler(_ *cobra.Command, _ []string) error {
+	if err := ensureRepoDir(); err != nil {
+		return err
+	}
+
+	reports, err := getImageReports(time.Now(), ReportUnusedFor)
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(reportImages(reports))
+	return nil
+}
+
+// getImageReports lists images of ClusterVirtualImages and VirtualImages, and images of deleted VirtualDisks.
+// Images of VirtualDisks that exist in the cluster are temporary and are not reported.
+func getImageReports(now time.Time, unusedFor time.Duration) ([]imageReport, error) {
+	images, err := registry.ListImagesAll()
+	if err != nil {
+		return nil, fmt.Errorf("list all images: %w", err)
+	}
+
+	virtClient, err := kubernetes.NewVirtualizationClient()
+	if err != nil {
+		return nil, fmt.Errorf("initialize Kubernetes client: %w", err)
+	}
+
+	kubeImages, err := virtClient.ListAllPossibleImages(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("list images in cluster: %w", err)
+	}
+
+	refs, err := virtClient.ListImageReferences(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("list images in use: %w", err)
+	}
+
+	pulls, err := registry.ReadPullLog(registry.PullsFile)
+	if err != nil {
+		return nil, err
+	}
+	if pulls == nil {
+		fmt.Println("No pulls are recorded yet, images are not reported as unused.")
+	}
+
+	absent := make(map[string]struct{})
+	for _, image := range compareRegistryAndClusterImages(images, kubeImages) {
+		absent[image.Path] = struct{}{}
+	}
+
+	reports := make([]imageReport, 0, len(images))
+	for _, image := range images {
+		_, isAbsent := absent[image.Path]
+		if image.Type == v1alpha2.VirtualDiskKind && !isAbsent {
+			continue
+		}
+
+		usage, err := registry.GetImageUsage(image, pulls)
+		if err != nil {
+			return nil, fmt.Errorf("get usage of image %s: %w", image.Path, err)
+		}
+
+		report := imageReport{Image: image, ImageUsage: usage}
+		_, inUse := refs[kubernetes.ImageRef{Type: image.Type, Namespace: image.Namespace, Name: image.Name}]
+		switch {
+		case isAbsent:
+			report.Status = imageStatusNoResource
+		case inUse:
+			report.Status = imageStatusInUse
+		case unusedFor > 0 && !usage.LastPull.IsZero() && now.Sub(usage.LastPull) >= unusedFor:
+			report.Status = imageStatusUnused
+		default:
+			report.Status = imageStatusIdle
+		}
+		reports = append(reports, report)
+	}
+
+	sort.SliceStable(reports, func(i, j int) bool {
+		return reports[i].Path < reports[j].Path
+	})
+
+	return reports, nil
+}
+
+func reportImages(reports []imageReport) (report string) {
+	var (
+		eligible      int
+		eligibleBytes int64
+	)
+
+	report += fmt.Sprintf("%-22s %-20s %-45s %10s %-12s %s\n", "KIND", "NAMESPACE", "NAME", "SIZE", "LAST PULL", "STATUS")
+	for _, r := range reports {
+		lastPull := "-"
+		if !r.LastPull.IsZero() {
+			lastPull = r.LastPull.UTC().Format(time.DateOnly)
+		}
+		report += fmt.Sprintf("%-22s %-20s %-45s %10s %-12s %s\n", r.Type, r.Namespace, r.Name, humanize.Bytes(r.Size), lastPull, r.Status)
+
+		if r.Status == imageStatusNoResource || r.Status == imageStatusUnused {
+			eligible++
+			eligibleBytes += r.Size
+		}
+	}
+	report += fmt.Sprintf("Images eligible for cleanup: %d, %s\n", eligible, humanize.Bytes(eligibleBytes))
+
+	return report
+}
+
+func validateUnusedAction() error {
+	UnusedAction = strings.ToLower(UnusedAction)
+	switch UnusedAction {
+	case UnusedActionDelete, UnusedActionArchive:
+		return nil
+	default:
+		return fmt.Errorf("unknown unused images action %q, expected %s or %s", UnusedAction, UnusedActionDelete, UnusedActionArchive)
+	}
+}
+
+// cleanupUnusedImages deletes or archives images that are not used by virtual machines and disks and were not pulled for the retention period.
+func cleanupUnusedImages() error {
+	reports, err := getImageReports(time.Now(), CleanupUnusedFor)
+	if err != nil {
+		return err
+	}
+
+	unused := make([]registry.Image, 0)
+	for _, r := range reports {
+		if r.Status == imageStatusUnused {
+			unused = append(unused, r.Image)
+		}
+	}
+
+	if len(unused) == 0 {
+		fmt.Printf("No images unused for %s.\n", CleanupUnusedFor)
+		return nil
+	}
+
+	if UnusedDryRun {
+		fmt.Printf("Dry run, the following images unused for %s are kept:\n", CleanupUnusedFor)
+		for _, image := range unused {
+			fmt.Printf("%s %s %s\n", image.Type, image.Namespace, image.Name)
+		}
+		return nil
+	}
+
+	switch UnusedAction {
+	case UnusedActionArchive:
+		for _, image := range unused {
+			if err = registry.ArchiveImage(image, ArchiveDir); err != nil {
+				return fmt.Errorf("archive unused images: %w", err)
+			}
+		}
+	default:
+		if err = registry.RemoveImages(unused); err != nil {
+			return fmt.Errorf("remove unused images: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/cleaner/registry"
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/humanize"
+)
+
+var StatsCmd = &cobra.Command{
+	Use:           "stats",
+	Short:         "Report how much space images share in the registry storage",
+	Args:          cobra.NoArgs,
+	RunE:          statsHandler,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+}
+
+func statsHandler(_ *cobra.Command, _ []string) error {
+	if err := ensureRepoDir(); err != nil {
+		return err
+	}
+
+	dedupInfo, err := registry.DedupStats()
+	if err != nil {
+		return fmt.Errorf("get deduplication stats: %w", err)
+	}
+
+	fmt.Print(reportDedupState(dedupInfo))
+	return nil
+}
+
+func reportDedupState(info registry.DedupInfo) (report string) {
+	report += fmt.Sprintf("%7s  %7s  %7s  %7s  %7s  %7s\n", "Images", "Chunked", "Logical", "Stored", "Saved", "Ratio")
+	report += fmt.Sprintf("%7d  %7d  %7s  %7s  %7s  %6.2fx\n",
+		info.Images,
+		info.ChunkedImages,
+		humanize.Bytes(info.LogicalBytes),
+		humanize.Bytes(info.StoredBytes),
+		humanize.Bytes(info.SavedBytes()),
+		info.Ratio(),
+	)
+
+	return report
+}
@@ -148,6 +148,100 @@ func (c *Client) ListVirtualDisksAll(ctx context.Context) ([]ImageInfo, error) {
 	return images, nil
 }
 
+// ImageRef identifies the repository of a VirtualImage or a ClusterVirtualImage in use.
+type ImageRef struct {
+	Type      string
+	Namespace string
+	// Name is the repository name, see ImageInfo.
+	Name string
+}
+
+// ListImageReferences returns images used by VirtualMachines, either directly or via
+// VirtualMachineBlockDeviceAttachments, and by VirtualDisks that are not provisioned yet.
+// A provisioned VirtualDisk holds its own copy of the data, so the image it was created from
+// is not needed anymore. Images used by the templates of VirtualMachinePools and images the
+// channels of ClusterVirtualImageCatalogs point to are returned too, as new virtual machines
+// and disks are created from them.
+func (c *Client) ListImageReferences(ctx context.Context) (map[ImageRef]struct{}, error) {
+	refs := make(map[ImageRef]struct{})
+	add := func(kind, namespace, name string) {
+		switch kind {
+		case v1alpha2.ClusterVirtualImageKind:
+			refs[ImageRef{
+				Type: v1alpha2.ClusterVirtualImageKind,
+				Name: dvcrrepo.ClusterImageRepoName(dvcrrepo.DefaultRegistryHost, name),
+			}] = struct{}{}
+		case v1alpha2.VirtualImageKind:
+			refs[ImageRef{
+				Type:      v1alpha2.VirtualImageKind,
+				Namespace: namespace,
+				Name:      dvcrrepo.ImageRepoName(dvcrrepo.DefaultRegistryHost, namespace, name),
+			}] = struct{}{}
+		}
+	}
+
+	vms, err := c.virtClient.VirtualMachines("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list virtual machines: %w", err)
+	}
+	for _, vm := range vms.Items {
+		for _, ref := range vm.Spec.BlockDeviceRefs {
+			add(string(ref.Kind), vm.Namespace, ref.Name)
+		}
+	}
+
+	vmbdas, err := c.virtClient.VirtualMachineBlockDeviceAttachments("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list virtual machine block device attachments: %w", err)
+	}
+	for _, vmbda := range vmbdas.Items {
+		add(string(vmbda.Spec.BlockDeviceRef.Kind), vmbda.Namespace, vmbda.Spec.BlockDeviceRef.Name)
+	}
+
+	vds, err := c.virtClient.VirtualDisks("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list virtual disks: %w", err)
+	}
+	for _, vd := range vds.Items {
+		ref := vd.Spec.DataSource
+		if ref == nil || ref.ObjectRef == nil || vd.Status.Phase == v1alpha2.DiskReady {
+			continue
+		}
+		add(string(ref.ObjectRef.Kind), vd.Namespace, ref.ObjectRef.Name)
+	}
+
+	vmpools, err := c.virtClient.VirtualMachinePools("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list virtual machine pools: %w", err)
+	}
+	for _, pool := range vmpools.Items {
+		for _, ref := range pool.Spec.VirtualMachineTemplate.Spec.BlockDeviceRefs {
+			add(string(ref.Kind), pool.Namespace, ref.Name)
+		}
+		for _, tmpl := range pool.Spec.VirtualDiskTemplates {
+			ref := tmpl.Spec.DataSource
+			if ref == nil || ref.ObjectRef == nil {
+				continue
+			}
+			add(string(ref.ObjectRef.Kind), pool.Namespace, ref.ObjectRef.Name)
+		}
+	}
+
+	catalogs, err := c.virtClient.ClusterVirtualImageCatalogs().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list cluster virtual image catalogs: %w", err)
+	}
+	for _, catalog := range catalogs.Items {
+		for _, channel := range catalog.Status.Channels {
+			if channel.ClusterVirtualImage != "" {
+				add(v1alpha2.ClusterVirtualImageKind, "", channel.ClusterVirtualImage)
+			}
+		}
+	}
+
+	return refs, nil
+}
+
 const (
 	garbageCollectionSecretNS   = "d8-virtualization"
 	garbageCollectionSecretName = "dvcr-garbage-collection"
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2/cvicondition"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2/vicondition"
+	dvcrrepo "github.com/deckhouse/virtualization/api/dvcr"
+)
+
+// StoredImage is a ClusterVirtualImage or a VirtualImage with the image stored in DVCR.
+type StoredImage struct {
+	Type      string
+	Namespace string
+	Name      string
+	// RegistryURL is the image reference in DVCR, taken from the status of the resource.
+	RegistryURL string
+	Phase       v1alpha2.ImagePhase
+}
+
+func (i StoredImage) String() string {
+	if i.Namespace == "" {
+		return fmt.Sprintf("%s/%s", i.Type, i.Name)
+	}
+	return fmt.Sprintf("%s/%s/%s", i.Type, i.Namespace, i.Name)
+}
+
+// Ref returns the reference to the image as reported by ListImageReferences.
+func (i StoredImage) Ref() ImageRef {
+	if i.Type == v1alpha2.ClusterVirtualImageKind {
+		return ImageRef{Type: i.Type, Name: dvcrrepo.ClusterImageRepoName(dvcrrepo.DefaultRegistryHost, i.Name)}
+	}
+	return ImageRef{Type: i.Type, Namespace: i.Namespace, Name: dvcrrepo.ImageRepoName(dvcrrepo.DefaultRegistryHost, i.Namespace, i.Name)}
+}
+
+// ListStoredImages returns ClusterVirtualImages and VirtualImages stored in DVCR that are Ready,
+// or Lost because their image has disappeared from DVCR.
+func (c *Client) ListStoredImages(ctx context.Context) ([]StoredImage, error) {
+	var images []StoredImage
+	stored := func(phase v1alpha2.ImagePhase, registryURL string) bool {
+		return registryURL != "" && (phase == v1alpha2.ImageReady || phase == v1alpha2.ImageLost)
+	}
+
+	cvis, err := c.virtClient.ClusterVirtualImages().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list cluster virtual images: %w", err)
+	}
+	for _, cvi := range cvis.Items {
+		if !stored(cvi.Status.Phase, cvi.Status.Target.RegistryURL) {
+			continue
+		}
+		images = append(images, StoredImage{
+			Type:        v1alpha2.ClusterVirtualImageKind,
+			Name:        cvi.Name,
+			RegistryURL: cvi.Status.Target.RegistryURL,
+			Phase:       cvi.Status.Phase,
+		})
+	}
+
+	vis, err := c.virtClient.VirtualImages("").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("list virtual images: %w", err)
+	}
+	for _, vi := range vis.Items {
+		if vi.Spec.Storage != v1alpha2.StorageContainerRegistry || !stored(vi.Status.Phase, vi.Status.Target.RegistryURL) {
+			continue
+		}
+		images = append(images, StoredImage{
+			Type:        v1alpha2.VirtualImageKind,
+			Namespace:   vi.Namespace,
+			Name:        vi.Name,
+			RegistryURL: vi.Status.Target.RegistryURL,
+			Phase:       vi.Status.Phase,
+		})
+	}
+
+	return images, nil
+}
+
+// SetReplicatedCondition sets the Replicated condition of the image resource.
+// The status is not updated if the condition is already set to the same value.
+func (c *Client) SetReplicatedCondition(ctx context.Context, image StoredImage, status metav1.ConditionStatus, reason, message string) error {
+	switch image.Type {
+	case v1alpha2.ClusterVirtualImageKind:
+		cvi, err := c.virtClient.ClusterVirtualImages().Get(ctx, image.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("get %s: %w", image, err)
+		}
+
+		if !setCondition(&cvi.Status.Conditions, cvi.Generation, cvicondition.ReplicatedType.String(), status, reason, message) {
+			return nil
+		}
+
+		if _, err = c.virtClient.ClusterVirtualImages().UpdateStatus(ctx, cvi, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("update status of %s: %w", image, err)
+		}
+	case v1alpha2.VirtualImageKind:
+		vi, err := c.virtClient.VirtualImages(image.Namespace).Get(ctx, image.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("get %s: %w", image, err)
+		}
+
+		if !setCondition(&vi.Status.Conditions, vi.Generation, vicondition.ReplicatedType.String(), status, reason, message) {
+			return nil
+		}
+
+		if _, err = c.virtClient.VirtualImages(image.Namespace).UpdateStatus(ctx, vi, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("update status of %s: %w", image, err)
+		}
+	default:
+		return fmt.Errorf("unexpected image kind %q", image.Type)
+	}
+
+	return nil
+}
+
+// setCondition reports whether the conditions have been changed.
+func setCondition(conds *[]metav1.Condition, generation int64, condType string, status metav1.ConditionStatus, reason, message string) bool {
+	current := meta.FindStatusCondition(*conds, condType)
+	if current != nil && current.Status == status && current.Reason == reason && current.Message == message && current.ObservedGeneration == generation {
+		return false
+	}
+
+	meta.SetStatusCondition(conds, metav1.Condition{
+		Type:               condType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+
+	return true
+}
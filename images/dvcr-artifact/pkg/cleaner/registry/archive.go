@@ -0,0 +1,144 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// ArchiveDir is where archived images are moved to. It is on the same volume as the registry
+// storage, so archiving hard-links the blobs instead of copying them.
+const ArchiveDir = "/var/lib/registry/archive"
+
+// ArchiveImage moves the image out of the registry into archiveDir. The archive has the layout
+// of the registry storage: the repository is moved to docker/registry/v2/repositories and its
+// blobs are linked to docker/registry/v2/blobs, so they survive the garbage collection and the
+// archived image can be served by a registry started on the archive directory or moved back.
+func ArchiveImage(image Image, archiveDir string) error {
+	return archiveImage(image, RepoDir, BlobsDir, archiveDir)
+}
+
+func archiveImage(image Image, repoDir, blobsDir, archiveDir string) error {
+	relPath, err := filepath.Rel(repoDir, image.Path)
+	if err != nil {
+		return fmt.Errorf("image directory %s is not in %s: %w", image.Path, repoDir, err)
+	}
+
+	archiveRepoDir := filepath.Join(archiveDir, "docker", "registry", "v2", "repositories")
+	archiveBlobsDir := filepath.Join(archiveDir, "docker", "registry", "v2", "blobs")
+
+	digests, err := taggedManifests(filepath.Join(image.Path, "_manifests", "tags"))
+	if err != nil {
+		return err
+	}
+
+	for _, digest := range digests {
+		manifest, err := readManifest(blobsDir, digest)
+		if err != nil {
+			return err
+		}
+
+		blobs := []string{digest, manifest.Config.Digest}
+		for _, layer := range manifest.Layers {
+			blobs = append(blobs, layer.Digest)
+		}
+
+		for _, blob := range blobs {
+			if blob == "" {
+				continue
+			}
+			if err = archiveBlob(blobsDir, archiveBlobsDir, blob); err != nil {
+				return err
+			}
+		}
+	}
+
+	target := filepath.Join(archiveRepoDir, relPath)
+	// The image may have been archived before: keep the latest one.
+	if err = os.RemoveAll(target); err != nil {
+		return fmt.Errorf("remove previously archived image %s: %w", target, err)
+	}
+	if err = os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return fmt.Errorf("create archive directory: %w", err)
+	}
+
+	fmt.Printf("Archive manifest in %s directory to %s\n", image.Path, target)
+	if err = os.Rename(image.Path, target); err != nil {
+		return fmt.Errorf("move image directory %s for `%s` %q to archive: %w", image.Path, image.Type, image.Name, err)
+	}
+
+	return nil
+}
+
+func archiveBlob(blobsDir, archiveBlobsDir, digest string) error {
+	src, err := blobDataPath(blobsDir, digest)
+	if err != nil {
+		return err
+	}
+	dst, err := blobDataPath(archiveBlobsDir, digest)
+	if err != nil {
+		return err
+	}
+
+	if _, err = os.Stat(dst); err == nil {
+		return nil
+	}
+
+	if err = os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return fmt.Errorf("create archive blob directory: %w", err)
+	}
+
+	err = os.Link(src, dst)
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("blob %s is not found: %w", digest, err)
+	}
+
+	// The archive is on another filesystem.
+	return copyFile(src, dst)
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("open blob %s: %w", src, err)
+	}
+	defer in.Close()
+
+	out, err := os.CreateTemp(filepath.Dir(dst), ".data-")
+	if err != nil {
+		return fmt.Errorf("create archive blob: %w", err)
+	}
+	defer os.Remove(out.Name())
+
+	if _, err = io.Copy(out, in); err != nil {
+		out.Close()
+		return fmt.Errorf("copy blob %s: %w", src, err)
+	}
+	if err = out.Close(); err != nil {
+		return fmt.Errorf("copy blob %s: %w", src, err)
+	}
+
+	return os.Rename(out.Name(), dst)
+}
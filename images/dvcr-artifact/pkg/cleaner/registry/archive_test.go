@@ -0,0 +1,87 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+func writeTestBlob(t *testing.T, blobsDir, hex, data string) {
+	t.Helper()
+
+	dir := filepath.Join(blobsDir, "sha256", hex[:2], hex)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "data"), []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArchiveImage(t *testing.T) {
+	root := t.TempDir()
+	repoDir := filepath.Join(root, "repositories")
+	blobsDir := filepath.Join(root, "blobs")
+	archiveDir := filepath.Join(root, "archive")
+
+	writeTestImage(t, repoDir, blobsDir, "vi/default/ubuntu", "aa01",
+		testLayer{"application/vnd.oci.image.layer.v1.tar+zstd", "sha256:bb02", 4},
+	)
+	writeTestBlob(t, blobsDir, "bb02", "disk")
+
+	image := Image{
+		Type:      v1alpha2.VirtualImageKind,
+		Namespace: "default",
+		Name:      "ubuntu",
+		Path:      filepath.Join(repoDir, "vi/default/ubuntu"),
+	}
+	if err := archiveImage(image, repoDir, blobsDir, archiveDir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := os.Stat(image.Path); !os.IsNotExist(err) {
+		t.Fatalf("image directory is not removed from the registry: %v", err)
+	}
+
+	archiveStorage := filepath.Join(archiveDir, "docker", "registry", "v2")
+	link, err := os.ReadFile(filepath.Join(archiveStorage, "repositories", "vi/default/ubuntu", "_manifests", "tags", "latest", "current", "link"))
+	if err != nil {
+		t.Fatalf("archived manifest link: %v", err)
+	}
+	if string(link) != "sha256:aa01" {
+		t.Fatalf("archived manifest link: got %q", link)
+	}
+
+	// Blobs must survive the garbage collection in the registry.
+	if err = os.RemoveAll(blobsDir); err != nil {
+		t.Fatal(err)
+	}
+	layer, err := os.ReadFile(filepath.Join(archiveStorage, "blobs", "sha256", "bb", "bb02", "data"))
+	if err != nil {
+		t.Fatalf("archived layer: %v", err)
+	}
+	if string(layer) != "disk" {
+		t.Fatalf("archived layer: got %q", layer)
+	}
+	if _, err = readManifest(filepath.Join(archiveStorage, "blobs"), "sha256:aa01"); err != nil {
+		t.Fatalf("archived manifest: %v", err)
+	}
+}
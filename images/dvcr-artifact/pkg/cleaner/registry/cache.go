@@ -0,0 +1,73 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// CacheImageType is the type of the pull-through cache images: copies of the container images
+// imported for ClusterVirtualImages, VirtualImages and VirtualDisks, keyed by digest.
+const CacheImageType = "PullThroughCache"
+
+// cacheDir returns a directory where stored all pull-through cache images.
+//
+// Example:
+//
+//	/.../repositories
+//	`-- cache
+//	    `-- sha256
+//	        |-- 3f1c...
+//	        `-- 9a07...
+func cacheDir() string {
+	return filepath.Join(RepoDir, "cache")
+}
+
+func ListCacheImages() ([]Image, error) {
+	return listCacheImages(cacheDir())
+}
+
+func listCacheImages(dir string) ([]Image, error) {
+	algorithms, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("cannot list pull-through cache images in %s: %w", dir, err)
+	}
+
+	images := make([]Image, 0)
+	for _, algorithm := range algorithms {
+		entries, err := os.ReadDir(filepath.Join(dir, algorithm.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("cannot list pull-through cache images in %s: %w", filepath.Join(dir, algorithm.Name()), err)
+		}
+
+		for _, entry := range entries {
+			images = append(images, Image{
+				Type: CacheImageType,
+				Name: algorithm.Name() + ":" + entry.Name(),
+				Path: filepath.Join(dir, algorithm.Name(), entry.Name()),
+			})
+		}
+	}
+	return images, nil
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestListCacheImages(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "cache")
+
+	images, err := listCacheImages(dir)
+	if err != nil || len(images) != 0 {
+		t.Fatalf("missing cache directory: got %v, %v, want no images", images, err)
+	}
+
+	for _, digest := range []string{"aa01", "bb02"} {
+		if err = os.MkdirAll(filepath.Join(dir, "sha256", digest, "_manifests"), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	images, err = listCacheImages(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(images) != 2 {
+		t.Fatalf("got %d images, want 2", len(images))
+	}
+	for i, digest := range []string{"aa01", "bb02"} {
+		if images[i].Type != CacheImageType || images[i].Name != "sha256:"+digest || images[i].Path != filepath.Join(dir, "sha256", digest) {
+			t.Fatalf("image %d: got %+v", i, images[i])
+		}
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	BlobsDir = "/var/lib/registry/docker/registry/v2/blobs"
+
+	// chunkMediaType is the media type of the layers of chunked images, see registry.ChunkMediaType in pkg/registry.
+	chunkMediaType = "application/vnd.deckhouse.virtualization.disk.chunk.v1"
+)
+
+// DedupInfo describes how much space the images share in the registry storage.
+type DedupInfo struct {
+	// Images is the number of image manifests.
+	Images int
+	// ChunkedImages is the number of images stored as content-defined chunks.
+	ChunkedImages int
+	// Layers is the number of layer references in all images.
+	Layers int
+	// UniqueLayers is the number of distinct layer blobs.
+	UniqueLayers int
+	// LogicalBytes is the size of all images as if each one was stored separately.
+	LogicalBytes int64
+	// StoredBytes is the size of the distinct layer blobs.
+	StoredBytes int64
+}
+
+// SavedBytes returns the space saved by sharing blobs between images.
+func (i DedupInfo) SavedBytes() int64 {
+	return i.LogicalBytes - i.StoredBytes
+}
+
+// Ratio returns the deduplication ratio, 1 if nothing is shared.
+func (i DedupInfo) Ratio() float64 {
+	if i.StoredBytes == 0 {
+		return 1
+	}
+	return float64(i.LogicalBytes) / float64(i.StoredBytes)
+}
+
+func DedupStats() (DedupInfo, error) {
+	return dedupStats(RepoDir, BlobsDir)
+}
+
+type manifestDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Size      int64  `json:"size"`
+}
+
+type imageManifest struct {
+	Config manifestDescriptor   `json:"config"`
+	Layers []manifestDescriptor `json:"layers"`
+}
+
+// dedupStats reads the manifests tagged in every repository and sums up their layers.
+func dedupStats(repoDir, blobsDir string) (DedupInfo, error) {
+	var info DedupInfo
+	layerSizes := make(map[string]int64)
+
+	err := filepath.WalkDir(repoDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && path == repoDir {
+				return filepath.SkipAll
+			}
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+
+		switch d.Name() {
+		case "_layers", "_uploads":
+			return filepath.SkipDir
+		case "_manifests":
+		default:
+			return nil
+		}
+
+		digests, err := taggedManifests(filepath.Join(path, "tags"))
+		if err != nil {
+			return err
+		}
+		for _, digest := range digests {
+			manifest, err := readManifest(blobsDir, digest)
+			if err != nil {
+				return err
+			}
+
+			info.Images++
+			chunked := false
+			for _, layer := range manifest.Layers {
+				chunked = chunked || layer.MediaType == chunkMediaType
+				info.Layers++
+				info.LogicalBytes += layer.Size
+				layerSizes[layer.Digest] = layer.Size
+			}
+			if chunked {
+				info.ChunkedImages++
+			}
+		}
+
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return DedupInfo{}, fmt.Errorf("walk repositories in %s: %w", repoDir, err)
+	}
+
+	info.UniqueLayers = len(layerSizes)
+	for _, size := range layerSizes {
+		info.StoredBytes += size
+	}
+
+	return info, nil
+}
+
+// taggedManifests returns the distinct digests of the manifests the tags in tagsDir point to.
+func taggedManifests(tagsDir string) ([]string, error) {
+	tags, err := os.ReadDir(tagsDir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read tags in %s: %w", tagsDir, err)
+	}
+
+	seen := make(map[string]struct{}, len(tags))
+	digests := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		link, err := os.ReadFile(filepath.Join(tagsDir, tag.Name(), "current", "link"))
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("read tag %s link: %w", tag.Name(), err)
+		}
+
+		digest := strings.TrimSpace(string(link))
+		if _, ok := seen[digest]; ok {
+			continue
+		}
+		seen[digest] = struct{}{}
+		digests = append(digests, digest)
+	}
+
+	return digests, nil
+}
+
+func readManifest(blobsDir, digest string) (imageManifest, error) {
+	path, err := blobDataPath(blobsDir, digest)
+	if err != nil {
+		return imageManifest{}, err
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return imageManifest{}, fmt.Errorf("read manifest %s: %w", digest, err)
+	}
+
+	var manifest imageManifest
+	if err := json.Unmarshal(raw, &manifest); err != nil {
+		return imageManifest{}, fmt.Errorf("parse manifest %s: %w", digest, err)
+	}
+
+	return manifest, nil
+}
+
+// blobDataPath returns the path of the blob data file in the registry storage.
+func blobDataPath(blobsDir, digest string) (string, error) {
+	algorithm, hex, ok := strings.Cut(digest, ":")
+	if !ok || len(hex) < 2 {
+		return "", fmt.Errorf("invalid blob digest %q", digest)
+	}
+
+	return filepath.Join(blobsDir, algorithm, hex[:2], hex, "data"), nil
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type testLayer struct {
+	mediaType string
+	digest    string
+	size      int64
+}
+
+func writeTestImage(t *testing.T, repoDir, blobsDir, repo, manifestHex string, layers ...testLayer) {
+	t.Helper()
+
+	descriptors := make([]string, 0, len(layers))
+	for _, layer := range layers {
+		descriptors = append(descriptors, fmt.Sprintf(`{"mediaType":%q,"digest":%q,"size":%d}`, layer.mediaType, layer.digest, layer.size))
+	}
+	manifest := fmt.Sprintf(`{"schemaVersion":2,"layers":[%s]}`, strings.Join(descriptors, ","))
+
+	blobDir := filepath.Join(blobsDir, "sha256", manifestHex[:2], manifestHex)
+	linkDir := filepath.Join(repoDir, repo, "_manifests", "tags", "latest", "current")
+	for _, dir := range []string{blobDir, linkDir, filepath.Join(repoDir, repo, "_layers", "sha256")} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(blobDir, "data"), []byte(manifest), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(linkDir, "link"), []byte("sha256:"+manifestHex), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDedupStats_CountsSharedChunksOnce(t *testing.T) {
+	root := t.TempDir()
+	repoDir := filepath.Join(root, "repositories")
+	blobsDir := filepath.Join(root, "blobs")
+
+	writeTestImage(t, repoDir, blobsDir, "cvi/ubuntu-a", "aa01",
+		testLayer{chunkMediaType, "sha256:c1", 100},
+		testLayer{chunkMediaType, "sha256:c2", 100},
+	)
+	writeTestImage(t, repoDir, blobsDir, "vd/default/ubuntu-b", "bb02",
+		testLayer{chunkMediaType, "sha256:c1", 100},
+		testLayer{chunkMediaType, "sha256:c3", 50},
+	)
+	writeTestImage(t, repoDir, blobsDir, "vi/default/alpine", "cc03",
+		testLayer{"application/vnd.docker.image.rootfs.diff.tar", "sha256:t1", 30},
+	)
+
+	info, err := dedupStats(repoDir, blobsDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := DedupInfo{
+		Images:        3,
+		ChunkedImages: 2,
+		Layers:        5,
+		UniqueLayers:  4,
+		LogicalBytes:  380,
+		StoredBytes:   280,
+	}
+	if info != want {
+		t.Fatalf("got %+v, want %+v", info, want)
+	}
+	if info.SavedBytes() != 100 {
+		t.Fatalf("saved bytes: got %d, want 100", info.SavedBytes())
+	}
+}
+
+func TestDedupStats_MissingRepoDir(t *testing.T) {
+	info, err := dedupStats(filepath.Join(t.TempDir(), "missing"), t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info != (DedupInfo{}) || info.Ratio() != 1 {
+		t.Fatalf("expected empty stats, got %+v", info)
+	}
+}
@@ -0,0 +1,208 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PullsFile keeps the time of the last pull of every repository. DVCR sends a notification
+// for every pull, and `dvcr-cleaner record-pulls` writes them to this file.
+const PullsFile = "/var/lib/registry/pulls.json"
+
+// PullLog is the content of PullsFile.
+type PullLog struct {
+	// Since is the time the recording started. Pulls before this time are unknown.
+	Since time.Time `json:"since"`
+	// Pulls is the time of the last pull by the repository name, for example vi/default/ubuntu.
+	Pulls map[string]time.Time `json:"pulls"`
+}
+
+// ReadPullLog reads the pull log from the file. It returns nil if no pulls were recorded yet.
+func ReadPullLog(path string) (*PullLog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read pull log: %w", err)
+	}
+
+	var log PullLog
+	if err = json.Unmarshal(data, &log); err != nil {
+		return nil, fmt.Errorf("parse pull log %s: %w", path, err)
+	}
+	if log.Pulls == nil {
+		log.Pulls = make(map[string]time.Time)
+	}
+
+	return &log, nil
+}
+
+// WritePullLog replaces the file with the pull log. The file is replaced atomically, so readers
+// never see a partially written log.
+func WritePullLog(path string, log *PullLog) error {
+	data, err := json.Marshal(log)
+	if err != nil {
+		return fmt.Errorf("marshal pull log: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	if err = os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("write pull log: %w", err)
+	}
+	if err = os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("replace pull log: %w", err)
+	}
+
+	return nil
+}
+
+// LastPull returns the time the image was pulled last. If no pull of the image was recorded, it is
+// the time the image was pushed or the time the recording started, whichever is later: the image
+// was not pulled since then. It returns zero time if pulls are not recorded.
+func (l *PullLog) LastPull(repo string, pushed time.Time) time.Time {
+	if l == nil {
+		return time.Time{}
+	}
+
+	lastPull := l.Since
+	if pushed.After(lastPull) {
+		lastPull = pushed
+	}
+	if pulled, ok := l.Pulls[repo]; ok && pulled.After(lastPull) {
+		lastPull = pulled
+	}
+
+	return lastPull
+}
+
+// repoName returns the name of the repository in repoDir as the registry knows it, for example vi/default/ubuntu.
+func repoName(repoDir, repoPath string) string {
+	name, err := filepath.Rel(repoDir, repoPath)
+	if err != nil {
+		return repoPath
+	}
+	return filepath.ToSlash(name)
+}
+
+// PullRecorder is the endpoint of the DVCR notifications. It keeps the time of the last pull
+// of every repository in memory, Flush writes them to the pull log.
+type PullRecorder struct {
+	path string
+	// ignoredUserAgent is the prefix of the user agent of the pulls that are not a use of the image,
+	// for example the pulls of the replication.
+	ignoredUserAgent string
+
+	mu    sync.Mutex
+	log   *PullLog
+	dirty bool
+}
+
+// NewPullRecorder loads the pull log from the file, or starts a new one.
+func NewPullRecorder(path, ignoredUserAgent string) (*PullRecorder, error) {
+	log, err := ReadPullLog(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &PullRecorder{path: path, ignoredUserAgent: ignoredUserAgent, log: log}
+	if log == nil {
+		r.log = &PullLog{Since: time.Now().UTC(), Pulls: make(map[string]time.Time)}
+		r.dirty = true
+	}
+
+	return r, nil
+}
+
+// notificationEnvelope is the part of the DVCR notification the recorder uses,
+// see https://distribution.github.io/distribution/about/notifications/.
+type notificationEnvelope struct {
+	Events []struct {
+		Action    string    `json:"action"`
+		Timestamp time.Time `json:"timestamp"`
+		Target    struct {
+			Repository string `json:"repository"`
+		} `json:"target"`
+		Request struct {
+			UserAgent string `json:"useragent"`
+		} `json:"request"`
+	} `json:"events"`
+}
+
+func (r *PullRecorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var envelope notificationEnvelope
+	if err := json.NewDecoder(req.Body).Decode(&envelope); err != nil {
+		// DVCR retries the failed notifications: accept a malformed one to not block the others.
+		fmt.Printf("Decode DVCR notification: %s\n", err)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, event := range envelope.Events {
+		if event.Action != "pull" || event.Target.Repository == "" {
+			continue
+		}
+		if r.ignoredUserAgent != "" && strings.HasPrefix(event.Request.UserAgent, r.ignoredUserAgent) {
+			continue
+		}
+
+		pulled := event.Timestamp.UTC()
+		if pulled.IsZero() {
+			pulled = time.Now().UTC()
+		}
+		if last, ok := r.log.Pulls[event.Target.Repository]; !ok || pulled.After(last) {
+			r.log.Pulls[event.Target.Repository] = pulled
+			r.dirty = true
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Flush writes the recorded pulls to the pull log if there are new ones.
+func (r *PullRecorder) Flush() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.dirty {
+		return nil
+	}
+	if err := WritePullLog(r.path, r.log); err != nil {
+		return err
+	}
+	r.dirty = false
+
+	return nil
+}
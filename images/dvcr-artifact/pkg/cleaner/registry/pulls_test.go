@@ -0,0 +1,90 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPullRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pulls.json")
+
+	recorder, err := NewPullRecorder(path, "dvcr-replication")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	notify := func(body string) {
+		rec := httptest.NewRecorder()
+		recorder.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(body)))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("notification status: got %d, want %d", rec.Code, http.StatusOK)
+		}
+	}
+
+	notify(`{"events": [
+		{"action": "pull", "timestamp": "2026-10-01T10:00:00Z", "target": {"repository": "vi/default/ubuntu"}, "request": {"useragent": "containerd/2.0"}},
+		{"action": "pull", "timestamp": "2026-10-02T10:00:00Z", "target": {"repository": "cvi/debian"}, "request": {"useragent": "dvcr-replication go-containerregistry/v0.20"}},
+		{"action": "push", "timestamp": "2026-10-03T10:00:00Z", "target": {"repository": "cvi/alpine"}}
+	]}`)
+	// An older pull does not move the last pull back.
+	notify(`{"events": [
+		{"action": "pull", "timestamp": "2026-09-01T10:00:00Z", "target": {"repository": "vi/default/ubuntu"}}
+	]}`)
+	notify(`not a notification`)
+
+	if err = recorder.Flush(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	log, err := ReadPullLog(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if log == nil || log.Since.IsZero() {
+		t.Fatalf("expected pull log with the start of the recording, got %+v", log)
+	}
+
+	want := map[string]time.Time{"vi/default/ubuntu": time.Date(2026, 10, 1, 10, 0, 0, 0, time.UTC)}
+	if len(log.Pulls) != len(want) || !log.Pulls["vi/default/ubuntu"].Equal(want["vi/default/ubuntu"]) {
+		t.Fatalf("pulls: got %v, want %v", log.Pulls, want)
+	}
+
+	// The recording continues from the file after a restart.
+	recorder, err = NewPullRecorder(path, "dvcr-replication")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !recorder.log.Since.Equal(log.Since) || len(recorder.log.Pulls) != 1 {
+		t.Fatalf("restored pull log: got %+v, want %+v", recorder.log, log)
+	}
+}
+
+func TestReadPullLog_NotRecorded(t *testing.T) {
+	log, err := ReadPullLog(filepath.Join(t.TempDir(), "pulls.json"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if log != nil {
+		t.Fatalf("expected no pull log, got %+v", log)
+	}
+}
@@ -51,7 +51,7 @@ func RemoveImage(image Image) error {
 		switch image.Type {
 		case v1alpha2.VirtualImageKind, v1alpha2.VirtualDiskKind:
 			return fmt.Errorf("delete image directory %s for `%s` %q in %q namespace: %w", image.Path, image.Type, image.Name, image.Namespace, err)
-		case v1alpha2.ClusterVirtualImageKind:
+		case v1alpha2.ClusterVirtualImageKind, CacheImageType:
 			return fmt.Errorf("delete image directory %s for `%s` %q: %w", image.Path, image.Type, image.Name, err)
 		default:
 			return fmt.Errorf("unknown image type: %s", image.Type)
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ImageUsage describes the space an image takes and when it was used last.
+type ImageUsage struct {
+	// Size is the size of the manifest, config and layers of the image.
+	// Layers shared with other images are counted for every image.
+	Size int64
+	// LastPull is the time the image was pulled last, see PullLog.LastPull.
+	// It is zero if pulls are not recorded.
+	LastPull time.Time
+}
+
+// GetImageUsage returns the usage of the image. The last pull is taken from the pulls recorded from
+// the DVCR notifications: the access time of the storage files is not reliable, as mount options
+// like noatime and relatime do not update it on every read.
+func GetImageUsage(image Image, pulls *PullLog) (ImageUsage, error) {
+	return imageUsage(image.Path, RepoDir, BlobsDir, pulls)
+}
+
+func imageUsage(repoPath, repoDir, blobsDir string, pulls *PullLog) (ImageUsage, error) {
+	var (
+		usage  ImageUsage
+		pushed time.Time
+	)
+
+	digests, err := taggedManifests(filepath.Join(repoPath, "_manifests", "tags"))
+	if err != nil {
+		return ImageUsage{}, err
+	}
+
+	for _, digest := range digests {
+		path, err := blobDataPath(blobsDir, digest)
+		if err != nil {
+			return ImageUsage{}, err
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return ImageUsage{}, fmt.Errorf("stat manifest %s: %w", digest, err)
+		}
+
+		// Blobs are never modified after they are written.
+		if info.ModTime().After(pushed) {
+			pushed = info.ModTime()
+		}
+
+		manifest, err := readManifest(blobsDir, digest)
+		if err != nil {
+			return ImageUsage{}, err
+		}
+
+		usage.Size += info.Size() + manifest.Config.Size
+		for _, layer := range manifest.Layers {
+			usage.Size += layer.Size
+		}
+	}
+
+	usage.LastPull = pulls.LastPull(repoName(repoDir, repoPath), pushed)
+
+	return usage, nil
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestImageUsage(t *testing.T) {
+	root := t.TempDir()
+	repoDir := filepath.Join(root, "repositories")
+	blobsDir := filepath.Join(root, "blobs")
+
+	writeTestImage(t, repoDir, blobsDir, "vi/default/ubuntu", "aa01",
+		testLayer{"application/vnd.oci.image.layer.v1.tar+zstd", "sha256:l1", 1000},
+		testLayer{"application/vnd.oci.image.layer.v1.tar+zstd", "sha256:l2", 500},
+	)
+
+	manifestPath := filepath.Join(blobsDir, "sha256", "aa", "aa01", "data")
+	pushed := time.Now().Add(-72 * time.Hour).Truncate(time.Second)
+	if err := os.Chtimes(manifestPath, pushed, pushed); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(manifestPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	usage, err := imageUsage(filepath.Join(repoDir, "vi/default/ubuntu"), repoDir, blobsDir, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := info.Size() + 1500; usage.Size != want {
+		t.Fatalf("size: got %d, want %d", usage.Size, want)
+	}
+	if !usage.LastPull.IsZero() {
+		t.Fatalf("last pull without recorded pulls: got %s, want zero", usage.LastPull)
+	}
+
+	since := pushed.Add(-24 * time.Hour)
+	pulled := pushed.Add(24 * time.Hour)
+	tests := []struct {
+		name string
+		log  *PullLog
+		want time.Time
+	}{
+		{"pulled after push", &PullLog{Since: since, Pulls: map[string]time.Time{"vi/default/ubuntu": pulled}}, pulled},
+		{"not pulled after push", &PullLog{Since: since, Pulls: map[string]time.Time{"vi/default/other": pulled}}, pushed},
+		{"pushed before recording", &PullLog{Since: pulled, Pulls: map[string]time.Time{}}, pulled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usage, err := imageUsage(filepath.Join(repoDir, "vi/default/ubuntu"), repoDir, blobsDir, tt.log)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !usage.LastPull.Equal(tt.want) {
+				t.Fatalf("last pull: got %s, want %s", usage.LastPull, tt.want)
+			}
+		})
+	}
+}
+
+func TestImageUsage_NoManifests(t *testing.T) {
+	root := t.TempDir()
+	usage, err := imageUsage(filepath.Join(root, "vi/default/empty"), root, t.TempDir(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if usage != (ImageUsage{}) {
+		t.Fatalf("expected empty usage, got %+v", usage)
+	}
+}
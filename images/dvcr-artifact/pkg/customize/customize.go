@@ -0,0 +1,436 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package customize modifies the image offline before it is stored in DVCR: installs packages,
+// cleans the image up with virt-sysprep, and writes files, the root password and SSH keys with virt-customize.
+// The image is never booted, the libguestfs tools run it in their own appliance.
+package customize
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"k8s.io/klog/v2"
+	"kubevirt.io/containerized-data-importer/pkg/importer"
+
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/datasource"
+	importerrs "github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/errors"
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/registry"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+const (
+	// workDirPattern is the pattern of the directory for the image and the uploaded files.
+	// The importer Pod has a read-only root filesystem, so the directory is created in the writable /tmp.
+	workDirPattern = "customize"
+	diskFilename   = "disk.img"
+	filesDir       = "files"
+
+	// repositoryName names the temporary repository of the packages in the image.
+	repositoryName = "d8v-customization"
+
+	// isoStandardIDOffset is the offset of the standard identifier of the first ISO 9660 volume descriptor.
+	isoStandardIDOffset = 16*2048 + 1
+	isoStandardID       = "CD001"
+
+	// outputTailSize limits the output of a failed tool kept in the error message.
+	outputTailSize = 1024
+
+	// applianceDir holds the fixed libguestfs appliance built with the image, see werf.inc.yaml.
+	applianceDir = "/usr/lib64/guestfs/appliance"
+	// hypervisor boots the appliance.
+	hypervisor = "/usr/bin/qemu-system-x86_64"
+	kvmDevice  = "/dev/kvm"
+)
+
+// Customize downloads the image from the data source to a local file, verifying the checksums of the source,
+// and customizes the file. The returned data source reads the customized image and removes the file on Close.
+func Customize(ctx context.Context, ds datasource.DataSourceInterface, checksums map[string]string, customization *v1alpha2.ImageCustomization) (datasource.DataSourceInterface, error) {
+	workDir, err := os.MkdirTemp("", workDirPattern)
+	if err != nil {
+		return nil, fmt.Errorf("error creating customization directory: %w", err)
+	}
+
+	customized, err := customize(ctx, ds, checksums, customization, workDir)
+	if err != nil {
+		_ = os.RemoveAll(workDir)
+		return nil, err
+	}
+
+	return customized, nil
+}
+
+func customize(ctx context.Context, ds datasource.DataSourceInterface, checksums map[string]string, customization *v1alpha2.ImageCustomization, workDir string) (*DataSource, error) {
+	disk := filepath.Join(workDir, diskFilename)
+
+	klog.Infoln("Customization: download the image")
+	if err := download(ds, checksums, disk); err != nil {
+		return nil, err
+	}
+
+	format, err := imageFormat(ctx, disk)
+	if err != nil {
+		return nil, err
+	}
+
+	if format == "raw" {
+		iso, err := isISO(disk)
+		if err != nil {
+			return nil, err
+		}
+		if iso {
+			return nil, importerrs.NewCustomizationError("check image", errors.New("ISO images cannot be customized"))
+		}
+	}
+
+	if customization.Packages != nil {
+		klog.Infoln("Customization: install packages")
+		if err = run(ctx, "virt-customize", packagesArgs(disk, format, customization.Packages)); err != nil {
+			return nil, importerrs.NewCustomizationError("install packages", err)
+		}
+	}
+
+	if customization.Sysprep != nil {
+		klog.Infoln("Customization: clean up the image")
+		if err = run(ctx, "virt-sysprep", sysprepArgs(disk, format, customization.Sysprep)); err != nil {
+			return nil, importerrs.NewCustomizationError("clean up the image", err)
+		}
+	}
+
+	if len(customization.Files) > 0 || customization.RootPasswordHash != "" || len(customization.SSHAuthorizedKeys) > 0 {
+		localFiles, err := writeFiles(filepath.Join(workDir, filesDir), customization.Files)
+		if err != nil {
+			return nil, err
+		}
+
+		klog.Infoln("Customization: write files, password and SSH keys")
+		if err = run(ctx, "virt-customize", customizeArgs(disk, format, customization, localFiles)); err != nil {
+			return nil, importerrs.NewCustomizationError("write files, password and SSH keys", err)
+		}
+	}
+
+	return newDataSource(workDir, disk)
+}
+
+// download writes the image to the file, decompressing it if it is compressed.
+// The checksums are of the source as is, so they are calculated before the decompression.
+func download(ds datasource.DataSourceInterface, checksums map[string]string, disk string) error {
+	sourceReader, err := ds.ReadCloser()
+	if err != nil {
+		return fmt.Errorf("error getting source image reader: %w", err)
+	}
+	defer sourceReader.Close()
+
+	checksumWriter, checkChecksums := registry.NewChecksumVerifier(checksums)
+	verifiedReader := io.TeeReader(sourceReader, checksumWriter)
+
+	formatReaders, err := importer.NewFormatReaders(io.NopCloser(verifiedReader), 0)
+	if err != nil {
+		return fmt.Errorf("error creating format readers: %w", err)
+	}
+
+	file, err := os.Create(disk)
+	if err != nil {
+		return fmt.Errorf("error creating image file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err = io.Copy(file, formatReaders.TopReader()); err != nil {
+		return fmt.Errorf("error downloading the image: %w", err)
+	}
+
+	// The decompressor may stop before the end of the source, but the checksums are of the whole source.
+	if _, err = io.Copy(io.Discard, verifiedReader); err != nil {
+		return fmt.Errorf("error downloading the image: %w", err)
+	}
+
+	if err = checkChecksums(); err != nil {
+		return err
+	}
+
+	return file.Close()
+}
+
+func imageFormat(ctx context.Context, disk string) (string, error) {
+	out, err := exec.CommandContext(ctx, "qemu-img", "info", "--output=json", disk).CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("error running qemu-img info: %s: %w", string(out), err)
+	}
+
+	var info struct {
+		Format string `json:"format"`
+	}
+	if err = json.Unmarshal(out, &info); err != nil {
+		return "", fmt.Errorf("error parsing qemu-img info output: %w", err)
+	}
+
+	return info.Format, nil
+}
+
+func isISO(disk string) (bool, error) {
+	file, err := os.Open(disk)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	id := make([]byte, len(isoStandardID))
+	_, err = file.ReadAt(id, isoStandardIDOffset)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return false, nil
+		}
+		return false, fmt.Errorf("error reading the image: %w", err)
+	}
+
+	return string(id) == isoStandardID, nil
+}
+
+// writeFiles writes the contents of the files to the directory to upload them to the image.
+// The returned paths are in the order of the files.
+func writeFiles(dir string, files []v1alpha2.ImageCustomizationFile) ([]string, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	if err := os.Mkdir(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("error creating directory for files: %w", err)
+	}
+
+	localFiles := make([]string, 0, len(files))
+	for i, file := range files {
+		// The files are named by their index: the path in the image is not a valid local name.
+		localFile := filepath.Join(dir, fmt.Sprintf("%d", i))
+		if err := os.WriteFile(localFile, []byte(file.Content), 0o600); err != nil {
+			return nil, fmt.Errorf("error writing file %s: %w", file.Path, err)
+		}
+		localFiles = append(localFiles, localFile)
+	}
+
+	return localFiles, nil
+}
+
+// packagesArgs installs the packages with the package manager found in the image.
+// The repository, if any, is added only for the installation, and the package caches are cleaned up.
+func packagesArgs(disk, format string, packages *v1alpha2.ImageCustomizationPackages) []string {
+	return []string{
+		"--format", format, "-a", disk,
+		"--network",
+		"--run-command", packagesScript(packages),
+	}
+}
+
+func packagesScript(packages *v1alpha2.ImageCustomizationPackages) string {
+	names := make([]string, 0, len(packages.Names))
+	for _, name := range packages.Names {
+		names = append(names, shellQuote(name))
+	}
+	pkgs := strings.Join(names, " ")
+
+	var aptRepo, aptRepoCleanup, yumRepo, yumRepoCleanup, zypperRepo, zypperRepoCleanup string
+	if packages.Repository != "" {
+		url := shellQuote(packages.Repository)
+		aptList := "/etc/apt/sources.list.d/" + repositoryName + ".list"
+		aptRepo = fmt.Sprintf("  printf 'deb [trusted=yes] %%s ./\\n' %s > %s\n", url, aptList)
+		aptRepoCleanup = fmt.Sprintf("  rm -f %s\n", aptList)
+		yumRepoFile := "/etc/yum.repos.d/" + repositoryName + ".repo"
+		yumRepo = fmt.Sprintf("  printf '[%s]\\nname=%s\\nbaseurl=%%s\\ngpgcheck=0\\nenabled=1\\n' %s > %s\n", repositoryName, repositoryName, url, yumRepoFile)
+		yumRepoCleanup = fmt.Sprintf("  rm -f %s\n", yumRepoFile)
+		zypperRepo = fmt.Sprintf("  zypper --non-interactive addrepo --no-gpgcheck %s %s\n", url, repositoryName)
+		zypperRepoCleanup = fmt.Sprintf("  zypper --non-interactive removerepo %s\n", repositoryName)
+	}
+
+	var script strings.Builder
+	script.WriteString("set -e\n")
+	script.WriteString("if command -v apt-get >/dev/null 2>&1; then\n")
+	script.WriteString(aptRepo)
+	script.WriteString("  apt-get update\n")
+	script.WriteString("  DEBIAN_FRONTEND=noninteractive apt-get install -y --no-install-recommends " + pkgs + "\n")
+	script.WriteString(aptRepoCleanup)
+	script.WriteString("  apt-get clean\n")
+	script.WriteString("elif command -v dnf >/dev/null 2>&1 || command -v yum >/dev/null 2>&1; then\n")
+	script.WriteString(yumRepo)
+	script.WriteString("  pm=$(command -v dnf || command -v yum)\n")
+	script.WriteString("  \"$pm\" install -y " + pkgs + "\n")
+	script.WriteString(yumRepoCleanup)
+	script.WriteString("  \"$pm\" clean all\n")
+	script.WriteString("elif command -v zypper >/dev/null 2>&1; then\n")
+	script.WriteString(zypperRepo)
+	script.WriteString("  zypper --non-interactive install " + pkgs + "\n")
+	script.WriteString(zypperRepoCleanup)
+	script.WriteString("  zypper --non-interactive clean --all\n")
+	script.WriteString("else\n")
+	script.WriteString("  echo 'no supported package manager found: apt-get, dnf, yum or zypper' >&2\n")
+	script.WriteString("  exit 1\n")
+	script.WriteString("fi\n")
+
+	return script.String()
+}
+
+func sysprepArgs(disk, format string, sysprep *v1alpha2.ImageCustomizationSysprep) []string {
+	args := []string{"--format", format, "-a", disk}
+	if len(sysprep.Operations) > 0 {
+		args = append(args, "--operations", strings.Join(sysprep.Operations, ","))
+	}
+
+	return args
+}
+
+// customizeArgs writes the files, sets the root password and injects the SSH keys.
+// The local files hold the contents of the files in the same order.
+func customizeArgs(disk, format string, customization *v1alpha2.ImageCustomization, localFiles []string) []string {
+	args := []string{"--format", format, "-a", disk}
+
+	for i, file := range customization.Files {
+		permissions := file.Permissions
+		if permissions == "" {
+			permissions = "0644"
+		}
+		if len(permissions) == 3 {
+			permissions = "0" + permissions
+		}
+
+		args = append(args,
+			"--mkdir", path.Dir(file.Path),
+			"--upload", localFiles[i]+":"+file.Path,
+			"--chmod", permissions+":"+file.Path,
+		)
+	}
+
+	if customization.RootPasswordHash != "" {
+		// The tools take the password in plain text only, so the hash is set directly.
+		args = append(args, "--run-command", "usermod -p "+shellQuote(customization.RootPasswordHash)+" root")
+	}
+
+	for _, authorizedKeys := range customization.SSHAuthorizedKeys {
+		user := authorizedKeys.User
+		if user == "" {
+			user = "root"
+		}
+		for _, key := range authorizedKeys.Keys {
+			args = append(args, "--ssh-inject", user+":string:"+strings.TrimSpace(key))
+		}
+	}
+
+	return args
+}
+
+// run runs the libguestfs tool.
+func run(ctx context.Context, tool string, args []string) error {
+	cmd := exec.CommandContext(ctx, tool, args...)
+	cmd.Env = append(os.Environ(), toolEnv()...)
+
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	err := cmd.Run()
+	klog.Infof("%s output: %s", tool, output.String())
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, outputTail(output.String()))
+	}
+
+	return nil
+}
+
+// toolEnv configures the libguestfs tools to boot the fixed appliance directly, without libvirt,
+// and to keep their cache and temporary files in the writable /tmp. The importer Pod has no
+// /dev/kvm, so the appliance is emulated with TCG.
+func toolEnv() []string {
+	env := []string{
+		"LIBGUESTFS_BACKEND=direct",
+		"LIBGUESTFS_PATH=" + applianceDir,
+		"LIBGUESTFS_HV=" + hypervisor,
+		"LIBGUESTFS_CACHEDIR=" + os.TempDir(),
+		"TMPDIR=" + os.TempDir(),
+	}
+	if _, err := os.Stat(kvmDevice); err != nil {
+		env = append(env, "LIBGUESTFS_BACKEND_SETTINGS=force_tcg")
+	}
+
+	return env
+}
+
+// outputTail keeps the end of the output, where the tools report the failure.
+func outputTail(output string) string {
+	output = strings.TrimSpace(output)
+	if len(output) > outputTailSize {
+		output = "..." + output[len(output)-outputTailSize:]
+	}
+
+	return output
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// DataSource reads the customized image.
+type DataSource struct {
+	workDir  string
+	file     *os.File
+	size     int64
+	filename string
+}
+
+func newDataSource(workDir, disk string) (*DataSource, error) {
+	file, err := os.Open(disk)
+	if err != nil {
+		return nil, fmt.Errorf("error opening customized image: %w", err)
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("error getting customized image size: %w", err)
+	}
+
+	uuid, _ := uuid.NewUUID()
+
+	return &DataSource{
+		workDir:  workDir,
+		file:     file,
+		size:     stat.Size(),
+		filename: uuid.String() + ".img",
+	}, nil
+}
+
+func (ds *DataSource) ReadCloser() (io.ReadCloser, error) {
+	return ds.file, nil
+}
+
+func (ds *DataSource) Length() (int, error) {
+	return int(ds.size), nil
+}
+
+func (ds *DataSource) Filename() (string, error) {
+	return ds.filename, nil
+}
+
+// Close removes the customized image.
+func (ds *DataSource) Close() error {
+	return errors.Join(ds.file.Close(), os.RemoveAll(ds.workDir))
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customize
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+func Test_CustomizeArgs(t *testing.T) {
+	customization := &v1alpha2.ImageCustomization{
+		Files: []v1alpha2.ImageCustomizationFile{
+			{Path: "/etc/motd", Content: "Welcome\n"},
+			{Path: "/usr/local/bin/hello", Content: "#!/bin/sh\necho hello\n", Permissions: "755"},
+		},
+		RootPasswordHash: "$6$salt$hash",
+		SSHAuthorizedKeys: []v1alpha2.ImageCustomizationSSHAuthorizedKeys{
+			{Keys: []string{"ssh-ed25519 AAAA admin@example.com\n"}},
+			{User: "cloud", Keys: []string{"ssh-rsa BBBB", "ssh-ed25519 CCCC"}},
+		},
+	}
+
+	args := customizeArgs("/tmp/customize/disk.img", "qcow2", customization, []string{"/tmp/files/0", "/tmp/files/1"})
+
+	require.Equal(t, []string{
+		"--format", "qcow2", "-a", "/tmp/customize/disk.img",
+		"--mkdir", "/etc",
+		"--upload", "/tmp/files/0:/etc/motd",
+		"--chmod", "0644:/etc/motd",
+		"--mkdir", "/usr/local/bin",
+		"--upload", "/tmp/files/1:/usr/local/bin/hello",
+		"--chmod", "0755:/usr/local/bin/hello",
+		"--run-command", "usermod -p '$6$salt$hash' root",
+		"--ssh-inject", "root:string:ssh-ed25519 AAAA admin@example.com",
+		"--ssh-inject", "cloud:string:ssh-rsa BBBB",
+		"--ssh-inject", "cloud:string:ssh-ed25519 CCCC",
+	}, args)
+}
+
+func Test_SysprepArgs(t *testing.T) {
+	require.Equal(t,
+		[]string{"--format", "raw", "-a", "disk.img"},
+		sysprepArgs("disk.img", "raw", &v1alpha2.ImageCustomizationSysprep{}),
+		"no operations must leave the defaults of virt-sysprep",
+	)
+
+	require.Equal(t,
+		[]string{"--format", "raw", "-a", "disk.img", "--operations", "machine-id,ssh-hostkeys"},
+		sysprepArgs("disk.img", "raw", &v1alpha2.ImageCustomizationSysprep{Operations: []string{"machine-id", "ssh-hostkeys"}}),
+	)
+}
+
+// Test_PackagesScript runs the script with a fake apt-get to make sure the
+// names of the packages reach the package manager intact.
+func Test_PackagesScript(t *testing.T) {
+	bin := t.TempDir()
+	log := filepath.Join(t.TempDir(), "apt-get.log")
+	fakeAptGet := "#!/bin/sh\necho \"$@\" >> " + log + "\n"
+	require.NoError(t, os.WriteFile(filepath.Join(bin, "apt-get"), []byte(fakeAptGet), 0o755))
+
+	script := packagesScript(&v1alpha2.ImageCustomizationPackages{
+		Names: []string{"nginx", "qemu-guest-agent", "it's; rm -rf /"},
+	})
+
+	cmd := exec.Command("/bin/sh", "-c", script)
+	cmd.Env = []string{"PATH=" + bin + ":/usr/bin:/bin"}
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	calls, err := os.ReadFile(log)
+	require.NoError(t, err)
+	require.Equal(t,
+		"update\ninstall -y --no-install-recommends nginx qemu-guest-agent it's; rm -rf /\nclean\n",
+		string(calls),
+	)
+}
+
+func Test_PackagesScript_Repository(t *testing.T) {
+	script := packagesScript(&v1alpha2.ImageCustomizationPackages{
+		Names:      []string{"nginx"},
+		Repository: "http://repo.example.com/ubuntu-24.04/",
+	})
+
+	out, err := exec.Command("/bin/sh", "-n", "-c", script).CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	require.Contains(t, script, "printf 'deb [trusted=yes] %s ./\\n' 'http://repo.example.com/ubuntu-24.04/' > /etc/apt/sources.list.d/d8v-customization.list")
+	require.Contains(t, script, "rm -f /etc/apt/sources.list.d/d8v-customization.list")
+	require.Contains(t, script, "baseurl=%s")
+	require.Contains(t, script, "rm -f /etc/yum.repos.d/d8v-customization.repo")
+	require.Contains(t, script, "zypper --non-interactive addrepo --no-gpgcheck 'http://repo.example.com/ubuntu-24.04/' d8v-customization")
+	require.Contains(t, script, "zypper --non-interactive removerepo d8v-customization")
+
+	require.False(t, strings.Contains(packagesScript(&v1alpha2.ImageCustomizationPackages{Names: []string{"nginx"}}), repositoryName),
+		"no repository must leave the repositories of the image alone")
+}
+
+func Test_IsISO(t *testing.T) {
+	dir := t.TempDir()
+
+	iso := make([]byte, isoStandardIDOffset+2048)
+	copy(iso[isoStandardIDOffset:], isoStandardID)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "image.iso"), iso, 0o644))
+
+	ok, err := isISO(filepath.Join(dir, "image.iso"))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "disk.img"), make([]byte, isoStandardIDOffset+2048), 0o644))
+	ok, err = isISO(filepath.Join(dir, "disk.img"))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "small.img"), make([]byte, 512), 0o644))
+	ok, err = isISO(filepath.Join(dir, "small.img"))
+	require.NoError(t, err)
+	require.False(t, ok, "an image smaller than the volume descriptors is not an ISO")
+}
+
+func Test_WriteFiles(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), filesDir)
+
+	localFiles, err := writeFiles(dir, []v1alpha2.ImageCustomizationFile{
+		{Path: "/etc/motd", Content: "Welcome\n"},
+		{Path: "/etc/hosts", Content: "127.0.0.1 localhost\n"},
+	})
+	require.NoError(t, err)
+	require.Len(t, localFiles, 2)
+
+	content, err := os.ReadFile(localFiles[1])
+	require.NoError(t, err)
+	require.Equal(t, "127.0.0.1 localhost\n", string(content))
+}
+
+// Test_Appliance boots the libguestfs appliance the way the customization does. It runs where the
+// appliance and the hypervisor are installed, as in the importer image, and is skipped elsewhere.
+func Test_Appliance(t *testing.T) {
+	testTool, err := exec.LookPath("libguestfs-test-tool")
+	if err != nil {
+		t.Skip("libguestfs-test-tool is not installed")
+	}
+	for _, path := range []string{applianceDir, hypervisor} {
+		if _, err = os.Stat(path); err != nil {
+			t.Skipf("%s is not installed", path)
+		}
+	}
+
+	cmd := exec.Command(testTool)
+	cmd.Env = append(os.Environ(), toolEnv()...)
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "the appliance does not boot: %s", outputTail(string(out)))
+	require.True(t, strings.Contains(string(out), "TEST FINISHED OK"), "unexpected output: %s", outputTail(string(out)))
+}
@@ -16,7 +16,11 @@ limitations under the License.
 
 package errors
 
-import "fmt"
+import (
+	"fmt"
+
+	dvcrrepo "github.com/deckhouse/virtualization/api/dvcr"
+)
 
 type ReasonError interface {
 	error
@@ -38,7 +42,7 @@ type BadImageChecksumError struct {
 }
 
 func (e BadImageChecksumError) Reason() string {
-	return "BadImageChecksum"
+	return dvcrrepo.BadImageChecksumReason
 }
 
 // Permanent reports that repeating the import cannot fix the mismatch: the
@@ -53,3 +57,66 @@ func (e BadImageChecksumError) Permanent() bool {
 func (e BadImageChecksumError) Error() string {
 	return fmt.Sprintf("%s sum mismatch: %s != %s", e.algorithm, e.expected, e.actual)
 }
+
+func NewSignatureVerificationError(image string, cause error) SignatureVerificationError {
+	return SignatureVerificationError{
+		image: image,
+		cause: cause,
+	}
+}
+
+type SignatureVerificationError struct {
+	image string
+	cause error
+}
+
+func (e SignatureVerificationError) Reason() string {
+	return dvcrrepo.SignatureVerificationFailedReason
+}
+
+// Permanent reports that the verdict does not depend on the attempt: the
+// signatures of the image are what they are, and pulling them again cannot
+// make an untrusted image trusted.
+func (e SignatureVerificationError) Permanent() bool {
+	return true
+}
+
+func (e SignatureVerificationError) Error() string {
+	return fmt.Sprintf("signature verification failed for image %s: %s", e.image, e.cause)
+}
+
+func (e SignatureVerificationError) Unwrap() error {
+	return e.cause
+}
+
+func NewCustomizationError(step string, cause error) CustomizationError {
+	return CustomizationError{
+		step:  step,
+		cause: cause,
+	}
+}
+
+type CustomizationError struct {
+	step  string
+	cause error
+}
+
+func (e CustomizationError) Reason() string {
+	return dvcrrepo.CustomizationFailedReason
+}
+
+// Permanent reports that the customization of the very same image with the
+// very same settings fails the very same way: a missing package or user is not
+// going to appear on the next attempt, while the attempt downloads the whole
+// image again.
+func (e CustomizationError) Permanent() bool {
+	return true
+}
+
+func (e CustomizationError) Error() string {
+	return fmt.Sprintf("customization failed: %s: %s", e.step, e.cause)
+}
+
+func (e CustomizationError) Unwrap() error {
+	return e.cause
+}
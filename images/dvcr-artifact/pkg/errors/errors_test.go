@@ -17,6 +17,7 @@ limitations under the License.
 package errors
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -40,3 +41,15 @@ func Test_BadImageChecksumError(t *testing.T) {
 		err.Error(),
 	)
 }
+
+// Test_CustomizationError makes sure the failed step reaches the Ready
+// condition of the resource and the failure is not retried.
+func Test_CustomizationError(t *testing.T) {
+	cause := errors.New("exit status 100: E: Unable to locate package nginx")
+	err := NewCustomizationError("install packages", cause)
+
+	require.Equal(t, "CustomizationFailed", err.Reason())
+	require.True(t, err.Permanent(), "a customization failure must not be retried")
+	require.ErrorIs(t, err, cause)
+	require.Equal(t, "customization failed: install packages: exit status 100: E: Unable to locate package nginx", err.Error())
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	envListenAddress = "LISTEN_ADDRESS"
+	envListenPort    = "LISTEN_PORT"
+	envHealthzPort   = "HEALTHZ_PORT"
+	envSourcePath    = "EXPORTER_SOURCE_PATH"
+	envFormat        = "EXPORTER_FORMAT"
+	envCompression   = "EXPORTER_COMPRESSION"
+	envToken         = "EXPORTER_TOKEN"
+	envFileName      = "EXPORTER_FILE_NAME"
+	envExpiresAt     = "EXPORTER_EXPIRES_AT"
+
+	defaultListenAddress = "0.0.0.0"
+	defaultListenPort    = 8445
+	defaultHealthzPort   = 8080
+)
+
+// Options is the full command-line/environment configuration of the exporter.
+type Options struct {
+	ListenAddress string
+	ListenPort    int
+	HealthzPort   int
+
+	// SourcePath is the block device or the disk image file to serve.
+	SourcePath  string
+	Format      string
+	Compression string
+	// Token is the secret part of the download path.
+	Token    string
+	FileName string
+	// ExpiresAt is the RFC 3339 time after which downloads are refused.
+	ExpiresAt string
+}
+
+// AddFlags registers the exporter flags, each defaulting to its environment
+// variable: the controller configures the exporter Pod with the environment.
+func (o *Options) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.ListenAddress, "listen-address", envStr(envListenAddress, defaultListenAddress), "Address the download server binds to")
+	fs.IntVar(&o.ListenPort, "listen-port", envInt(envListenPort, defaultListenPort), "Port the download server listens on")
+	fs.IntVar(&o.HealthzPort, "healthz-port", envInt(envHealthzPort, defaultHealthzPort), "Port the healthz endpoint listens on")
+
+	fs.StringVar(&o.SourcePath, "source", envStr(envSourcePath, ""), "Path to the block device or the raw disk image to export")
+	fs.StringVar(&o.Format, "format", envStr(envFormat, FormatRaw), "Format of the served image: raw or qcow2")
+	fs.StringVar(&o.Compression, "compression", envStr(envCompression, CompressionNone), "Compression of the served image: none or gzip")
+	fs.StringVar(&o.Token, "token", envStr(envToken, ""), "Access token expected in the download path")
+	fs.StringVar(&o.FileName, "file-name", envStr(envFileName, ""), "Name of the file the image is served as")
+	fs.StringVar(&o.ExpiresAt, "expires-at", envStr(envExpiresAt, ""), "RFC 3339 time after which downloads are refused")
+}
+
+// Complete validates the options and turns them into a ready-to-run Server.
+func (o *Options) Complete() (*Server, error) {
+	if o.SourcePath == "" {
+		return nil, fmt.Errorf("--source (%s) is required", envSourcePath)
+	}
+
+	if len(o.Token) < minTokenLength {
+		return nil, fmt.Errorf("--token (%s) must be at least %d characters long", envToken, minTokenLength)
+	}
+
+	switch o.Format {
+	case FormatRaw, FormatQcow2:
+	default:
+		return nil, fmt.Errorf("unknown format %q, expected %s or %s", o.Format, FormatRaw, FormatQcow2)
+	}
+
+	switch o.Compression {
+	case CompressionNone, CompressionGzip:
+	default:
+		return nil, fmt.Errorf("unknown compression %q, expected %s or %s", o.Compression, CompressionNone, CompressionGzip)
+	}
+
+	var expiresAt time.Time
+	if o.ExpiresAt != "" {
+		var err error
+		expiresAt, err = time.Parse(time.RFC3339, o.ExpiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("--expires-at (%s): %w", envExpiresAt, err)
+		}
+	}
+
+	address := net.JoinHostPort(o.ListenAddress, strconv.Itoa(o.ListenPort))
+
+	return NewServer(address, o.HealthzPort, Export{
+		SourcePath:  o.SourcePath,
+		Format:      o.Format,
+		Compression: o.Compression,
+		Token:       o.Token,
+		FileName:    o.FileName,
+		ExpiresAt:   expiresAt,
+	}), nil
+}
+
+func envStr(key, def string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return def
+}
+
+func envInt(key string, def int) int {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return def
+	}
+	return n
+}
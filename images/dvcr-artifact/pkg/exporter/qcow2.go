@@ -0,0 +1,309 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// The exporter writes a QCOW2 version 2 image in a single pass over the
+// output, so it can be streamed to the client without a scratch volume. It is
+// possible because the layout is fully known in advance: a scan of the source
+// finds the clusters holding data, and everything else is only metadata about
+// them. The image is laid out as follows:
+//
+//	header | L1 table | refcount table | refcount blocks | L2 tables | data clusters
+//
+// Every cluster is referenced exactly once, so all refcounts are 1 and all
+// table entries carry the COPIED flag, as qemu-img check expects.
+const (
+	qcow2Magic         = 0x514649fb // "QFI\xfb"
+	qcow2Version       = 2
+	qcow2ClusterBits   = 16
+	qcow2ClusterSize   = 1 << qcow2ClusterBits
+	qcow2HeaderSize    = 72
+	qcow2EntrySize     = 8
+	qcow2RefcountSize  = 2 // refcount_order 4 is the only one version 2 knows.
+	qcow2FlagCopied    = uint64(1) << 63
+	l2EntriesPerTable  = qcow2ClusterSize / qcow2EntrySize
+	refcountsPerBlock  = qcow2ClusterSize / qcow2RefcountSize
+	refTableEntriesPer = qcow2ClusterSize / qcow2EntrySize
+)
+
+// Qcow2Layout describes a QCOW2 image built from the allocated clusters of a
+// raw source.
+type Qcow2Layout struct {
+	virtualSize int64
+	// allocated marks the guest clusters that hold data.
+	allocated []bool
+
+	l1Size         int64
+	l1Clusters     int64
+	refTableOffset int64
+	refTableLen    int64
+	refBlocks      int64
+	l2Tables       []int64 // indexes of the L1 entries with an L2 table
+	l2Offset       int64
+	dataOffset     int64
+	totalClusters  int64
+}
+
+// ScanQcow2Layout reads the source once and plans the QCOW2 image: clusters
+// consisting of zeroes only are left unallocated in the image.
+func ScanQcow2Layout(ctx context.Context, src io.ReaderAt, size int64) (*Qcow2Layout, error) {
+	clusters := divCeil(size, qcow2ClusterSize)
+	allocated := make([]bool, clusters)
+
+	buf := make([]byte, qcow2ClusterSize)
+	for i := range allocated {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		n, err := readCluster(src, buf, int64(i), size)
+		if err != nil {
+			return nil, err
+		}
+
+		allocated[i] = !isZero(buf[:n])
+	}
+
+	return newQcow2Layout(size, allocated), nil
+}
+
+func newQcow2Layout(size int64, allocated []bool) *Qcow2Layout {
+	l := &Qcow2Layout{
+		virtualSize: size,
+		allocated:   allocated,
+	}
+
+	l.l1Size = divCeil(int64(len(allocated)), l2EntriesPerTable)
+	l.l1Clusters = max(divCeil(l.l1Size*qcow2EntrySize, qcow2ClusterSize), 1)
+
+	var data int64
+	for i := int64(0); i < l.l1Size; i++ {
+		end := min((i+1)*l2EntriesPerTable, int64(len(allocated)))
+
+		used := false
+		for _, a := range allocated[i*l2EntriesPerTable : end] {
+			if a {
+				used = true
+				data++
+			}
+		}
+
+		if used {
+			l.l2Tables = append(l.l2Tables, i)
+		}
+	}
+
+	// The refcount structures count themselves, so grow them until they
+	// cover every cluster of the image.
+	fixed := 1 + l.l1Clusters + int64(len(l.l2Tables)) + data
+	l.refBlocks = 1
+	for {
+		l.refTableLen = divCeil(l.refBlocks, refTableEntriesPer)
+		total := fixed + l.refTableLen + l.refBlocks
+		if need := divCeil(total, refcountsPerBlock); need > l.refBlocks {
+			l.refBlocks = need
+			continue
+		}
+		l.totalClusters = total
+		break
+	}
+
+	l.refTableOffset = (1 + l.l1Clusters) * qcow2ClusterSize
+	l.l2Offset = l.refTableOffset + (l.refTableLen+l.refBlocks)*qcow2ClusterSize
+	l.dataOffset = l.l2Offset + int64(len(l.l2Tables))*qcow2ClusterSize
+
+	return l
+}
+
+// Size returns the size of the QCOW2 image in bytes.
+func (l *Qcow2Layout) Size() int64 {
+	return l.totalClusters * qcow2ClusterSize
+}
+
+// WriteTo streams the QCOW2 image to w, reading the allocated clusters from
+// src. src must not change since the scan.
+func (l *Qcow2Layout) WriteTo(ctx context.Context, w io.Writer, src io.ReaderAt) (int64, error) {
+	cw := &countingWriter{w: w}
+
+	if err := l.writeMetadata(cw); err != nil {
+		return cw.n, err
+	}
+
+	buf := make([]byte, qcow2ClusterSize)
+	for i, allocated := range l.allocated {
+		if !allocated {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return cw.n, err
+		}
+
+		n, err := readCluster(src, buf, int64(i), l.virtualSize)
+		if err != nil {
+			return cw.n, err
+		}
+		// The tail of the last cluster is beyond the virtual size: pad it.
+		clear(buf[n:])
+
+		if _, err = cw.Write(buf); err != nil {
+			return cw.n, err
+		}
+	}
+
+	if cw.n != l.Size() {
+		return cw.n, fmt.Errorf("written %d bytes of the qcow2 image, expected %d: the source has changed during the export", cw.n, l.Size())
+	}
+
+	return cw.n, nil
+}
+
+func (l *Qcow2Layout) writeMetadata(w io.Writer) error {
+	var header bytes.Buffer
+	for _, v := range []any{
+		uint32(qcow2Magic),
+		uint32(qcow2Version),
+		uint64(0), // backing_file_offset
+		uint32(0), // backing_file_size
+		uint32(qcow2ClusterBits),
+		uint64(l.virtualSize),
+		uint32(0), // crypt_method
+		uint32(l.l1Size),
+		uint64(qcow2ClusterSize), // l1_table_offset
+		uint64(l.refTableOffset),
+		uint32(l.refTableLen),
+		uint32(0), // nb_snapshots
+		uint64(0), // snapshots_offset
+	} {
+		_ = binary.Write(&header, binary.BigEndian, v)
+	}
+
+	if header.Len() != qcow2HeaderSize {
+		return fmt.Errorf("unexpected qcow2 header size %d", header.Len())
+	}
+
+	if err := writePadded(w, header.Bytes(), 1); err != nil {
+		return err
+	}
+
+	// L1 table.
+	l1 := make([]byte, l.l1Clusters*qcow2ClusterSize)
+	for n, idx := range l.l2Tables {
+		offset := uint64(l.l2Offset+int64(n)*qcow2ClusterSize) | qcow2FlagCopied
+		binary.BigEndian.PutUint64(l1[idx*qcow2EntrySize:], offset)
+	}
+	if _, err := w.Write(l1); err != nil {
+		return err
+	}
+
+	// Refcount table: the refcount blocks follow it immediately.
+	refTable := make([]byte, l.refTableLen*qcow2ClusterSize)
+	firstBlock := l.refTableOffset + l.refTableLen*qcow2ClusterSize
+	for i := int64(0); i < l.refBlocks; i++ {
+		binary.BigEndian.PutUint64(refTable[i*qcow2EntrySize:], uint64(firstBlock+i*qcow2ClusterSize))
+	}
+	if _, err := w.Write(refTable); err != nil {
+		return err
+	}
+
+	// Refcount blocks: every cluster up to the end of the image is used once.
+	block := make([]byte, qcow2ClusterSize)
+	for i := int64(0); i < l.refBlocks; i++ {
+		clear(block)
+		for j := int64(0); j < refcountsPerBlock; j++ {
+			if i*refcountsPerBlock+j >= l.totalClusters {
+				break
+			}
+			binary.BigEndian.PutUint16(block[j*qcow2RefcountSize:], 1)
+		}
+		if _, err := w.Write(block); err != nil {
+			return err
+		}
+	}
+
+	// L2 tables: data clusters are written in the guest order.
+	next := l.dataOffset
+	table := make([]byte, qcow2ClusterSize)
+	for _, idx := range l.l2Tables {
+		clear(table)
+		start := idx * l2EntriesPerTable
+		end := min(start+l2EntriesPerTable, int64(len(l.allocated)))
+		for c := start; c < end; c++ {
+			if !l.allocated[c] {
+				continue
+			}
+			binary.BigEndian.PutUint64(table[(c-start)*qcow2EntrySize:], uint64(next)|qcow2FlagCopied)
+			next += qcow2ClusterSize
+		}
+		if _, err := w.Write(table); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readCluster(src io.ReaderAt, buf []byte, idx, size int64) (int, error) {
+	offset := idx * qcow2ClusterSize
+	want := min(int64(len(buf)), size-offset)
+
+	n, err := src.ReadAt(buf[:want], offset)
+	if err != nil && !(err == io.EOF && int64(n) == want) {
+		return n, fmt.Errorf("read cluster %d: %w", idx, err)
+	}
+
+	return n, nil
+}
+
+func writePadded(w io.Writer, data []byte, clusters int64) error {
+	buf := make([]byte, clusters*qcow2ClusterSize)
+	copy(buf, data)
+	_, err := w.Write(buf)
+	return err
+}
+
+func isZero(buf []byte) bool {
+	for _, b := range buf {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func divCeil(a, b int64) int64 {
+	return (a + b - 1) / b
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
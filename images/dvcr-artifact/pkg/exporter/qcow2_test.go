@@ -0,0 +1,142 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// readQcow2 reassembles the guest data of a QCOW2 image produced by the
+// exporter and checks that every cluster of the image has refcount 1.
+func readQcow2(t *testing.T, img []byte) []byte {
+	t.Helper()
+
+	require.Equal(t, uint32(qcow2Magic), binary.BigEndian.Uint32(img[0:]))
+	require.Equal(t, uint32(qcow2Version), binary.BigEndian.Uint32(img[4:]))
+	require.Equal(t, uint32(qcow2ClusterBits), binary.BigEndian.Uint32(img[20:]))
+
+	size := int64(binary.BigEndian.Uint64(img[24:]))
+	l1Size := int64(binary.BigEndian.Uint32(img[36:]))
+	l1Offset := int64(binary.BigEndian.Uint64(img[40:]))
+	refTableOffset := int64(binary.BigEndian.Uint64(img[48:]))
+	refTableClusters := int64(binary.BigEndian.Uint32(img[56:]))
+
+	require.Zero(t, int64(len(img))%qcow2ClusterSize, "the image must consist of whole clusters")
+	clusters := int64(len(img)) / qcow2ClusterSize
+
+	var refcounted int64
+	for i := int64(0); i < refTableClusters*refTableEntriesPer; i++ {
+		block := int64(binary.BigEndian.Uint64(img[refTableOffset+i*qcow2EntrySize:]))
+		if block == 0 {
+			continue
+		}
+		for j := int64(0); j < refcountsPerBlock; j++ {
+			refcount := binary.BigEndian.Uint16(img[block+j*qcow2RefcountSize:])
+			if i*refcountsPerBlock+j < clusters {
+				require.Equal(t, uint16(1), refcount, "cluster %d", i*refcountsPerBlock+j)
+				refcounted++
+			} else {
+				require.Zero(t, refcount, "cluster %d is beyond the image", i*refcountsPerBlock+j)
+			}
+		}
+	}
+	require.Equal(t, clusters, refcounted)
+
+	guest := make([]byte, size)
+	for i := int64(0); i < l1Size; i++ {
+		l1Entry := binary.BigEndian.Uint64(img[l1Offset+i*qcow2EntrySize:])
+		if l1Entry == 0 {
+			continue
+		}
+		require.NotZero(t, l1Entry&qcow2FlagCopied)
+		l2Offset := int64(l1Entry &^ qcow2FlagCopied)
+
+		for j := int64(0); j < l2EntriesPerTable; j++ {
+			l2Entry := binary.BigEndian.Uint64(img[l2Offset+j*qcow2EntrySize:])
+			if l2Entry == 0 {
+				continue
+			}
+			require.NotZero(t, l2Entry&qcow2FlagCopied)
+			dataOffset := int64(l2Entry &^ qcow2FlagCopied)
+			guestOffset := (i*l2EntriesPerTable + j) * qcow2ClusterSize
+			copy(guest[guestOffset:], img[dataOffset:dataOffset+qcow2ClusterSize])
+		}
+	}
+
+	return guest
+}
+
+func Test_Qcow2Layout(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		size int64
+		data []int64 // offsets of the non-zero bytes
+	}{
+		{name: "empty disk", size: 0},
+		{name: "zeroed disk", size: 3 * qcow2ClusterSize},
+		{name: "sparse disk", size: 16 * qcow2ClusterSize, data: []int64{0, 5*qcow2ClusterSize + 17, 15 * qcow2ClusterSize}},
+		{name: "partial last cluster", size: 2*qcow2ClusterSize + 512, data: []int64{2*qcow2ClusterSize + 511}},
+		{name: "several L2 tables", size: (2*l2EntriesPerTable + 3) * qcow2ClusterSize, data: []int64{1, l2EntriesPerTable * qcow2ClusterSize * 2}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			src := make([]byte, tt.size)
+			for _, off := range tt.data {
+				src[off] = 0xAB
+			}
+
+			layout, err := ScanQcow2Layout(context.Background(), bytes.NewReader(src), tt.size)
+			require.NoError(t, err)
+
+			var img bytes.Buffer
+			n, err := layout.WriteTo(context.Background(), &img, bytes.NewReader(src))
+			require.NoError(t, err)
+			require.Equal(t, layout.Size(), n)
+			require.Equal(t, int64(img.Len()), n)
+
+			require.Equal(t, src, readQcow2(t, img.Bytes()))
+		})
+	}
+}
+
+func Test_Qcow2LayoutSkipsZeroClusters(t *testing.T) {
+	size := int64(64 * qcow2ClusterSize)
+	src := make([]byte, size)
+	src[10*qcow2ClusterSize] = 1
+
+	layout, err := ScanQcow2Layout(context.Background(), bytes.NewReader(src), size)
+	require.NoError(t, err)
+
+	// header, L1, refcount table, refcount block, one L2 table and one data cluster.
+	require.Equal(t, int64(6*qcow2ClusterSize), layout.Size())
+}
+
+func Test_Qcow2LayoutDetectsChangedSource(t *testing.T) {
+	size := int64(4 * qcow2ClusterSize)
+	src := make([]byte, size)
+	src[0] = 1
+
+	layout, err := ScanQcow2Layout(context.Background(), bytes.NewReader(src), size)
+	require.NoError(t, err)
+
+	_, err = layout.WriteTo(context.Background(), &bytes.Buffer{}, bytes.NewReader(src[:qcow2ClusterSize/2]))
+	require.Error(t, err)
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package exporter serves the contents of a virtual disk volume over HTTP, so
+// a disk can be downloaded without access to the cluster storage.
+package exporter
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	FormatRaw   = "raw"
+	FormatQcow2 = "qcow2"
+
+	CompressionNone = "none"
+	CompressionGzip = "gzip"
+
+	// DownloadPathPrefix is followed by the access token of the export.
+	DownloadPathPrefix = "/download/"
+
+	healthzPath    = "/healthz"
+	minTokenLength = 32
+)
+
+// Export describes what the server serves and to whom.
+type Export struct {
+	SourcePath  string
+	Format      string
+	Compression string
+	Token       string
+	FileName    string
+	// ExpiresAt is zero when the export never expires.
+	ExpiresAt time.Time
+}
+
+type Server struct {
+	address     string
+	healthzPort int
+	export      Export
+
+	source *os.File
+	size   int64
+	layout *Qcow2Layout
+	// ready is set once the source is opened and, for qcow2, scanned.
+	ready atomic.Bool
+	// active limits the exporter to a single download at a time: the source
+	// volume is read at full speed, parallel downloads would only compete.
+	active atomic.Bool
+}
+
+func NewServer(address string, healthzPort int, export Export) *Server {
+	if healthzPort == 0 {
+		healthzPort = defaultHealthzPort
+	}
+
+	return &Server{
+		address:     address,
+		healthzPort: healthzPort,
+		export:      export,
+	}
+}
+
+// Run prepares the source and serves downloads until ctx is done.
+func (s *Server) Run(ctx context.Context) error {
+	healthzListener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.healthzPort))
+	if err != nil {
+		return fmt.Errorf("create healthz listener: %w", err)
+	}
+
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return fmt.Errorf("create download listener: %w", err)
+	}
+
+	healthzMux := http.NewServeMux()
+	healthzMux.HandleFunc(healthzPath, s.healthzHandler)
+	healthzServer := &http.Server{Handler: healthzMux, ReadHeaderTimeout: 10 * time.Second}
+
+	downloadMux := http.NewServeMux()
+	downloadMux.HandleFunc(DownloadPathPrefix, s.downloadHandler)
+	downloadServer := &http.Server{Handler: downloadMux, ReadHeaderTimeout: 10 * time.Second}
+
+	errChan := make(chan error, 3)
+
+	go func() { errChan <- healthzServer.Serve(healthzListener) }()
+	go func() { errChan <- s.prepare(ctx) }()
+
+	defer func() {
+		_ = healthzServer.Shutdown(context.Background())
+		_ = downloadServer.Shutdown(context.Background())
+		if s.source != nil {
+			_ = s.source.Close()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err = <-errChan:
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+
+			if s.ready.Load() && listener != nil {
+				klog.Infof("Serving %s as %s on %s", s.export.SourcePath, s.fileName(), s.address)
+				l := listener
+				listener = nil
+				go func() { errChan <- downloadServer.Serve(l) }()
+			}
+		}
+	}
+}
+
+func (s *Server) prepare(ctx context.Context) error {
+	f, err := os.Open(s.export.SourcePath)
+	if err != nil {
+		return fmt.Errorf("open source: %w", err)
+	}
+	s.source = f
+
+	// Seek works for both block devices and regular files, Stat does not.
+	s.size, err = f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return fmt.Errorf("get source size: %w", err)
+	}
+
+	if s.export.Format == FormatQcow2 {
+		klog.Infof("Scanning %d bytes of %s for allocated clusters", s.size, s.export.SourcePath)
+
+		s.layout, err = ScanQcow2Layout(ctx, f, s.size)
+		if err != nil {
+			return fmt.Errorf("scan source: %w", err)
+		}
+	}
+
+	s.ready.Store(true)
+
+	return nil
+}
+
+func (s *Server) healthzHandler(w http.ResponseWriter, _ *http.Request) {
+	if !s.ready.Load() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	if _, err := io.WriteString(w, "OK"); err != nil {
+		klog.Errorf("healthzHandler: failed to send response; %v", err)
+	}
+}
+
+func (s *Server) downloadHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	token := strings.TrimPrefix(r.URL.Path, DownloadPathPrefix)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(s.export.Token)) != 1 {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if !s.export.ExpiresAt.IsZero() && time.Now().After(s.export.ExpiresAt) {
+		http.Error(w, "The download link has expired.", http.StatusGone)
+		return
+	}
+
+	if r.Method == http.MethodGet && !s.active.CompareAndSwap(false, true) {
+		http.Error(w, "Another download is in progress, retry later.", http.StatusTooManyRequests)
+		return
+	}
+	if r.Method == http.MethodGet {
+		defer s.active.Store(false)
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", s.fileName()))
+
+	// A raw uncompressed image is the source itself: serve it with range
+	// support, so an interrupted download can be resumed.
+	if s.export.Format == FormatRaw && s.export.Compression == CompressionNone {
+		http.ServeContent(w, r, "", time.Time{}, io.NewSectionReader(s.source, 0, s.size))
+		return
+	}
+
+	if s.export.Compression == CompressionNone {
+		w.Header().Set("Content-Length", strconv.FormatInt(s.layout.Size(), 10))
+	}
+
+	if r.Method == http.MethodHead {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	klog.Infof("Download of %s started by %s", s.fileName(), r.RemoteAddr)
+
+	n, err := s.write(r.Context(), w)
+	if err != nil {
+		// The status is already sent: the client detects the failure by
+		// the short or broken body.
+		klog.Errorf("Download of %s failed after %d bytes: %v", s.fileName(), n, err)
+		return
+	}
+
+	klog.Infof("Download of %s completed: %d bytes", s.fileName(), n)
+}
+
+func (s *Server) write(ctx context.Context, w io.Writer) (int64, error) {
+	if s.export.Compression == CompressionGzip {
+		gz := gzip.NewWriter(w)
+		n, err := s.writeImage(ctx, gz)
+		if err != nil {
+			return n, err
+		}
+		return n, gz.Close()
+	}
+
+	return s.writeImage(ctx, w)
+}
+
+func (s *Server) writeImage(ctx context.Context, w io.Writer) (int64, error) {
+	if s.export.Format == FormatQcow2 {
+		return s.layout.WriteTo(ctx, w, s.source)
+	}
+
+	return io.Copy(w, io.NewSectionReader(s.source, 0, s.size))
+}
+
+func (s *Server) fileName() string {
+	if s.export.FileName != "" {
+		return s.export.FileName
+	}
+
+	name := "disk." + s.export.Format
+	if s.export.Compression == CompressionGzip {
+		name += ".gz"
+	}
+
+	return name
+}
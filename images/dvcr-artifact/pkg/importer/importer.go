@@ -19,6 +19,7 @@ package importer
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -37,10 +38,15 @@ import (
 	prometheusutil "kubevirt.io/containerized-data-importer/pkg/util/prometheus"
 
 	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/auth"
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/customize"
 	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/datasource"
 	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/monitoring"
 	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/registry"
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/registry/cabundle"
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/registry/replication"
 	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/retry"
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/signature"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 )
 
 // FIXME(ilya-lesikov): certdir
@@ -54,6 +60,30 @@ const (
 	// ImporterChecksums is an environment variable with the checksums to verify
 	// the downloaded image against, in the algorithm:sum format, comma separated.
 	ImporterChecksums = "IMPORTER_CHECKSUMS"
+	// ImporterTrustedKeys is an environment variable with the PEM-encoded keys
+	// trusted to sign the container image. Signature verification is off if unset.
+	ImporterTrustedKeys = "IMPORTER_TRUSTED_KEYS"
+	// ImporterSignatureRequired is an environment variable that defines whether
+	// an unsigned container image is rejected.
+	ImporterSignatureRequired = "IMPORTER_SIGNATURE_REQUIRED"
+	// ImporterCustomization is an environment variable with the JSON-encoded offline
+	// customization to perform on the image before storing it. No customization if unset.
+	ImporterCustomization = "IMPORTER_CUSTOMIZATION"
+	// DestinationChunkedVar is an environment variable that defines whether the image
+	// is stored in DVCR as content-defined chunks deduplicated across images.
+	DestinationChunkedVar = "DESTINATION_CHUNKED"
+	// DestinationCompressionVar is an environment variable with the compression algorithm
+	// of the image layer stored in DVCR, see registry.CompressionZstd. No compression if unset.
+	DestinationCompressionVar = "DESTINATION_COMPRESSION"
+	// ImporterCacheImage is an environment variable with the DVCR pull-through cache image
+	// of the source container image, referenced by digest. The cache is not used if unset.
+	ImporterCacheImage = "IMPORTER_CACHE_IMAGE"
+	// ImporterCacheFill is an environment variable that defines whether the importer
+	// copies the source container image to the pull-through cache before importing it.
+	ImporterCacheFill = "IMPORTER_CACHE_FILL"
+
+	// cacheImageTag keeps the pull-through cache image from being removed by the garbage collection as untagged.
+	cacheImageTag = "latest"
 )
 
 func New() *Importer {
@@ -61,18 +91,24 @@ func New() *Importer {
 }
 
 type Importer struct {
-	src            string
-	srcType        string
-	srcContentType string
-	srcUsername    string
-	srcPassword    string
-	srcInsecure    bool
-	destImageName  string
-	destUsername   string
-	destPassword   string
-	destInsecure   bool
-	certDir        string
-	checksums      map[string]string
+	src             string
+	srcType         string
+	srcContentType  string
+	srcUsername     string
+	srcPassword     string
+	srcInsecure     bool
+	destImageName   string
+	destUsername    string
+	destPassword    string
+	destInsecure    bool
+	destChunked     bool
+	destCompression string
+	cacheImage      string
+	cacheFill       bool
+	certDir         string
+	checksums       map[string]string
+	verifier        *signature.Verifier
+	customization   *v1alpha2.ImageCustomization
 }
 
 func (i *Importer) Run(ctx context.Context) error {
@@ -99,7 +135,11 @@ func (i *Importer) parseOptions() error {
 	i.srcInsecure, _ = strconv.ParseBool(os.Getenv(common.InsecureTLSVar))
 	i.destImageName, _ = util.ParseEnvVar(common.ImporterDestinationEndpoint, false)
 	i.destInsecure, _ = strconv.ParseBool(os.Getenv(common.DestinationInsecureTLSVar))
+	i.destChunked, _ = strconv.ParseBool(os.Getenv(DestinationChunkedVar))
+	i.destCompression, _ = util.ParseEnvVar(DestinationCompressionVar, false)
 	i.certDir, _ = util.ParseEnvVar(common.ImporterCertDirVar, false)
+	i.cacheImage, _ = util.ParseEnvVar(ImporterCacheImage, false)
+	i.cacheFill, _ = strconv.ParseBool(os.Getenv(ImporterCacheFill))
 
 	checksums, _ := util.ParseEnvVar(ImporterChecksums, false)
 	var err error
@@ -108,6 +148,21 @@ func (i *Importer) parseOptions() error {
 		return err
 	}
 
+	if trustedKeys, _ := util.ParseEnvVar(ImporterTrustedKeys, false); trustedKeys != "" {
+		required, _ := strconv.ParseBool(os.Getenv(ImporterSignatureRequired))
+		i.verifier, err = signature.NewVerifier(trustedKeys, required)
+		if err != nil {
+			return fmt.Errorf("error parsing trusted keys: %w", err)
+		}
+	}
+
+	if customization, _ := util.ParseEnvVar(ImporterCustomization, false); customization != "" {
+		i.customization = &v1alpha2.ImageCustomization{}
+		if err = json.Unmarshal([]byte(customization), i.customization); err != nil {
+			return fmt.Errorf("error parsing customization: %w", err)
+		}
+	}
+
 	i.srcUsername, _ = util.ParseEnvVar(common.ImporterAccessKeyID, false)
 	i.srcPassword, _ = util.ParseEnvVar(common.ImporterSecretKey, false)
 	if i.srcUsername == "" && i.srcPassword == "" && i.srcType == cc.SourceRegistry {
@@ -160,6 +215,20 @@ func (i *Importer) runForDVCRSource(ctx context.Context) error {
 func (i *Importer) runForDataSource(ctx context.Context) error {
 	durCollector := monitoring.NewDurationCollector()
 
+	if i.verifier != nil && i.srcType == cc.SourceRegistry {
+		err := i.verifySignature(ctx)
+		if err != nil {
+			return monitoring.WriteImportFailureMessage(err)
+		}
+	}
+
+	if i.cacheImage != "" && i.srcType == cc.SourceRegistry {
+		err := i.useCache(ctx)
+		if err != nil {
+			return monitoring.WriteImportFailureMessage(err)
+		}
+	}
+
 	var res registry.ImportRes
 
 	err := retry.Retry(ctx, func(ctx context.Context) error {
@@ -168,12 +237,28 @@ func (i *Importer) runForDataSource(ctx context.Context) error {
 			return fmt.Errorf("error creating data source: %w", err)
 		}
 		defer ds.Close()
+
+		checksums := i.checksums
+		if i.customization != nil {
+			customized, err := customize.Customize(ctx, ds, i.checksums, i.customization)
+			if err != nil {
+				return err
+			}
+			defer customized.Close()
+
+			// The checksums are verified on download, the customized image does not match them.
+			ds = customized
+			checksums = nil
+		}
+
 		processor, err := registry.NewDataProcessor(ds, registry.DestinationRegistry{
-			ImageName: i.destImageName,
-			Username:  i.destUsername,
-			Password:  i.destPassword,
-			Insecure:  i.destInsecure,
-		}, i.checksums)
+			ImageName:   i.destImageName,
+			Username:    i.destUsername,
+			Password:    i.destPassword,
+			Insecure:    i.destInsecure,
+			Chunked:     i.destChunked,
+			Compression: i.destCompression,
+		}, checksums)
 		if err != nil {
 			return err
 		}
@@ -186,7 +271,72 @@ func (i *Importer) runForDataSource(ctx context.Context) error {
 		return monitoring.WriteImportFailureMessage(err)
 	}
 
-	return monitoring.WriteImportCompleteMessage(res.SourceImageSize, res.VirtualSize, res.AvgSpeed, res.Format, durCollector.Collect())
+	return monitoring.WriteImportCompleteMessage(res.SourceImageSize, res.VirtualSize, res.AvgSpeed, res.Format, res.Inspection, durCollector.Collect())
+}
+
+// verifySignature checks the signatures of the source image before anything is
+// written to DVCR and pins the source to the verified digest.
+func (i *Importer) verifySignature(ctx context.Context) error {
+	img := strings.TrimPrefix(i.src, DockerRegistrySchemePrefix)
+
+	remoteOpts, err := i.srcRemoteOptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	var pinned string
+	err = retry.Retry(ctx, func(ctx context.Context) error {
+		var err error
+		pinned, err = i.verifier.Verify(ctx, img, i.srcNameOptions(), remoteOpts...)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	i.src = DockerRegistrySchemePrefix + pinned
+
+	return nil
+}
+
+// useCache switches the source to the pull-through cache image in DVCR, copying the
+// source image there first if this importer is the one to fill the cache.
+// The cache image is read by digest, so it is the same image as in the source registry.
+func (i *Importer) useCache(ctx context.Context) error {
+	if i.cacheFill {
+		cacheRef, err := name.NewDigest(i.cacheImage, i.destNameOptions()...)
+		if err != nil {
+			return fmt.Errorf("error parsing cache image name: %w", err)
+		}
+
+		src := replication.Endpoint{
+			Username: i.srcUsername,
+			Password: i.srcPassword,
+			Insecure: i.srcInsecure,
+			CABundle: i.certDir,
+		}
+		dst := replication.Endpoint{
+			Username: i.destUsername,
+			Password: i.destPassword,
+			Insecure: i.destInsecure,
+		}
+
+		err = retry.Retry(ctx, func(ctx context.Context) error {
+			_, _, err := replication.Replicate(ctx, strings.TrimPrefix(i.src, DockerRegistrySchemePrefix), src, cacheRef.Context().Tag(cacheImageTag).String(), dst)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("error copying image to the pull-through cache: %w", err)
+		}
+	}
+
+	i.src = DockerRegistrySchemePrefix + i.cacheImage
+	i.srcUsername = i.destUsername
+	i.srcPassword = i.destPassword
+	i.srcInsecure = i.destInsecure
+	i.certDir = ""
+
+	return nil
 }
 
 func (i *Importer) newDataSource(ctx context.Context) (datasource.DataSourceInterface, error) {
@@ -233,21 +383,47 @@ func (i *Importer) destNameOptions() []name.Option {
 	return nameOpts
 }
 
-func (i *Importer) srcRemoteOptions(ctx context.Context) []remote.Option {
+func (i *Importer) srcNameOptions() []name.Option {
+	var nameOpts []name.Option
+
+	if i.srcInsecure {
+		nameOpts = append(nameOpts, name.Insecure)
+	}
+
+	return nameOpts
+}
+
+func (i *Importer) srcRemoteOptions(ctx context.Context) ([]remote.Option, error) {
 	tlsConfig := &tls.Config{
 		InsecureSkipVerify: i.srcInsecure,
 	}
 
+	// A custom CA bundle only makes sense when the certificate is being verified.
+	if !i.srcInsecure && i.certDir != "" {
+		rootCAs, err := cabundle.Load(i.certDir)
+		if err != nil {
+			return nil, fmt.Errorf("load source CA bundle: %w", err)
+		}
+
+		tlsConfig.RootCAs = rootCAs
+	}
+
 	transport := http.DefaultTransport.(*http.Transport).Clone()
 	transport.TLSClientConfig = tlsConfig
 
+	// Public registries are read anonymously when no imagePullSecret is set.
+	var auth authn.Authenticator = authn.Anonymous
+	if i.srcUsername != "" || i.srcPassword != "" {
+		auth = &authn.Basic{Username: i.srcUsername, Password: i.srcPassword}
+	}
+
 	remoteOpts := []remote.Option{
 		remote.WithContext(ctx),
 		remote.WithTransport(transport),
-		remote.WithAuth(&authn.Basic{Username: i.srcUsername, Password: i.srcPassword}),
+		remote.WithAuth(auth),
 	}
 
-	return remoteOpts
+	return remoteOpts, nil
 }
 
 func (i *Importer) destRemoteOptions(ctx context.Context) []remote.Option {
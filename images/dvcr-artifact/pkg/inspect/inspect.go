@@ -0,0 +1,456 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package inspect detects the operating system, the partition table and the boot modes of a raw disk image.
+// The image is probed read-only: only the partition table, the boot sector and the headers of partitions
+// and filesystems are read, so a sample of the first megabytes of the image is usually enough.
+package inspect
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+const (
+	OSFamilyLinux   = "Linux"
+	OSFamilyWindows = "Windows"
+
+	PartitionTableGPT = "GPT"
+	PartitionTableMBR = "MBR"
+)
+
+// Result describes the content of the image. Empty fields mean that nothing has been detected.
+type Result struct {
+	OSFamily       string
+	OSVersion      string
+	PartitionTable string
+	// EFISystemPartition is set if the image has an EFI system partition or an El Torito EFI boot image.
+	EFISystemPartition bool
+	// BIOSBootable is set if the image has boot code in the MBR, a BIOS boot partition or an El Torito BIOS boot image.
+	BIOSBootable bool
+}
+
+const (
+	sectorSize    = 512
+	isoSectorSize = 2048
+
+	mbrBootCodeSize       = 440
+	mbrPartitionsOffset   = 446
+	mbrSignatureOffset    = 510
+	mbrTypeGPTProtective  = 0xee
+	gptMaxPartitions      = 1024
+	gptLegacyBIOSBootable = 1 << 2
+)
+
+// Partition types of MBR.
+var (
+	mbrTypesESP     = []byte{0xef}
+	mbrTypesWindows = []byte{0x27}
+	mbrTypesLinux   = []byte{0x82, 0x83, 0x8e, 0xfd}
+)
+
+// Partition type GUIDs of GPT.
+const (
+	gptTypeESP             = "C12A7328-F81F-11D2-BA4B-00A0C93EC93B"
+	gptTypeBIOSBoot        = "21686148-6449-6E6F-744E-656564454649"
+	gptTypeMSReserved      = "E3C9E316-0B5C-4DB8-817D-F92DF00215AE"
+	gptTypeWindowsRecovery = "DE94BBA4-06D1-4D40-A16A-BFD50179D6AC"
+)
+
+var gptTypesLinux = map[string]struct{}{
+	"0FC63DAF-8483-4772-8E79-3D69D8477DE4": {}, // Filesystem data.
+	"44479540-F297-41B2-9AF7-D131D5F0458A": {}, // Root (x86).
+	"4F68BCE3-E8CD-4DB1-96E7-FBCAF984B709": {}, // Root (x86-64).
+	"B921B045-1DF0-41C3-AF44-4C6F280D3FAE": {}, // Root (AArch64).
+	"8484680C-9521-48C6-9C11-B0720656F69E": {}, // /usr (x86-64).
+	"BC13C2FF-59E6-4262-A352-B275FD6F7172": {}, // Extended boot loader.
+	"0657FD6D-A4AB-43C4-84E5-0933C84B4F4F": {}, // Swap.
+	"E6D6D379-F507-44C2-A23C-238F2A3DF928": {}, // LVM.
+	"A19D880F-05FC-4D3B-A006-743F0F84911E": {}, // RAID.
+}
+
+// Signatures of the ISO 9660 volume descriptors and of the El Torito boot catalog.
+const (
+	isoDescriptorsSector   = 16
+	isoMaxDescriptors      = 32
+	isoTypeBootRecord      = 0
+	isoTypePrimary         = 1
+	isoTypeTerminator      = 255
+	isoStandardID          = "CD001"
+	isoElToritoID          = "EL TORITO SPECIFICATION"
+	isoPlatformBIOS        = 0x00
+	isoPlatformEFI         = 0xef
+	isoEntryBootable       = 0x88
+	isoHeaderSection       = 0x90
+	isoHeaderSectionFinal  = 0x91
+	isoVolumeIDOffset      = 40
+	isoVolumeIDSize        = 32
+	isoBootCatalogOffset   = 0x47
+	isoBootCatalogEntrySz  = 32
+	isoBootCatalogMaxEntry = isoSectorSize / isoBootCatalogEntrySz
+)
+
+var (
+	// Volume labels of Windows installation media, e.g. CCCOMA_X64FRE_EN-US_DV9 or SSS_X64FREE_EN-US_DV9.
+	windowsVolumeIDRe = regexp.MustCompile(`(?i)(^CCCOMA_|^CPBA_|^CENA_|^SSS_|^IR[0-9]_|^GRMS|X64FRE|X86FRE|A64FRE)`)
+	linuxVolumeIDRe   = regexp.MustCompile(`(?i)(ubuntu|debian|centos|rocky|alma|fedora|rhel|opensuse|^sle|alpine|^arch_|astra|redos|mint|kali|nixos|talos|flatcar|linux)`)
+	// Architectures are removed from the volume label before looking for the version, e.g. Fedora-S-dvd-x86_64-40.
+	architectureRe = regexp.MustCompile(`(?i)(x86[_-]64|amd64|aarch64|arm64|i[3-6]86|x64|x86)`)
+	versionRe      = regexp.MustCompile(`[0-9]+(?:[._][0-9]+)*`)
+)
+
+// Inspect probes the raw disk image of the specified size.
+// Data beyond the size is treated as unknown, so a partition that starts beyond it is recognised by its type only.
+func Inspect(r io.ReaderAt, size int64) (Result, error) {
+	in := inspector{r: r, size: size}
+
+	var res Result
+
+	err := in.inspectISO(&res)
+	if err != nil {
+		return Result{}, fmt.Errorf("inspect iso: %w", err)
+	}
+
+	err = in.inspectPartitionTable(&res)
+	if err != nil {
+		return Result{}, fmt.Errorf("inspect partition table: %w", err)
+	}
+
+	res.OSFamily = in.osFamily()
+	if res.OSFamily == "" {
+		res.OSVersion = ""
+	}
+
+	return res, nil
+}
+
+type inspector struct {
+	r    io.ReaderAt
+	size int64
+
+	windows bool
+	linux   bool
+}
+
+func (in *inspector) osFamily() string {
+	switch {
+	case in.windows:
+		return OSFamilyWindows
+	case in.linux:
+		return OSFamilyLinux
+	default:
+		return ""
+	}
+}
+
+// read returns nil without an error if the requested data is beyond the image.
+func (in *inspector) read(offset int64, n int) ([]byte, error) {
+	if offset < 0 || offset+int64(n) > in.size {
+		return nil, nil
+	}
+
+	buf := make([]byte, n)
+	_, err := in.r.ReadAt(buf, offset)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+func (in *inspector) inspectISO(res *Result) error {
+	var bootCatalogSector uint32
+
+	for i := range int64(isoMaxDescriptors) {
+		desc, err := in.read((isoDescriptorsSector+i)*isoSectorSize, isoSectorSize)
+		if err != nil {
+			return err
+		}
+
+		if desc == nil || string(desc[1:6]) != isoStandardID {
+			break
+		}
+
+		switch desc[0] {
+		case isoTypePrimary:
+			volumeID := strings.TrimSpace(string(desc[isoVolumeIDOffset : isoVolumeIDOffset+isoVolumeIDSize]))
+			in.inspectVolumeID(volumeID, res)
+		case isoTypeBootRecord:
+			if strings.TrimRight(string(desc[7:7+len(isoElToritoID)]), "\x00") == isoElToritoID {
+				bootCatalogSector = binary.LittleEndian.Uint32(desc[isoBootCatalogOffset:])
+			}
+		}
+
+		if desc[0] == isoTypeTerminator {
+			break
+		}
+	}
+
+	if bootCatalogSector == 0 {
+		return nil
+	}
+
+	catalog, err := in.read(int64(bootCatalogSector)*isoSectorSize, isoSectorSize)
+	if err != nil || catalog == nil {
+		return err
+	}
+
+	in.inspectBootCatalog(catalog, res)
+
+	return nil
+}
+
+func (in *inspector) inspectVolumeID(volumeID string, res *Result) {
+	switch {
+	case windowsVolumeIDRe.MatchString(volumeID):
+		in.windows = true
+	case linuxVolumeIDRe.MatchString(volumeID):
+		in.linux = true
+		res.OSVersion = strings.ReplaceAll(versionRe.FindString(architectureRe.ReplaceAllString(volumeID, "")), "_", ".")
+	}
+}
+
+// inspectBootCatalog reads the El Torito boot catalog: the validation entry with the platform of the initial entry,
+// followed by the initial entry and the sections of entries for other platforms.
+func (in *inspector) inspectBootCatalog(catalog []byte, res *Result) {
+	entry := func(i int) []byte {
+		return catalog[i*isoBootCatalogEntrySz : (i+1)*isoBootCatalogEntrySz]
+	}
+
+	setBootable := func(platform byte) {
+		switch platform {
+		case isoPlatformBIOS:
+			res.BIOSBootable = true
+		case isoPlatformEFI:
+			res.EFISystemPartition = true
+		}
+	}
+
+	validation := entry(0)
+	if validation[0] != 1 || validation[30] != 0x55 || validation[31] != 0xaa {
+		return
+	}
+
+	if entry(1)[0] == isoEntryBootable {
+		setBootable(validation[1])
+	}
+
+	for i := 2; i < isoBootCatalogMaxEntry; {
+		header := entry(i)
+		if header[0] != isoHeaderSection && header[0] != isoHeaderSectionFinal {
+			return
+		}
+
+		platform := header[1]
+		count := int(binary.LittleEndian.Uint16(header[2:4]))
+		for j := i + 1; j <= i+count && j < isoBootCatalogMaxEntry; j++ {
+			if entry(j)[0] == isoEntryBootable {
+				setBootable(platform)
+			}
+		}
+
+		if header[0] == isoHeaderSectionFinal {
+			return
+		}
+
+		i += count + 1
+	}
+}
+
+func (in *inspector) inspectPartitionTable(res *Result) error {
+	mbr, err := in.read(0, sectorSize)
+	if err != nil || mbr == nil {
+		return err
+	}
+
+	if mbr[mbrSignatureOffset] != 0x55 || mbr[mbrSignatureOffset+1] != 0xaa {
+		return nil
+	}
+
+	// A disk image without the partition table has a filesystem in its first sector.
+	if in.inspectFilesystem(0) {
+		return nil
+	}
+
+	hasBootCode := !isZero(mbr[:mbrBootCodeSize])
+
+	type mbrPartition struct {
+		kind  byte
+		start uint32
+	}
+
+	var partitions []mbrPartition
+	for i := range 4 {
+		p := mbr[mbrPartitionsOffset+i*16 : mbrPartitionsOffset+(i+1)*16]
+		if p[4] == 0 {
+			continue
+		}
+		partitions = append(partitions, mbrPartition{
+			kind:  p[4],
+			start: binary.LittleEndian.Uint32(p[8:12]),
+		})
+	}
+
+	if len(partitions) == 0 {
+		return nil
+	}
+
+	for _, p := range partitions {
+		if p.kind == mbrTypeGPTProtective {
+			ok, err := in.inspectGPT(res, hasBootCode)
+			if err != nil || ok {
+				return err
+			}
+		}
+	}
+
+	res.PartitionTable = PartitionTableMBR
+	res.BIOSBootable = res.BIOSBootable || hasBootCode
+
+	for _, p := range partitions {
+		switch {
+		case bytes.IndexByte(mbrTypesESP, p.kind) >= 0:
+			res.EFISystemPartition = true
+		case bytes.IndexByte(mbrTypesWindows, p.kind) >= 0:
+			in.windows = true
+		case bytes.IndexByte(mbrTypesLinux, p.kind) >= 0:
+			in.linux = true
+		}
+
+		in.inspectFilesystem(int64(p.start) * sectorSize)
+	}
+
+	return nil
+}
+
+// inspectGPT looks for the GPT header in the second logical block for 512-byte and 4K sectors.
+func (in *inspector) inspectGPT(res *Result, hasBootCode bool) (bool, error) {
+	for _, blockSize := range []int64{sectorSize, 4096} {
+		header, err := in.read(blockSize, sectorSize)
+		if err != nil {
+			return false, err
+		}
+
+		if header == nil || string(header[:8]) != "EFI PART" {
+			continue
+		}
+
+		entriesLBA := binary.LittleEndian.Uint64(header[72:80])
+		entriesCount := binary.LittleEndian.Uint32(header[80:84])
+		entrySize := binary.LittleEndian.Uint32(header[84:88])
+
+		if entrySize < 128 || entrySize > 4096 || entriesLBA > uint64(in.size/blockSize) {
+			return false, nil
+		}
+
+		entriesCount = min(entriesCount, gptMaxPartitions)
+
+		entries, err := in.read(int64(entriesLBA)*blockSize, int(entriesCount*entrySize))
+		if err != nil {
+			return false, err
+		}
+
+		if entries == nil {
+			return false, nil
+		}
+
+		res.PartitionTable = PartitionTableGPT
+
+		for i := range int(entriesCount) {
+			entry := entries[i*int(entrySize) : (i+1)*int(entrySize)]
+			if isZero(entry[:16]) {
+				continue
+			}
+
+			kind := guidString(entry[:16])
+			firstLBA := binary.LittleEndian.Uint64(entry[32:40])
+			attributes := binary.LittleEndian.Uint64(entry[48:56])
+
+			switch kind {
+			case gptTypeESP:
+				res.EFISystemPartition = true
+			case gptTypeBIOSBoot:
+				res.BIOSBootable = true
+			case gptTypeMSReserved, gptTypeWindowsRecovery:
+				in.windows = true
+			default:
+				if _, ok := gptTypesLinux[kind]; ok {
+					in.linux = true
+				}
+			}
+
+			// Legacy BIOS bootable partitions are booted by the MBR code of syslinux.
+			if attributes&gptLegacyBIOSBootable != 0 && hasBootCode {
+				res.BIOSBootable = true
+			}
+
+			in.inspectFilesystem(int64(firstLBA) * blockSize)
+		}
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// inspectFilesystem recognises the filesystem at the offset by its magic and reports whether one is found.
+func (in *inspector) inspectFilesystem(offset int64) bool {
+	check := func(at int64, magic string) bool {
+		buf, err := in.read(offset+at, len(magic))
+		return err == nil && buf != nil && string(buf) == magic
+	}
+
+	switch {
+	case check(3, "NTFS    "):
+		in.windows = true
+	case check(1024+56, "\x53\xef"), // ext2/3/4.
+		check(0, "XFSB"),
+		check(0x10040, "_BHRfS_M"), // Btrfs.
+		check(512, "LABELONE"),     // LVM physical volume.
+		check(4096-10, "SWAPSPACE2"):
+		in.linux = true
+	default:
+		return false
+	}
+
+	return true
+}
+
+// guidString formats the mixed-endian GUID as it is written in the specifications.
+func guidString(b []byte) string {
+	return fmt.Sprintf("%08X-%04X-%04X-%X-%X",
+		binary.LittleEndian.Uint32(b[0:4]),
+		binary.LittleEndian.Uint16(b[4:6]),
+		binary.LittleEndian.Uint16(b[6:8]),
+		b[8:10],
+		b[10:16],
+	)
+}
+
+func isZero(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+
+	return true
+}
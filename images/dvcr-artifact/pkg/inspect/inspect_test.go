@@ -0,0 +1,316 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspect
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+const testImageSize = 4 * 1024 * 1024
+
+type testImage []byte
+
+func newTestImage() testImage {
+	return make(testImage, testImageSize)
+}
+
+func (img testImage) inspect(t *testing.T) Result {
+	t.Helper()
+
+	res, err := Inspect(bytes.NewReader(img), int64(len(img)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return res
+}
+
+func (img testImage) setMBR(bootCode bool, partitions ...[2]uint32) {
+	if bootCode {
+		copy(img, []byte{0xfa, 0x31, 0xc0, 0x8e, 0xd8})
+	}
+
+	for i, p := range partitions {
+		entry := img[mbrPartitionsOffset+i*16:]
+		entry[4] = byte(p[0])
+		binary.LittleEndian.PutUint32(entry[8:], p[1])
+	}
+
+	img[mbrSignatureOffset] = 0x55
+	img[mbrSignatureOffset+1] = 0xaa
+}
+
+type testGPTPartition struct {
+	kind       string
+	firstLBA   uint64
+	attributes uint64
+}
+
+func (img testImage) setGPT(t *testing.T, bootCode bool, partitions ...testGPTPartition) {
+	t.Helper()
+
+	img.setMBR(bootCode, [2]uint32{mbrTypeGPTProtective, 1})
+
+	header := img[sectorSize:]
+	copy(header, "EFI PART")
+	binary.LittleEndian.PutUint64(header[72:], 2)
+	binary.LittleEndian.PutUint32(header[80:], 128)
+	binary.LittleEndian.PutUint32(header[84:], 128)
+
+	for i, p := range partitions {
+		entry := img[2*sectorSize+i*128:]
+		copy(entry, guidBytes(t, p.kind))
+		binary.LittleEndian.PutUint64(entry[32:], p.firstLBA)
+		binary.LittleEndian.PutUint64(entry[48:], p.attributes)
+	}
+}
+
+func (img testImage) setExt(offset int) {
+	copy(img[offset+1024+56:], "\x53\xef")
+}
+
+func (img testImage) setNTFS(offset int) {
+	copy(img[offset+3:], "NTFS    ")
+}
+
+func (img testImage) setISO(volumeID string, bootCatalogSector uint32, platforms ...byte) {
+	primary := img[isoDescriptorsSector*isoSectorSize:]
+	primary[0] = isoTypePrimary
+	copy(primary[1:], isoStandardID)
+	copy(primary[isoVolumeIDOffset:], volumeID+strings.Repeat(" ", isoVolumeIDSize-len(volumeID)))
+
+	bootRecord := img[(isoDescriptorsSector+1)*isoSectorSize:]
+	bootRecord[0] = isoTypeBootRecord
+	copy(bootRecord[1:], isoStandardID)
+	copy(bootRecord[7:], isoElToritoID)
+	binary.LittleEndian.PutUint32(bootRecord[isoBootCatalogOffset:], bootCatalogSector)
+
+	terminator := img[(isoDescriptorsSector+2)*isoSectorSize:]
+	terminator[0] = isoTypeTerminator
+	copy(terminator[1:], isoStandardID)
+
+	catalog := img[bootCatalogSector*isoSectorSize:]
+	validation := catalog[:isoBootCatalogEntrySz]
+	validation[0] = 1
+	validation[1] = platforms[0]
+	validation[30] = 0x55
+	validation[31] = 0xaa
+	catalog[isoBootCatalogEntrySz] = isoEntryBootable
+
+	for i, platform := range platforms[1:] {
+		header := catalog[(2+2*i)*isoBootCatalogEntrySz:]
+		header[0] = isoHeaderSection
+		if i == len(platforms)-2 {
+			header[0] = isoHeaderSectionFinal
+		}
+		header[1] = platform
+		binary.LittleEndian.PutUint16(header[2:], 1)
+		catalog[(3+2*i)*isoBootCatalogEntrySz] = isoEntryBootable
+	}
+}
+
+func guidBytes(t *testing.T, guid string) []byte {
+	t.Helper()
+
+	b, err := hex.DecodeString(strings.ReplaceAll(guid, "-", ""))
+	if err != nil {
+		t.Fatalf("invalid guid %s: %v", guid, err)
+	}
+
+	// The first three fields are little-endian.
+	for _, r := range [][2]int{{0, 4}, {4, 6}, {6, 8}} {
+		for i, j := r[0], r[1]-1; i < j; i, j = i+1, j-1 {
+			b[i], b[j] = b[j], b[i]
+		}
+	}
+
+	return b
+}
+
+func assertResult(t *testing.T, got, want Result) {
+	t.Helper()
+
+	if got != want {
+		t.Fatalf("unexpected result:\ngot:  %+v\nwant: %+v", got, want)
+	}
+}
+
+func TestInspect_Empty(t *testing.T) {
+	assertResult(t, newTestImage().inspect(t), Result{})
+}
+
+func TestInspect_SmallerThanSector(t *testing.T) {
+	res, err := Inspect(bytes.NewReader([]byte{1, 2, 3}), 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertResult(t, res, Result{})
+}
+
+func TestInspect_LinuxGPT(t *testing.T) {
+	img := newTestImage()
+	img.setGPT(t, true,
+		testGPTPartition{kind: gptTypeBIOSBoot, firstLBA: 34},
+		testGPTPartition{kind: gptTypeESP, firstLBA: 2048},
+		testGPTPartition{kind: "0FC63DAF-8483-4772-8E79-3D69D8477DE4", firstLBA: 4096},
+	)
+	img.setExt(4096 * sectorSize)
+
+	assertResult(t, img.inspect(t), Result{
+		OSFamily:           OSFamilyLinux,
+		PartitionTable:     PartitionTableGPT,
+		EFISystemPartition: true,
+		BIOSBootable:       true,
+	})
+}
+
+func TestInspect_EFIOnlyGPT(t *testing.T) {
+	img := newTestImage()
+	img.setGPT(t, false,
+		testGPTPartition{kind: gptTypeESP, firstLBA: 2048},
+		testGPTPartition{kind: "4F68BCE3-E8CD-4DB1-96E7-FBCAF984B709", firstLBA: 1 << 30},
+	)
+
+	// The root partition is beyond the sample, so it is recognised by its type only.
+	assertResult(t, img.inspect(t), Result{
+		OSFamily:           OSFamilyLinux,
+		PartitionTable:     PartitionTableGPT,
+		EFISystemPartition: true,
+	})
+}
+
+func TestInspect_LegacyBIOSBootableAttribute(t *testing.T) {
+	img := newTestImage()
+	img.setGPT(t, true,
+		testGPTPartition{kind: "0FC63DAF-8483-4772-8E79-3D69D8477DE4", firstLBA: 2048, attributes: gptLegacyBIOSBootable},
+	)
+
+	assertResult(t, img.inspect(t), Result{
+		OSFamily:       OSFamilyLinux,
+		PartitionTable: PartitionTableGPT,
+		BIOSBootable:   true,
+	})
+}
+
+func TestInspect_WindowsGPT(t *testing.T) {
+	img := newTestImage()
+	img.setGPT(t, false,
+		testGPTPartition{kind: gptTypeESP, firstLBA: 2048},
+		testGPTPartition{kind: gptTypeMSReserved, firstLBA: 4096},
+		testGPTPartition{kind: "EBD0A0A2-B9E5-4433-87C0-68B6B72699C7", firstLBA: 6144},
+	)
+	img.setNTFS(6144 * sectorSize)
+
+	assertResult(t, img.inspect(t), Result{
+		OSFamily:           OSFamilyWindows,
+		PartitionTable:     PartitionTableGPT,
+		EFISystemPartition: true,
+	})
+}
+
+func TestInspect_WindowsMBR(t *testing.T) {
+	img := newTestImage()
+	img.setMBR(true, [2]uint32{0x07, 2048})
+	img.setNTFS(2048 * sectorSize)
+
+	assertResult(t, img.inspect(t), Result{
+		OSFamily:       OSFamilyWindows,
+		PartitionTable: PartitionTableMBR,
+		BIOSBootable:   true,
+	})
+}
+
+func TestInspect_LinuxMBR(t *testing.T) {
+	img := newTestImage()
+	img.setMBR(true, [2]uint32{0x83, 2048}, [2]uint32{0x82, 1 << 30})
+	img.setExt(2048 * sectorSize)
+
+	assertResult(t, img.inspect(t), Result{
+		OSFamily:       OSFamilyLinux,
+		PartitionTable: PartitionTableMBR,
+		BIOSBootable:   true,
+	})
+}
+
+func TestInspect_FilesystemWithoutPartitionTable(t *testing.T) {
+	img := newTestImage()
+	img.setNTFS(0)
+	img[mbrSignatureOffset] = 0x55
+	img[mbrSignatureOffset+1] = 0xaa
+
+	assertResult(t, img.inspect(t), Result{OSFamily: OSFamilyWindows})
+}
+
+func TestInspect_LinuxISO(t *testing.T) {
+	img := newTestImage()
+	img.setISO("Ubuntu-Server 24.04.1 LTS amd64", 40, isoPlatformBIOS, isoPlatformEFI)
+
+	assertResult(t, img.inspect(t), Result{
+		OSFamily:           OSFamilyLinux,
+		OSVersion:          "24.04.1",
+		EFISystemPartition: true,
+		BIOSBootable:       true,
+	})
+}
+
+func TestInspect_VersionWithoutArchitecture(t *testing.T) {
+	img := newTestImage()
+	img.setISO("Fedora-S-dvd-x86_64-40", 40, isoPlatformEFI)
+
+	assertResult(t, img.inspect(t), Result{
+		OSFamily:           OSFamilyLinux,
+		OSVersion:          "40",
+		EFISystemPartition: true,
+	})
+}
+
+func TestInspect_HybridISO(t *testing.T) {
+	img := newTestImage()
+	img.setISO("debian 12.5.0 amd64 n", 40, isoPlatformBIOS, isoPlatformEFI)
+	img.setMBR(true, [2]uint32{0x00, 0}, [2]uint32{0xef, 1024})
+
+	assertResult(t, img.inspect(t), Result{
+		OSFamily:           OSFamilyLinux,
+		OSVersion:          "12.5.0",
+		PartitionTable:     PartitionTableMBR,
+		EFISystemPartition: true,
+		BIOSBootable:       true,
+	})
+}
+
+func TestInspect_WindowsISO(t *testing.T) {
+	img := newTestImage()
+	img.setISO("CCCOMA_X64FRE_EN-US_DV9", 40, isoPlatformBIOS, isoPlatformEFI)
+
+	assertResult(t, img.inspect(t), Result{
+		OSFamily:           OSFamilyWindows,
+		EFISystemPartition: true,
+		BIOSBootable:       true,
+	})
+}
+
+func TestInspect_UnknownISO(t *testing.T) {
+	img := newTestImage()
+	img.setISO("DATA 2024", 40, isoPlatformBIOS)
+
+	assertResult(t, img.inspect(t), Result{BIOSBootable: true})
+}
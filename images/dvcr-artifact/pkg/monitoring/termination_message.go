@@ -24,15 +24,24 @@ import (
 
 	"k8s.io/klog/v2"
 	"kubevirt.io/containerized-data-importer/pkg/util"
+
+	importerrs "github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/errors"
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/inspect"
 )
 
 type ImportInfo struct {
-	SourceImageSize        uint64        `json:"source-image-size,omitempty"`
-	SourceImageVirtualSize uint64        `json:"source-image-virtual-size,omitempty"`
-	SourceImageFormat      string        `json:"source-image-format,omitempty"`
-	Duration               time.Duration `json:"duration,omitempty"`
-	AverageSpeed           uint64        `json:"average-speed,omitempty"`
-	ErrMessage             string        `json:"error-message,omitempty"`
+	SourceImageSize               uint64        `json:"source-image-size,omitempty"`
+	SourceImageVirtualSize        uint64        `json:"source-image-virtual-size,omitempty"`
+	SourceImageFormat             string        `json:"source-image-format,omitempty"`
+	SourceImageOSFamily           string        `json:"source-image-os-family,omitempty"`
+	SourceImageOSVersion          string        `json:"source-image-os-version,omitempty"`
+	SourceImagePartitionTable     string        `json:"source-image-partition-table,omitempty"`
+	SourceImageEFISystemPartition bool          `json:"source-image-efi-system-partition,omitempty"`
+	SourceImageBIOSBootable       bool          `json:"source-image-bios-bootable,omitempty"`
+	Duration                      time.Duration `json:"duration,omitempty"`
+	AverageSpeed                  uint64        `json:"average-speed,omitempty"`
+	ErrMessage                    string        `json:"error-message,omitempty"`
+	ErrReason                     string        `json:"error-reason,omitempty"`
 }
 
 var ErrFailedTerminationMessage = errors.New("failed to write termination message")
@@ -43,10 +52,20 @@ var ErrFailedTerminationMessage = errors.New("failed to write termination messag
 // process. A failed import must terminate the pod in the Failed phase:
 // a pod that completes successfully makes the controller read the (empty)
 // size stats of the failed import and choke on them.
+//
+// The reason of an error that has one is persisted as well, so the controller
+// can report the failure with a dedicated condition reason.
 func WriteImportFailureMessage(importErr error) error {
-	rawMsg, err := json.Marshal(ImportInfo{
+	info := ImportInfo{
 		ErrMessage: importErr.Error(),
-	})
+	}
+
+	var reasonErr importerrs.ReasonError
+	if errors.As(importErr, &reasonErr) {
+		info.ErrReason = reasonErr.Reason()
+	}
+
+	rawMsg, err := json.Marshal(info)
 	if err != nil {
 		return errors.Join(importErr, fmt.Errorf("%w: %w", ErrFailedTerminationMessage, err))
 	}
@@ -83,13 +102,18 @@ func WriteDVCRSourceImportCompleteMessage(duration time.Duration) error {
 	return nil
 }
 
-func WriteImportCompleteMessage(sourceImageSize, sourceImageVirtualSize, avgSpeed uint64, sourceImageFormat string, duration time.Duration) error {
+func WriteImportCompleteMessage(sourceImageSize, sourceImageVirtualSize, avgSpeed uint64, sourceImageFormat string, inspection inspect.Result, duration time.Duration) error {
 	rawMsg, err := json.Marshal(ImportInfo{
-		SourceImageSize:        sourceImageSize,
-		SourceImageVirtualSize: sourceImageVirtualSize,
-		SourceImageFormat:      sourceImageFormat,
-		AverageSpeed:           avgSpeed,
-		Duration:               duration,
+		SourceImageSize:               sourceImageSize,
+		SourceImageVirtualSize:        sourceImageVirtualSize,
+		SourceImageFormat:             sourceImageFormat,
+		SourceImageOSFamily:           inspection.OSFamily,
+		SourceImageOSVersion:          inspection.OSVersion,
+		SourceImagePartitionTable:     inspection.PartitionTable,
+		SourceImageEFISystemPartition: inspection.EFISystemPartition,
+		SourceImageBIOSBootable:       inspection.BIOSBootable,
+		AverageSpeed:                  avgSpeed,
+		Duration:                      duration,
 	})
 	if err != nil {
 		return fmt.Errorf("%w: %w", ErrFailedTerminationMessage, err)
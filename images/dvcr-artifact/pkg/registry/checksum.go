@@ -97,6 +97,23 @@ func newChecksumVerifiers(checksums map[string]string) ([]io.Writer, []func() er
 	return writers, checks
 }
 
+// NewChecksumVerifier is newChecksumVerifiers for the callers reading the source
+// on their own: the writer feeds all the hashes at once, and the check reports
+// the first mismatch once the whole source has been written to it.
+func NewChecksumVerifier(checksums map[string]string) (io.Writer, func() error) {
+	writers, checks := newChecksumVerifiers(checksums)
+
+	return io.MultiWriter(writers...), func() error {
+		for _, check := range checks {
+			if err := check(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
 // SupportedChecksumAlgorithms lists algorithm names for error messages.
 func SupportedChecksumAlgorithms() string {
 	return strings.Join(sortedChecksumAlgorithms(checksumAlgorithms), ", ")
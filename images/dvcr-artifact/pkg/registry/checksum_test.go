@@ -138,3 +138,27 @@ func Test_ChecksumVerifiers(t *testing.T) {
 		require.Contains(t, errs[0].Error(), "md5 sum mismatch")
 	})
 }
+
+func Test_NewChecksumVerifier(t *testing.T) {
+	const data = "hello"
+
+	t.Run("no checksums accept any data", func(t *testing.T) {
+		writer, check := NewChecksumVerifier(nil)
+		_, err := writer.Write([]byte(data))
+		require.NoError(t, err)
+		require.NoError(t, check())
+	})
+
+	t.Run("a mismatch is reported once the data is written", func(t *testing.T) {
+		writer, check := NewChecksumVerifier(map[string]string{
+			"md5":    "5d41402abc4b2a76b9719d911017c592",
+			"sha256": "0cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+		})
+		_, err := writer.Write([]byte(data))
+		require.NoError(t, err)
+
+		var badChecksum importerrs.BadImageChecksumError
+		require.ErrorAs(t, check(), &badChecksum)
+		require.Contains(t, badChecksum.Error(), "sha256 sum mismatch")
+	})
+}
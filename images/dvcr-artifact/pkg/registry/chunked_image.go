@@ -0,0 +1,192 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/klog/v2"
+)
+
+// Chunked image format.
+//
+// A chunked image stores the disk image as a sequence of content-defined chunks, each one a
+// layer blob of ChunkMediaType holding raw bytes. Concatenating the layers in the manifest
+// order gives back the disk image. Registry blobs are addressed by their digest, so a chunk
+// shared by several images, e.g. by two builds of the same OS, is stored only once.
+//
+// The layers are not tar archives, so a container runtime cannot unpack a chunked image:
+// it is only read by the pvc-importer, which recognizes it by the imageLabelContentDefinedChunks
+// label and reassembles the disk image.
+const (
+	ChunkMediaType types.MediaType = "application/vnd.deckhouse.virtualization.disk.chunk.v1"
+
+	imageLabelContentDefinedChunks = "content-defined-chunks"
+	imageLabelSourceImageFilename  = "source-image-filename"
+
+	chunkUploadConcurrency = 4
+)
+
+// chunkedManifest is the raw OCI manifest of a chunked image.
+type chunkedManifest []byte
+
+func (m chunkedManifest) RawManifest() ([]byte, error) {
+	return m, nil
+}
+
+func (m chunkedManifest) MediaType() (types.MediaType, error) {
+	return types.OCIManifestSchema1, nil
+}
+
+func (p DataProcessor) uploadChunkedImage(
+	ctx context.Context,
+	pipeReader io.ReadCloser,
+	sourceImageFilename string,
+	sourceImageSize int,
+	informer *ImageInformer,
+) error {
+	ref, repo, remoteOpts, err := p.destination(ctx)
+	if err != nil {
+		return err
+	}
+
+	klog.Infoln("Uploading content-defined chunks to registry")
+	chunks, err := uploadChunks(ctx, pipeReader, repo, remoteOpts)
+	if err != nil {
+		return err
+	}
+	klog.Infof("%d chunks uploaded", len(chunks))
+
+	cnf, err := empty.Image.ConfigFile()
+	if err != nil {
+		return fmt.Errorf("error getting image config: %w", err)
+	}
+
+	informer.Wait()
+
+	klog.Infof("Got image info: virtual size: %d, format: %s", informer.GetVirtualSize(), informer.GetFormat())
+
+	populateCommonConfigFields(cnf)
+
+	cnf.Config.Labels[imageLabelSourceImageVirtualSize] = fmt.Sprintf("%d", informer.GetVirtualSize())
+	cnf.Config.Labels[imageLabelSourceImageSize] = fmt.Sprintf("%d", sourceImageSize)
+	cnf.Config.Labels[imageLabelSourceImageFormat] = informer.GetFormat()
+	cnf.Config.Labels[imageLabelContentDefinedChunks] = "true"
+	cnf.Config.Labels[imageLabelSourceImageFilename] = filepath.Base(sourceImageFilename)
+	delete(cnf.Config.Labels, imageLabelEROFSCompatible)
+
+	cnf.RootFS = v1.RootFS{Type: "layers"}
+	for _, chunk := range chunks {
+		cnf.RootFS.DiffIDs = append(cnf.RootFS.DiffIDs, chunk.Digest)
+	}
+
+	rawConfig, err := json.Marshal(cnf)
+	if err != nil {
+		return fmt.Errorf("error marshaling image config: %w", err)
+	}
+	config := static.NewLayer(rawConfig, types.OCIConfigJSON)
+	if err := remote.WriteLayer(repo, config, remoteOpts...); err != nil {
+		return fmt.Errorf("error uploading image config: %w", err)
+	}
+	configDigest, err := config.Digest()
+	if err != nil {
+		return fmt.Errorf("error getting image config digest: %w", err)
+	}
+
+	rawManifest, err := json.Marshal(v1.Manifest{
+		SchemaVersion: 2,
+		MediaType:     types.OCIManifestSchema1,
+		Config: v1.Descriptor{
+			MediaType: types.OCIConfigJSON,
+			Size:      int64(len(rawConfig)),
+			Digest:    configDigest,
+		},
+		Layers: chunks,
+	})
+	if err != nil {
+		return fmt.Errorf("error marshaling image manifest: %w", err)
+	}
+
+	klog.Infof("Uploading chunked image %q to registry", p.destImageName)
+	if err := remote.Put(ref, chunkedManifest(rawManifest), remoteOpts...); err != nil {
+		return fmt.Errorf("error uploading image: %w", err)
+	}
+
+	return nil
+}
+
+// uploadChunks splits the stream into content-defined chunks and uploads every chunk as a blob.
+// It returns the chunk descriptors in the stream order.
+func uploadChunks(ctx context.Context, r io.Reader, repo name.Repository, remoteOpts []remote.Option) ([]v1.Descriptor, error) {
+	group, ctx := errgroup.WithContext(ctx)
+	group.SetLimit(chunkUploadConcurrency)
+	remoteOpts = append(slices.Clone(remoteOpts), remote.WithContext(ctx))
+
+	chunker, err := NewChunker(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var chunks []v1.Descriptor
+	for ctx.Err() == nil {
+		chunk, err := chunker.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("error reading chunk: %w", err), group.Wait())
+		}
+
+		layer := static.NewLayer(chunk, ChunkMediaType)
+		digest, err := layer.Digest()
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("error getting chunk digest: %w", err), group.Wait())
+		}
+		chunks = append(chunks, v1.Descriptor{
+			MediaType: ChunkMediaType,
+			Size:      int64(len(chunk)),
+			Digest:    digest,
+		})
+
+		group.Go(func() error {
+			// The registry skips the upload of a blob it already has in the repository.
+			if err := remote.WriteLayer(repo, layer, remoteOpts...); err != nil {
+				return fmt.Errorf("error uploading chunk %s: %w", digest, err)
+			}
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	return chunks, nil
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+)
+
+// Content-defined chunking parameters. Chunk boundaries depend only on the data around them,
+// so an insertion or a change in one part of an image shifts the boundaries only locally and
+// the rest of the chunks stay byte-identical to those of the images it was derived from.
+// Changing these values changes every boundary and disables deduplication against the
+// images already stored in DVCR.
+const (
+	ChunkMinSize = 2 << 20
+	ChunkAvgSize = 8 << 20
+	ChunkMaxSize = 32 << 20
+)
+
+// gearTable maps a byte to a pseudo-random value for the rolling gear hash.
+// It is generated from a fixed seed and must never change, see the chunking parameters.
+var gearTable = func() (table [256]uint64) {
+	// splitmix64
+	state := uint64(0x6a09e667f3bcc908)
+	for i := range table {
+		state += 0x9e3779b97f4a7c15
+		z := state
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		table[i] = z ^ (z >> 31)
+	}
+	return table
+}()
+
+// Chunker splits a stream into content-defined chunks using the FastCDC algorithm with
+// normalized chunking: a stricter mask before the average size and a looser one after it
+// keep the chunk sizes close to the average.
+type Chunker struct {
+	r        io.Reader
+	buf      []byte
+	start    int
+	end      int
+	eof      bool
+	minSize  int
+	avgSize  int
+	maxSize  int
+	maskHard uint64
+	maskEasy uint64
+}
+
+// NewChunker returns a Chunker with the DVCR chunking parameters.
+func NewChunker(r io.Reader) (*Chunker, error) {
+	return newChunker(r, ChunkMinSize, ChunkAvgSize, ChunkMaxSize)
+}
+
+func newChunker(r io.Reader, minSize, avgSize, maxSize int) (*Chunker, error) {
+	if minSize <= 0 || minSize >= avgSize || avgSize >= maxSize {
+		return nil, fmt.Errorf("invalid chunk sizes: min %d, avg %d, max %d", minSize, avgSize, maxSize)
+	}
+	if avgSize&(avgSize-1) != 0 {
+		return nil, fmt.Errorf("average chunk size %d is not a power of two", avgSize)
+	}
+
+	avgBits := bits.TrailingZeros(uint(avgSize))
+	return &Chunker{
+		r:        r,
+		buf:      make([]byte, 2*maxSize),
+		minSize:  minSize,
+		avgSize:  avgSize,
+		maxSize:  maxSize,
+		maskHard: topBitsMask(avgBits + 2),
+		maskEasy: topBitsMask(avgBits - 2),
+	}, nil
+}
+
+// topBitsMask returns a mask of the n most significant bits: with the gear hash shifting
+// left, the top bits depend on the widest window of the preceding bytes.
+func topBitsMask(n int) uint64 {
+	return ^uint64(0) << (64 - n)
+}
+
+// Next returns the next chunk or io.EOF after the last one. The returned slice is owned
+// by the caller.
+func (c *Chunker) Next() ([]byte, error) {
+	if err := c.fill(); err != nil {
+		return nil, err
+	}
+	if c.start == c.end {
+		return nil, io.EOF
+	}
+
+	n := c.cutPoint(c.buf[c.start:c.end])
+	chunk := make([]byte, n)
+	copy(chunk, c.buf[c.start:c.start+n])
+	c.start += n
+
+	return chunk, nil
+}
+
+// fill reads from the source until the buffer holds at least a maximum-sized chunk or the source ends.
+func (c *Chunker) fill() error {
+	if c.end-c.start >= c.maxSize || c.eof {
+		return nil
+	}
+
+	copy(c.buf, c.buf[c.start:c.end])
+	c.end -= c.start
+	c.start = 0
+
+	for c.end < c.maxSize && !c.eof {
+		n, err := c.r.Read(c.buf[c.end:])
+		c.end += n
+		if errors.Is(err, io.EOF) {
+			c.eof = true
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Chunker) cutPoint(data []byte) int {
+	n := len(data)
+	if n <= c.minSize {
+		return n
+	}
+	if n > c.maxSize {
+		n = c.maxSize
+	}
+	normal := c.avgSize
+	if normal > n {
+		normal = n
+	}
+
+	var hash uint64
+	i := c.minSize
+	for ; i < normal; i++ {
+		hash = (hash << 1) + gearTable[data[i]]
+		if hash&c.maskHard == 0 {
+			return i + 1
+		}
+	}
+	for ; i < n; i++ {
+		hash = (hash << 1) + gearTable[data[i]]
+		if hash&c.maskEasy == 0 {
+			return i + 1
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+const (
+	testChunkMin = 4 << 10
+	testChunkAvg = 16 << 10
+	testChunkMax = 64 << 10
+)
+
+func randomData(seed int64, size int) []byte {
+	data := make([]byte, size)
+	_, _ = rand.New(rand.NewSource(seed)).Read(data)
+	return data
+}
+
+func chunkAll(t *testing.T, data []byte) [][]byte {
+	t.Helper()
+
+	c, err := newChunker(bytes.NewReader(data), testChunkMin, testChunkAvg, testChunkMax)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var chunks [][]byte
+	for {
+		chunk, err := c.Next()
+		if errors.Is(err, io.EOF) {
+			return chunks
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		chunks = append(chunks, chunk)
+	}
+}
+
+func Test_Chunker_ReassemblesInputWithinSizeBounds(t *testing.T) {
+	data := randomData(1, 2<<20)
+
+	chunks := chunkAll(t, data)
+
+	if got := bytes.Join(chunks, nil); !bytes.Equal(got, data) {
+		t.Fatalf("reassembled data differs from the input")
+	}
+	for i, chunk := range chunks {
+		if len(chunk) > testChunkMax {
+			t.Fatalf("chunk %d: size %d exceeds max %d", i, len(chunk), testChunkMax)
+		}
+		if len(chunk) < testChunkMin && i != len(chunks)-1 {
+			t.Fatalf("chunk %d: size %d is below min %d", i, len(chunk), testChunkMin)
+		}
+	}
+	if avg := len(data) / len(chunks); avg < testChunkMin || avg > testChunkMax/2 {
+		t.Fatalf("average chunk size %d is far from the expected %d", avg, testChunkAvg)
+	}
+}
+
+func Test_Chunker_InsertionChangesOnlyNearbyChunks(t *testing.T) {
+	data := randomData(2, 2<<20)
+	edited := append(append(append([]byte{}, data[:1<<20]...), []byte("a few inserted bytes")...), data[1<<20:]...)
+
+	original := make(map[[sha256.Size]byte]struct{})
+	for _, chunk := range chunkAll(t, data) {
+		original[sha256.Sum256(chunk)] = struct{}{}
+	}
+
+	editedChunks := chunkAll(t, edited)
+	changed := 0
+	for _, chunk := range editedChunks {
+		if _, ok := original[sha256.Sum256(chunk)]; !ok {
+			changed++
+		}
+	}
+
+	if changed == 0 || changed > 3 {
+		t.Fatalf("expected 1 to 3 changed chunks out of %d, got %d", len(editedChunks), changed)
+	}
+}
+
+func Test_Chunker_CutsZeroRunsAtMaxSize(t *testing.T) {
+	data := make([]byte, 3*testChunkMax+100)
+
+	chunks := chunkAll(t, data)
+
+	if len(chunks) != 4 || len(chunks[0]) != testChunkMax || len(chunks[3]) != 100 {
+		sizes := make([]int, len(chunks))
+		for i, chunk := range chunks {
+			sizes[i] = len(chunk)
+		}
+		t.Fatalf("unexpected chunk sizes: %v", sizes)
+	}
+}
+
+func Test_Chunker_RejectsInvalidSizes(t *testing.T) {
+	if _, err := newChunker(bytes.NewReader(nil), testChunkAvg, testChunkMin, testChunkMax); err == nil {
+		t.Fatalf("expected an error for min > avg")
+	}
+	if _, err := newChunker(bytes.NewReader(nil), testChunkMin, testChunkAvg+1, testChunkMax); err == nil {
+		t.Fatalf("expected an error for a non power of two average")
+	}
+}
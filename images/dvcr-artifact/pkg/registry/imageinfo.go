@@ -30,6 +30,8 @@ import (
 	"k8s.io/klog/v2"
 	"kubevirt.io/containerized-data-importer/pkg/image"
 	"kubevirt.io/containerized-data-importer/pkg/importer"
+
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/inspect"
 )
 
 const (
@@ -41,6 +43,7 @@ const (
 	imageInfoSize        = 64 * 1024 * 1024
 	tempImageInfoPattern = "tempfile"
 	isoImageType         = "iso"
+	inspectBlockSize     = 1024 * 1024
 )
 
 func getImageInfo(ctx context.Context, sourceReader io.ReadCloser) (ImageInfo, error) {
@@ -143,6 +146,8 @@ func getImageInfoVMDK(ctx context.Context, sourceReader io.Reader, headerBuf []b
 		return ImageInfo{}, fmt.Errorf("error parsing qemu-img output: %w", err)
 	}
 
+	imageInfo.Inspection = inspectImage(ctx, syntheticPath, imageInfo.Format)
+
 	return imageInfo, nil
 }
 
@@ -192,6 +197,8 @@ func getImageInfoStandard(ctx context.Context, formatSourceReaders *importer.For
 			return ImageInfo{}, fmt.Errorf("error parsing qemu-img info output: %w", err)
 		}
 
+		imageInfo.Inspection = inspectImage(ctx, tempImageInfoFile.Name(), imageInfo.Format)
+
 		if imageInfo.Format != "raw" {
 			// It's necessary to read everything from the original image to avoid blocking.
 			_, err = io.Copy(&EmptyWriter{}, formatSourceReaders.TopReader())
@@ -233,6 +240,60 @@ func getImageInfoStandard(ctx context.Context, formatSourceReaders *importer.For
 	}
 }
 
+// inspectImage detects the operating system, the partition table and the boot modes of the image by its sample.
+// A sample in a format other than raw is converted to raw first. The conversion may fail if the metadata
+// of the image is beyond the sample, so the inspection is best-effort: errors are logged and an empty result is returned.
+func inspectImage(ctx context.Context, samplePath, format string) inspect.Result {
+	rawPath := samplePath
+
+	if format != "raw" {
+		rawFile, err := os.CreateTemp("", tempImageInfoPattern)
+		if err != nil {
+			klog.Warningf("Skip image inspection: error creating temp file: %v", err)
+			return inspect.Result{}
+		}
+		_ = rawFile.Close()
+		defer os.Remove(rawFile.Name())
+
+		cmd := exec.CommandContext(ctx, "qemu-img", "dd",
+			"-f", format, "-O", "raw",
+			fmt.Sprintf("bs=%d", inspectBlockSize),
+			fmt.Sprintf("count=%d", imageInfoSize/inspectBlockSize),
+			"if="+samplePath, "of="+rawFile.Name(),
+		)
+		rawOut, err := cmd.CombinedOutput()
+		if err != nil {
+			klog.Warningf("Skip image inspection: error converting the sample to raw: %s: %v", string(rawOut), err)
+			return inspect.Result{}
+		}
+
+		rawPath = rawFile.Name()
+	}
+
+	f, err := os.Open(rawPath)
+	if err != nil {
+		klog.Warningf("Skip image inspection: %v", err)
+		return inspect.Result{}
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		klog.Warningf("Skip image inspection: %v", err)
+		return inspect.Result{}
+	}
+
+	res, err := inspect.Inspect(f, stat.Size())
+	if err != nil {
+		klog.Warningf("Skip image inspection: %v", err)
+		return inspect.Result{}
+	}
+
+	klog.Infof("Image inspection result: %+v", res)
+
+	return res
+}
+
 func createSyntheticVMDK(headBuf []byte, tailBuf *TailBuffer, totalSize int64) (string, error) {
 	tmpFile, err := os.CreateTemp("", "synthetic-*.vmdk")
 	if err != nil {
@@ -16,9 +16,12 @@ limitations under the License.
 
 package registry
 
+import "github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/inspect"
+
 type ImageInformer struct {
 	virtualSize uint64
 	format      string
+	inspection  inspect.Result
 
 	wait chan struct{}
 }
@@ -29,9 +32,10 @@ func NewImageInformer() *ImageInformer {
 	}
 }
 
-func (r *ImageInformer) Set(virtualSize uint64, format string) {
+func (r *ImageInformer) Set(virtualSize uint64, format string, inspection inspect.Result) {
 	r.virtualSize = virtualSize
 	r.format = format
+	r.inspection = inspection
 
 	close(r.wait)
 }
@@ -47,3 +51,7 @@ func (r *ImageInformer) GetVirtualSize() uint64 {
 func (r *ImageInformer) GetFormat() string {
 	return r.format
 }
+
+func (r *ImageInformer) GetInspection() inspect.Result {
+	return r.inspection
+}
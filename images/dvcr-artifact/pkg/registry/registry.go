@@ -20,14 +20,11 @@ import (
 	"archive/tar"
 	"context"
 	"crypto/tls"
-	"crypto/x509"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
-	"os"
 	"path"
-	"path/filepath"
 	"time"
 
 	"github.com/google/go-containerregistry/pkg/authn"
@@ -36,11 +33,14 @@ import (
 	"github.com/google/go-containerregistry/pkg/v1/empty"
 	"github.com/google/go-containerregistry/pkg/v1/mutate"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/types"
 	"golang.org/x/sync/errgroup"
 	"k8s.io/klog/v2"
 
 	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/datasource"
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/inspect"
 	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/monitoring"
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/registry/cabundle"
 )
 
 // FIXME(ilya-lesikov): certdir
@@ -61,21 +61,25 @@ type ImportRes struct {
 	VirtualSize     uint64
 	AvgSpeed        uint64
 	Format          string
+	Inspection      inspect.Result
 }
 
 type ImageInfo struct {
-	VirtualSize uint64 `json:"virtual-size"`
-	Format      string `json:"format"`
+	VirtualSize uint64         `json:"virtual-size"`
+	Format      string         `json:"format"`
+	Inspection  inspect.Result `json:"-"`
 }
 
 type DataProcessor struct {
-	ds            datasource.DataSourceInterface
-	destUsername  string
-	destPassword  string
-	destImageName string
-	checksums     map[string]string
-	destInsecure  bool
-	destCABundle  string
+	ds              datasource.DataSourceInterface
+	destUsername    string
+	destPassword    string
+	destImageName   string
+	checksums       map[string]string
+	destInsecure    bool
+	destCABundle    string
+	destChunked     bool
+	destCompression string
 }
 
 type DestinationRegistry struct {
@@ -86,6 +90,11 @@ type DestinationRegistry struct {
 	// CABundle is a path to a PEM file or a directory with PEM files used to
 	// verify the destination registry certificate. Ignored when Insecure is set.
 	CABundle string
+	// Chunked stores the image as content-defined chunks deduplicated across images, see ChunkMediaType.
+	// Such an image can only be read by the pvc-importer.
+	Chunked bool
+	// Compression is the compression algorithm of the image layer, see CompressionZstd. Ignored for chunked images.
+	Compression string
 }
 
 func NewDataProcessor(ds datasource.DataSourceInterface, dest DestinationRegistry, checksums map[string]string) (*DataProcessor, error) {
@@ -95,14 +104,20 @@ func NewDataProcessor(ds datasource.DataSourceInterface, dest DestinationRegistr
 		}
 	}
 
+	if err := ValidateCompression(dest.Compression); err != nil {
+		return nil, err
+	}
+
 	return &DataProcessor{
-		ds:            ds,
-		destUsername:  dest.Username,
-		destPassword:  dest.Password,
-		destImageName: dest.ImageName,
-		checksums:     checksums,
-		destInsecure:  dest.Insecure,
-		destCABundle:  dest.CABundle,
+		ds:              ds,
+		destUsername:    dest.Username,
+		destPassword:    dest.Password,
+		destImageName:   dest.ImageName,
+		checksums:       checksums,
+		destInsecure:    dest.Insecure,
+		destCABundle:    dest.CABundle,
+		destChunked:     dest.Chunked,
+		destCompression: dest.Compression,
 	}, nil
 }
 
@@ -161,9 +176,16 @@ func (p DataProcessor) Process(ctx context.Context) (ImportRes, error) {
 	go func() {
 		defer pipeReader.Close()
 
-		err := p.uploadLayersAndImage(
-			ctx, pipeReader, sourceImageSize, informer,
-		)
+		var err error
+		if p.destChunked {
+			err = p.uploadChunkedImage(
+				ctx, pipeReader, sourceImageFilename, sourceImageSize, informer,
+			)
+		} else {
+			err = p.uploadLayersAndImage(
+				ctx, pipeReader, sourceImageSize, informer,
+			)
+		}
 		if err != nil {
 			cancel()
 			err = fmt.Errorf("layers uploading error: %w", err)
@@ -190,6 +212,7 @@ func (p DataProcessor) Process(ctx context.Context) (ImportRes, error) {
 		VirtualSize:     informer.GetVirtualSize(),
 		AvgSpeed:        progressMeter.GetAvgSpeed(),
 		Format:          informer.GetFormat(),
+		Inspection:      informer.GetInspection(),
 	}, nil
 }
 
@@ -201,8 +224,10 @@ func (p DataProcessor) inspectAndStreamSourceImage(
 	pipeWriter io.WriteCloser,
 	informer *ImageInformer,
 ) error {
+	// A chunked image holds the disk image itself, a regular one holds it in a tar archive.
+	dataWriter := io.Writer(pipeWriter)
 	var tarWriter *tar.Writer
-	{
+	if !p.destChunked {
 		now := time.Now()
 
 		tarWriter = tar.NewWriter(pipeWriter)
@@ -234,13 +259,14 @@ func (p DataProcessor) inspectAndStreamSourceImage(
 		if err := tarWriter.WriteHeader(header); err != nil {
 			return fmt.Errorf("error writing tar header [%s]: %w", imagePath, err)
 		}
+		dataWriter = tarWriter
 	}
 
 	checksumWriters, checksumCheckFuncList := newChecksumVerifiers(p.checksums)
 
 	var streamWriter io.Writer
 	{
-		writers := []io.Writer{tarWriter}
+		writers := []io.Writer{dataWriter}
 		writers = append(writers, checksumWriters...)
 		streamWriter = io.MultiWriter(writers...)
 	}
@@ -250,7 +276,11 @@ func (p DataProcessor) inspectAndStreamSourceImage(
 	imageInfoReader, imageInfoWriter := io.Pipe()
 
 	errsGroup.Go(func() error {
-		defer tarWriter.Close()
+		defer func() {
+			if tarWriter != nil {
+				_ = tarWriter.Close()
+			}
+		}()
 		defer pipeWriter.Close()
 		defer sourceImageReader.Close()
 		defer imageInfoWriter.Close()
@@ -272,9 +302,11 @@ func (p DataProcessor) inspectAndStreamSourceImage(
 		}
 
 		// Append end-of-file marker for tar archive.
-		err = writeTarEOFMarker(pipeWriter)
-		if err != nil {
-			return fmt.Errorf("adding tar EOF marker: %w", err)
+		if tarWriter != nil {
+			err = writeTarEOFMarker(pipeWriter)
+			if err != nil {
+				return fmt.Errorf("adding tar EOF marker: %w", err)
+			}
 		}
 
 		klog.Infoln("Source streaming completed")
@@ -290,7 +322,7 @@ func (p DataProcessor) inspectAndStreamSourceImage(
 			return err
 		}
 
-		informer.Set(info.VirtualSize, info.Format)
+		informer.Set(info.VirtualSize, info.Format, info.Inspection)
 
 		return nil
 	})
@@ -304,29 +336,28 @@ func (p DataProcessor) uploadLayersAndImage(
 	sourceImageSize int,
 	informer *ImageInformer,
 ) error {
-	nameOpts := destNameOptions(p.destInsecure)
-	remoteOpts, err := destRemoteOptions(ctx, p.destUsername, p.destPassword, p.destCABundle, p.destInsecure)
+	ref, repo, remoteOpts, err := p.destination(ctx)
 	if err != nil {
 		return err
 	}
 	image := empty.Image
 
-	ref, err := name.ParseReference(p.destImageName, nameOpts...)
-	if err != nil {
-		return fmt.Errorf("error parsing image name: %w", err)
-	}
-
-	repo, err := name.NewRepository(ref.Context().Name(), nameOpts...)
-	if err != nil {
-		return fmt.Errorf("error constructing new repository: %w", err)
-	}
-
-	// Upload the tar stream as an uncompressed layer. gzip compression
+	// Upload the tar stream as an uncompressed layer by default. gzip compression
 	// (the default of stream.NewLayer) is single-threaded and CPU-bound, and
 	// caps the import speed of large disk images in the CPU-limited
 	// provisioning pod. Disk images barely compress, so skipping gzip removes
 	// the bottleneck without meaningfully growing the stored layer.
-	layer := newUncompressedLayer(pipeReader)
+	// zstd is opt-in: it is fast enough and pays off for mostly-zero raw images.
+	var layer v1.Layer
+	switch p.destCompression {
+	case CompressionZstd, CompressionZstdSeekable:
+		layer = newZstdLayer(pipeReader, p.destCompression == CompressionZstdSeekable)
+		// zstd layers are defined by the OCI spec only.
+		image = mutate.MediaType(image, types.OCIManifestSchema1)
+		image = mutate.ConfigMediaType(image, types.OCIConfigJSON)
+	default:
+		layer = newUncompressedLayer(pipeReader)
+	}
 
 	klog.Infoln("Uploading layer to registry")
 	if err := remote.WriteLayer(repo, layer, remoteOpts...); err != nil {
@@ -367,6 +398,27 @@ func (p DataProcessor) uploadLayersAndImage(
 	return nil
 }
 
+// destination returns the reference and the repository of the destination image with the options to access it.
+func (p DataProcessor) destination(ctx context.Context) (name.Reference, name.Repository, []remote.Option, error) {
+	nameOpts := destNameOptions(p.destInsecure)
+	remoteOpts, err := destRemoteOptions(ctx, p.destUsername, p.destPassword, p.destCABundle, p.destInsecure)
+	if err != nil {
+		return nil, name.Repository{}, nil, err
+	}
+
+	ref, err := name.ParseReference(p.destImageName, nameOpts...)
+	if err != nil {
+		return nil, name.Repository{}, nil, fmt.Errorf("error parsing image name: %w", err)
+	}
+
+	repo, err := name.NewRepository(ref.Context().Name(), nameOpts...)
+	if err != nil {
+		return nil, name.Repository{}, nil, fmt.Errorf("error constructing new repository: %w", err)
+	}
+
+	return ref, repo, remoteOpts, nil
+}
+
 // populateCommonConfigFields adds some required fields according to the document:
 // https://github.com/opencontainers/image-spec/blob/main/config.md
 func populateCommonConfigFields(cnf *v1.ConfigFile) {
@@ -407,7 +459,7 @@ func destRemoteOptions(ctx context.Context, destUsername, destPassword, destCABu
 
 	// A custom CA bundle only makes sense when the certificate is being verified.
 	if !destInsecure && destCABundle != "" {
-		rootCAs, err := loadCABundle(destCABundle)
+		rootCAs, err := cabundle.Load(destCABundle)
 		if err != nil {
 			return nil, fmt.Errorf("load destination CA bundle: %w", err)
 		}
@@ -427,54 +479,6 @@ func destRemoteOptions(ctx context.Context, destUsername, destPassword, destCABu
 	return remoteOpts, nil
 }
 
-// loadCABundle builds a CertPool from a PEM file or a directory with PEM files.
-// It starts from the system pool so well-known CAs keep working alongside the
-// supplied bundle.
-func loadCABundle(path string) (*x509.CertPool, error) {
-	pool, err := x509.SystemCertPool()
-	if err != nil || pool == nil {
-		pool = x509.NewCertPool()
-	}
-
-	info, err := os.Stat(path)
-	if err != nil {
-		return nil, fmt.Errorf("stat %q: %w", path, err)
-	}
-
-	var files []string
-	if info.IsDir() {
-		entries, err := os.ReadDir(path)
-		if err != nil {
-			return nil, fmt.Errorf("read dir %q: %w", path, err)
-		}
-		for _, entry := range entries {
-			if entry.IsDir() {
-				continue
-			}
-			files = append(files, filepath.Join(path, entry.Name()))
-		}
-	} else {
-		files = append(files, path)
-	}
-
-	appended := false
-	for _, file := range files {
-		pemData, err := os.ReadFile(file)
-		if err != nil {
-			return nil, fmt.Errorf("read %q: %w", file, err)
-		}
-		if pool.AppendCertsFromPEM(pemData) {
-			appended = true
-		}
-	}
-
-	if !appended {
-		return nil, fmt.Errorf("no valid certificates found in %q", path)
-	}
-
-	return pool, nil
-}
-
 type EmptyWriter struct{}
 
 func (w EmptyWriter) Write(p []byte) (int, error) {
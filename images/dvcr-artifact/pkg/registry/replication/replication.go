@@ -0,0 +1,164 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package replication copies DVCR images to and from a mirror registry.
+// It does not depend on the import machinery, so the dvcr-cleaner can use it.
+package replication
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+
+	"github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/registry/cabundle"
+)
+
+// UserAgent is sent with every request of the replication, so DVCR pulls made by the
+// replication can be told apart from pulls made by virtual machines and disks.
+const UserAgent = "dvcr-replication"
+
+// Endpoint holds the options to access a registry: DVCR or the mirror registry.
+type Endpoint struct {
+	Username string
+	Password string
+	Insecure bool
+	// CABundle is a path to a PEM file or a directory with PEM files used to
+	// verify the registry certificate. Ignored when Insecure is set.
+	CABundle string
+}
+
+// ImageDigest returns the manifest digest of the image, found is false if there is no such image in the registry.
+func ImageDigest(ctx context.Context, image string, endpoint Endpoint) (digest v1.Hash, found bool, err error) {
+	ref, opts, err := endpointReference(ctx, image, endpoint)
+	if err != nil {
+		return v1.Hash{}, false, err
+	}
+
+	desc, err := remote.Head(ref, opts...)
+	if err != nil {
+		if isNotFound(err) {
+			return v1.Hash{}, false, nil
+		}
+		return v1.Hash{}, false, fmt.Errorf("error getting manifest of image %q: %w", image, err)
+	}
+
+	return desc.Digest, true, nil
+}
+
+// Replicate copies the image from one registry to another as is: the manifest, the config and the layers
+// keep their digests, so chunked and compressed images stay readable by the importers.
+// The copy is skipped if the destination already holds the image with the same digest.
+// It returns the digest of the image and whether it was copied.
+func Replicate(ctx context.Context, srcImage string, src Endpoint, dstImage string, dst Endpoint) (v1.Hash, bool, error) {
+	srcRef, srcOpts, err := endpointReference(ctx, srcImage, src)
+	if err != nil {
+		return v1.Hash{}, false, err
+	}
+
+	dstRef, dstOpts, err := endpointReference(ctx, dstImage, dst)
+	if err != nil {
+		return v1.Hash{}, false, err
+	}
+
+	desc, err := remote.Get(srcRef, srcOpts...)
+	if err != nil {
+		return v1.Hash{}, false, fmt.Errorf("error getting image %q: %w", srcImage, err)
+	}
+
+	dstDesc, err := remote.Head(dstRef, dstOpts...)
+	switch {
+	case err == nil && dstDesc.Digest == desc.Digest:
+		return desc.Digest, false, nil
+	case err != nil && !isNotFound(err):
+		return v1.Hash{}, false, fmt.Errorf("error getting manifest of image %q: %w", dstImage, err)
+	}
+
+	if desc.MediaType.IsIndex() {
+		index, err := desc.ImageIndex()
+		if err != nil {
+			return v1.Hash{}, false, fmt.Errorf("error reading image index %q: %w", srcImage, err)
+		}
+
+		if err = remote.WriteIndex(dstRef, index, dstOpts...); err != nil {
+			return v1.Hash{}, false, fmt.Errorf("error uploading image index %q: %w", dstImage, err)
+		}
+
+		return desc.Digest, true, nil
+	}
+
+	image, err := desc.Image()
+	if err != nil {
+		return v1.Hash{}, false, fmt.Errorf("error reading image %q: %w", srcImage, err)
+	}
+
+	if err = remote.Write(dstRef, image, dstOpts...); err != nil {
+		return v1.Hash{}, false, fmt.Errorf("error uploading image %q: %w", dstImage, err)
+	}
+
+	return desc.Digest, true, nil
+}
+
+func endpointReference(ctx context.Context, image string, endpoint Endpoint) (name.Reference, []remote.Option, error) {
+	var nameOpts []name.Option
+	if endpoint.Insecure {
+		nameOpts = append(nameOpts, name.Insecure)
+	}
+
+	ref, err := name.ParseReference(image, nameOpts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing image name %q: %w", image, err)
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: endpoint.Insecure,
+	}
+
+	if !endpoint.Insecure && endpoint.CABundle != "" {
+		rootCAs, err := cabundle.Load(endpoint.CABundle)
+		if err != nil {
+			return nil, nil, fmt.Errorf("load CA bundle: %w", err)
+		}
+
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	httpTransport := http.DefaultTransport.(*http.Transport).Clone()
+	httpTransport.TLSClientConfig = tlsConfig
+
+	remoteOpts := []remote.Option{
+		remote.WithContext(ctx),
+		remote.WithTransport(httpTransport),
+		remote.WithUserAgent(UserAgent),
+	}
+	if endpoint.Username != "" || endpoint.Password != "" {
+		remoteOpts = append(remoteOpts, remote.WithAuth(&authn.Basic{Username: endpoint.Username, Password: endpoint.Password}))
+	}
+
+	return ref, remoteOpts, nil
+}
+
+func isNotFound(err error) bool {
+	var terr *transport.Error
+	return errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replication
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	ggcrregistry "github.com/google/go-containerregistry/pkg/registry"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+)
+
+func newTestRegistry(t *testing.T) string {
+	t.Helper()
+
+	server := httptest.NewServer(ggcrregistry.New())
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("parse registry url: %v", err)
+	}
+	return u.Host
+}
+
+func Test_Replicate(t *testing.T) {
+	ctx := context.Background()
+	endpoint := Endpoint{Insecure: true}
+
+	src := newTestRegistry(t) + "/vi/ns/image:uid"
+	dst := newTestRegistry(t) + "/mirror/vi/ns/image:uid"
+
+	image, err := random.Image(1024, 2)
+	if err != nil {
+		t.Fatalf("random image: %v", err)
+	}
+	want, err := image.Digest()
+	if err != nil {
+		t.Fatalf("image digest: %v", err)
+	}
+	srcRef, err := name.ParseReference(src, name.Insecure)
+	if err != nil {
+		t.Fatalf("parse source image name: %v", err)
+	}
+	if err = remote.Write(srcRef, image); err != nil {
+		t.Fatalf("push source image: %v", err)
+	}
+
+	if _, found, err := ImageDigest(ctx, dst, endpoint); err != nil || found {
+		t.Fatalf("ImageDigest before replication: found %v, err %v, want not found", found, err)
+	}
+
+	digest, copied, err := Replicate(ctx, src, endpoint, dst, endpoint)
+	if err != nil {
+		t.Fatalf("Replicate: %v", err)
+	}
+	if !copied || digest != want {
+		t.Fatalf("Replicate: got digest %s copied %v, want digest %s copied true", digest, copied, want)
+	}
+
+	got, found, err := ImageDigest(ctx, dst, endpoint)
+	if err != nil || !found || got != want {
+		t.Fatalf("ImageDigest after replication: got %s found %v err %v, want %s", got, found, err, want)
+	}
+
+	_, copied, err = Replicate(ctx, src, endpoint, dst, endpoint)
+	if err != nil {
+		t.Fatalf("Replicate again: %v", err)
+	}
+	if copied {
+		t.Fatal("Replicate again: image copied, want skipped as up to date")
+	}
+}
+
+func Test_Replicate_MissingSource(t *testing.T) {
+	endpoint := Endpoint{Insecure: true}
+	host := newTestRegistry(t)
+
+	_, copied, err := Replicate(context.Background(), host+"/vi/ns/missing:uid", endpoint, host+"/mirror/vi/ns/missing:uid", endpoint)
+	if err == nil || copied {
+		t.Fatalf("Replicate: got copied %v err %v, want an error", copied, err)
+	}
+}
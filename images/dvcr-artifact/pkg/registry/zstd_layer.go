@@ -0,0 +1,230 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"crypto"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"runtime"
+	"sync"
+
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/stream"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression algorithms of the image layer stored in DVCR.
+const (
+	CompressionNone = "None"
+	CompressionZstd = "Zstd"
+	// CompressionZstdSeekable is zstd in the seekable format: the layer is still
+	// readable by any zstd decoder, but its ranges can be decompressed independently.
+	CompressionZstdSeekable = "ZstdSeekable"
+)
+
+// ValidateCompression returns an error if the compression algorithm is unknown.
+// An empty value means no compression.
+func ValidateCompression(compression string) error {
+	switch compression {
+	case "", CompressionNone, CompressionZstd, CompressionZstdSeekable:
+		return nil
+	default:
+		return fmt.Errorf("unknown compression %q, supported are %s, %s and %s", compression, CompressionNone, CompressionZstd, CompressionZstdSeekable)
+	}
+}
+
+// zstdLayer is a single-pass streaming v1.Layer that uploads the tar stream
+// compressed with zstd as an application/vnd.oci.image.layer.v1.tar+zstd layer.
+//
+// Unlike gzip, zstd compresses with all available cores at the fastest level, so
+// it does not become the bottleneck of the upload, and it shrinks the zero-filled
+// areas of raw disk images to almost nothing.
+type zstdLayer struct {
+	blob     io.ReadCloser
+	seekable bool
+	consumed bool
+
+	mu     sync.Mutex
+	digest *v1.Hash
+	diffID *v1.Hash
+	size   int64
+}
+
+var _ v1.Layer = (*zstdLayer)(nil)
+
+// newZstdLayer creates a zstd compressed streaming Layer from rc.
+func newZstdLayer(rc io.ReadCloser, seekable bool) *zstdLayer {
+	return &zstdLayer{blob: rc, seekable: seekable}
+}
+
+// Digest implements v1.Layer, see uncompressedLayer.Digest.
+func (l *zstdLayer) Digest() (v1.Hash, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.digest == nil {
+		return v1.Hash{}, stream.ErrNotComputed
+	}
+	return *l.digest, nil
+}
+
+// DiffID implements v1.Layer. It is the digest of the uncompressed tar stream.
+func (l *zstdLayer) DiffID() (v1.Hash, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.diffID == nil {
+		return v1.Hash{}, stream.ErrNotComputed
+	}
+	return *l.diffID, nil
+}
+
+// Size implements v1.Layer.
+func (l *zstdLayer) Size() (int64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.size == 0 {
+		return 0, stream.ErrNotComputed
+	}
+	return l.size, nil
+}
+
+// MediaType implements v1.Layer.
+func (l *zstdLayer) MediaType() (types.MediaType, error) {
+	return types.OCILayerZStd, nil
+}
+
+// Uncompressed implements v1.Layer. The layer is only uploaded, so as stream.Layer it is not implemented.
+func (l *zstdLayer) Uncompressed() (io.ReadCloser, error) {
+	return nil, errors.New("zstd layer: Uncompressed is not implemented")
+}
+
+// Compressed implements v1.Layer.
+func (l *zstdLayer) Compressed() (io.ReadCloser, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.consumed {
+		return nil, stream.ErrConsumed
+	}
+	return newZstdReader(l)
+}
+
+// finalize sets the layer to consumed and records the digests and size computed while streaming.
+func (l *zstdLayer) finalize(digestHash, diffIDHash hash.Hash, size int64) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	digest, err := v1.NewHash("sha256:" + hex.EncodeToString(digestHash.Sum(nil)))
+	if err != nil {
+		return err
+	}
+	diffID, err := v1.NewHash("sha256:" + hex.EncodeToString(diffIDHash.Sum(nil)))
+	if err != nil {
+		return err
+	}
+
+	l.digest = &digest
+	l.diffID = &diffID
+	l.size = size
+	l.consumed = true
+	return nil
+}
+
+type zstdReader struct {
+	pr     io.Reader
+	closer func() error
+}
+
+func newZstdReader(l *zstdLayer) (*zstdReader, error) {
+	// Collect the digest and size of the compressed stream and the diffID of the raw one.
+	digestHash := crypto.SHA256.New()
+	diffIDHash := crypto.SHA256.New()
+	count := &countWriter{}
+
+	pr, pw := io.Pipe()
+
+	// Tee the compressed stream to the pipe reader (consumed by the uploader),
+	// the hasher (digest), and the counter (size).
+	mw := io.MultiWriter(pw, digestHash, count)
+
+	opts := []zstd.EOption{
+		zstd.WithEncoderLevel(zstd.SpeedFastest),
+		zstd.WithEncoderConcurrency(runtime.GOMAXPROCS(0)),
+	}
+
+	var zw io.WriteCloser
+	var err error
+	if l.seekable {
+		zw, err = newSeekableZstdWriter(mw, opts...)
+	} else {
+		zw, err = zstd.NewWriter(mw, opts...)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create zstd encoder: %w", err)
+	}
+
+	doneDigesting := make(chan struct{})
+
+	r := &zstdReader{
+		pr: pr,
+		closer: func() error {
+			// NOTE: pw.Close never returns an error.
+			_ = pw.Close()
+
+			// Close the inner ReadCloser. net/http may have already closed it
+			// on success, so ignore os.ErrClosed.
+			if err := l.blob.Close(); err != nil && !errors.Is(err, os.ErrClosed) {
+				return err
+			}
+
+			<-doneDigesting
+			return l.finalize(digestHash, diffIDHash, count.n)
+		},
+	}
+
+	go func() {
+		_, copyErr := io.Copy(zw, io.TeeReader(l.blob, diffIDHash))
+		if copyErr == nil {
+			// Flush the last frame (and the seek table) before the digest is taken.
+			copyErr = zw.Close()
+		}
+		if copyErr != nil {
+			close(doneDigesting)
+			pw.CloseWithError(copyErr)
+			// Release the encoder: the pipe is closed, so it cannot block on writing.
+			_ = zw.Close()
+			return
+		}
+
+		// Notify closer that digest/size are done being written.
+		close(doneDigesting)
+
+		// Close the reader to finalize digest/size. This causes pr to return
+		// EOF so readers of the stream finish.
+		pw.CloseWithError(r.Close())
+	}()
+
+	return r, nil
+}
+
+func (r *zstdReader) Read(b []byte) (int, error) { return r.pr.Read(b) }
+
+func (r *zstdReader) Close() error { return r.closer() }
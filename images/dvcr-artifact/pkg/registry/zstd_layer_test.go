@@ -0,0 +1,121 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/v1/stream"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/klauspost/compress/zstd"
+)
+
+func Test_ZstdLayer_MediaType(t *testing.T) {
+	l := newZstdLayer(io.NopCloser(bytes.NewReader(nil)), false)
+	mt, err := l.MediaType()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mt != types.OCILayerZStd {
+		t.Fatalf("media type: got %q, want %q", mt, types.OCILayerZStd)
+	}
+}
+
+func Test_ZstdLayer_CompressesAndComputesDigests(t *testing.T) {
+	payload := append(bytes.Repeat([]byte("virtualization-disk-image"), 4096), make([]byte, 3*seekableFrameSize)...)
+
+	for _, seekable := range []bool{false, true} {
+		l := newZstdLayer(io.NopCloser(bytes.NewReader(payload)), seekable)
+
+		if _, err := l.DiffID(); !errors.Is(err, stream.ErrNotComputed) {
+			t.Fatalf("DiffID before consume: got %v, want stream.ErrNotComputed", err)
+		}
+
+		rc, err := l.Compressed()
+		if err != nil {
+			t.Fatalf("Compressed: %v", err)
+		}
+		compressed, err := io.ReadAll(rc)
+		if err != nil {
+			t.Fatalf("ReadAll: %v", err)
+		}
+		if err := rc.Close(); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+
+		if len(compressed) >= len(payload)/100 {
+			t.Fatalf("seekable=%v: compressed size %d is too large for %d bytes of repeated data", seekable, len(compressed), len(payload))
+		}
+
+		// Any zstd decoder reads the layer, the seek table is skipped.
+		decoder, err := zstd.NewReader(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decompressed, err := decoder.DecodeAll(compressed, nil)
+		decoder.Close()
+		if err != nil {
+			t.Fatalf("seekable=%v: decode: %v", seekable, err)
+		}
+		if !bytes.Equal(decompressed, payload) {
+			t.Fatalf("seekable=%v: decompressed data differs from input", seekable)
+		}
+
+		digest, err := l.Digest()
+		if err != nil {
+			t.Fatalf("Digest after consume: %v", err)
+		}
+		if want := "sha256:" + hex.EncodeToString(sum256(compressed)); digest.String() != want {
+			t.Fatalf("digest: got %q, want %q", digest, want)
+		}
+
+		diffID, err := l.DiffID()
+		if err != nil {
+			t.Fatalf("DiffID after consume: %v", err)
+		}
+		if want := "sha256:" + hex.EncodeToString(sum256(payload)); diffID.String() != want {
+			t.Fatalf("diffID: got %q, want %q", diffID, want)
+		}
+
+		size, err := l.Size()
+		if err != nil {
+			t.Fatalf("Size after consume: %v", err)
+		}
+		if size != int64(len(compressed)) {
+			t.Fatalf("size: got %d, want %d", size, len(compressed))
+		}
+
+		if _, err := l.Compressed(); !errors.Is(err, stream.ErrConsumed) {
+			t.Fatalf("second Compressed: got %v, want stream.ErrConsumed", err)
+		}
+	}
+}
+
+func Test_ValidateCompression(t *testing.T) {
+	for _, compression := range []string{"", CompressionNone, CompressionZstd, CompressionZstdSeekable} {
+		if err := ValidateCompression(compression); err != nil {
+			t.Fatalf("%q: unexpected error: %v", compression, err)
+		}
+	}
+	if err := ValidateCompression("gzip"); err == nil {
+		t.Fatal("expected an error for unknown compression")
+	}
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// The seekable zstd format is described in
+// https://github.com/facebook/zstd/blob/dev/contrib/seekable_format/zstd_seekable_compression_format.md
+//
+// The data is split into frames compressed independently, and a seek table with
+// the sizes of the frames is appended in a skippable frame. Regular zstd decoders
+// read the whole stream and ignore the seek table, while the table allows to
+// decompress an arbitrary range by reading only the frames it covers.
+const (
+	// seekableFrameSize is the uncompressed size of a frame. It bounds the amount
+	// of data to decompress for a range read.
+	seekableFrameSize = 4 << 20
+
+	seekableSkippableMagic = 0x184D2A5E
+	seekableMagic          = 0x8F92EAB1
+	seekableFooterSize     = 9
+	seekableEntrySize      = 8
+	seekableChecksumFlag   = 1 << 7
+	skippableHeaderSize    = 8
+)
+
+type seekableFrame struct {
+	compressedOffset   int64
+	compressedSize     int64
+	decompressedOffset int64
+	decompressedSize   int64
+}
+
+// seekableZstdWriter compresses the written data in the seekable zstd format.
+type seekableZstdWriter struct {
+	w       io.Writer
+	encoder *zstd.Encoder
+	buf     []byte
+	dst     []byte
+	entries []byte
+	frames  uint32
+}
+
+func newSeekableZstdWriter(w io.Writer, opts ...zstd.EOption) (*seekableZstdWriter, error) {
+	encoder, err := zstd.NewWriter(nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &seekableZstdWriter{
+		w:       w,
+		encoder: encoder,
+		buf:     make([]byte, 0, seekableFrameSize),
+	}, nil
+}
+
+func (s *seekableZstdWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := min(len(p), seekableFrameSize-len(s.buf))
+		s.buf = append(s.buf, p[:n]...)
+		p = p[n:]
+		written += n
+
+		if len(s.buf) == seekableFrameSize {
+			if err := s.flushFrame(); err != nil {
+				return written, err
+			}
+		}
+	}
+
+	return written, nil
+}
+
+// Close writes the pending frame and the seek table. It does not close the underlying writer.
+func (s *seekableZstdWriter) Close() error {
+	if len(s.buf) > 0 {
+		if err := s.flushFrame(); err != nil {
+			return err
+		}
+	}
+
+	table := binary.LittleEndian.AppendUint32(nil, seekableSkippableMagic)
+	table = binary.LittleEndian.AppendUint32(table, uint32(len(s.entries)+seekableFooterSize))
+	table = append(table, s.entries...)
+	table = binary.LittleEndian.AppendUint32(table, s.frames)
+	table = append(table, 0)
+	table = binary.LittleEndian.AppendUint32(table, seekableMagic)
+
+	_, err := s.w.Write(table)
+	return err
+}
+
+func (s *seekableZstdWriter) flushFrame() error {
+	s.dst = s.encoder.EncodeAll(s.buf, s.dst[:0])
+	if _, err := s.w.Write(s.dst); err != nil {
+		return err
+	}
+
+	s.entries = binary.LittleEndian.AppendUint32(s.entries, uint32(len(s.dst)))
+	s.entries = binary.LittleEndian.AppendUint32(s.entries, uint32(len(s.buf)))
+	s.frames++
+	s.buf = s.buf[:0]
+
+	return nil
+}
+
+// SeekableZstdReader decompresses arbitrary ranges of data in the seekable zstd format.
+type SeekableZstdReader struct {
+	r       io.ReaderAt
+	frames  []seekableFrame
+	size    int64
+	decoder *zstd.Decoder
+}
+
+var _ io.ReaderAt = (*SeekableZstdReader)(nil)
+
+// NewSeekableZstdReader reads the seek table from the end of r, size is the compressed size of the data.
+func NewSeekableZstdReader(r io.ReaderAt, size int64) (*SeekableZstdReader, error) {
+	if size < skippableHeaderSize+seekableFooterSize {
+		return nil, errors.New("seekable zstd: data is too short")
+	}
+
+	footer := make([]byte, seekableFooterSize)
+	if _, err := r.ReadAt(footer, size-seekableFooterSize); err != nil {
+		return nil, fmt.Errorf("seekable zstd: read footer: %w", err)
+	}
+	if binary.LittleEndian.Uint32(footer[5:]) != seekableMagic {
+		return nil, errors.New("seekable zstd: seek table not found")
+	}
+
+	entrySize := int64(seekableEntrySize)
+	if footer[4]&seekableChecksumFlag != 0 {
+		entrySize += 4
+	}
+	count := int64(binary.LittleEndian.Uint32(footer[:4]))
+	tableSize := count*entrySize + seekableFooterSize + skippableHeaderSize
+	if tableSize > size {
+		return nil, errors.New("seekable zstd: seek table exceeds data size")
+	}
+
+	table := make([]byte, tableSize-seekableFooterSize)
+	if _, err := r.ReadAt(table, size-tableSize); err != nil {
+		return nil, fmt.Errorf("seekable zstd: read seek table: %w", err)
+	}
+	if binary.LittleEndian.Uint32(table[:4]) != seekableSkippableMagic {
+		return nil, errors.New("seekable zstd: invalid seek table frame")
+	}
+
+	frames := make([]seekableFrame, 0, count)
+	var compressedOffset, decompressedOffset int64
+	for entry := table[skippableHeaderSize:]; len(entry) > 0; entry = entry[entrySize:] {
+		frame := seekableFrame{
+			compressedOffset:   compressedOffset,
+			compressedSize:     int64(binary.LittleEndian.Uint32(entry[:4])),
+			decompressedOffset: decompressedOffset,
+			decompressedSize:   int64(binary.LittleEndian.Uint32(entry[4:8])),
+		}
+		compressedOffset += frame.compressedSize
+		decompressedOffset += frame.decompressedSize
+		frames = append(frames, frame)
+	}
+	if compressedOffset != size-tableSize {
+		return nil, errors.New("seekable zstd: seek table does not match data size")
+	}
+
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SeekableZstdReader{
+		r:       r,
+		frames:  frames,
+		size:    decompressedOffset,
+		decoder: decoder,
+	}, nil
+}
+
+// Size returns the decompressed size of the data.
+func (s *SeekableZstdReader) Size() int64 {
+	return s.size
+}
+
+// ReadAt decompresses only the frames covering the requested range.
+func (s *SeekableZstdReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("seekable zstd: negative offset")
+	}
+
+	i := sort.Search(len(s.frames), func(i int) bool {
+		return s.frames[i].decompressedOffset+s.frames[i].decompressedSize > off
+	})
+
+	n := 0
+	for ; n < len(p) && i < len(s.frames); i++ {
+		frame := s.frames[i]
+
+		compressed := make([]byte, frame.compressedSize)
+		if _, err := s.r.ReadAt(compressed, frame.compressedOffset); err != nil {
+			return n, fmt.Errorf("seekable zstd: read frame %d: %w", i, err)
+		}
+		data, err := s.decoder.DecodeAll(compressed, make([]byte, 0, frame.decompressedSize))
+		if err != nil {
+			return n, fmt.Errorf("seekable zstd: decode frame %d: %w", i, err)
+		}
+
+		n += copy(p[n:], data[off+int64(n)-frame.decompressedOffset:])
+	}
+
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// Close releases the decoder resources.
+func (s *SeekableZstdReader) Close() {
+	s.decoder.Close()
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+func seekableCompress(t *testing.T, data []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w, err := newSeekableZstdWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func Test_SeekableZstd_ReadAtRanges(t *testing.T) {
+	data := make([]byte, 2*seekableFrameSize+12345)
+	rand.New(rand.NewSource(1)).Read(data[:seekableFrameSize])
+
+	compressed := seekableCompress(t, data)
+
+	r, err := NewSeekableZstdReader(bytes.NewReader(compressed), int64(len(compressed)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer r.Close()
+
+	if r.Size() != int64(len(data)) {
+		t.Fatalf("size: got %d, want %d", r.Size(), len(data))
+	}
+	if len(r.frames) != 3 {
+		t.Fatalf("frames: got %d, want 3", len(r.frames))
+	}
+
+	ranges := []struct{ off, n int64 }{
+		{0, 100},
+		{seekableFrameSize - 10, 20},
+		{seekableFrameSize / 2, seekableFrameSize + 100},
+		{int64(len(data)) - 7, 7},
+	}
+	for _, rng := range ranges {
+		got := make([]byte, rng.n)
+		n, err := r.ReadAt(got, rng.off)
+		if err != nil {
+			t.Fatalf("ReadAt(%d, %d): %v", rng.off, rng.n, err)
+		}
+		if !bytes.Equal(got[:n], data[rng.off:rng.off+rng.n]) {
+			t.Fatalf("ReadAt(%d, %d): data mismatch", rng.off, rng.n)
+		}
+	}
+
+	n, err := r.ReadAt(make([]byte, 10), int64(len(data))-4)
+	if n != 4 || !errors.Is(err, io.EOF) {
+		t.Fatalf("read past the end: got %d, %v, want 4, EOF", n, err)
+	}
+}
+
+func Test_SeekableZstd_RejectsDataWithoutSeekTable(t *testing.T) {
+	compressed := seekableCompress(t, []byte("disk"))
+	compressed = compressed[:len(compressed)-1]
+
+	if _, err := NewSeekableZstdReader(bytes.NewReader(compressed), int64(len(compressed))); err == nil {
+		t.Fatal("expected an error for truncated data")
+	}
+}
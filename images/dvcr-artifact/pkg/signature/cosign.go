@@ -0,0 +1,284 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"k8s.io/klog/v2"
+
+	importerrs "github.com/deckhouse/virtualization-controller/dvcr-importers/pkg/errors"
+)
+
+const (
+	// cosignSignatureAnnotation keeps the base64-encoded signature of the
+	// simple signing payload stored in the layer it annotates.
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// cosignSignatureTagSuffix is appended to the image digest to get the tag
+	// cosign pushes the signatures to, e.g. sha256-78be...b9b5.sig.
+	cosignSignatureTagSuffix = ".sig"
+)
+
+var (
+	ErrNoSignatures       = errors.New("the image is not signed")
+	ErrNoTrustedSignature = errors.New("no signature of the image is made with a trusted key")
+)
+
+// Verifier checks cosign signatures of container images against a set of
+// trusted public keys.
+type Verifier struct {
+	keys     []crypto.PublicKey
+	required bool
+}
+
+// NewVerifier parses the PEM-encoded public keys and X.509 certificates the
+// importer Pod receives in a single environment variable.
+func NewVerifier(trustedKeys string, required bool) (*Verifier, error) {
+	keys, err := ParseTrustedKeys([]byte(trustedKeys))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("no trusted keys to verify the image signature with")
+	}
+
+	return &Verifier{
+		keys:     keys,
+		required: required,
+	}, nil
+}
+
+// ParseTrustedKeys returns the public keys of all PEM blocks in data. Public
+// keys of certificates are trusted as is: the certificate only carries the
+// key, its chain is not verified.
+func ParseTrustedKeys(data []byte) ([]crypto.PublicKey, error) {
+	var keys []crypto.PublicKey
+
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err := x509.ParsePKIXPublicKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("parse public key: %w", err)
+			}
+			keys = append(keys, key)
+		case "CERTIFICATE":
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("parse certificate: %w", err)
+			}
+			keys = append(keys, cert.PublicKey)
+		default:
+			return nil, fmt.Errorf("unexpected PEM block %q, expected a public key or a certificate", block.Type)
+		}
+	}
+
+	if len(strings.TrimSpace(string(data))) != 0 {
+		return nil, errors.New("trailing data after the PEM blocks of the trusted keys")
+	}
+
+	return keys, nil
+}
+
+// Verify resolves the image reference to a digest and checks the signatures
+// cosign keeps for that digest. It returns the image reference pinned to the
+// verified digest: the import must read exactly the image that was verified,
+// even if the tag moves meanwhile.
+//
+// A verdict on the signatures is returned as SignatureVerificationError.
+// Other errors are registry failures the caller may retry.
+func (v *Verifier) Verify(ctx context.Context, image string, nameOpts []name.Option, remoteOpts ...remote.Option) (string, error) {
+	ref, err := name.ParseReference(image, nameOpts...)
+	if err != nil {
+		return "", fmt.Errorf("parse image reference %q: %w", image, err)
+	}
+
+	desc, err := remote.Head(ref, remoteOpts...)
+	if err != nil {
+		return "", fmt.Errorf("resolve image digest: %w", err)
+	}
+
+	pinned := ref.Context().Digest(desc.Digest.String())
+
+	signatures, err := fetchSignatures(ctx, pinned, remoteOpts...)
+	if err != nil {
+		return "", err
+	}
+
+	if len(signatures) == 0 {
+		if v.required {
+			return "", importerrs.NewSignatureVerificationError(image, ErrNoSignatures)
+		}
+
+		klog.Warningf("Image %s is not signed, the signature is not required: continue the import", pinned)
+		return pinned.String(), nil
+	}
+
+	for _, sig := range signatures {
+		err = v.verifySignature(sig, desc.Digest)
+		if err == nil {
+			klog.Infof("Image %s has a signature made with a trusted key", pinned)
+			return pinned.String(), nil
+		}
+
+		klog.Infof("Skip signature of the image %s: %s", pinned, err)
+	}
+
+	return "", importerrs.NewSignatureVerificationError(image, ErrNoTrustedSignature)
+}
+
+type cosignSignature struct {
+	payload   []byte
+	signature []byte
+}
+
+// simpleSigningPayload is the part of the cosign simple signing payload the
+// verification relies on.
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+func fetchSignatures(ctx context.Context, ref name.Digest, remoteOpts ...remote.Option) ([]cosignSignature, error) {
+	tag := ref.Context().Tag(strings.Replace(ref.DigestStr(), ":", "-", 1) + cosignSignatureTagSuffix)
+
+	img, err := remote.Image(tag, remoteOpts...)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get image signatures: %w", err)
+	}
+
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("get image signatures manifest: %w", err)
+	}
+
+	var signatures []cosignSignature
+
+	for _, layer := range manifest.Layers {
+		sig, ok := layer.Annotations[cosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+
+		rawSig, err := base64.StdEncoding.DecodeString(sig)
+		if err != nil {
+			klog.Infof("Skip signature layer %s: malformed signature: %s", layer.Digest, err)
+			continue
+		}
+
+		blob, err := img.LayerByDigest(layer.Digest)
+		if err != nil {
+			return nil, fmt.Errorf("get signature payload %s: %w", layer.Digest, err)
+		}
+
+		payload, err := readPayload(ctx, blob)
+		if err != nil {
+			return nil, fmt.Errorf("read signature payload %s: %w", layer.Digest, err)
+		}
+
+		signatures = append(signatures, cosignSignature{
+			payload:   payload,
+			signature: rawSig,
+		})
+	}
+
+	return signatures, nil
+}
+
+func readPayload(ctx context.Context, layer v1.Layer) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	rc, err := layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return io.ReadAll(rc)
+}
+
+func (v *Verifier) verifySignature(sig cosignSignature, digest v1.Hash) error {
+	var payload simpleSigningPayload
+	if err := json.Unmarshal(sig.payload, &payload); err != nil {
+		return fmt.Errorf("malformed payload: %w", err)
+	}
+
+	if payload.Critical.Image.DockerManifestDigest != digest.String() {
+		return fmt.Errorf("the payload is signed for %q", payload.Critical.Image.DockerManifestDigest)
+	}
+
+	for _, key := range v.keys {
+		if verifyWithKey(key, sig.payload, sig.signature) {
+			return nil
+		}
+	}
+
+	return errors.New("made with an untrusted key")
+}
+
+func verifyWithKey(key crypto.PublicKey, payload, signature []byte) bool {
+	sum := sha256.Sum256(payload)
+
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(k, sum[:], signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, sum[:], signature) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, payload, signature)
+	default:
+		return false
+	}
+}
+
+func isNotFound(err error) bool {
+	var terr *transport.Error
+	return errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound
+}
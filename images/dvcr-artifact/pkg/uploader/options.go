@@ -37,15 +37,17 @@ import (
 const (
 	// Environment variables. Destination-related names are kept unchanged for
 	// backward compatibility with existing deployments.
-	envListenAddress       = "LISTEN_ADDRESS"
-	envListenPort          = "LISTEN_PORT"
-	envHealthzPort         = "HEALTHZ_PORT"
-	envServerCertFile      = "TLS_CERT_FILE"
-	envServerKeyFile       = "TLS_KEY_FILE"
-	envClientCAFile        = "CLIENT_CA_FILE"
-	envClientName          = "CLIENT_NAME"
-	envDestinationCABundle = "UPLOADER_DESTINATION_CA_BUNDLE"
-	envChecksums           = "UPLOADER_CHECKSUMS"
+	envListenAddress          = "LISTEN_ADDRESS"
+	envListenPort             = "LISTEN_PORT"
+	envHealthzPort            = "HEALTHZ_PORT"
+	envServerCertFile         = "TLS_CERT_FILE"
+	envServerKeyFile          = "TLS_KEY_FILE"
+	envClientCAFile           = "CLIENT_CA_FILE"
+	envClientName             = "CLIENT_NAME"
+	envDestinationCABundle    = "UPLOADER_DESTINATION_CA_BUNDLE"
+	envChecksums              = "UPLOADER_CHECKSUMS"
+	envDestinationChunked     = "DESTINATION_CHUNKED"
+	envDestinationCompression = "DESTINATION_COMPRESSION"
 
 	defaultListenAddress = "0.0.0.0"
 	defaultListenPort    = 8444
@@ -71,12 +73,14 @@ type Options struct {
 	MinTLSVersion string
 
 	// Destination DVCR registry.
-	DestinationEndpoint   string
-	DestinationUsername   string
-	DestinationPassword   string
-	DestinationAuthConfig string
-	DestinationInsecure   bool
-	DestinationCABundle   string
+	DestinationEndpoint    string
+	DestinationUsername    string
+	DestinationPassword    string
+	DestinationAuthConfig  string
+	DestinationInsecure    bool
+	DestinationCABundle    string
+	DestinationChunked     bool
+	DestinationCompression string
 
 	// Checksums the uploaded data has to match, in the algorithm:sum format,
 	// comma separated. Empty means the upload is accepted as it arrives.
@@ -103,6 +107,8 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.DestinationAuthConfig, "destination-auth-config", envStr(common.UploaderDestinationAuthConfig, ""), "Path to a docker auth config used to resolve DVCR registry credentials")
 	fs.BoolVar(&o.DestinationInsecure, "destination-insecure-tls", envBool(common.DestinationInsecureTLSVar, false), "Skip TLS verification of the DVCR registry certificate")
 	fs.StringVar(&o.DestinationCABundle, "destination-ca-bundle", envStr(envDestinationCABundle, ""), "Path to a PEM file or a directory with PEM files used to verify the DVCR registry certificate")
+	fs.BoolVar(&o.DestinationChunked, "destination-chunked", envBool(envDestinationChunked, false), "Store the image as content-defined chunks deduplicated across images; such an image can only be imported into a PersistentVolumeClaim")
+	fs.StringVar(&o.DestinationCompression, "destination-compression", envStr(envDestinationCompression, ""), "Compression of the image layer stored in DVCR: None, Zstd or ZstdSeekable")
 
 	fs.StringVar(&o.Checksums, "checksums", envStr(envChecksums, ""), "Checksums the uploaded data has to match, in the algorithm:sum format, comma separated")
 }
@@ -201,11 +207,13 @@ func (o *Options) minTLSVersion() (uint16, error) {
 // auth config) and returns the destination description.
 func (o *Options) buildDestination() (Destination, error) {
 	dst := Destination{
-		Endpoint: o.DestinationEndpoint,
-		Username: o.DestinationUsername,
-		Password: o.DestinationPassword,
-		Insecure: o.DestinationInsecure,
-		CABundle: o.DestinationCABundle,
+		Endpoint:    o.DestinationEndpoint,
+		Username:    o.DestinationUsername,
+		Password:    o.DestinationPassword,
+		Insecure:    o.DestinationInsecure,
+		CABundle:    o.DestinationCABundle,
+		Chunked:     o.DestinationChunked,
+		Compression: o.DestinationCompression,
 	}
 
 	if dst.Username != "" || dst.Password != "" || o.DestinationAuthConfig == "" {
@@ -57,6 +57,10 @@ type Destination struct {
 	// CABundle is a path to a PEM file or a directory with PEM files used to
 	// verify the DVCR registry certificate.
 	CABundle string
+	// Chunked stores the image as content-defined chunks, see registry.DestinationRegistry.
+	Chunked bool
+	// Compression is the compression of the image layer, see registry.DestinationRegistry.
+	Compression string
 }
 
 // Server receives an uploaded image over HTTP(S) and pushes it to the DVCR
@@ -314,11 +318,13 @@ func (s *Server) upload(stream io.ReadCloser, sourceContentType string, dvConten
 	defer uds.Close()
 
 	processor, err := registry.NewDataProcessor(uds, registry.DestinationRegistry{
-		ImageName: s.destination.Endpoint,
-		Username:  s.destination.Username,
-		Password:  s.destination.Password,
-		Insecure:  s.destination.Insecure,
-		CABundle:  s.destination.CABundle,
+		ImageName:   s.destination.Endpoint,
+		Username:    s.destination.Username,
+		Password:    s.destination.Password,
+		Insecure:    s.destination.Insecure,
+		CABundle:    s.destination.CABundle,
+		Chunked:     s.destination.Chunked,
+		Compression: s.destination.Compression,
 	}, s.checksums)
 	if err != nil {
 		return err
@@ -337,7 +343,7 @@ func (s *Server) upload(stream io.ReadCloser, sourceContentType string, dvConten
 		return err
 	}
 
-	return monitoring.WriteImportCompleteMessage(res.SourceImageSize, res.VirtualSize, res.AvgSpeed, res.Format, durCollector.Collect())
+	return monitoring.WriteImportCompleteMessage(res.SourceImageSize, res.VirtualSize, res.AvgSpeed, res.Format, res.Inspection, durCollector.Collect())
 }
 
 func newContentReader(stream io.ReadCloser, contentType string) io.ReadCloser {
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/containers/image/v5/types"
+	"github.com/pkg/errors"
+	"k8s.io/klog/v2"
+
+	"github.com/deckhouse/virtualization/images/pvc-artifact/pkg/util"
+	prometheusutil "github.com/deckhouse/virtualization/images/pvc-artifact/pkg/util/prometheus"
+)
+
+// A chunked DVCR image stores the disk image as content-defined chunks, one raw layer per
+// chunk, so that images sharing data share the chunk blobs. The disk image is the
+// concatenation of the layers in the manifest order. See dvcr-artifact/pkg/registry.
+const (
+	chunkedImageLabel         = "content-defined-chunks"
+	chunkedImageFilenameLabel = "source-image-filename"
+	chunkMediaType            = "application/vnd.deckhouse.virtualization.disk.chunk.v1"
+
+	defaultChunkedImageFilename = "disk.img"
+)
+
+func isChunkedImage(info *types.ImageInspectInfo) bool {
+	return info != nil && info.Labels[chunkedImageLabel] == "true"
+}
+
+// chunkedImageFilename returns the name of the disk image file stored in the chunked image.
+func chunkedImageFilename(info *types.ImageInspectInfo) string {
+	name := filepath.Base(info.Labels[chunkedImageFilenameLabel])
+	if name == "." || name == "/" || name == "" {
+		return defaultChunkedImageFilename
+	}
+	return name
+}
+
+// copyChunkedImage reassembles the disk image from the chunk layers into destFile. With checkFormat set,
+// the disk image header must match the target format, as for the direct transfer of a regular image.
+func copyChunkedImage(ctx context.Context, src types.ImageSource, layers []types.BlobInfo, cache types.BlobInfoCache, destFile string, checkFormat bool) error {
+	klog.Infof("Reassembling the disk image from %d chunks into '%v'", len(layers), destFile)
+
+	var totalSize int64
+	for _, layer := range layers {
+		if layer.MediaType != chunkMediaType {
+			return errors.Errorf("unexpected layer media type %q in a chunked image", layer.MediaType)
+		}
+		totalSize += layer.Size
+	}
+
+	chunks := &chunkReader{ctx: ctx, src: src, layers: layers, cache: cache}
+	defer func() { _ = chunks.Close() }()
+
+	var reader io.Reader = chunks
+	if totalSize > 0 {
+		progressReader := prometheusutil.NewProgressReader(chunks, transferProgressMetric(), uint64(totalSize))
+		progressReader.StartTimedUpdate()
+		reader = progressReader
+	}
+
+	if checkFormat {
+		targetFormat, err := util.GetFormat(destFile)
+		if err != nil {
+			return errors.Wrap(err, "Could not determine target format")
+		}
+		diskReaders, err := NewFormatReaders(io.NopCloser(reader), 0)
+		if err != nil {
+			return errors.Wrap(err, "Could not read disk image header")
+		}
+		if diskReaders.ImageFormat != targetFormat {
+			return errors.Errorf("disk image format %q does not match target format %q; refusing direct transfer", diskReaders.ImageFormat, targetFormat)
+		}
+		reader = diskReaders.TopReader()
+	} else if err := os.MkdirAll(filepath.Dir(destFile), os.ModePerm); err != nil {
+		return errors.Wrap(err, "Error creating output file's directory")
+	}
+
+	if err := streamDataToFile(reader, destFile); err != nil {
+		klog.Errorf("Error copying file: %v", err)
+		return errors.Wrap(err, "Error copying file")
+	}
+
+	return nil
+}
+
+// chunkReader reads the chunk blobs one after another, verifying the digest of each.
+type chunkReader struct {
+	ctx    context.Context
+	src    types.ImageSource
+	layers []types.BlobInfo
+	cache  types.BlobInfoCache
+
+	current  io.ReadCloser
+	verifier interface{ Verified() bool }
+	layer    types.BlobInfo
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for {
+		if r.current == nil {
+			if len(r.layers) == 0 {
+				return 0, io.EOF
+			}
+			if err := r.next(); err != nil {
+				return 0, err
+			}
+		}
+
+		n, err := r.current.Read(p)
+		if errors.Is(err, io.EOF) {
+			_ = r.current.Close()
+			r.current = nil
+			if !r.verifier.Verified() {
+				return n, errors.Errorf("chunk %s digest mismatch", r.layer.Digest)
+			}
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+
+		return n, err
+	}
+}
+
+func (r *chunkReader) next() error {
+	r.layer, r.layers = r.layers[0], r.layers[1:]
+
+	blob, _, err := r.src.GetBlob(r.ctx, r.layer, r.cache)
+	if err != nil {
+		klog.Errorf("Could not read chunk: %v", err)
+		return errors.Wrapf(err, "Could not read chunk %s", r.layer.Digest)
+	}
+
+	verifier := r.layer.Digest.Verifier()
+	r.verifier = verifier
+	r.current = struct {
+		io.Reader
+		io.Closer
+	}{io.TeeReader(blob, verifier), blob}
+
+	return nil
+}
+
+func (r *chunkReader) Close() error {
+	if r.current == nil {
+		return nil
+	}
+	return r.current.Close()
+}
@@ -193,6 +193,9 @@ func processLayer(ctx context.Context,
 		progressReader.StartTimedUpdate()
 		reader = progressReader
 	}
+	// Compressed layers (gzip, zstd and seekable zstd, whose seek table is a
+	// skippable frame ignored by the decoder) are detected by their magic and
+	// decompressed by the format readers.
 	fr, err := NewFormatReaders(reader, 0)
 	if err != nil {
 		return false, errors.Wrap(err, "Could not read layer")
@@ -273,6 +276,19 @@ func copyRegistryImage(url, destDir, pathPrefix, accessKey, secKey, certDir stri
 	defer func() { _ = imgCloser.Close() }()
 
 	cache := blobinfocache.DefaultCache(srcCtx)
+
+	info, err := imgCloser.Inspect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if isChunkedImage(info) {
+		destFile := filepath.Join(destDir, pathPrefix, chunkedImageFilename(info))
+		if err := copyChunkedImage(ctx, src, imgCloser.LayerInfos(), cache, destFile, false); err != nil {
+			return nil, err
+		}
+		return info, nil
+	}
+
 	found := false
 	layers := imgCloser.LayerInfos()
 
@@ -295,11 +311,6 @@ func copyRegistryImage(url, destDir, pathPrefix, accessKey, secKey, certDir stri
 		return nil, errors.New("Failed to find VM disk image file in the container image")
 	}
 
-	info, err := imgCloser.Inspect(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	return info, nil
 }
 
@@ -342,6 +353,18 @@ func CopyRegistryImageToFile(url, destFile, pathPrefix, accessKey, secKey, certD
 	defer func() { _ = imgCloser.Close() }()
 
 	cache := blobinfocache.DefaultCache(srcCtx)
+
+	info, err := imgCloser.Inspect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if isChunkedImage(info) {
+		if err := copyChunkedImage(ctx, src, imgCloser.LayerInfos(), cache, destFile, true); err != nil {
+			return nil, err
+		}
+		return info, nil
+	}
+
 	found := false
 	for _, layer := range imgCloser.LayerInfos() {
 		klog.Infof("Processing layer %+v", layer)
@@ -360,11 +383,6 @@ func CopyRegistryImageToFile(url, destFile, pathPrefix, accessKey, secKey, certD
 		return nil, errors.New("Failed to find VM disk image file in the container image")
 	}
 
-	info, err := imgCloser.Inspect(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	return info, nil
 }
 
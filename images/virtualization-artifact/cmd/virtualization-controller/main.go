@@ -43,10 +43,13 @@ import (
 	storagev1alpha1 "github.com/deckhouse/virtualization-controller/pkg/apis/storage/v1alpha1"
 	appconfig "github.com/deckhouse/virtualization-controller/pkg/config"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/cvi"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/cvicatalog"
 	dvcrgarbagecollection "github.com/deckhouse/virtualization-controller/pkg/controller/dvcr-garbage-collection"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/evacuation"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/indexer"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/ippool"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/livemigration"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/macpool"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/migrationiface"
 	mc "github.com/deckhouse/virtualization-controller/pkg/controller/moduleconfig"
 	mcapi "github.com/deckhouse/virtualization-controller/pkg/controller/moduleconfig/api"
@@ -56,6 +59,7 @@ import (
 	"github.com/deckhouse/virtualization-controller/pkg/controller/storageprofile"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/usbdevice"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/vd"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/vdexport"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/vdsnapshot"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/vi"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/vm"
@@ -67,6 +71,7 @@ import (
 	"github.com/deckhouse/virtualization-controller/pkg/controller/vmmaclease"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/vmop"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/vmpool"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/vmsg"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/vmsnapshot"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/vmsop"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/volumemigration"
@@ -385,6 +390,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	cvicatalogLogger := logger.NewControllerLogger(cvicatalog.ControllerName, logLevel, logOutput, logDebugVerbosity, logDebugControllerList)
+	if err = cvicatalog.SetupController(ctx, mgr, cvicatalogLogger); err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+
 	vdLogger := logger.NewControllerLogger(vd.ControllerName, logLevel, logOutput, logDebugVerbosity, logDebugControllerList)
 	if _, err = vd.NewController(ctx, mgr, vdLogger, importSettings.ImporterImage, importSettings.DiskImporterImage, importSettings.UploaderImage, importSettings.Requirements, dvcrSettings, vdStorageClassSettings); err != nil {
 		log.Error(err.Error())
@@ -503,6 +514,16 @@ func main() {
 		os.Exit(1)
 	}
 
+	vdexportLogger := logger.NewControllerLogger(vdexport.ControllerName, logLevel, logOutput, logDebugVerbosity, logDebugControllerList)
+	if err = vdexport.SetupController(ctx, mgr, vdexportLogger, importSettings.ExporterImage, importSettings.Requirements, dvcrSettings); err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+	if err = vdexport.SetupGC(mgr, vdexportLogger, gcSettings.VDExportSchedule); err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+
 	liveMigrationLogger := logger.NewControllerLogger(livemigration.ControllerName, logLevel, logOutput, logDebugVerbosity, logDebugControllerList)
 	if err = livemigration.SetupController(ctx, mgr, liveMigrationLogger); err != nil {
 		log.Error(err.Error())
@@ -538,6 +559,12 @@ func main() {
 		os.Exit(1)
 	}
 
+	vmsgLogger := logger.NewControllerLogger(vmsg.ControllerName, logLevel, logOutput, logDebugVerbosity, logDebugControllerList)
+	if err = vmsg.SetupController(ctx, mgr, vmsgLogger); err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+
 	vmmacLogger := logger.NewControllerLogger(vmmac.ControllerName, logLevel, logOutput, logDebugVerbosity, logDebugControllerList)
 	if _, err = vmmac.NewController(ctx, mgr, vmmacLogger, clusterUUID, virtClient); err != nil {
 		log.Error(err.Error())
@@ -550,6 +577,18 @@ func main() {
 		os.Exit(1)
 	}
 
+	macpoolLogger := logger.NewControllerLogger(macpool.ControllerName, logLevel, logOutput, logDebugVerbosity, logDebugControllerList)
+	if err = macpool.SetupController(ctx, mgr, macpoolLogger); err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+
+	ippoolLogger := logger.NewControllerLogger(ippool.ControllerName, logLevel, logOutput, logDebugVerbosity, logDebugControllerList)
+	if err = ippool.SetupController(ctx, mgr, ippoolLogger); err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+
 	dvcrGarbageCollectionLogger := logger.NewControllerLogger(dvcrgarbagecollection.ControllerName, logLevel, logOutput, logDebugVerbosity, logDebugControllerList)
 	if _, err = dvcrgarbagecollection.NewController(ctx, mgr, dvcrGarbageCollectionLogger, dvcrSettings); err != nil {
 		log.Error(err.Error())
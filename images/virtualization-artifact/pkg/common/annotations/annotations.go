@@ -25,9 +25,10 @@ import (
 )
 
 const (
-	CVIShortName = "cvi"
-	VDShortName  = "vd"
-	VIShortName  = "vi"
+	CVIShortName      = "cvi"
+	VDShortName       = "vd"
+	VIShortName       = "vi"
+	VDExportShortName = "vdexport"
 
 	// AnnIntegrityGroup is the Integrity for virtualization-contrller.
 	AnnIntegrityGroup = "integrity.virtualization.deckhouse.io/"
@@ -148,6 +149,13 @@ const (
 	// LabelVirtualMachineMACAddressUID is a label to link VirtualMachineMACAddressLease to VirtualMachineMACAddress.
 	LabelVirtualMachineMACAddressUID = LabelsPrefix + "/virtual-machine-mac-address-uid"
 
+	// LabelImageCatalog is a label on a ClusterVirtualImage with the name of the ClusterVirtualImageCatalog it was created by.
+	LabelImageCatalog = LabelsPrefix + "/image-catalog"
+
+	// LabelDVCRCacheFill is a label on the importer Pod that copies a container image to the DVCR pull-through cache.
+	// The value identifies the source image, so other imports of the same image wait for the copy instead of pulling it too.
+	LabelDVCRCacheFill = LabelsPrefix + "/dvcr-cache-fill"
+
 	UploaderServiceLabel = "service"
 
 	// PVCImportRoleLabel distinguishes source/target importer pods in a host-assigned PVC clone.
@@ -239,12 +247,29 @@ const (
 	// AnnTapProvisionByDVPSupported is the annotation that indicates DVP supports TAP provision for the Pod.
 	AnnTapProvisionByDVPSupported = "network.deckhouse.io/tap-provision-by-dvp-supported"
 
+	// AnnIngressBandwidth is the annotation with the rate limit of the traffic received by the Pod, in bits per second.
+	// It is enforced by the CNI plugin of the cluster.
+	AnnIngressBandwidth = "kubernetes.io/ingress-bandwidth"
+	// AnnEgressBandwidth is the annotation with the rate limit of the traffic sent by the Pod, in bits per second.
+	// It is enforced by the CNI plugin of the cluster.
+	AnnEgressBandwidth = "kubernetes.io/egress-bandwidth"
+
 	// AnnMigrationIface names the kernel interface that virt-handler binds
 	// live-migration traffic to. Written on Nodes by the migrationiface
 	// controller from a SystemNetwork CR (sdn module); read by virt-handler
 	// on startup.
 	AnnMigrationIface = AnnAPIGroupV + "/migration-iface"
 
+	// AnnMACAddressNetwork is the annotation on a VirtualMachineMACAddress created for an interface of a virtual machine
+	// with the network of the interface, in the <type>/<name> format. It selects the MACAddressPool bound to the network.
+	AnnMACAddressNetwork = AnnAPIGroupV + "/network"
+
+	// AnnImageCatalogChannel is the annotation on a VirtualDisk created from a channel of a ClusterVirtualImageCatalog, in the <catalog>/<channel> format.
+	AnnImageCatalogChannel = AnnAPIGroupV + "/image-catalog-channel"
+	// AnnImageCatalogVersion is the annotation on a VirtualDisk with the catalog version the channel pointed to when the disk was created,
+	// and on a ClusterVirtualImage with the catalog version it was created for.
+	AnnImageCatalogVersion = AnnAPIGroupV + "/image-catalog-version"
+
 	// AnnVirtualDiskOriginalAnnotations is the annotation for storing original VirtualDisk annotations.
 	AnnVirtualDiskOriginalAnnotations = AnnAPIGroupV + "/vd-original-annotations"
 	// AnnVirtualDiskOriginalLabels is the annotation for storing original VirtualDisk labels.
@@ -268,6 +293,9 @@ const (
 
 	// AnnDVCRGarbageCollectionResult is an annotation on deployment dvcr with last garbage collection result JSON.
 	AnnDVCRGarbageCollectionResult = AnnAPIGroupV + "/dvcr-garbage-collection-result"
+	// AnnDVCRSoftQuota is an annotation on a namespace with a soft limit of the total size of VirtualImages stored in DVCR (e.g. "100Gi").
+	// It is checked only before an import starts, so the usage may end up above it.
+	AnnDVCRSoftQuota = AnnAPIGroupV + "/dvcr-soft-quota"
 
 	// AnnUSBClaimSpecHash provides a const for annotation with hash of the rendered USB ResourceClaimTemplate spec.
 	AnnUSBClaimSpecHash = AnnAPIGroup + "/usb-claim-spec-hash"
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudinit
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// networkConfigVersion is the version of the cloud-init network configuration
+// generated for virtual machines. Only configurations of this version are merged.
+//
+// See https://cloudinit.readthedocs.io/en/latest/reference/network-config-format-v2.html
+const networkConfigVersion = 2
+
+// ValidateNetworkConfig reports what looks wrong with a cloud-init network
+// configuration. Every finding is a warning; an empty result means nothing was found.
+func ValidateNetworkConfig(data []byte) []string {
+	if strings.TrimSpace(string(data)) == "" {
+		return []string{"network config is empty"}
+	}
+
+	config, err := parseNetworkConfig(string(data))
+	if err != nil {
+		return []string{fmt.Sprintf(
+			"network config is not a YAML mapping, so cloud-init will refuse it: %s",
+			firstLine(err.Error()),
+		)}
+	}
+
+	switch version := config["version"]; version {
+	case nil:
+		return []string{"network config has no version, so cloud-init will refuse it"}
+	case float64(1):
+		return []string{"network config has version 1, so it cannot be merged and replaces the generated network config"}
+	case float64(networkConfigVersion):
+	default:
+		return []string{fmt.Sprintf("network config has version %v, while cloud-init supports versions 1 and 2", version)}
+	}
+
+	if ethernets, ok := config["ethernets"]; ok {
+		if _, ok := ethernets.(map[string]any); !ok {
+			return []string{"network config ethernets is not a mapping, so cloud-init will refuse it"}
+		}
+	}
+
+	return nil
+}
+
+// MergeNetworkConfig merges the network configuration specified by the user into
+// the generated one. An interface the user describes in ethernets under the same
+// identifier or with the same match.macaddress replaces the generated description
+// of the interface; other sections of the user configuration, such as bonds or
+// vlans, are added as is. A user configuration of another version is returned
+// unchanged, as it cannot be merged.
+func MergeNetworkConfig(generated, user string) (string, error) {
+	if strings.TrimSpace(user) == "" {
+		return generated, nil
+	}
+	if generated == "" {
+		return user, nil
+	}
+
+	userConfig, userEthernets, err := parseUserNetworkConfig(user)
+	if err != nil {
+		return "", err
+	}
+	if userConfig == nil {
+		return user, nil
+	}
+
+	generatedConfig, err := parseNetworkConfig(generated)
+	if err != nil {
+		return "", fmt.Errorf("parse generated network config: %w", err)
+	}
+	generatedEthernets, _ := generatedConfig["ethernets"].(map[string]any)
+
+	var userMACs []string
+	for _, ethernet := range userEthernets {
+		if mac := matchMACAddress(ethernet); mac != "" {
+			userMACs = append(userMACs, mac)
+		}
+	}
+
+	ethernets := make(map[string]any, len(generatedEthernets)+len(userEthernets))
+	for id, ethernet := range generatedEthernets {
+		if _, found := userEthernets[id]; found {
+			continue
+		}
+		if slices.Contains(userMACs, matchMACAddress(ethernet)) {
+			continue
+		}
+		ethernets[id] = ethernet
+	}
+	for id, ethernet := range userEthernets {
+		ethernets[id] = ethernet
+	}
+
+	merged := make(map[string]any, len(userConfig)+1)
+	for section, value := range userConfig {
+		merged[section] = value
+	}
+	merged["ethernets"] = ethernets
+
+	data, err := yaml.Marshal(merged)
+	if err != nil {
+		return "", fmt.Errorf("marshal merged network config: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// CheckNetworkConfigMerge returns the error MergeNetworkConfig fails with on
+// the network configuration specified by the user, if any.
+func CheckNetworkConfigMerge(user string) error {
+	if strings.TrimSpace(user) == "" {
+		return nil
+	}
+	_, _, err := parseUserNetworkConfig(user)
+	return err
+}
+
+// parseUserNetworkConfig returns the network configuration specified by the user
+// and its ethernets. The configuration is nil if it is of another version and
+// cannot be merged.
+func parseUserNetworkConfig(user string) (map[string]any, map[string]any, error) {
+	userConfig, err := parseNetworkConfig(user)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parse user network config: %w", err)
+	}
+	if userConfig["version"] != float64(networkConfigVersion) {
+		return nil, nil, nil
+	}
+	userEthernets, ok := userConfig["ethernets"].(map[string]any)
+	if !ok && userConfig["ethernets"] != nil {
+		return nil, nil, fmt.Errorf("user network config ethernets is not a mapping")
+	}
+	return userConfig, userEthernets, nil
+}
+
+// parseNetworkConfig returns the network configuration as a mapping. cloud-init
+// accepts the configuration both on its own and nested under the network key.
+func parseNetworkConfig(data string) (map[string]any, error) {
+	var config map[string]any
+	if err := yaml.Unmarshal([]byte(data), &config); err != nil {
+		return nil, err
+	}
+	if config == nil {
+		return nil, fmt.Errorf("network config is not a mapping")
+	}
+	if network, ok := config["network"].(map[string]any); ok && len(config) == 1 {
+		return network, nil
+	}
+	return config, nil
+}
+
+// matchMACAddress returns the lowercased match.macaddress of an ethernets entry, if any.
+func matchMACAddress(ethernet any) string {
+	entry, ok := ethernet.(map[string]any)
+	if !ok {
+		return ""
+	}
+	match, ok := entry["match"].(map[string]any)
+	if !ok {
+		return ""
+	}
+	mac, _ := match["macaddress"].(string)
+	return strings.ToLower(mac)
+}
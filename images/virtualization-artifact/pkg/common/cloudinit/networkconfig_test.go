@@ -0,0 +1,141 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudinit
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ValidateNetworkConfig", func() {
+	DescribeTable("says nothing about configurations cloud-init understands",
+		func(networkConfig string) {
+			Expect(ValidateNetworkConfig([]byte(networkConfig))).To(BeEmpty())
+		},
+		Entry("a version 2 configuration", "version: 2\nethernets:\n  eth0:\n    dhcp4: true\n"),
+		Entry("a configuration nested under the network key", "network:\n  version: 2\n  vlans: {}\n"),
+	)
+
+	DescribeTable("warns about configurations that look wrong",
+		func(networkConfig, warning string) {
+			Expect(ValidateNetworkConfig([]byte(networkConfig))).To(ConsistOf(ContainSubstring(warning)))
+		},
+		Entry("an empty configuration", " \n", "network config is empty"),
+		Entry("not a mapping", "- eth0\n", "is not a YAML mapping"),
+		Entry("no version", "ethernets: {}\n", "has no version"),
+		Entry("version 1", "version: 1\nconfig: []\n", "cannot be merged"),
+		Entry("an unknown version", "version: 3\n", "has version 3"),
+		Entry("ethernets that is not a mapping", "version: 2\nethernets: [eth0]\n", "ethernets is not a mapping"),
+	)
+})
+
+var _ = Describe("MergeNetworkConfig", func() {
+	const generated = `
+version: 2
+ethernets:
+  net1:
+    match:
+      macaddress: "02:aa:bb:cc:dd:ee"
+    dhcp4: true
+  net2:
+    match:
+      macaddress: "02:00:00:00:00:01"
+    dhcp4: true
+  net3:
+    match:
+      macaddress: "02:00:00:00:00:02"
+    dhcp4: true
+`
+
+	It("returns the generated configuration if the user specified none", func() {
+		Expect(MergeNetworkConfig(generated, "")).To(Equal(generated))
+	})
+
+	It("returns the user configuration if nothing is generated", func() {
+		Expect(MergeNetworkConfig("", "version: 2\n")).To(Equal("version: 2\n"))
+	})
+
+	It("lets the user override the interfaces and add other sections", func() {
+		merged, err := MergeNetworkConfig(generated, `
+network:
+  version: 2
+  ethernets:
+    net2:
+      match:
+        macaddress: "02:00:00:00:00:01"
+      addresses: ["10.0.0.5/24"]
+    uplink:
+      match:
+        macaddress: "02:00:00:00:00:02"
+      dhcp4: false
+  vlans:
+    vlan10:
+      id: 10
+      link: uplink
+`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(merged).To(MatchYAML(`
+version: 2
+ethernets:
+  net1:
+    match:
+      macaddress: "02:aa:bb:cc:dd:ee"
+    dhcp4: true
+  net2:
+    match:
+      macaddress: "02:00:00:00:00:01"
+    addresses: ["10.0.0.5/24"]
+  uplink:
+    match:
+      macaddress: "02:00:00:00:00:02"
+    dhcp4: false
+vlans:
+  vlan10:
+    id: 10
+    link: uplink
+`))
+	})
+
+	It("returns a version 1 user configuration as is", func() {
+		user := "version: 1\nconfig:\n  - type: physical\n    name: eth0\n"
+		Expect(MergeNetworkConfig(generated, user)).To(Equal(user))
+	})
+
+	It("fails on a user configuration that is not a mapping", func() {
+		_, err := MergeNetworkConfig(generated, "- eth0\n")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("CheckNetworkConfigMerge", func() {
+	DescribeTable("accepts configurations that can be merged or replace the generated one",
+		func(networkConfig string) {
+			Expect(CheckNetworkConfigMerge(networkConfig)).To(Succeed())
+		},
+		Entry("an empty configuration", ""),
+		Entry("a version 2 configuration", "version: 2\nethernets:\n  eth0:\n    dhcp4: true\n"),
+		Entry("a version 1 configuration", "version: 1\nconfig: []\n"),
+	)
+
+	DescribeTable("fails on configurations MergeNetworkConfig fails on",
+		func(networkConfig string) {
+			Expect(CheckNetworkConfigMerge(networkConfig)).NotTo(Succeed())
+		},
+		Entry("not a mapping", "- eth0\n"),
+		Entry("ethernets is not a mapping", "version: 2\nethernets: []\n"),
+	)
+})
@@ -42,6 +42,8 @@ const (
 	UploaderPodImageNameVar = "UPLOADER_IMAGE"
 	// BounderPodImageNameVar is a name of variable with the image name for the bounder Pod
 	BounderPodImageNameVar = "BOUNDER_IMAGE"
+	// ExporterPodImageNameVar is a name of variable with the image name for the exporter Pod
+	ExporterPodImageNameVar = "EXPORTER_IMAGE"
 	// ImporterCertDir is where the configmap containing certs will be mounted
 	ImporterCertDir = "/certs"
 	// ImporterProxyCertDir is where the configmap containing proxy certs will be mounted
@@ -102,9 +104,30 @@ const (
 	ImporterDestinationAuthConfigFile = "/dvcr-auth/.dockerconfigjson"
 	// DestinationInsecureTLSVar is an environment variable for Importer Pod that defines whether DVCR is insecure.
 	DestinationInsecureTLSVar = "DESTINATION_INSECURE_TLS"
+	// DestinationChunkedVar is an environment variable for Importer and Uploader Pods that defines whether
+	// the image is stored in DVCR as content-defined chunks shared with other images.
+	DestinationChunkedVar = "DESTINATION_CHUNKED"
+	// DestinationCompressionVar is an environment variable for Importer and Uploader Pods with
+	// the compression algorithm of the image layer stored in DVCR.
+	DestinationCompressionVar = "DESTINATION_COMPRESSION"
 	// ImporterChecksums is an environment variable with the checksums to verify
 	// the downloaded image against, in the algorithm:sum format, comma separated.
-	ImporterChecksums           = "IMPORTER_CHECKSUMS"
+	ImporterChecksums = "IMPORTER_CHECKSUMS"
+	// ImporterCacheImage is an environment variable with the DVCR pull-through cache image
+	// of the source container image, referenced by digest.
+	ImporterCacheImage = "IMPORTER_CACHE_IMAGE"
+	// ImporterCacheFill is an environment variable that defines whether the importer
+	// copies the source container image to the pull-through cache before importing it.
+	ImporterCacheFill = "IMPORTER_CACHE_FILL"
+	// ImporterTrustedKeys is an environment variable with the PEM-encoded keys
+	// trusted to sign the container image.
+	ImporterTrustedKeys = "IMPORTER_TRUSTED_KEYS"
+	// ImporterCustomization is an environment variable with the JSON-encoded
+	// offline customization to perform on the image before storing it.
+	ImporterCustomization = "IMPORTER_CUSTOMIZATION"
+	// ImporterSignatureRequired is an environment variable that defines whether
+	// an unsigned container image is rejected.
+	ImporterSignatureRequired   = "IMPORTER_SIGNATURE_REQUIRED"
 	ImporterAuthConfigVar       = "IMPORTER_AUTH_CONFIG"
 	ImporterAuthConfigDir       = "/dvcr-src-auth"
 	ImporterAuthConfigFile      = "/dvcr-src-auth/.dockerconfigjson"
@@ -17,15 +17,27 @@ limitations under the License.
 package ip
 
 import (
+	"encoding/hex"
 	"net"
+	"net/netip"
 	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 )
 
 const ipPrefix = "ip-"
 
+// ipv6Groups is the number of the 16-bit groups of an IPv6 address.
+const ipv6Groups = 8
+
 type AllocatedIPs map[string]struct{}
 
 // IPToLeaseName generate the Virtual Machine IP Address Lease's name from the ip address.
+// An IPv4 address keeps its dotted decimal octets, e.g. ip-10-0-0-1, while an IPv6 address is
+// written in full as eight groups of four hex digits, e.g. ip-fd00-0000-0000-0000-0000-0000-0000-0001,
+// so the names of the families never clash.
 func IPToLeaseName(ip string) string {
 	addr := net.ParseIP(ip)
 	if addr.To4() != nil {
@@ -33,14 +45,69 @@ func IPToLeaseName(ip string) string {
 		return ipPrefix + strings.ReplaceAll(addr.String(), ".", "-")
 	}
 
+	if addr.To16() != nil {
+		// IPv6 address
+		groups := make([]string, 0, ipv6Groups)
+		for i := 0; i < net.IPv6len; i += 2 {
+			groups = append(groups, hex.EncodeToString(addr[i:i+2]))
+		}
+		return ipPrefix + strings.Join(groups, "-")
+	}
+
 	return ""
 }
 
 // LeaseNameToIP generate the ip address from the Virtual Machine IP Address Lease's name.
 func LeaseNameToIP(leaseName string) string {
-	if strings.HasPrefix(leaseName, ipPrefix) && len(leaseName) > len(ipPrefix) {
-		return strings.ReplaceAll(leaseName[len(ipPrefix):], "-", ".")
+	if !strings.HasPrefix(leaseName, ipPrefix) || len(leaseName) <= len(ipPrefix) {
+		return ""
 	}
 
-	return ""
+	parts := strings.Split(leaseName[len(ipPrefix):], "-")
+	if len(parts) == ipv6Groups {
+		addr, err := netip.ParseAddr(strings.Join(parts, ":"))
+		if err != nil {
+			return ""
+		}
+		return addr.String()
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// Family returns the IP family of the address or an empty family if the address is invalid.
+func Family(ip string) corev1.IPFamily {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return ""
+	}
+
+	if addr.Unmap().Is4() {
+		return corev1.IPv4Protocol
+	}
+
+	return corev1.IPv6Protocol
+}
+
+// Addresses returns the assigned addresses of the VirtualMachineIPAddress, the primary address comes first.
+func Addresses(vmip *v1alpha2.VirtualMachineIPAddress) []string {
+	if len(vmip.Status.Addresses) > 0 {
+		return vmip.Status.Addresses
+	}
+
+	// The VirtualMachineIPAddress was bound before the dual-stack support and has only the primary address.
+	if vmip.Status.Address != "" {
+		return []string{vmip.Status.Address}
+	}
+
+	return nil
+}
+
+// PrefixFamily returns the IP family of the subnet.
+func PrefixFamily(prefix netip.Prefix) corev1.IPFamily {
+	if prefix.Addr().Is4() {
+		return corev1.IPv4Protocol
+	}
+
+	return corev1.IPv6Protocol
 }
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ip
+
+import (
+	"net/netip"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestIPUtilities(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "IP Utilities Suite")
+}
+
+var _ = Describe("IP Utilities", func() {
+	DescribeTable("IPToLeaseName and LeaseNameToIP",
+		func(address, leaseName, canonical string) {
+			Expect(IPToLeaseName(address)).To(Equal(leaseName))
+			Expect(LeaseNameToIP(leaseName)).To(Equal(canonical))
+		},
+		Entry("IPv4", "10.0.0.1", "ip-10-0-0-1", "10.0.0.1"),
+		Entry("IPv6", "fd00::1", "ip-fd00-0000-0000-0000-0000-0000-0000-0001", "fd00::1"),
+		Entry("IPv6 in full form", "2001:0DB8:0000:0000:0000:ff00:0042:8329", "ip-2001-0db8-0000-0000-0000-ff00-0042-8329", "2001:db8::ff00:42:8329"),
+	)
+
+	It("should return an empty lease name for an invalid address", func() {
+		Expect(IPToLeaseName("not-an-ip")).To(BeEmpty())
+	})
+
+	It("should return an empty address for an invalid lease name", func() {
+		Expect(LeaseNameToIP("lease-10-0-0-1")).To(BeEmpty())
+		Expect(LeaseNameToIP("ip-zzzz-0000-0000-0000-0000-0000-0000-0001")).To(BeEmpty())
+	})
+
+	It("should detect the IP family", func() {
+		Expect(Family("10.0.0.1")).To(Equal(corev1.IPv4Protocol))
+		Expect(Family("fd00::1")).To(Equal(corev1.IPv6Protocol))
+		Expect(Family("invalid")).To(BeEmpty())
+		Expect(PrefixFamily(netip.MustParsePrefix("10.0.0.0/24"))).To(Equal(corev1.IPv4Protocol))
+		Expect(PrefixFamily(netip.MustParsePrefix("fd00::/64"))).To(Equal(corev1.IPv6Protocol))
+	})
+})
@@ -0,0 +1,360 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ip
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/netip"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+// ErrPoolExhausted is returned when all addresses of the pool are assigned.
+var ErrPoolExhausted = errors.New("no remaining IP addresses in the pool")
+
+// maxHostBits is the largest number of the host bits of a subnet the size of which is counted exactly:
+// the larger subnets are considered to have math.MaxInt64 addresses.
+const maxHostBits = 62
+
+// Subnet is a parsed subnet of an IPAddressPool.
+type Subnet struct {
+	Prefix netip.Prefix
+	// Gateway is the zero Addr if the subnet has no gateway.
+	Gateway  netip.Addr
+	excluded []addrRange
+}
+
+type addrRange struct {
+	first netip.Addr
+	last  netip.Addr
+}
+
+// Pool is the parsed address space of an IPAddressPool.
+type Pool struct {
+	subnets []Subnet
+	// reserved maps the reserved addresses to the MAC addresses of the interfaces they are reserved for.
+	reserved map[netip.Addr]string
+}
+
+// NewPool parses and validates the subnets, the reservations and the DNS settings of the pool.
+func NewPool(spec v1alpha2.IPAddressPoolSpec) (Pool, error) {
+	pool := Pool{
+		subnets:  make([]Subnet, 0, len(spec.Subnets)),
+		reserved: make(map[netip.Addr]string, len(spec.Reservations)),
+	}
+
+	for i, s := range spec.Subnets {
+		subnet, err := parseSubnet(s)
+		if err != nil {
+			return Pool{}, fmt.Errorf("subnet %d: %w", i, err)
+		}
+		if slices.ContainsFunc(pool.subnets, func(other Subnet) bool {
+			return PrefixFamily(other.Prefix) == PrefixFamily(subnet.Prefix)
+		}) {
+			return Pool{}, fmt.Errorf("subnet %d: more than one subnet of the %s family", i, PrefixFamily(subnet.Prefix))
+		}
+		pool.subnets = append(pool.subnets, subnet)
+	}
+
+	reservedFamilies := make(map[string]struct{}, len(spec.Reservations))
+	for i, r := range spec.Reservations {
+		hw, err := net.ParseMAC(r.MACAddress)
+		if err != nil || len(hw) != 6 {
+			return Pool{}, fmt.Errorf("reservation %d: invalid mac address %q", i, r.MACAddress)
+		}
+		addr, err := netip.ParseAddr(r.Address)
+		if err != nil {
+			return Pool{}, fmt.Errorf("reservation %d: invalid address %q", i, r.Address)
+		}
+		addr = addr.Unmap()
+		if !pool.isAssignable(addr) {
+			return Pool{}, fmt.Errorf("reservation %d: address %q does not belong to the subnets or is excluded", i, r.Address)
+		}
+		if _, ok := pool.reserved[addr]; ok {
+			return Pool{}, fmt.Errorf("reservation %d: address %q is reserved more than once", i, r.Address)
+		}
+		key := hw.String() + "/" + string(Family(addr.String()))
+		if _, ok := reservedFamilies[key]; ok {
+			return Pool{}, fmt.Errorf("reservation %d: mac address %q has more than one reserved address of the %s family", i, r.MACAddress, Family(addr.String()))
+		}
+		reservedFamilies[key] = struct{}{}
+		pool.reserved[addr] = hw.String()
+	}
+
+	if spec.DNS != nil {
+		for _, ns := range spec.DNS.Nameservers {
+			if _, err := netip.ParseAddr(ns); err != nil {
+				return Pool{}, fmt.Errorf("dns: invalid nameserver %q", ns)
+			}
+		}
+	}
+
+	return pool, nil
+}
+
+// Families returns the IP families of the subnets of the pool.
+func (p Pool) Families() []corev1.IPFamily {
+	families := make([]corev1.IPFamily, 0, len(p.subnets))
+	for _, s := range p.subnets {
+		families = append(families, PrefixFamily(s.Prefix))
+	}
+	return families
+}
+
+// SubnetOf returns the subnet the address belongs to.
+func (p Pool) SubnetOf(address string) (Subnet, bool) {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return Subnet{}, false
+	}
+
+	for _, s := range p.subnets {
+		if s.Prefix.Contains(addr.Unmap()) {
+			return s, true
+		}
+	}
+
+	return Subnet{}, false
+}
+
+// Size returns the number of the assignable addresses of the pool, at most math.MaxInt64.
+func (p Pool) Size() uint64 {
+	var size uint64
+	for _, s := range p.subnets {
+		n := s.size()
+		if n >= math.MaxInt64-size {
+			return math.MaxInt64
+		}
+		size += n
+	}
+	return size
+}
+
+// Reserved returns the address of the family reserved for the MAC address, or an empty string.
+func (p Pool) Reserved(macAddress string, family corev1.IPFamily) string {
+	hw, err := net.ParseMAC(macAddress)
+	if err != nil {
+		return ""
+	}
+
+	// A MAC address has at most one reserved address per family.
+	for addr, reservedFor := range p.reserved {
+		if reservedFor == hw.String() && Family(addr.String()) == family {
+			return addr.String()
+		}
+	}
+
+	return ""
+}
+
+// IsAssignable reports whether the address can be assigned to the interface with the MAC address:
+// it belongs to a subnet, is not excluded, and is not reserved for another interface.
+func (p Pool) IsAssignable(address, macAddress string) bool {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	if !p.isAssignable(addr) {
+		return false
+	}
+
+	reservedFor, ok := p.reserved[addr]
+	if !ok {
+		return true
+	}
+
+	hw, err := net.ParseMAC(macAddress)
+	return err == nil && hw.String() == reservedFor
+}
+
+// Allocate returns the first address of the family that is neither assigned nor reserved.
+func (p Pool) Allocate(allocated AllocatedIPs, family corev1.IPFamily) (string, error) {
+	for _, s := range p.subnets {
+		if PrefixFamily(s.Prefix) != family {
+			continue
+		}
+
+		for addr := s.Prefix.Addr(); s.Prefix.Contains(addr); addr = addr.Next() {
+			if !s.isAssignable(addr) {
+				continue
+			}
+			if _, ok := p.reserved[addr]; ok {
+				continue
+			}
+			if _, ok := allocated[addr.String()]; !ok {
+				return addr.String(), nil
+			}
+		}
+	}
+
+	return "", ErrPoolExhausted
+}
+
+func (p Pool) isAssignable(addr netip.Addr) bool {
+	for _, s := range p.subnets {
+		if s.Prefix.Contains(addr) {
+			return s.isAssignable(addr)
+		}
+	}
+	return false
+}
+
+func parseSubnet(s v1alpha2.IPAddressPoolSubnet) (Subnet, error) {
+	prefix, err := netip.ParsePrefix(s.CIDR)
+	if err != nil {
+		return Subnet{}, fmt.Errorf("invalid cidr %q", s.CIDR)
+	}
+	if prefix != prefix.Masked() {
+		return Subnet{}, fmt.Errorf("cidr %q has the host bits set, expected %q", s.CIDR, prefix.Masked())
+	}
+
+	subnet := Subnet{Prefix: prefix}
+
+	if s.Gateway != "" {
+		gateway, err := netip.ParseAddr(s.Gateway)
+		if err != nil {
+			return Subnet{}, fmt.Errorf("invalid gateway %q", s.Gateway)
+		}
+		if !prefix.Contains(gateway) {
+			return Subnet{}, fmt.Errorf("gateway %q does not belong to the subnet", s.Gateway)
+		}
+		subnet.Gateway = gateway
+	}
+
+	for i, r := range s.ExcludedRanges {
+		first, err := netip.ParseAddr(r.Start)
+		if err != nil {
+			return Subnet{}, fmt.Errorf("excluded range %d: invalid start %q", i, r.Start)
+		}
+		last := first
+		if r.End != "" {
+			last, err = netip.ParseAddr(r.End)
+			if err != nil {
+				return Subnet{}, fmt.Errorf("excluded range %d: invalid end %q", i, r.End)
+			}
+		}
+		if !prefix.Contains(first) || !prefix.Contains(last) {
+			return Subnet{}, fmt.Errorf("excluded range %d does not belong to the subnet", i)
+		}
+		if last.Less(first) {
+			return Subnet{}, fmt.Errorf("excluded range %d: end %q is less than start %q", i, r.End, r.Start)
+		}
+		subnet.excluded = append(subnet.excluded, addrRange{first: first, last: last})
+	}
+
+	return subnet, nil
+}
+
+// isAssignable reports whether the address of the subnet is neither its first or last address,
+// nor the gateway, nor excluded.
+func (s Subnet) isAssignable(addr netip.Addr) bool {
+	if s.Prefix.Bits() < addr.BitLen() {
+		if addr == s.Prefix.Addr() || addr == s.last() {
+			return false
+		}
+	}
+	if addr == s.Gateway {
+		return false
+	}
+	for _, r := range s.excluded {
+		if r.first.Compare(addr) <= 0 && addr.Compare(r.last) <= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// last returns the last address of the subnet.
+func (s Subnet) last() netip.Addr {
+	b := s.Prefix.Addr().AsSlice()
+	for bit := s.Prefix.Bits(); bit < len(b)*8; bit++ {
+		b[bit/8] |= 1 << (7 - bit%8)
+	}
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
+
+// size returns the number of the assignable addresses of the subnet.
+func (s Subnet) size() uint64 {
+	hostBits := s.Prefix.Addr().BitLen() - s.Prefix.Bits()
+	if hostBits > maxHostBits {
+		return math.MaxInt64
+	}
+	if hostBits == 0 {
+		if s.isAssignable(s.Prefix.Addr()) {
+			return 1
+		}
+		return 0
+	}
+
+	// The first and the last addresses are never assigned.
+	first, last := s.offset(s.Prefix.Addr())+1, s.offset(s.last())-1
+	if last < first {
+		return 0
+	}
+
+	// Collect the unassignable addresses as the ranges of the offsets clamped to the assignable ones and merge them.
+	var holes [][2]uint64
+	if s.Gateway.IsValid() {
+		holes = append(holes, [2]uint64{s.offset(s.Gateway), s.offset(s.Gateway)})
+	}
+	for _, r := range s.excluded {
+		holes = append(holes, [2]uint64{s.offset(r.first), s.offset(r.last)})
+	}
+	slices.SortFunc(holes, func(a, b [2]uint64) int {
+		switch {
+		case a[0] < b[0]:
+			return -1
+		case a[0] > b[0]:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	size := last - first + 1
+	next := first
+	for _, h := range holes {
+		lo, hi := max(h[0], next), min(h[1], last)
+		if lo > hi {
+			continue
+		}
+		size -= hi - lo + 1
+		next = hi + 1
+	}
+
+	return size
+}
+
+// offset returns the index of the address within the subnet. The subnet must have at most maxHostBits host bits.
+func (s Subnet) offset(addr netip.Addr) uint64 {
+	a, base := addr.As16(), s.Prefix.Addr().As16()
+	var n, b uint64
+	for i := 8; i < 16; i++ {
+		n = n<<8 | uint64(a[i])
+		b = b<<8 | uint64(base[i])
+	}
+	return n - b
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ip
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+var _ = Describe("Pool", func() {
+	spec := func(subnets []v1alpha2.IPAddressPoolSubnet, reservations ...v1alpha2.IPAddressPoolReservation) v1alpha2.IPAddressPoolSpec {
+		return v1alpha2.IPAddressPoolSpec{Subnets: subnets, Reservations: reservations}
+	}
+
+	It("should exclude the edges, the gateway and the excluded ranges", func() {
+		pool, err := NewPool(spec([]v1alpha2.IPAddressPoolSubnet{{
+			CIDR:    "192.168.10.0/24",
+			Gateway: "192.168.10.1",
+			ExcludedRanges: []v1alpha2.IPAddressPoolRange{
+				{Start: "192.168.10.2", End: "192.168.10.9"},
+				{Start: "192.168.10.5", End: "192.168.10.10"},
+				{Start: "192.168.10.200"},
+			},
+		}}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pool.Size()).To(Equal(uint64(254 - 1 - 9 - 1)))
+		Expect(pool.IsAssignable("192.168.10.0", "")).To(BeFalse())
+		Expect(pool.IsAssignable("192.168.10.1", "")).To(BeFalse())
+		Expect(pool.IsAssignable("192.168.10.10", "")).To(BeFalse())
+		Expect(pool.IsAssignable("192.168.10.11", "")).To(BeTrue())
+		Expect(pool.IsAssignable("192.168.10.200", "")).To(BeFalse())
+		Expect(pool.IsAssignable("192.168.10.255", "")).To(BeFalse())
+		Expect(pool.IsAssignable("192.168.11.11", "")).To(BeFalse())
+
+		address, err := pool.Allocate(AllocatedIPs{"192.168.10.11": {}}, corev1.IPv4Protocol)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(address).To(Equal("192.168.10.12"))
+	})
+
+	It("should give the reserved address only to its MAC address", func() {
+		pool, err := NewPool(spec(
+			[]v1alpha2.IPAddressPoolSubnet{{CIDR: "10.0.0.0/29"}, {CIDR: "fd00::/64"}},
+			v1alpha2.IPAddressPoolReservation{MACAddress: "00:1A:2B:10:00:01", Address: "10.0.0.1"},
+		))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pool.Families()).To(Equal([]corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}))
+		Expect(pool.Size()).To(Equal(uint64(math.MaxInt64)))
+		Expect(pool.Reserved("00:1a:2b:10:00:01", corev1.IPv4Protocol)).To(Equal("10.0.0.1"))
+		Expect(pool.Reserved("00:1a:2b:10:00:01", corev1.IPv6Protocol)).To(BeEmpty())
+		Expect(pool.IsAssignable("10.0.0.1", "00:1a:2b:10:00:01")).To(BeTrue())
+		Expect(pool.IsAssignable("10.0.0.1", "00:1a:2b:10:00:02")).To(BeFalse())
+
+		address, err := pool.Allocate(AllocatedIPs{}, corev1.IPv4Protocol)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(address).To(Equal("10.0.0.2"))
+
+		address, err = pool.Allocate(AllocatedIPs{}, corev1.IPv6Protocol)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(address).To(Equal("fd00::1"))
+
+		subnet, ok := pool.SubnetOf("fd00::1")
+		Expect(ok).To(BeTrue())
+		Expect(subnet.Prefix.String()).To(Equal("fd00::/64"))
+	})
+
+	It("should report the exhausted pool", func() {
+		pool, err := NewPool(spec([]v1alpha2.IPAddressPoolSubnet{{CIDR: "10.0.0.0/30"}}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pool.Size()).To(Equal(uint64(2)))
+
+		_, err = pool.Allocate(AllocatedIPs{"10.0.0.1": {}, "10.0.0.2": {}}, corev1.IPv4Protocol)
+		Expect(err).To(MatchError(ErrPoolExhausted))
+	})
+
+	DescribeTable("should reject invalid pools",
+		func(spec v1alpha2.IPAddressPoolSpec) {
+			_, err := NewPool(spec)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("malformed cidr", spec([]v1alpha2.IPAddressPoolSubnet{{CIDR: "10.0.0.0"}})),
+		Entry("host bits set", spec([]v1alpha2.IPAddressPoolSubnet{{CIDR: "10.0.0.1/24"}})),
+		Entry("two subnets of a family", spec([]v1alpha2.IPAddressPoolSubnet{{CIDR: "10.0.0.0/24"}, {CIDR: "10.0.1.0/24"}})),
+		Entry("gateway outside the subnet", spec([]v1alpha2.IPAddressPoolSubnet{{CIDR: "10.0.0.0/24", Gateway: "10.0.1.1"}})),
+		Entry("reversed excluded range", spec([]v1alpha2.IPAddressPoolSubnet{{
+			CIDR:           "10.0.0.0/24",
+			ExcludedRanges: []v1alpha2.IPAddressPoolRange{{Start: "10.0.0.9", End: "10.0.0.2"}},
+		}})),
+		Entry("excluded reservation", spec(
+			[]v1alpha2.IPAddressPoolSubnet{{CIDR: "10.0.0.0/24", Gateway: "10.0.0.1"}},
+			v1alpha2.IPAddressPoolReservation{MACAddress: "00:1a:2b:10:00:01", Address: "10.0.0.1"},
+		)),
+		Entry("address reserved twice", spec(
+			[]v1alpha2.IPAddressPoolSubnet{{CIDR: "10.0.0.0/24"}},
+			v1alpha2.IPAddressPoolReservation{MACAddress: "00:1a:2b:10:00:01", Address: "10.0.0.5"},
+			v1alpha2.IPAddressPoolReservation{MACAddress: "00:1a:2b:10:00:02", Address: "10.0.0.5"},
+		)),
+		Entry("invalid nameserver", v1alpha2.IPAddressPoolSpec{
+			Subnets: []v1alpha2.IPAddressPoolSubnet{{CIDR: "10.0.0.0/24"}},
+			DNS:     &v1alpha2.IPAddressPoolDNS{Nameservers: []string{"dns.example.com"}},
+		}),
+	)
+})
@@ -0,0 +1,195 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mac
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+// ErrPoolExhausted is returned when all addresses of the pool are allocated.
+var ErrPoolExhausted = errors.New("no remaining MAC addresses in the pool")
+
+// Range is a range of addresses as 48-bit numbers, both ends inclusive.
+type Range struct {
+	First uint64
+	Last  uint64
+}
+
+func (r Range) size() uint64 {
+	return r.Last - r.First + 1
+}
+
+// Pool is the parsed address space of a MACAddressPool.
+type Pool struct {
+	ranges []Range
+}
+
+// NewPool parses the OUI and the ranges of the device part of the addresses.
+// Without ranges, the pool spans the whole OUI.
+func NewPool(oui string, ranges []v1alpha2.MACAddressPoolRange) (Pool, error) {
+	prefix, err := parseOctets(oui)
+	if err != nil {
+		return Pool{}, fmt.Errorf("invalid oui %q: %w", oui, err)
+	}
+	if prefix&(1<<16) != 0 {
+		return Pool{}, fmt.Errorf("invalid oui %q: the multicast bit of the first octet is set", oui)
+	}
+	prefix <<= 24
+
+	if len(ranges) == 0 {
+		return Pool{ranges: []Range{{First: prefix, Last: prefix | 0xffffff}}}, nil
+	}
+
+	pool := Pool{ranges: make([]Range, 0, len(ranges))}
+	for i, r := range ranges {
+		start, err := parseOctets(r.Start)
+		if err != nil {
+			return Pool{}, fmt.Errorf("range %d: invalid start %q: %w", i, r.Start, err)
+		}
+		end, err := parseOctets(r.End)
+		if err != nil {
+			return Pool{}, fmt.Errorf("range %d: invalid end %q: %w", i, r.End, err)
+		}
+		if end < start {
+			return Pool{}, fmt.Errorf("range %d: end %q is less than start %q", i, r.End, r.Start)
+		}
+
+		next := Range{First: prefix | start, Last: prefix | end}
+		for j, prev := range pool.ranges {
+			if next.overlaps(prev) {
+				return Pool{}, fmt.Errorf("range %d overlaps with range %d", i, j)
+			}
+		}
+		pool.ranges = append(pool.ranges, next)
+	}
+
+	return pool, nil
+}
+
+// Size returns the number of addresses in the pool.
+func (p Pool) Size() uint64 {
+	var size uint64
+	for _, r := range p.ranges {
+		size += r.size()
+	}
+	return size
+}
+
+// Contains reports whether the address belongs to the pool.
+func (p Pool) Contains(address string) bool {
+	n, err := parseAddress(address)
+	if err != nil {
+		return false
+	}
+
+	for _, r := range p.ranges {
+		if n >= r.First && n <= r.Last {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Overlaps reports whether the pools have common addresses.
+func (p Pool) Overlaps(other Pool) bool {
+	for _, a := range p.ranges {
+		for _, b := range other.ranges {
+			if a.overlaps(b) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Allocate returns a free address of the pool. The search starts at a random address
+// and goes through the pool in order, so a free address is found while there is one.
+func (p Pool) Allocate(allocated AllocatedMACs) (string, error) {
+	size := p.Size()
+	if size == 0 {
+		return "", ErrPoolExhausted
+	}
+
+	offset := rand.Uint64N(size)
+	for i := uint64(0); i < size; i++ {
+		address := formatAddress(p.at((offset + i) % size))
+		if _, ok := allocated[address]; !ok {
+			return address, nil
+		}
+	}
+
+	return "", ErrPoolExhausted
+}
+
+// at returns the address with the index n within the ranges of the pool.
+func (p Pool) at(n uint64) uint64 {
+	for _, r := range p.ranges {
+		if n < r.size() {
+			return r.First + n
+		}
+		n -= r.size()
+	}
+
+	return 0
+}
+
+func (r Range) overlaps(other Range) bool {
+	return r.First <= other.Last && other.First <= r.Last
+}
+
+// parseOctets parses three octets separated by colons into a 24-bit number.
+func parseOctets(s string) (uint64, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return 0, errors.New("expected three octets separated by colons")
+	}
+
+	var n uint64
+	for _, part := range parts {
+		if len(part) != 2 {
+			return 0, fmt.Errorf("invalid octet %q", part)
+		}
+		octet, err := strconv.ParseUint(part, 16, 8)
+		if err != nil {
+			return 0, fmt.Errorf("invalid octet %q", part)
+		}
+		n = n<<8 | octet
+	}
+
+	return n, nil
+}
+
+func parseAddress(address string) (uint64, error) {
+	if !IsValidAddressFormat(address) {
+		return 0, errors.New("invalid MAC address format")
+	}
+
+	hex := strings.NewReplacer(":", "", "-", "").Replace(strings.TrimSpace(address))
+	return strconv.ParseUint(hex, 16, 48)
+}
+
+func formatAddress(n uint64) string {
+	return fmt.Sprintf("%02x:%02x:%02x:%02x:%02x:%02x", byte(n>>40), byte(n>>32), byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mac
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+var _ = Describe("Pool", func() {
+	It("should span the whole OUI without ranges", func() {
+		pool, err := NewPool("00:1A:2B", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pool.Size()).To(Equal(uint64(1 << 24)))
+		Expect(pool.Contains("00:1a:2b:00:00:00")).To(BeTrue())
+		Expect(pool.Contains("00:1a:2b:ff:ff:ff")).To(BeTrue())
+		Expect(pool.Contains("00:1a:2c:00:00:00")).To(BeFalse())
+	})
+
+	It("should limit the addresses to the ranges", func() {
+		pool, err := NewPool("00:1a:2b", []v1alpha2.MACAddressPoolRange{
+			{Start: "10:00:00", End: "10:00:0f"},
+			{Start: "20:00:00", End: "20:00:00"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pool.Size()).To(Equal(uint64(17)))
+		Expect(pool.Contains("00:1a:2b:10:00:0f")).To(BeTrue())
+		Expect(pool.Contains("00:1a:2b:10:00:10")).To(BeFalse())
+		Expect(pool.Contains("00:1a:2b:20:00:00")).To(BeTrue())
+	})
+
+	DescribeTable("should reject invalid pools",
+		func(oui string, ranges []v1alpha2.MACAddressPoolRange) {
+			_, err := NewPool(oui, ranges)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("malformed oui", "00:1a", nil),
+		Entry("multicast oui", "01:1a:2b", nil),
+		Entry("reversed range", "00:1a:2b", []v1alpha2.MACAddressPoolRange{{Start: "10:00:01", End: "10:00:00"}}),
+		Entry("overlapping ranges", "00:1a:2b", []v1alpha2.MACAddressPoolRange{
+			{Start: "10:00:00", End: "10:00:ff"},
+			{Start: "10:00:80", End: "10:01:00"},
+		}),
+	)
+
+	It("should allocate the remaining free address", func() {
+		pool, err := NewPool("00:1a:2b", []v1alpha2.MACAddressPoolRange{{Start: "00:00:00", End: "00:00:02"}})
+		Expect(err).NotTo(HaveOccurred())
+
+		allocated := AllocatedMACs{
+			"00:1a:2b:00:00:00": nil,
+			"00:1a:2b:00:00:02": nil,
+		}
+		for range 10 {
+			Expect(pool.Allocate(allocated)).To(Equal("00:1a:2b:00:00:01"))
+		}
+
+		allocated["00:1a:2b:00:00:01"] = nil
+		_, err = pool.Allocate(allocated)
+		Expect(err).To(MatchError(ErrPoolExhausted))
+	})
+
+	It("should detect overlapping pools", func() {
+		a, err := NewPool("00:1a:2b", []v1alpha2.MACAddressPoolRange{{Start: "00:00:00", End: "0f:ff:ff"}})
+		Expect(err).NotTo(HaveOccurred())
+		b, err := NewPool("00:1a:2b", []v1alpha2.MACAddressPoolRange{{Start: "0f:00:00", End: "1f:ff:ff"}})
+		Expect(err).NotTo(HaveOccurred())
+		c, err := NewPool("00:1a:2c", nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(a.Overlaps(b)).To(BeTrue())
+		Expect(a.Overlaps(c)).To(BeFalse())
+	})
+})
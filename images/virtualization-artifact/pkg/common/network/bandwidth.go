@@ -0,0 +1,54 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+// ValidateBandwidth checks the constraints the CRD schema cannot express: rates and bursts must be positive.
+func ValidateBandwidth(path string, bandwidth *v1alpha2.NetworkBandwidth) error {
+	if bandwidth == nil {
+		return nil
+	}
+	if err := validateBandwidthLimit(path+".ingress", bandwidth.Ingress); err != nil {
+		return err
+	}
+	return validateBandwidthLimit(path+".egress", bandwidth.Egress)
+}
+
+func validateBandwidthLimit(path string, limit *v1alpha2.NetworkBandwidthLimit) error {
+	if limit == nil {
+		return nil
+	}
+	for _, q := range []struct {
+		name  string
+		value *resource.Quantity
+	}{
+		{"rate", &limit.Rate},
+		{"burst", limit.Burst},
+	} {
+		if q.value != nil && q.value.Sign() <= 0 {
+			return fmt.Errorf("%s.%s must be greater than zero", path, q.name)
+		}
+	}
+	return nil
+}
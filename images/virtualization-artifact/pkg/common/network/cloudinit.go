@@ -0,0 +1,161 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"crypto/md5"
+	"fmt"
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/ip"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+// additionalRouteMetric is the metric of the default routes via the additional interfaces.
+// It is higher than the metric of the default route received by DHCP on the Main network,
+// so the Main network stays the primary route of the guest.
+const additionalRouteMetric = 200
+
+// NetworkConfig is the cloud-init network configuration version 2.
+type NetworkConfig struct {
+	Version   int                              `json:"version"`
+	Ethernets map[string]NetworkConfigEthernet `json:"ethernets"`
+}
+
+type NetworkConfigEthernet struct {
+	Match          *NetworkConfigMatch         `json:"match,omitempty"`
+	DHCP4          bool                        `json:"dhcp4,omitempty"`
+	DHCP6          bool                        `json:"dhcp6,omitempty"`
+	DHCP4Overrides *NetworkConfigDHCPOverrides `json:"dhcp4-overrides,omitempty"`
+	Optional       bool                        `json:"optional,omitempty"`
+	Addresses      []string                    `json:"addresses,omitempty"`
+	Routes         []NetworkConfigRoute        `json:"routes,omitempty"`
+	Nameservers    *NetworkConfigNameservers   `json:"nameservers,omitempty"`
+}
+
+type NetworkConfigMatch struct {
+	MACAddress string `json:"macaddress"`
+}
+
+type NetworkConfigDHCPOverrides struct {
+	RouteMetric int `json:"route-metric,omitempty"`
+}
+
+type NetworkConfigRoute struct {
+	To     string `json:"to"`
+	Via    string `json:"via"`
+	Metric int    `json:"metric,omitempty"`
+}
+
+type NetworkConfigNameservers struct {
+	Addresses []string `json:"addresses,omitempty"`
+	Search    []string `json:"search,omitempty"`
+}
+
+// MainInterfaceMAC returns the MAC address of the Main network interface of the virtual machine.
+// The address is derived from the virtual machine UID and is locally administered, so the guest
+// network configuration can match the interface by it.
+func MainInterfaceMAC(vm *v1alpha2.VirtualMachine) string {
+	sum := md5.Sum([]byte(vm.UID))
+	return net.HardwareAddr{0x02, sum[0], sum[1], sum[2], sum[3], sum[4]}.String()
+}
+
+// GenerateNetworkConfig returns the cloud-init network configuration of the interfaces, matched by their MAC addresses:
+//   - Main: DHCP for the IP families of the mainAddresses;
+//   - additional interfaces served by IPAddressPool resources: static addresses, routes and DNS from pools;
+//   - additional interfaces with the IPAM of the SDN module: DHCPv4;
+//   - other additional interfaces: DHCPv4 the guest does not wait for at boot.
+//
+// The routes of additional interfaces have a lower priority than the routes of the Main interface.
+// Returns an empty string if there are no additional interfaces, leaving the guest network configuration
+// to the image defaults.
+func GenerateNetworkConfig(specs InterfaceSpecList, mainAddresses []string, pools map[string]PoolAddressing) (string, error) {
+	cfg := NetworkConfig{
+		Version:   2,
+		Ethernets: make(map[string]NetworkConfigEthernet),
+	}
+
+	var hasAdditional bool
+	for _, spec := range specs {
+		if spec.MAC == "" {
+			continue
+		}
+
+		ethernet := NetworkConfigEthernet{
+			Match: &NetworkConfigMatch{MACAddress: spec.MAC},
+		}
+		addressing := pools[SpecKey(v1alpha2.NetworksSpec{Type: spec.Type, Name: spec.Name})]
+
+		switch {
+		case spec.Type == v1alpha2.NetworksTypeMain:
+			for _, address := range mainAddresses {
+				switch ip.Family(address) {
+				case corev1.IPv4Protocol:
+					ethernet.DHCP4 = true
+				case corev1.IPv6Protocol:
+					ethernet.DHCP6 = true
+				}
+			}
+			if !ethernet.DHCP4 && !ethernet.DHCP6 {
+				ethernet.DHCP4 = true
+			}
+		case addressing.IsAssigned():
+			hasAdditional = true
+			ethernet.Addresses = addressing.Addresses
+			for _, gateway := range addressing.Gateways {
+				ethernet.Routes = append(ethernet.Routes, NetworkConfigRoute{
+					To:     "default",
+					Via:    gateway,
+					Metric: additionalRouteMetric,
+				})
+			}
+			if len(addressing.Nameservers) > 0 || len(addressing.Search) > 0 {
+				ethernet.Nameservers = &NetworkConfigNameservers{
+					Addresses: addressing.Nameservers,
+					Search:    addressing.Search,
+				}
+			}
+		case spec.IPAssignmentMode == IPAssignmentModeDHCP:
+			hasAdditional = true
+			ethernet.DHCP4 = true
+			ethernet.DHCP4Overrides = &NetworkConfigDHCPOverrides{RouteMetric: additionalRouteMetric}
+		default:
+			// An L2-only network may have a DHCP server of its own, but the guest must not
+			// wait for it if there is none.
+			hasAdditional = true
+			ethernet.DHCP4 = true
+			ethernet.DHCP4Overrides = &NetworkConfigDHCPOverrides{RouteMetric: additionalRouteMetric}
+			ethernet.Optional = true
+		}
+
+		cfg.Ethernets[fmt.Sprintf("net%d", spec.ID)] = ethernet
+	}
+
+	if !hasAdditional {
+		return "", nil
+	}
+
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return "", fmt.Errorf("marshal network config: %w", err)
+	}
+
+	return string(data), nil
+}
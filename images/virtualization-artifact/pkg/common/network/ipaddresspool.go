@@ -0,0 +1,192 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/ip"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+// PoolAddressing is the static addressing of an additional interface served by an IPAddressPool.
+type PoolAddressing struct {
+	// Pool is the name of the IPAddressPool serving the interface.
+	Pool string
+	// Addresses are the assigned addresses in CIDR notation, one per IP family.
+	// Empty while the pool has not assigned the addresses yet.
+	Addresses   []string
+	Gateways    []string
+	Nameservers []string
+	Search      []string
+}
+
+// IsAssigned reports whether the pool has assigned the addresses to the interface.
+func (a PoolAddressing) IsAssigned() bool {
+	return len(a.Addresses) > 0
+}
+
+// IPAddress returns the first assigned address without the prefix length, or an empty string.
+func (a PoolAddressing) IPAddress() string {
+	if !a.IsAssigned() {
+		return ""
+	}
+	prefix, err := netip.ParsePrefix(a.Addresses[0])
+	if err != nil {
+		return ""
+	}
+	return prefix.Addr().String()
+}
+
+// IPAddressPoolApplies reports whether the pool applies to the interface connected to the network
+// of a virtual machine in the namespace.
+func IPAddressPoolApplies(pool *v1alpha2.IPAddressPool, namespace *corev1.Namespace, netSpec v1alpha2.NetworksSpec) bool {
+	if !slices.ContainsFunc(pool.Spec.Networks, func(n v1alpha2.IPAddressPoolNetwork) bool {
+		return n.Type == netSpec.Type && n.Name == netSpec.Name
+	}) {
+		return false
+	}
+
+	if pool.Spec.NamespaceSelector == nil {
+		return true
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(pool.Spec.NamespaceSelector)
+	if err != nil {
+		return false
+	}
+
+	return selector.Matches(labels.Set(namespace.GetLabels()))
+}
+
+// IPAddressPoolAllocations returns the addresses assigned by the pool to the interface of the virtual machine
+// connected to the network.
+func IPAddressPoolAllocations(pool *v1alpha2.IPAddressPool, namespace, vmName string, netSpec v1alpha2.NetworksSpec) []v1alpha2.IPAddressPoolAllocation {
+	var allocations []v1alpha2.IPAddressPoolAllocation
+	for _, a := range pool.Status.Allocations {
+		if a.Namespace == namespace && a.VirtualMachine == vmName && a.Network.Type == netSpec.Type && a.Network.Name == netSpec.Name {
+			allocations = append(allocations, a)
+		}
+	}
+	return allocations
+}
+
+// SelectIPAddressPool returns the pool serving the interface of the virtual machine connected to the network,
+// or nil if no valid pool applies to it. The pool that has already assigned addresses to the interface is preferred
+// to keep the addresses stable, otherwise the pool with the lexicographically smallest name is used.
+func SelectIPAddressPool(pools []v1alpha2.IPAddressPool, namespace *corev1.Namespace, vmName string, netSpec v1alpha2.NetworksSpec) *v1alpha2.IPAddressPool {
+	var selected *v1alpha2.IPAddressPool
+	for i := range pools {
+		pool := &pools[i]
+		if !IPAddressPoolApplies(pool, namespace, netSpec) {
+			continue
+		}
+		if _, err := ip.NewPool(pool.Spec); err != nil {
+			continue
+		}
+		if len(IPAddressPoolAllocations(pool, namespace.Name, vmName, netSpec)) > 0 {
+			return pool
+		}
+		if selected == nil || pool.Name < selected.Name {
+			selected = pool
+		}
+	}
+	return selected
+}
+
+// ResolveIPAddressPools returns the addressing of the additional interfaces served by the IPAddressPool resources,
+// keyed by SpecKey. The interfaces of the networks with IPAM configured in the SDN module are served by SDN and skipped.
+func ResolveIPAddressPools(ctx context.Context, c client.Client, vm *v1alpha2.VirtualMachine, specs InterfaceSpecList) (map[string]PoolAddressing, error) {
+	var pools v1alpha2.IPAddressPoolList
+	if err := c.List(ctx, &pools); err != nil {
+		return nil, fmt.Errorf("list ip address pools: %w", err)
+	}
+	if len(pools.Items) == 0 {
+		return nil, nil
+	}
+
+	var namespace corev1.Namespace
+	if err := c.Get(ctx, types.NamespacedName{Name: vm.Namespace}, &namespace); err != nil {
+		return nil, fmt.Errorf("get namespace %s: %w", vm.Namespace, err)
+	}
+
+	result := make(map[string]PoolAddressing)
+	for _, spec := range specs {
+		if spec.Type == v1alpha2.NetworksTypeMain || spec.MAC == "" {
+			continue
+		}
+
+		netSpec := v1alpha2.NetworksSpec{Type: spec.Type, Name: spec.Name}
+		pool := SelectIPAddressPool(pools.Items, &namespace, vm.Name, netSpec)
+		if pool == nil {
+			continue
+		}
+
+		hasIPAM, err := HasIPAM(ctx, c, vm.Namespace, netSpec)
+		if err != nil && !meta.IsNoMatchError(err) {
+			return nil, err
+		}
+		if hasIPAM {
+			continue
+		}
+
+		result[SpecKey(netSpec)] = poolAddressing(pool, vm, netSpec, spec.MAC)
+	}
+
+	return result, nil
+}
+
+func poolAddressing(pool *v1alpha2.IPAddressPool, vm *v1alpha2.VirtualMachine, netSpec v1alpha2.NetworksSpec, macAddress string) PoolAddressing {
+	addressing := PoolAddressing{Pool: pool.Name}
+	if pool.Spec.DNS != nil {
+		addressing.Nameservers = pool.Spec.DNS.Nameservers
+		addressing.Search = pool.Spec.DNS.Search
+	}
+
+	addresses, err := ip.NewPool(pool.Spec)
+	if err != nil {
+		return addressing
+	}
+
+	for _, a := range IPAddressPoolAllocations(pool, vm.Namespace, vm.Name, netSpec) {
+		// The allocation is stale until the pool catches up with the new MAC address of the interface.
+		if !strings.EqualFold(a.MACAddress, macAddress) {
+			continue
+		}
+		subnet, ok := addresses.SubnetOf(a.Address)
+		if !ok {
+			continue
+		}
+		addressing.Addresses = append(addressing.Addresses, netip.PrefixFrom(netip.MustParseAddr(a.Address), subnet.Prefix.Bits()).String())
+		if subnet.Gateway.IsValid() {
+			addressing.Gateways = append(addressing.Gateways, subnet.Gateway.String())
+		}
+	}
+
+	return addressing
+}
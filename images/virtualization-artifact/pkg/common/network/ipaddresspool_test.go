@@ -0,0 +1,248 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package network
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+var _ = Describe("IPAddressPool", func() {
+	userNet := v1alpha2.NetworksSpec{Type: v1alpha2.NetworksTypeNetwork, Name: "user-net"}
+
+	newPool := func(name string, allocations ...v1alpha2.IPAddressPoolAllocation) *v1alpha2.IPAddressPool {
+		return &v1alpha2.IPAddressPool{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1alpha2.IPAddressPoolSpec{
+				Networks: []v1alpha2.IPAddressPoolNetwork{{Type: userNet.Type, Name: userNet.Name}},
+				Subnets:  []v1alpha2.IPAddressPoolSubnet{{CIDR: "192.168.10.0/24", Gateway: "192.168.10.1"}},
+				DNS:      &v1alpha2.IPAddressPoolDNS{Nameservers: []string{"192.168.10.2"}, Search: []string{"example.com"}},
+			},
+			Status: v1alpha2.IPAddressPoolStatus{Allocations: allocations},
+		}
+	}
+
+	allocation := func(address, mac string) v1alpha2.IPAddressPoolAllocation {
+		return v1alpha2.IPAddressPoolAllocation{
+			Address:        address,
+			MACAddress:     mac,
+			Namespace:      "ns",
+			VirtualMachine: "vm",
+			Network:        v1alpha2.IPAddressPoolNetwork{Type: userNet.Type, Name: userNet.Name},
+		}
+	}
+
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns", Labels: map[string]string{"tenant": "a"}}}
+
+	Describe("IPAddressPoolApplies", func() {
+		It("should apply to the listed networks only", func() {
+			pool := newPool("pool")
+			Expect(IPAddressPoolApplies(pool, namespace, userNet)).To(BeTrue())
+			Expect(IPAddressPoolApplies(pool, namespace, v1alpha2.NetworksSpec{Type: v1alpha2.NetworksTypeClusterNetwork, Name: "user-net"})).To(BeFalse())
+		})
+
+		It("should respect the namespace selector", func() {
+			pool := newPool("pool")
+			pool.Spec.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "a"}}
+			Expect(IPAddressPoolApplies(pool, namespace, userNet)).To(BeTrue())
+
+			pool.Spec.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "b"}}
+			Expect(IPAddressPoolApplies(pool, namespace, userNet)).To(BeFalse())
+		})
+	})
+
+	Describe("SelectIPAddressPool", func() {
+		It("should prefer the pool with the smallest name", func() {
+			pools := []v1alpha2.IPAddressPool{*newPool("b"), *newPool("a")}
+			Expect(SelectIPAddressPool(pools, namespace, "vm", userNet).Name).To(Equal("a"))
+		})
+
+		It("should prefer the pool that has already assigned an address", func() {
+			pools := []v1alpha2.IPAddressPool{*newPool("a"), *newPool("b", allocation("192.168.10.5", "02:00:00:00:00:01"))}
+			Expect(SelectIPAddressPool(pools, namespace, "vm", userNet).Name).To(Equal("b"))
+		})
+
+		It("should skip invalid pools", func() {
+			invalid := newPool("a")
+			invalid.Spec.Subnets[0].CIDR = "192.168.10.1/24"
+			pools := []v1alpha2.IPAddressPool{*invalid, *newPool("b")}
+			Expect(SelectIPAddressPool(pools, namespace, "vm", userNet).Name).To(Equal("b"))
+		})
+
+		It("should return nil if no pool applies", func() {
+			pools := []v1alpha2.IPAddressPool{*newPool("a")}
+			Expect(SelectIPAddressPool(pools, namespace, "vm", v1alpha2.NetworksSpec{Type: v1alpha2.NetworksTypeNetwork, Name: "other"})).To(BeNil())
+		})
+	})
+
+	Describe("ResolveIPAddressPools", func() {
+		vm := &v1alpha2.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "vm", Namespace: "ns", UID: types.UID("vm-uid")},
+		}
+		specs := InterfaceSpecList{
+			{ID: 1, Type: v1alpha2.NetworksTypeMain, Name: "", InterfaceName: NameDefaultInterface},
+			{ID: 2, Type: userNet.Type, Name: userNet.Name, MAC: "02:00:00:00:00:01"},
+		}
+
+		resolve := func(objs ...client.Object) map[string]PoolAddressing {
+			GinkgoHelper()
+			c := newIPAMFakeClient(append(objs, namespace.DeepCopy())...)
+			result, err := ResolveIPAddressPools(context.Background(), c, vm, specs)
+			Expect(err).NotTo(HaveOccurred())
+			return result
+		}
+
+		It("should return nothing without pools", func() {
+			Expect(resolve()).To(BeEmpty())
+		})
+
+		It("should wait for the pool to assign an address", func() {
+			result := resolve(newPool("pool"))
+			Expect(result).To(HaveKey(SpecKey(userNet)))
+			Expect(result[SpecKey(userNet)].Pool).To(Equal("pool"))
+			Expect(result[SpecKey(userNet)].IsAssigned()).To(BeFalse())
+		})
+
+		It("should ignore the allocation of another MAC address", func() {
+			result := resolve(newPool("pool", allocation("192.168.10.5", "02:00:00:00:00:02")))
+			Expect(result[SpecKey(userNet)].IsAssigned()).To(BeFalse())
+		})
+
+		It("should return the assigned address with the gateway and DNS", func() {
+			result := resolve(newPool("pool", allocation("192.168.10.5", "02:00:00:00:00:01")))
+			Expect(result[SpecKey(userNet)]).To(Equal(PoolAddressing{
+				Pool:        "pool",
+				Addresses:   []string{"192.168.10.5/24"},
+				Gateways:    []string{"192.168.10.1"},
+				Nameservers: []string{"192.168.10.2"},
+				Search:      []string{"example.com"},
+			}))
+		})
+
+		It("should skip the networks with the IPAM of the SDN module", func() {
+			result := resolve(newPool("pool"), newNetworkObj("Network", "user-net", "ns", true))
+			Expect(result).To(BeEmpty())
+		})
+	})
+
+	Describe("GenerateNetworkConfig", func() {
+		specs := InterfaceSpecList{
+			{ID: 1, Type: v1alpha2.NetworksTypeMain, InterfaceName: NameDefaultInterface, MAC: "02:aa:bb:cc:dd:ee"},
+			{ID: 2, Type: userNet.Type, Name: userNet.Name, MAC: "02:00:00:00:00:01"},
+			{ID: 3, Type: v1alpha2.NetworksTypeClusterNetwork, Name: "sdn", MAC: "02:00:00:00:00:02", IPAssignmentMode: IPAssignmentModeDHCP},
+			{ID: 4, Type: v1alpha2.NetworksTypeClusterNetwork, Name: "l2", MAC: "02:00:00:00:00:03"},
+		}
+
+		It("should generate nothing if there are no additional interfaces", func() {
+			data, err := GenerateNetworkConfig(specs[:1], []string{"10.66.10.5"}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(BeEmpty())
+		})
+
+		It("should use DHCP on the interfaces not served by a pool", func() {
+			data, err := GenerateNetworkConfig(specs, nil, map[string]PoolAddressing{SpecKey(userNet): {Pool: "pool"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(MatchYAML(`
+version: 2
+ethernets:
+  net1:
+    match:
+      macaddress: "02:aa:bb:cc:dd:ee"
+    dhcp4: true
+  net2:
+    match:
+      macaddress: "02:00:00:00:00:01"
+    dhcp4: true
+    dhcp4-overrides:
+      route-metric: 200
+    optional: true
+  net3:
+    match:
+      macaddress: "02:00:00:00:00:02"
+    dhcp4: true
+    dhcp4-overrides:
+      route-metric: 200
+  net4:
+    match:
+      macaddress: "02:00:00:00:00:03"
+    dhcp4: true
+    dhcp4-overrides:
+      route-metric: 200
+    optional: true
+`))
+		})
+
+		It("should configure the interfaces by their MAC addresses", func() {
+			data, err := GenerateNetworkConfig(specs, []string{"10.66.10.5", "fd00::5"}, map[string]PoolAddressing{
+				SpecKey(userNet): {
+					Pool:        "pool",
+					Addresses:   []string{"192.168.10.5/24"},
+					Gateways:    []string{"192.168.10.1"},
+					Nameservers: []string{"192.168.10.2"},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(MatchYAML(`
+version: 2
+ethernets:
+  net1:
+    match:
+      macaddress: "02:aa:bb:cc:dd:ee"
+    dhcp4: true
+    dhcp6: true
+  net2:
+    match:
+      macaddress: "02:00:00:00:00:01"
+    addresses: ["192.168.10.5/24"]
+    routes:
+      - to: default
+        via: 192.168.10.1
+        metric: 200
+    nameservers:
+      addresses: ["192.168.10.2"]
+  net3:
+    match:
+      macaddress: "02:00:00:00:00:02"
+    dhcp4: true
+    dhcp4-overrides:
+      route-metric: 200
+  net4:
+    match:
+      macaddress: "02:00:00:00:00:03"
+    dhcp4: true
+    dhcp4-overrides:
+      route-metric: 200
+    optional: true
+`))
+		})
+	})
+
+	It("should derive a stable locally administered MAC address for the Main interface", func() {
+		vm := &v1alpha2.VirtualMachine{ObjectMeta: metav1.ObjectMeta{UID: types.UID("vm-uid")}}
+		mac := MainInterfaceMAC(vm)
+		Expect(mac).To(HavePrefix("02:"))
+		Expect(mac).To(Equal(MainInterfaceMAC(vm.DeepCopy())))
+	})
+})
@@ -21,6 +21,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
@@ -57,6 +58,7 @@ func newNetworkObj(kind, name, namespace string, withPool bool) *unstructured.Un
 func newIPAMFakeClient(objs ...client.Object) client.Client {
 	scheme := apiruntime.NewScheme()
 	_ = v1alpha2.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
 	scheme.AddKnownTypeWithName(ClusterNetworkGVK, &unstructured.Unstructured{})
 	scheme.AddKnownTypeWithName(NetworkGVK, &unstructured.Unstructured{})
 	scheme.AddKnownTypeWithName(IPAddressGVK, &unstructured.Unstructured{})
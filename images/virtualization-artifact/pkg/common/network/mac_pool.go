@@ -16,11 +16,20 @@ limitations under the License.
 
 package network
 
-import "github.com/deckhouse/virtualization/api/core/v1alpha2"
+import (
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
 
 type MacAddressPool struct {
 	reservedByName map[string]string
-	available      []string
+	available      []availableMAC
+}
+
+type availableMAC struct {
+	address string
+	// network is the key of the network the address was created for, if any.
+	network string
 }
 
 func NewMacAddressPool(vm *v1alpha2.VirtualMachine, vmmacs []*v1alpha2.VirtualMachineMACAddress) *MacAddressPool {
@@ -34,11 +43,14 @@ func NewMacAddressPool(vm *v1alpha2.VirtualMachine, vmmacs []*v1alpha2.VirtualMa
 		}
 	}
 
-	var available []string
+	var available []availableMAC
 	for _, v := range vmmacs {
 		mac := v.Status.Address
 		if mac != "" && !takenMacs[mac] {
-			available = append(available, mac)
+			available = append(available, availableMAC{
+				address: mac,
+				network: v.GetAnnotations()[annotations.AnnMACAddressNetwork],
+			})
 		}
 	}
 
@@ -48,15 +60,25 @@ func NewMacAddressPool(vm *v1alpha2.VirtualMachine, vmmacs []*v1alpha2.VirtualMa
 	}
 }
 
-func (p *MacAddressPool) Assign(networkName string) string {
-	if mac, exists := p.reservedByName[networkName]; exists {
+// Assign returns the MAC address for the network: the address already used by the network,
+// or a free address, preferring the one created for this network and then the one created for no network.
+func (p *MacAddressPool) Assign(net v1alpha2.NetworksSpec) string {
+	if mac, exists := p.reservedByName[net.Name]; exists {
 		return mac
 	}
 
-	if len(p.available) > 0 {
-		mac := p.available[0]
-		p.available = p.available[1:]
-		return mac
+	key := SpecKey(net)
+	for _, match := range []func(availableMAC) bool{
+		func(m availableMAC) bool { return m.network == key },
+		func(m availableMAC) bool { return m.network == "" },
+		func(availableMAC) bool { return true },
+	} {
+		for i, m := range p.available {
+			if match(m) {
+				p.available = append(p.available[:i], p.available[i+1:]...)
+				return m.address
+			}
+		}
 	}
 
 	return ""
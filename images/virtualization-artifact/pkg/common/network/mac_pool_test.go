@@ -20,6 +20,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 )
 
@@ -46,9 +47,9 @@ var _ = Describe("MacAddressPool", func() {
 			newVMMAC("00:1A:2B:3C:4D:6A"),
 		})
 
-		Expect(pool.Assign("net-a")).To(Equal("00:1A:2B:3C:4D:5E"))
-		Expect(pool.Assign("net-b")).To(Equal("00:1A:2B:3C:4D:5F"))
-		Expect(pool.Assign("net-c")).To(Equal("00:1A:2B:3C:4D:6A"))
+		Expect(pool.Assign(v1alpha2.NetworksSpec{Type: v1alpha2.NetworksTypeNetwork, Name: "net-a"})).To(Equal("00:1A:2B:3C:4D:5E"))
+		Expect(pool.Assign(v1alpha2.NetworksSpec{Type: v1alpha2.NetworksTypeNetwork, Name: "net-b"})).To(Equal("00:1A:2B:3C:4D:5F"))
+		Expect(pool.Assign(v1alpha2.NetworksSpec{Type: v1alpha2.NetworksTypeNetwork, Name: "net-c"})).To(Equal("00:1A:2B:3C:4D:6A"))
 	})
 
 	It("should return empty MAC when pool is exhausted", func() {
@@ -57,7 +58,21 @@ var _ = Describe("MacAddressPool", func() {
 			newVMMAC("00:1A:2B:3C:4D:5E"),
 		})
 
-		Expect(pool.Assign("net-a")).To(Equal("00:1A:2B:3C:4D:5E"))
-		Expect(pool.Assign("net-b")).To(Equal(""))
+		Expect(pool.Assign(v1alpha2.NetworksSpec{Type: v1alpha2.NetworksTypeNetwork, Name: "net-a"})).To(Equal("00:1A:2B:3C:4D:5E"))
+		Expect(pool.Assign(v1alpha2.NetworksSpec{Type: v1alpha2.NetworksTypeNetwork, Name: "net-b"})).To(Equal(""))
+	})
+
+	It("should prefer the MAC created for the network", func() {
+		vm := &v1alpha2.VirtualMachine{}
+		vmmacA := newVMMAC("00:1A:2B:3C:4D:5E")
+		vmmacA.Annotations = map[string]string{annotations.AnnMACAddressNetwork: "ClusterNetwork/net-a"}
+		vmmacB := newVMMAC("00:1A:2B:3C:4D:5F")
+		vmmacB.Annotations = map[string]string{annotations.AnnMACAddressNetwork: "Network/net-b"}
+
+		pool := NewMacAddressPool(vm, []*v1alpha2.VirtualMachineMACAddress{vmmacA, vmmacB, newVMMAC("00:1A:2B:3C:4D:6A")})
+
+		Expect(pool.Assign(v1alpha2.NetworksSpec{Type: v1alpha2.NetworksTypeNetwork, Name: "net-b"})).To(Equal("00:1A:2B:3C:4D:5F"))
+		Expect(pool.Assign(v1alpha2.NetworksSpec{Type: v1alpha2.NetworksTypeNetwork, Name: "net-c"})).To(Equal("00:1A:2B:3C:4D:6A"))
+		Expect(pool.Assign(v1alpha2.NetworksSpec{Type: v1alpha2.NetworksTypeClusterNetwork, Name: "net-a"})).To(Equal("00:1A:2B:3C:4D:5E"))
 	})
 })
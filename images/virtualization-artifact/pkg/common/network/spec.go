@@ -44,7 +44,7 @@ func CreateNetworkSpec(vm *v1alpha2.VirtualMachine, vmmacs []*v1alpha2.VirtualMa
 			continue
 		}
 
-		mac := macPool.Assign(net.Name)
+		mac := macPool.Assign(net)
 		if mac != "" {
 			specs = append(specs, createAdditionalInterfaceSpec(net, mac))
 		}
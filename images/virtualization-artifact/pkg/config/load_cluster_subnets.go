@@ -20,18 +20,21 @@ import (
 	"fmt"
 	"net/netip"
 	"os"
+	"strings"
 )
 
 const (
 	// ClusterPodSubnetVar is an env variable holds global podSubnetCIDR value.
+	// A dual-stack cluster specifies a comma-separated pair of an IPv4 and an IPv6 subnet.
 	ClusterPodSubnetVar = "CLUSTER_POD_SUBNET_CIDR"
 	// ClusterServiceSubnetVar is an env variable holds global serviceSubnetCIDR value.
+	// A dual-stack cluster specifies a comma-separated pair of an IPv4 and an IPv6 subnet.
 	ClusterServiceSubnetVar = "CLUSTER_SERVICE_SUBNET_CIDR"
 )
 
 type ClusterSubnets struct {
-	PodSubnet     netip.Prefix
-	ServiceSubnet netip.Prefix
+	PodSubnets     []netip.Prefix
+	ServiceSubnets []netip.Prefix
 }
 
 func LoadClusterSubnetsFromEnvs() (*ClusterSubnets, error) {
@@ -40,7 +43,7 @@ func LoadClusterSubnetsFromEnvs() (*ClusterSubnets, error) {
 		return nil, fmt.Errorf("environment variable %q undefined, specify global podSubnetCIDR from cluster configuration", ClusterPodSubnetVar)
 	}
 
-	podSubnet, err := netip.ParsePrefix(podSubnetStr)
+	podSubnets, err := parseSubnets(podSubnetStr)
 	if err != nil {
 		return nil, fmt.Errorf("parse podSubnetCIDR: %w", err)
 	}
@@ -50,13 +53,26 @@ func LoadClusterSubnetsFromEnvs() (*ClusterSubnets, error) {
 		return nil, fmt.Errorf("environment variable %q undefined, specify global serviceSubnetCIDR from cluster configuration", ClusterServiceSubnetVar)
 	}
 
-	serviceSubnet, err := netip.ParsePrefix(serviceSubnetStr)
+	serviceSubnets, err := parseSubnets(serviceSubnetStr)
 	if err != nil {
-		return nil, fmt.Errorf("parse podSubnetCIDR: %w", err)
+		return nil, fmt.Errorf("parse serviceSubnetCIDR: %w", err)
 	}
 
 	return &ClusterSubnets{
-		PodSubnet:     podSubnet,
-		ServiceSubnet: serviceSubnet,
+		PodSubnets:     podSubnets,
+		ServiceSubnets: serviceSubnets,
 	}, nil
 }
+
+func parseSubnets(value string) ([]netip.Prefix, error) {
+	var subnets []netip.Prefix
+	for _, subnetStr := range strings.Split(value, ",") {
+		subnet, err := netip.ParsePrefix(strings.TrimSpace(subnetStr))
+		if err != nil {
+			return nil, err
+		}
+		subnets = append(subnets, subnet)
+	}
+
+	return subnets, nil
+}
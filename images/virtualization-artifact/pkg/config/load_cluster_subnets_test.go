@@ -0,0 +1,54 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"net/netip"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LoadClusterSubnetsFromEnvs", func() {
+	It("parses single-stack subnets", func() {
+		GinkgoT().Setenv(ClusterPodSubnetVar, "10.111.0.0/16")
+		GinkgoT().Setenv(ClusterServiceSubnetVar, "10.222.0.0/16")
+
+		subnets, err := LoadClusterSubnetsFromEnvs()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(subnets.PodSubnets).To(Equal([]netip.Prefix{netip.MustParsePrefix("10.111.0.0/16")}))
+		Expect(subnets.ServiceSubnets).To(Equal([]netip.Prefix{netip.MustParsePrefix("10.222.0.0/16")}))
+	})
+
+	It("parses dual-stack subnets", func() {
+		GinkgoT().Setenv(ClusterPodSubnetVar, "10.111.0.0/16,fd00:111::/56")
+		GinkgoT().Setenv(ClusterServiceSubnetVar, "10.222.0.0/16, fd00:222::/112")
+
+		subnets, err := LoadClusterSubnetsFromEnvs()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(subnets.PodSubnets).To(Equal([]netip.Prefix{netip.MustParsePrefix("10.111.0.0/16"), netip.MustParsePrefix("fd00:111::/56")}))
+		Expect(subnets.ServiceSubnets).To(Equal([]netip.Prefix{netip.MustParsePrefix("10.222.0.0/16"), netip.MustParsePrefix("fd00:222::/112")}))
+	})
+
+	It("rejects an invalid subnet", func() {
+		GinkgoT().Setenv(ClusterPodSubnetVar, "10.111.0.0/16,invalid")
+		GinkgoT().Setenv(ClusterServiceSubnetVar, "10.222.0.0/16")
+
+		_, err := LoadClusterSubnetsFromEnvs()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -41,6 +41,12 @@ const (
 	DVCRImageMonitorScheduleVar = "DVCR_IMAGE_MONITOR_SCHEDULE"
 	// DVCRGCScheduleVar is an env variable holds the cron schedule to run DVCR garbage collection.
 	DVCRGCScheduleVar = "DVCR_GC_SCHEDULE"
+	// DVCRCompressionVar is an env variable holds the default compression of the images stored in DVCR.
+	DVCRCompressionVar = "DVCR_COMPRESSION"
+	// DVCRPullThroughCacheVar is an env variable holds the flag whether the pull-through cache of the ContainerImage data sources is enabled.
+	DVCRPullThroughCacheVar = "DVCR_PULL_THROUGH_CACHE"
+	// DVCRSignatureTrustedKeysVar is an env variable holds the PEM-encoded keys every ContainerImage data source must be signed with.
+	DVCRSignatureTrustedKeysVar = "DVCR_SIGNATURE_TRUSTED_KEYS"
 	// DVCRTokenPrivateKeyVar holds the PEM ECDSA private key used to mint scoped
 	// per-namespace DVCR tokens.
 	DVCRTokenPrivateKeyVar = "DVCR_TOKEN_PRIVATE_KEY"
@@ -75,6 +81,9 @@ func LoadDVCRSettingsFromEnvs(controllerNamespace string) (*dvcr.Settings, error
 		InsecureTLS:          os.Getenv(DVCRInsecureTLSVar),
 		ImageMonitorSchedule: os.Getenv(DVCRImageMonitorScheduleVar),
 		GCSchedule:           os.Getenv(DVCRGCScheduleVar),
+		Compression:          os.Getenv(DVCRCompressionVar),
+		PullThroughCache:     os.Getenv(DVCRPullThroughCacheVar) == "true",
+		SignatureTrustedKeys: os.Getenv(DVCRSignatureTrustedKeysVar),
 		UploaderIngressSettings: dvcr.UploaderIngressSettings{
 			Host:               os.Getenv(UploaderIngressHostVar),
 			TLSSecret:          os.Getenv(UploaderIngressTLSSecretVar),
@@ -31,12 +31,20 @@ const (
 	GcVMIMigrationScheduleVar = "GC_VMI_MIGRATION_SCHEDULE"
 	GcVMPodTTLVar             = "GC_VM_POD_TTL"
 	GcVMPodScheduleVar        = "GC_VM_POD_SCHEDULE"
+	GcVDExportScheduleVar     = "GC_VDEXPORT_SCHEDULE"
+
+	// defaultVDExportGcSchedule is frequent: an export lives for hours, not days,
+	// and its download link must not outlive the TTL by much.
+	defaultVDExportGcSchedule = "*/5 * * * *"
 )
 
 type GCSettings struct {
 	VMOP         BaseGcSettings
 	VMIMigration BaseGcSettings
 	VMPod        BaseGcSettings
+	// VDExportSchedule is the schedule of the VirtualDiskExport cleanup. There
+	// is no TTL setting: every export carries its own TTL.
+	VDExportSchedule string
 }
 
 type BaseGcSettings struct {
@@ -64,6 +72,11 @@ func LoadGcSettings() (GCSettings, error) {
 	}
 	gcSettings.VMPod = base
 
+	gcSettings.VDExportSchedule = defaultVDExportGcSchedule
+	if v, ok := os.LookupEnv(GcVDExportScheduleVar); ok {
+		gcSettings.VDExportSchedule = v
+	}
+
 	return gcSettings, nil
 }
 
@@ -36,6 +36,7 @@ type ImportSettings struct {
 	DiskImporterImage string
 	UploaderImage     string
 	BounderImage      string
+	ExporterImage     string
 	Requirements      corev1.ResourceRequirements
 }
 
@@ -62,6 +63,11 @@ func LoadImportSettingsFromEnv() (ImportSettings, error) {
 		return ImportSettings{}, err
 	}
 
+	settings.ExporterImage, err = GetRequiredEnvVar(common.ExporterPodImageNameVar)
+	if err != nil {
+		return ImportSettings{}, err
+	}
+
 	limits := os.Getenv(ProvisioningPodLimitsVar)
 	if limits != "" {
 		err = json.Unmarshal([]byte(limits), &settings.Requirements.Limits)
@@ -162,6 +162,7 @@ func (ds HTTPDataSource) Sync(ctx context.Context, cvi *v1alpha2.ClusterVirtualI
 		cvi.Status.Phase = v1alpha2.ImageReady
 		cvi.Status.Size = ds.statService.GetSize(pod)
 		cvi.Status.CDROM = ds.statService.GetCDROM(pod)
+		cvi.Status.Inspection = ds.statService.GetInspection(pod)
 		cvi.Status.Format = ds.statService.GetFormat(pod)
 		cvi.Status.Progress = servicestat.ProgressDone
 		cvi.Status.Target.RegistryURL = ds.statService.GetDVCRImageName(pod)
@@ -220,6 +221,8 @@ func (ds HTTPDataSource) getEnvSettings(cvi *v1alpha2.ClusterVirtualImage, supge
 		supgen,
 		ds.dvcrSettings.RegistryImageForCVI(cvi),
 	)
+	importer.ApplyDVCRCompressionSettings(&settings, ds.dvcrSettings, cvi.Spec.Compression)
+	importer.ApplyCustomizationSettings(&settings, cvi.Spec.Customization)
 
 	return &settings
 }
@@ -60,6 +60,7 @@ type Uploader interface {
 type Stat interface {
 	GetFormat(pod *corev1.Pod) string
 	GetCDROM(pod *corev1.Pod) bool
+	GetInspection(pod *corev1.Pod) *v1alpha2.ImageStatusInspection
 	GetSize(pod *corev1.Pod) v1alpha2.ImageStatusSize
 	GetDVCRImageName(pod *corev1.Pod) string
 	GetDownloadSpeed(ownerUID types.UID, pod *corev1.Pod) *v1alpha2.StatusSpeed
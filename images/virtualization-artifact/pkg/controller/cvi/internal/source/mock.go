@@ -996,6 +996,9 @@ var _ Stat = &StatMock{}
 //			GetFormatFunc: func(pod *corev1.Pod) string {
 //				panic("mock out the GetFormat method")
 //			},
+//			GetInspectionFunc: func(pod *corev1.Pod) *v1alpha2.ImageStatusInspection {
+//				panic("mock out the GetInspection method")
+//			},
 //			GetProgressFunc: func(ownerUID types.UID, pod *corev1.Pod, prevProgress string, opts ...servicestat.GetProgressOption) string {
 //				panic("mock out the GetProgress method")
 //			},
@@ -1030,6 +1033,9 @@ type StatMock struct {
 	// GetFormatFunc mocks the GetFormat method.
 	GetFormatFunc func(pod *corev1.Pod) string
 
+	// GetInspectionFunc mocks the GetInspection method.
+	GetInspectionFunc func(pod *corev1.Pod) *v1alpha2.ImageStatusInspection
+
 	// GetProgressFunc mocks the GetProgress method.
 	GetProgressFunc func(ownerUID types.UID, pod *corev1.Pod, prevProgress string, opts ...servicestat.GetProgressOption) string
 
@@ -1071,6 +1077,11 @@ type StatMock struct {
 			// Pod is the pod argument value.
 			Pod *corev1.Pod
 		}
+		// GetInspection holds details about calls to the GetInspection method.
+		GetInspection []struct {
+			// Pod is the pod argument value.
+			Pod *corev1.Pod
+		}
 		// GetProgress holds details about calls to the GetProgress method.
 		GetProgress []struct {
 			// OwnerUID is the ownerUID argument value.
@@ -1109,6 +1120,7 @@ type StatMock struct {
 	lockGetDVCRImageName sync.RWMutex
 	lockGetDownloadSpeed sync.RWMutex
 	lockGetFormat        sync.RWMutex
+	lockGetInspection    sync.RWMutex
 	lockGetProgress      sync.RWMutex
 	lockGetSize          sync.RWMutex
 	lockIsUploadStarted  sync.RWMutex
@@ -1279,6 +1291,38 @@ func (mock *StatMock) GetFormatCalls() []struct {
 	return calls
 }
 
+// GetInspection calls GetInspectionFunc.
+func (mock *StatMock) GetInspection(pod *corev1.Pod) *v1alpha2.ImageStatusInspection {
+	if mock.GetInspectionFunc == nil {
+		panic("StatMock.GetInspectionFunc: method is nil but Stat.GetInspection was just called")
+	}
+	callInfo := struct {
+		Pod *corev1.Pod
+	}{
+		Pod: pod,
+	}
+	mock.lockGetInspection.Lock()
+	mock.calls.GetInspection = append(mock.calls.GetInspection, callInfo)
+	mock.lockGetInspection.Unlock()
+	return mock.GetInspectionFunc(pod)
+}
+
+// GetInspectionCalls gets all the calls that were made to GetInspection.
+// Check the length with:
+//
+//	len(mockedStat.GetInspectionCalls())
+func (mock *StatMock) GetInspectionCalls() []struct {
+	Pod *corev1.Pod
+} {
+	var calls []struct {
+		Pod *corev1.Pod
+	}
+	mock.lockGetInspection.RLock()
+	calls = mock.calls.GetInspection
+	mock.lockGetInspection.RUnlock()
+	return calls
+}
+
 // GetProgress calls GetProgressFunc.
 func (mock *StatMock) GetProgress(ownerUID types.UID, pod *corev1.Pod, prevProgress string, opts ...servicestat.GetProgressOption) string {
 	if mock.GetProgressFunc == nil {
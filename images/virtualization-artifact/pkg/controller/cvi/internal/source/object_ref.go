@@ -241,6 +241,7 @@ func (ds ObjectRefDataSource) Sync(ctx context.Context, cvi *v1alpha2.ClusterVir
 
 		cvi.Status.Size = dvcrDataSource.GetSize()
 		cvi.Status.CDROM = dvcrDataSource.IsCDROM()
+		cvi.Status.Inspection = dvcrDataSource.GetInspection()
 		cvi.Status.Format = dvcrDataSource.GetFormat()
 		cvi.Status.Progress = servicestat.ProgressDone
 		cvi.Status.Target.RegistryURL = ds.statService.GetDVCRImageName(pod)
@@ -156,6 +156,7 @@ func (ds ObjectRefVirtualDisk) Sync(ctx context.Context, cvi *v1alpha2.ClusterVi
 		cvi.Status.Phase = v1alpha2.ImageReady
 		cvi.Status.Size = ds.statService.GetSize(pod)
 		cvi.Status.CDROM = ds.statService.GetCDROM(pod)
+		cvi.Status.Inspection = ds.statService.GetInspection(pod)
 		cvi.Status.Format = ds.statService.GetFormat(pod)
 		cvi.Status.Progress = servicestat.ProgressDone
 		cvi.Status.Target.RegistryURL = ds.statService.GetDVCRImageName(pod)
@@ -216,6 +217,7 @@ func (ds ObjectRefVirtualDisk) getEnvSettings(cvi *v1alpha2.ClusterVirtualImage,
 		sup,
 		ds.dvcrSettings.RegistryImageForCVI(cvi),
 	)
+	importer.ApplyDVCRCompressionSettings(&settings, ds.dvcrSettings, cvi.Spec.Compression)
 
 	return &settings
 }
@@ -246,6 +246,7 @@ func (ds ObjectRefVirtualDiskSnapshot) Sync(ctx context.Context, cvi *v1alpha2.C
 		cvi.Status.Phase = v1alpha2.ImageReady
 		cvi.Status.Size = ds.statService.GetSize(pod)
 		cvi.Status.CDROM = ds.statService.GetCDROM(pod)
+		cvi.Status.Inspection = ds.statService.GetInspection(pod)
 		cvi.Status.Format = ds.statService.GetFormat(pod)
 		cvi.Status.Progress = servicestat.ProgressDone
 		cvi.Status.Target.RegistryURL = ds.statService.GetDVCRImageName(pod)
@@ -346,6 +347,7 @@ func (ds ObjectRefVirtualDiskSnapshot) getEnvSettings(cvi *v1alpha2.ClusterVirtu
 		sup,
 		ds.dvcrSettings.RegistryImageForVI(cvi),
 	)
+	importer.ApplyDVCRCompressionSettings(&settings, ds.dvcrSettings, cvi.Spec.Compression)
 
 	return &settings
 }
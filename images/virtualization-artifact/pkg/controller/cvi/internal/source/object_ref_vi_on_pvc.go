@@ -155,6 +155,7 @@ func (ds ObjectRefVirtualImageOnPvc) Sync(ctx context.Context, cvi *v1alpha2.Clu
 		cvi.Status.Phase = v1alpha2.ImageReady
 		cvi.Status.Size = viRef.Status.Size
 		cvi.Status.CDROM = viRef.Status.CDROM
+		cvi.Status.Inspection = viRef.Status.Inspection
 		cvi.Status.Format = viRef.Status.Format
 		cvi.Status.Progress = servicestat.ProgressDone
 		cvi.Status.Target.RegistryURL = ds.statService.GetDVCRImageName(pod)
@@ -213,6 +214,7 @@ func (ds ObjectRefVirtualImageOnPvc) getEnvSettings(cvi *v1alpha2.ClusterVirtual
 		sup,
 		ds.dvcrSettings.RegistryImageForCVI(cvi),
 	)
+	importer.ApplyDVCRCompressionSettings(&settings, ds.dvcrSettings, cvi.Spec.Compression)
 
 	return &settings
 }
@@ -169,6 +169,7 @@ func (ds RegistryDataSource) Sync(ctx context.Context, cvi *v1alpha2.ClusterVirt
 		cvi.Status.Phase = v1alpha2.ImageReady
 		cvi.Status.Size = ds.statService.GetSize(pod)
 		cvi.Status.CDROM = ds.statService.GetCDROM(pod)
+		cvi.Status.Inspection = ds.statService.GetInspection(pod)
 		cvi.Status.Format = ds.statService.GetFormat(pod)
 		cvi.Status.Progress = servicestat.ProgressDone
 		cvi.Status.Target.RegistryURL = ds.statService.GetDVCRImageName(pod)
@@ -233,12 +234,15 @@ func (ds RegistryDataSource) getEnvSettings(cvi *v1alpha2.ClusterVirtualImage, s
 		CABundle: cvi.Spec.DataSource.ContainerImage.CABundle,
 	}
 	importer.ApplyRegistrySourceSettings(&settings, containerImage, supgen)
+	importer.ApplySignatureVerificationSettings(&settings, ds.dvcrSettings.ImageSignatureVerification(cvi.Spec.DataSource.ContainerImage.Verification))
 	importer.ApplyDVCRDestinationSettings(
 		&settings,
 		ds.dvcrSettings,
 		supgen,
 		ds.dvcrSettings.RegistryImageForCVI(cvi),
 	)
+	importer.ApplyDVCRCompressionSettings(&settings, ds.dvcrSettings, cvi.Spec.Compression)
+	importer.ApplyCustomizationSettings(&settings, cvi.Spec.Customization)
 
 	return &settings
 }
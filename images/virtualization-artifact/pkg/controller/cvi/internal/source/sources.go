@@ -141,6 +141,18 @@ func setPhaseConditionFromPodError(cb *conditions.ConditionBuilder, cvi *v1alpha
 			Reason(cvicondition.ProvisioningNotStarted).
 			Message(service.CapitalizeFirstLetter(err.Error() + "."))
 		return nil
+	case errors.Is(err, servicestat.ErrSignatureVerificationFailed):
+		cb.
+			Status(metav1.ConditionFalse).
+			Reason(cvicondition.SignatureVerificationFailed).
+			Message(service.CapitalizeFirstLetter(err.Error() + "."))
+		return nil
+	case errors.Is(err, servicestat.ErrCustomizationFailed):
+		cb.
+			Status(metav1.ConditionFalse).
+			Reason(cvicondition.CustomizationFailed).
+			Message(service.CapitalizeFirstLetter(err.Error() + "."))
+		return nil
 	case errors.Is(err, servicestat.ErrProvisioningFailed):
 		cb.
 			Status(metav1.ConditionFalse).
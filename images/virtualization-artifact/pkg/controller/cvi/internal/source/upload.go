@@ -213,6 +213,7 @@ func (ds UploadDataSource) Sync(ctx context.Context, cvi *v1alpha2.ClusterVirtua
 		cvi.Status.Phase = v1alpha2.ImageReady
 		cvi.Status.Size = ds.statService.GetSize(pod)
 		cvi.Status.CDROM = ds.statService.GetCDROM(pod)
+		cvi.Status.Inspection = ds.statService.GetInspection(pod)
 		cvi.Status.Format = ds.statService.GetFormat(pod)
 		cvi.Status.Progress = servicestat.ProgressDone
 		cvi.Status.Target.RegistryURL = ds.statService.GetDVCRImageName(pod)
@@ -348,6 +349,7 @@ func (ds UploadDataSource) getEnvSettings(cvi *v1alpha2.ClusterVirtualImage, sup
 		supgen,
 		ds.dvcrSettings.RegistryImageForCVI(cvi),
 	)
+	serviceuploader.ApplyDVCRCompressionSettings(&settings, ds.dvcrSettings, cvi.Spec.Compression)
 
 	serviceuploader.ApplyUploadSourceSettings(&settings, cvi.Spec.DataSource.Upload)
 
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2/cvicatalogcondition"
+)
+
+// ChannelsHandler points every channel of the catalog to a ready version:
+//   - `latest` and the channels without settings follow the newest ready version;
+//   - a pinned channel stays on its version;
+//   - a delayed channel follows the newest version that has been ready for at least the delay.
+type ChannelsHandler struct{}
+
+func NewChannelsHandler() *ChannelsHandler {
+	return &ChannelsHandler{}
+}
+
+func (h ChannelsHandler) Handle(_ context.Context, catalog *v1alpha2.ClusterVirtualImageCatalog) (reconcile.Result, error) {
+	if !catalog.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	cb := conditions.NewConditionBuilder(cvicatalogcondition.ReadyType).Generation(catalog.Generation)
+
+	now := time.Now()
+	var requeueAfter time.Duration
+	var unresolved []string
+
+	channels := append([]v1alpha2.ImageCatalogChannel{{Name: v1alpha2.ImageCatalogChannelLatest}}, catalog.Spec.Channels...)
+	catalog.Status.Channels = make([]v1alpha2.ImageCatalogChannelStatus, 0, len(channels))
+
+	for _, ch := range channels {
+		status, after := resolveChannel(catalog.Status.Versions, ch, now)
+		if after > 0 && (requeueAfter == 0 || after < requeueAfter) {
+			requeueAfter = after
+		}
+
+		if status.ClusterVirtualImage == "" {
+			unresolved = append(unresolved, ch.Name)
+		}
+
+		catalog.Status.Channels = append(catalog.Status.Channels, status)
+	}
+
+	if len(unresolved) > 0 {
+		cb.Status(metav1.ConditionFalse).
+			Reason(cvicatalogcondition.ChannelNotResolved).
+			Message(fmt.Sprintf("Waiting for a ready version of the channels: %s.", strings.Join(unresolved, ", ")))
+	} else {
+		cb.Status(metav1.ConditionTrue).Reason(cvicatalogcondition.Ready).Message("")
+	}
+
+	conditions.SetCondition(cb, &catalog.Status.Conditions)
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// resolveChannel returns the status of the channel and, for a delayed channel, the time until a newer version is due.
+func resolveChannel(versions []v1alpha2.ImageCatalogVersion, ch v1alpha2.ImageCatalogChannel, now time.Time) (v1alpha2.ImageCatalogChannelStatus, time.Duration) {
+	status := v1alpha2.ImageCatalogChannelStatus{Name: ch.Name}
+
+	if ch.Version != "" {
+		status.Version = ch.Version
+		for _, v := range versions {
+			if v.Version == ch.Version && v.Phase == v1alpha2.ImageReady {
+				status.ClusterVirtualImage = v.ClusterVirtualImage
+			}
+		}
+		return status, 0
+	}
+
+	var delay time.Duration
+	if ch.Delay != nil {
+		delay = ch.Delay.Duration
+	}
+
+	var requeueAfter time.Duration
+
+	// The versions are sorted from the newest.
+	for _, v := range versions {
+		if v.Phase != v1alpha2.ImageReady || v.ReadyAt == nil {
+			continue
+		}
+
+		due := v.ReadyAt.Add(delay)
+		if due.After(now) {
+			requeueAfter = due.Sub(now)
+			continue
+		}
+
+		status.Version = v.Version
+		status.ClusterVirtualImage = v.ClusterVirtualImage
+		break
+	}
+
+	return status, requeueAfter
+}
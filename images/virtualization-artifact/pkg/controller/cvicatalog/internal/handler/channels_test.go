@@ -0,0 +1,99 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2/cvicatalogcondition"
+)
+
+var _ = Describe("ChannelsHandler", func() {
+	var catalog *v1alpha2.ClusterVirtualImageCatalog
+
+	readyAt := func(ago time.Duration) *metav1.Time {
+		t := metav1.NewTime(time.Now().Add(-ago))
+		return &t
+	}
+
+	BeforeEach(func() {
+		catalog = &v1alpha2.ClusterVirtualImageCatalog{
+			ObjectMeta: metav1.ObjectMeta{Name: "ubuntu"},
+			Status: v1alpha2.ClusterVirtualImageCatalogStatus{
+				Versions: []v1alpha2.ImageCatalogVersion{
+					{Version: "3", ClusterVirtualImage: "ubuntu-3", Phase: v1alpha2.ImageProvisioning},
+					{Version: "2", ClusterVirtualImage: "ubuntu-2", Phase: v1alpha2.ImageReady, ReadyAt: readyAt(time.Hour)},
+					{Version: "1", ClusterVirtualImage: "ubuntu-1", Phase: v1alpha2.ImageReady, ReadyAt: readyAt(72 * time.Hour)},
+				},
+			},
+		}
+	})
+
+	channel := func(name string) v1alpha2.ImageCatalogChannelStatus {
+		GinkgoHelper()
+		for _, ch := range catalog.Status.Channels {
+			if ch.Name == name {
+				return ch
+			}
+		}
+		Fail("channel " + name + " not found")
+		return v1alpha2.ImageCatalogChannelStatus{}
+	}
+
+	It("should resolve the channels", func() {
+		catalog.Spec.Channels = []v1alpha2.ImageCatalogChannel{
+			{Name: "pinned", Version: "1"},
+			{Name: "stable", Delay: &metav1.Duration{Duration: 24 * time.Hour}},
+			{Name: "follow"},
+		}
+
+		res, err := NewChannelsHandler().Handle(context.Background(), catalog)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(channel(v1alpha2.ImageCatalogChannelLatest).ClusterVirtualImage).To(Equal("ubuntu-2"))
+		Expect(channel("follow").ClusterVirtualImage).To(Equal("ubuntu-2"))
+		Expect(channel("pinned").ClusterVirtualImage).To(Equal("ubuntu-1"))
+		Expect(channel("stable").ClusterVirtualImage).To(Equal("ubuntu-1"))
+
+		// The stable channel moves to the version 2 in 23 hours.
+		Expect(res.RequeueAfter).To(BeNumerically("~", 23*time.Hour, time.Minute))
+
+		ready, _ := conditions.GetCondition(cvicatalogcondition.ReadyType, catalog.Status.Conditions)
+		Expect(ready.Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("should not resolve a channel pinned to a version that is not ready", func() {
+		catalog.Spec.Channels = []v1alpha2.ImageCatalogChannel{{Name: "next", Version: "3"}}
+
+		_, err := NewChannelsHandler().Handle(context.Background(), catalog)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(channel("next").Version).To(Equal("3"))
+		Expect(channel("next").ClusterVirtualImage).To(BeEmpty())
+
+		ready, _ := conditions.GetCondition(cvicatalogcondition.ReadyType, catalog.Status.Conditions)
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal(cvicatalogcondition.ChannelNotResolved.String()))
+	})
+})
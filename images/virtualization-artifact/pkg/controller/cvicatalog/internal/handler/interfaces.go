@@ -0,0 +1,34 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+
+	"github.com/deckhouse/virtualization-controller/pkg/controller/cvicatalog/internal/lister"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+//go:generate go tool moq -rm -out mock.go . RegistryLister HTTPLister
+
+type RegistryLister interface {
+	List(ctx context.Context, src *v1alpha2.ImageCatalogContainerImage) ([]lister.Version, error)
+}
+
+type HTTPLister interface {
+	List(ctx context.Context, src *v1alpha2.ImageCatalogHTTPDirectory) ([]lister.Version, error)
+}
@@ -0,0 +1,284 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/cvicatalog/internal/lister"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/service"
+	"github.com/deckhouse/virtualization-controller/pkg/logger"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2/cvicatalogcondition"
+)
+
+const (
+	defaultCheckInterval = time.Hour
+	defaultHistoryLimit  = 3
+)
+
+// SyncHandler checks the source of the catalog for new versions and keeps a ClusterVirtualImage
+// for each of the last versions, plus the versions the channels still point to.
+type SyncHandler struct {
+	client   client.Client
+	registry RegistryLister
+	http     HTTPLister
+}
+
+func NewSyncHandler(client client.Client, registry RegistryLister, http HTTPLister) *SyncHandler {
+	return &SyncHandler{
+		client:   client,
+		registry: registry,
+		http:     http,
+	}
+}
+
+func (h SyncHandler) Handle(ctx context.Context, catalog *v1alpha2.ClusterVirtualImageCatalog) (reconcile.Result, error) {
+	if !catalog.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	log := logger.FromContext(ctx).With(logger.SlogHandler("sync"))
+
+	cb := conditions.NewConditionBuilder(cvicatalogcondition.SyncedType).Generation(catalog.Generation)
+
+	now := metav1.Now()
+	interval := checkInterval(catalog)
+
+	synced, _ := conditions.GetCondition(cvicatalogcondition.SyncedType, catalog.Status.Conditions)
+	if catalog.Status.LastCheckTime == nil || synced.ObservedGeneration != catalog.Generation || !now.Before(&metav1.Time{Time: catalog.Status.LastCheckTime.Add(interval)}) {
+		found, err := h.list(ctx, catalog)
+		catalog.Status.LastCheckTime = &now
+
+		switch {
+		case err == nil:
+			catalog.Status.Versions = mergeVersions(catalog, found, now)
+			cb.Status(metav1.ConditionTrue).Reason(cvicatalogcondition.Synced).Message("")
+		case errors.Is(err, lister.ErrInvalidPattern):
+			cb.Status(metav1.ConditionFalse).Reason(cvicatalogcondition.InvalidPattern).Message(service.CapitalizeFirstLetter(err.Error()) + ".")
+		default:
+			log.Error("Failed to check the source for new versions", logger.SlogErr(err))
+			cb.Status(metav1.ConditionFalse).Reason(cvicatalogcondition.SourceCheckFailed).Message(service.CapitalizeFirstLetter(err.Error()) + ".")
+		}
+
+		conditions.SetCondition(cb, &catalog.Status.Conditions)
+	}
+
+	catalog.Status.Versions = pruneVersions(catalog)
+
+	err := h.syncImages(ctx, catalog)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: catalog.Status.LastCheckTime.Add(interval).Sub(now.Time)}, nil
+}
+
+func (h SyncHandler) list(ctx context.Context, catalog *v1alpha2.ClusterVirtualImageCatalog) ([]lister.Version, error) {
+	src := catalog.Spec.Source
+
+	switch {
+	case src.Type == v1alpha2.ImageCatalogSourceTypeContainerImage && src.ContainerImage != nil:
+		return h.registry.List(ctx, src.ContainerImage)
+	case src.Type == v1alpha2.ImageCatalogSourceTypeHTTP && src.HTTP != nil:
+		return h.http.List(ctx, src.HTTP)
+	default:
+		return nil, fmt.Errorf("the source of the %s type is not specified", src.Type)
+	}
+}
+
+// syncImages creates the missing images, tracks the phase of the existing ones and deletes the images of the pruned versions.
+func (h SyncHandler) syncImages(ctx context.Context, catalog *v1alpha2.ClusterVirtualImageCatalog) error {
+	var cvis v1alpha2.ClusterVirtualImageList
+	err := h.client.List(ctx, &cvis, client.MatchingLabels{annotations.LabelImageCatalog: catalog.Name})
+	if err != nil {
+		return fmt.Errorf("list images of the catalog: %w", err)
+	}
+
+	existing := make(map[string]*v1alpha2.ClusterVirtualImage, len(cvis.Items))
+	for i := range cvis.Items {
+		existing[cvis.Items[i].Name] = &cvis.Items[i]
+	}
+
+	for i := range catalog.Status.Versions {
+		version := &catalog.Status.Versions[i]
+
+		cvi, ok := existing[version.ClusterVirtualImage]
+		delete(existing, version.ClusterVirtualImage)
+
+		if !ok {
+			err = h.client.Create(ctx, newImage(catalog, version))
+			if err != nil && !k8serrors.IsAlreadyExists(err) {
+				return fmt.Errorf("create image %q: %w", version.ClusterVirtualImage, err)
+			}
+			version.Phase = v1alpha2.ImagePending
+			continue
+		}
+
+		version.Phase = cvi.Status.Phase
+		if version.Phase == v1alpha2.ImageReady && version.ReadyAt == nil {
+			now := metav1.Now()
+			version.ReadyAt = &now
+		}
+	}
+
+	for _, cvi := range existing {
+		if !cvi.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		err = h.client.Delete(ctx, cvi)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return fmt.Errorf("delete image %q: %w", cvi.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func newImage(catalog *v1alpha2.ClusterVirtualImageCatalog, version *v1alpha2.ImageCatalogVersion) *v1alpha2.ClusterVirtualImage {
+	cvi := &v1alpha2.ClusterVirtualImage{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: version.ClusterVirtualImage,
+			Labels: map[string]string{
+				annotations.LabelImageCatalog: catalog.Name,
+			},
+			Annotations: map[string]string{
+				annotations.AnnImageCatalogVersion: version.Version,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(catalog, v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.ClusterVirtualImageCatalogKind)),
+			},
+		},
+		Spec: v1alpha2.ClusterVirtualImageSpec{
+			Compression: catalog.Spec.Compression,
+		},
+	}
+
+	src := catalog.Spec.Source
+	switch src.Type {
+	case v1alpha2.ImageCatalogSourceTypeContainerImage:
+		cvi.Spec.DataSource = v1alpha2.ClusterVirtualImageDataSource{
+			Type: v1alpha2.DataSourceTypeContainerImage,
+			ContainerImage: &v1alpha2.ClusterVirtualImageContainerImage{
+				Image:           version.Source,
+				ImagePullSecret: src.ContainerImage.ImagePullSecret,
+				CABundle:        src.ContainerImage.CABundle,
+			},
+		}
+	case v1alpha2.ImageCatalogSourceTypeHTTP:
+		cvi.Spec.DataSource = v1alpha2.ClusterVirtualImageDataSource{
+			Type: v1alpha2.DataSourceTypeHTTP,
+			HTTP: &v1alpha2.DataSourceHTTP{
+				URL:      version.Source,
+				CABundle: src.HTTP.CABundle,
+			},
+		}
+	}
+
+	return cvi
+}
+
+// mergeVersions adds the found versions to the known ones. Known versions are never replaced:
+// an image once imported for a version stays the same.
+func mergeVersions(catalog *v1alpha2.ClusterVirtualImageCatalog, found []lister.Version, now metav1.Time) []v1alpha2.ImageCatalogVersion {
+	versions := slices.Clone(catalog.Status.Versions)
+
+	for _, v := range found {
+		if slices.ContainsFunc(versions, func(known v1alpha2.ImageCatalogVersion) bool { return known.Version == v.Version }) {
+			continue
+		}
+
+		versions = append(versions, v1alpha2.ImageCatalogVersion{
+			Version:             v.Version,
+			Source:              v.Source,
+			ClusterVirtualImage: ImageName(catalog.Name, v.Version),
+			DiscoveredAt:        now,
+		})
+	}
+
+	slices.SortStableFunc(versions, func(a, b v1alpha2.ImageCatalogVersion) int {
+		return -lister.CompareVersions(a.Version, b.Version)
+	})
+
+	return versions
+}
+
+// pruneVersions keeps the last historyLimit versions and the versions the channels point to or are pinned to.
+func pruneVersions(catalog *v1alpha2.ClusterVirtualImageCatalog) []v1alpha2.ImageCatalogVersion {
+	limit := int(catalog.Spec.HistoryLimit)
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+
+	inUse := make(map[string]struct{})
+	for _, ch := range catalog.Spec.Channels {
+		if ch.Version != "" {
+			inUse[ch.Version] = struct{}{}
+		}
+	}
+	for _, ch := range catalog.Status.Channels {
+		if ch.Version != "" {
+			inUse[ch.Version] = struct{}{}
+		}
+	}
+
+	var versions []v1alpha2.ImageCatalogVersion
+	for i, v := range catalog.Status.Versions {
+		if _, ok := inUse[v.Version]; i < limit || ok {
+			versions = append(versions, v)
+		}
+	}
+
+	return versions
+}
+
+func checkInterval(catalog *v1alpha2.ClusterVirtualImageCatalog) time.Duration {
+	if catalog.Spec.CheckInterval.Duration <= 0 {
+		return defaultCheckInterval
+	}
+
+	return catalog.Spec.CheckInterval.Duration
+}
+
+// ImageName returns the name of the ClusterVirtualImage for the version of the catalog.
+func ImageName(catalog, version string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '.':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r - 'A' + 'a'
+		default:
+			return '-'
+		}
+	}, version)
+
+	return catalog + "-" + strings.Trim(name, "-.")
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization-controller/pkg/common/testutil"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/cvicatalog/internal/lister"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2/cvicatalogcondition"
+)
+
+var _ = Describe("SyncHandler", func() {
+	var (
+		ctx      context.Context
+		catalog  *v1alpha2.ClusterVirtualImageCatalog
+		registry *RegistryListerMock
+		found    []lister.Version
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.ContextBackgroundWithNoOpLogger()
+		catalog = &v1alpha2.ClusterVirtualImageCatalog{
+			ObjectMeta: metav1.ObjectMeta{Name: "ubuntu", UID: "uid", Generation: 1},
+			Spec: v1alpha2.ClusterVirtualImageCatalogSpec{
+				Source: v1alpha2.ImageCatalogSource{
+					Type: v1alpha2.ImageCatalogSourceTypeContainerImage,
+					ContainerImage: &v1alpha2.ImageCatalogContainerImage{
+						Repository: "registry.example.com/ubuntu",
+					},
+				},
+				CheckInterval: metav1.Duration{Duration: time.Hour},
+				HistoryLimit:  2,
+				Compression:   v1alpha2.ImageCompressionZstd,
+			},
+		}
+		found = []lister.Version{
+			{Version: "24.04.3", Source: "registry.example.com/ubuntu:24.04.3"},
+			{Version: "24.04.2", Source: "registry.example.com/ubuntu:24.04.2"},
+			{Version: "24.04.1", Source: "registry.example.com/ubuntu:24.04.1"},
+		}
+		registry = &RegistryListerMock{
+			ListFunc: func(_ context.Context, _ *v1alpha2.ImageCatalogContainerImage) ([]lister.Version, error) {
+				return found, nil
+			},
+		}
+	})
+
+	handle := func(objs ...client.Object) client.Client {
+		GinkgoHelper()
+		fakeClient, err := testutil.NewFakeClientWithObjects(objs...)
+		Expect(err).NotTo(HaveOccurred())
+
+		res, err := NewSyncHandler(fakeClient, registry, &HTTPListerMock{}).Handle(ctx, catalog)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.RequeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+
+		return fakeClient
+	}
+
+	listImages := func(c client.Client) []v1alpha2.ClusterVirtualImage {
+		GinkgoHelper()
+		var cvis v1alpha2.ClusterVirtualImageList
+		Expect(c.List(ctx, &cvis)).To(Succeed())
+		return cvis.Items
+	}
+
+	It("should create images for the last versions", func() {
+		c := handle()
+
+		Expect(catalog.Status.Versions).To(HaveLen(2))
+		Expect(catalog.Status.Versions[0].Version).To(Equal("24.04.3"))
+		Expect(catalog.Status.Versions[0].ClusterVirtualImage).To(Equal("ubuntu-24.04.3"))
+		Expect(catalog.Status.Versions[1].Version).To(Equal("24.04.2"))
+		Expect(catalog.Status.LastCheckTime).NotTo(BeNil())
+
+		synced, _ := conditions.GetCondition(cvicatalogcondition.SyncedType, catalog.Status.Conditions)
+		Expect(synced.Status).To(Equal(metav1.ConditionTrue))
+
+		cvis := listImages(c)
+		Expect(cvis).To(HaveLen(2))
+		for _, cvi := range cvis {
+			Expect(cvi.Labels).To(HaveKeyWithValue(annotations.LabelImageCatalog, "ubuntu"))
+			Expect(cvi.OwnerReferences).To(HaveLen(1))
+			Expect(cvi.Spec.Compression).To(Equal(v1alpha2.ImageCompressionZstd))
+			Expect(cvi.Spec.DataSource.Type).To(Equal(v1alpha2.DataSourceTypeContainerImage))
+			Expect(cvi.Spec.DataSource.ContainerImage.Image).To(Equal("registry.example.com/ubuntu:" + cvi.Annotations[annotations.AnnImageCatalogVersion]))
+		}
+	})
+
+	It("should keep the versions the channels point to and delete the others", func() {
+		catalog.Spec.Channels = []v1alpha2.ImageCatalogChannel{{Name: "pinned", Version: "24.04.1"}}
+		catalog.Status.Channels = []v1alpha2.ImageCatalogChannelStatus{{Name: "stable", Version: "24.04.0"}}
+
+		old := &v1alpha2.ClusterVirtualImage{ObjectMeta: metav1.ObjectMeta{
+			Name:   "ubuntu-23.10",
+			Labels: map[string]string{annotations.LabelImageCatalog: "ubuntu"},
+		}}
+		catalog.Status.Versions = []v1alpha2.ImageCatalogVersion{
+			{Version: "24.04.0", ClusterVirtualImage: "ubuntu-24.04.0"},
+			{Version: "23.10", ClusterVirtualImage: "ubuntu-23.10"},
+		}
+
+		c := handle(old)
+
+		var versions []string
+		for _, v := range catalog.Status.Versions {
+			versions = append(versions, v.Version)
+		}
+		Expect(versions).To(Equal([]string{"24.04.3", "24.04.2", "24.04.1", "24.04.0"}))
+
+		var names []string
+		for _, cvi := range listImages(c) {
+			names = append(names, cvi.Name)
+		}
+		Expect(names).To(ConsistOf("ubuntu-24.04.3", "ubuntu-24.04.2", "ubuntu-24.04.1", "ubuntu-24.04.0"))
+	})
+
+	It("should track the phase of the images", func() {
+		cvi := &v1alpha2.ClusterVirtualImage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "ubuntu-24.04.3",
+				Labels: map[string]string{annotations.LabelImageCatalog: "ubuntu"},
+			},
+			Status: v1alpha2.ClusterVirtualImageStatus{Phase: v1alpha2.ImageReady},
+		}
+
+		handle(cvi)
+
+		Expect(catalog.Status.Versions[0].Phase).To(Equal(v1alpha2.ImageReady))
+		Expect(catalog.Status.Versions[0].ReadyAt).NotTo(BeNil())
+		Expect(catalog.Status.Versions[1].Phase).To(Equal(v1alpha2.ImagePending))
+		Expect(catalog.Status.Versions[1].ReadyAt).To(BeNil())
+	})
+
+	It("should not check the source before the interval", func() {
+		lastCheck := metav1.NewTime(time.Now().Add(-time.Minute))
+		catalog.Status.LastCheckTime = &lastCheck
+		catalog.Status.Conditions = []metav1.Condition{{
+			Type:               cvicatalogcondition.SyncedType.String(),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: catalog.Generation,
+		}}
+
+		fakeClient, err := testutil.NewFakeClientWithObjects()
+		Expect(err).NotTo(HaveOccurred())
+
+		res, err := NewSyncHandler(fakeClient, registry, &HTTPListerMock{}).Handle(ctx, catalog)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(registry.ListCalls()).To(BeEmpty())
+		Expect(res.RequeueAfter).To(BeNumerically("~", 59*time.Minute, time.Minute))
+	})
+
+	DescribeTable("should report the failed check",
+		func(err error, reason cvicatalogcondition.SyncedReason) {
+			catalog.Status.Versions = []v1alpha2.ImageCatalogVersion{{Version: "24.04.0", ClusterVirtualImage: "ubuntu-24.04.0"}}
+			registry.ListFunc = func(_ context.Context, _ *v1alpha2.ImageCatalogContainerImage) ([]lister.Version, error) {
+				return nil, err
+			}
+
+			handle()
+
+			synced, _ := conditions.GetCondition(cvicatalogcondition.SyncedType, catalog.Status.Conditions)
+			Expect(synced.Status).To(Equal(metav1.ConditionFalse))
+			Expect(synced.Reason).To(Equal(reason.String()))
+			Expect(catalog.Status.Versions).To(HaveLen(1))
+		},
+		Entry("invalid pattern", lister.ErrInvalidPattern, cvicatalogcondition.InvalidPattern),
+		Entry("unavailable source", errors.New("connection refused"), cvicatalogcondition.SourceCheckFailed),
+	)
+})
+
+var _ = Describe("ImageName", func() {
+	DescribeTable("should make a valid name",
+		func(version, expected string) {
+			Expect(ImageName("ubuntu", version)).To(Equal(expected))
+		},
+		Entry("plain", "24.04.1", "ubuntu-24.04.1"),
+		Entry("upper case and underscores", "V1_2", "ubuntu-v1-2"),
+		Entry("file name", "noble-server-cloudimg-amd64.img", "ubuntu-noble-server-cloudimg-amd64.img"),
+	)
+})
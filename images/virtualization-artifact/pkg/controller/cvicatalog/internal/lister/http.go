@@ -0,0 +1,109 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lister
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+// maxIndexSize limits the size of the directory index page.
+const maxIndexSize = 16 << 20
+
+var hrefRegexp = regexp.MustCompile(`(?i)href\s*=\s*["']([^"'#?]+)`)
+
+// HTTPLister lists the files of an HTTP directory index, as served by nginx, Apache and most mirrors.
+type HTTPLister struct{}
+
+func NewHTTPLister() *HTTPLister {
+	return &HTTPLister{}
+}
+
+func (l HTTPLister) List(ctx context.Context, src *v1alpha2.ImageCatalogHTTPDirectory) ([]Version, error) {
+	re, err := compilePattern(src.FilePattern)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := url.Parse(src.URL)
+	if err != nil {
+		return nil, fmt.Errorf("parse url %q: %w", src.URL, err)
+	}
+
+	// The URL is a directory: the links of the index are relative to it.
+	if !strings.HasSuffix(base.Path, "/") {
+		base.Path += "/"
+	}
+
+	transport, err := newTransport(src.CABundle)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, base.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("get %q: %w", src.URL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get %q: unexpected status %s", src.URL, resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxIndexSize))
+	if err != nil {
+		return nil, fmt.Errorf("read %q: %w", src.URL, err)
+	}
+
+	return SortVersions(parseIndex(base, body, re)), nil
+}
+
+func parseIndex(base *url.URL, body []byte, re *regexp.Regexp) []Version {
+	var versions []Version
+	for _, match := range hrefRegexp.FindAllSubmatch(body, -1) {
+		ref, err := url.Parse(string(match[1]))
+		if err != nil {
+			continue
+		}
+
+		file := base.ResolveReference(ref)
+		version, ok := matchVersion(re, path.Base(file.Path))
+		if !ok {
+			continue
+		}
+
+		versions = append(versions, Version{
+			Version: version,
+			Source:  file.String(),
+		})
+	}
+
+	return versions
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lister
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+func TestLister(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ClusterVirtualImageCatalog lister Suite")
+}
+
+var _ = Describe("CompareVersions", func() {
+	DescribeTable("should compare versions naturally",
+		func(a, b string, expected int) {
+			Expect(CompareVersions(a, b)).To(Equal(expected))
+			Expect(CompareVersions(b, a)).To(Equal(-expected))
+		},
+		Entry("equal", "1.2.3", "1.2.3", 0),
+		Entry("numbers, not strings", "1.10", "1.9", 1),
+		Entry("leading zeros", "24.04", "24.4", 0),
+		Entry("longer is newer", "1.0.1", "1.0", 1),
+		Entry("release is newer than a suffix", "1.0.1", "1.0-rc1", 1),
+		Entry("dates", "20250101", "20241231", 1),
+		Entry("letters", "v2b", "v2a", 1),
+	)
+
+	It("should sort versions from the newest and drop duplicates", func() {
+		versions := SortVersions([]Version{
+			{Version: "1.9"}, {Version: "1.10"}, {Version: "1.2"}, {Version: "1.10"},
+		})
+		Expect(versions).To(Equal([]Version{{Version: "1.10"}, {Version: "1.9"}, {Version: "1.2"}}))
+	})
+})
+
+var _ = Describe("HTTPLister", func() {
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/images/" {
+				http.NotFound(w, r)
+				return
+			}
+			_, _ = w.Write([]byte(`<html><body>
+<a href="../">../</a>
+<a href="ubuntu-24.04.1.img">ubuntu-24.04.1.img</a>
+<a href="ubuntu-24.04.10.img">ubuntu-24.04.10.img</a>
+<a href="ubuntu-24.04.2.img">ubuntu-24.04.2.img</a>
+<a href="ubuntu-24.04.2.img.sha256">ubuntu-24.04.2.img.sha256</a>
+<a href="/other/ubuntu-22.04.img">ubuntu-22.04.img</a>
+</body></html>`))
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should list the files matching the pattern", func() {
+		versions, err := NewHTTPLister().List(context.Background(), &v1alpha2.ImageCatalogHTTPDirectory{
+			URL:         server.URL + "/images",
+			FilePattern: `ubuntu-([0-9.]+)\.img`,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(versions).To(Equal([]Version{
+			{Version: "24.04.10", Source: server.URL + "/images/ubuntu-24.04.10.img"},
+			{Version: "24.04.2", Source: server.URL + "/images/ubuntu-24.04.2.img"},
+			{Version: "24.04.1", Source: server.URL + "/images/ubuntu-24.04.1.img"},
+			{Version: "22.04", Source: server.URL + "/other/ubuntu-22.04.img"},
+		}))
+	})
+
+	It("should use the whole file name without a capture group", func() {
+		versions, err := NewHTTPLister().List(context.Background(), &v1alpha2.ImageCatalogHTTPDirectory{
+			URL:         server.URL + "/images/",
+			FilePattern: `ubuntu-24\.04\.1\.img`,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(versions).To(Equal([]Version{
+			{Version: "ubuntu-24.04.1.img", Source: server.URL + "/images/ubuntu-24.04.1.img"},
+		}))
+	})
+
+	It("should report an invalid pattern", func() {
+		_, err := NewHTTPLister().List(context.Background(), &v1alpha2.ImageCatalogHTTPDirectory{
+			URL:         server.URL + "/images/",
+			FilePattern: `ubuntu-(`,
+		})
+		Expect(err).To(MatchError(ErrInvalidPattern))
+	})
+
+	It("should fail on an unexpected status", func() {
+		_, err := NewHTTPLister().List(context.Background(), &v1alpha2.ImageCatalogHTTPDirectory{
+			URL:         server.URL + "/missing/",
+			FilePattern: `.*`,
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
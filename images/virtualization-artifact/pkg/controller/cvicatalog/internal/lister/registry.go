@@ -0,0 +1,102 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lister
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-containerregistry/pkg/authn/kubernetes"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+// RegistryLister lists the tags of a container image repository.
+type RegistryLister struct {
+	client client.Client
+}
+
+func NewRegistryLister(client client.Client) *RegistryLister {
+	return &RegistryLister{client: client}
+}
+
+func (l RegistryLister) List(ctx context.Context, src *v1alpha2.ImageCatalogContainerImage) ([]Version, error) {
+	pattern := src.TagPattern
+	if pattern == "" {
+		pattern = ".+"
+	}
+
+	re, err := compilePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := name.NewRepository(src.Repository)
+	if err != nil {
+		return nil, fmt.Errorf("parse repository %q: %w", src.Repository, err)
+	}
+
+	opts := []remote.Option{remote.WithContext(ctx)}
+
+	if src.ImagePullSecret.Name != "" {
+		key := types.NamespacedName{Name: src.ImagePullSecret.Name, Namespace: src.ImagePullSecret.Namespace}
+
+		var secret corev1.Secret
+		err = l.client.Get(ctx, key, &secret)
+		if err != nil {
+			return nil, fmt.Errorf("get image pull secret %s: %w", key, err)
+		}
+
+		keychain, err := kubernetes.NewFromPullSecrets(ctx, []corev1.Secret{secret})
+		if err != nil {
+			return nil, fmt.Errorf("create keychain from image pull secret: %w", err)
+		}
+
+		opts = append(opts, remote.WithAuthFromKeychain(keychain))
+	}
+
+	transport, err := newTransport(src.CABundle)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, remote.WithTransport(transport))
+
+	tags, err := remote.List(repo, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("list tags of %q: %w", src.Repository, err)
+	}
+
+	var versions []Version
+	for _, tag := range tags {
+		version, ok := matchVersion(re, tag)
+		if !ok {
+			continue
+		}
+
+		versions = append(versions, Version{
+			Version: version,
+			Source:  repo.Tag(tag).String(),
+		})
+	}
+
+	return SortVersions(versions), nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lister
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidPattern is returned when the tag or file pattern of the catalog is not a valid regular expression.
+var ErrInvalidPattern = errors.New("invalid pattern")
+
+// Version is a version of the image found in the source of the catalog.
+type Version struct {
+	// Version is the version as it is shown in the catalog status.
+	Version string
+	// Source is the container image or URL of the version.
+	Source string
+}
+
+// compilePattern compiles the pattern matching the whole tag or file name.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrInvalidPattern, pattern, err)
+	}
+
+	return re, nil
+}
+
+// matchVersion returns the version for the name matched by the pattern:
+// the first capture group if the pattern has one, or the whole name otherwise.
+func matchVersion(re *regexp.Regexp, name string) (string, bool) {
+	match := re.FindStringSubmatch(name)
+	if match == nil {
+		return "", false
+	}
+
+	if len(match) > 1 && match[1] != "" {
+		return match[1], true
+	}
+
+	return name, true
+}
+
+// SortVersions sorts the versions from the newest to the oldest and drops duplicates.
+// The versions are compared naturally: runs of digits are compared as numbers, so 1.10 is newer than 1.9.
+func SortVersions(versions []Version) []Version {
+	slices.SortStableFunc(versions, func(a, b Version) int {
+		return -CompareVersions(a.Version, b.Version)
+	})
+
+	return slices.CompactFunc(versions, func(a, b Version) bool {
+		return a.Version == b.Version
+	})
+}
+
+// CompareVersions compares two versions naturally and returns -1, 0 or 1.
+func CompareVersions(a, b string) int {
+	for a != "" && b != "" {
+		var chunkA, chunkB string
+		chunkA, a = nextChunk(a)
+		chunkB, b = nextChunk(b)
+
+		digitsA := isDigit(chunkA[0])
+		digitsB := isDigit(chunkB[0])
+
+		switch {
+		case digitsA && digitsB:
+			chunkA = strings.TrimLeft(chunkA, "0")
+			chunkB = strings.TrimLeft(chunkB, "0")
+			if len(chunkA) != len(chunkB) {
+				if len(chunkA) < len(chunkB) {
+					return -1
+				}
+				return 1
+			}
+			if c := strings.Compare(chunkA, chunkB); c != 0 {
+				return c
+			}
+		case digitsA != digitsB:
+			// A number is newer than a suffix: 1.0.1 > 1.0-rc1.
+			if digitsA {
+				return 1
+			}
+			return -1
+		default:
+			if c := strings.Compare(chunkA, chunkB); c != 0 {
+				return c
+			}
+		}
+	}
+
+	// The longer version is newer: 1.0.1 > 1.0.
+	switch {
+	case a == "" && b == "":
+		return 0
+	case a == "":
+		return -1
+	default:
+		return 1
+	}
+}
+
+func nextChunk(s string) (string, string) {
+	digits := isDigit(s[0])
+	i := 1
+	for i < len(s) && isDigit(s[i]) == digits {
+		i++
+	}
+
+	return s[:i], s[i:]
+}
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+func newTransport(caBundle []byte) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if len(caBundle) > 0 {
+		certPool, err := x509.SystemCertPool()
+		if err != nil {
+			certPool = x509.NewCertPool()
+		}
+		if !certPool.AppendCertsFromPEM(caBundle) {
+			return nil, errors.New("failed to append CA bundle to pool")
+		}
+
+		transport.TLSClientConfig = &tls.Config{RootCAs: certPool}
+	}
+
+	return transport, nil
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watcher
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+func NewClusterVirtualImageWatcher() *ClusterVirtualImageWatcher {
+	return &ClusterVirtualImageWatcher{}
+}
+
+// ClusterVirtualImageWatcher follows the images of the catalog: the channels move when a new version becomes Ready.
+type ClusterVirtualImageWatcher struct{}
+
+func (w ClusterVirtualImageWatcher) Watch(mgr manager.Manager, ctr controller.Controller) error {
+	if err := ctr.Watch(
+		source.Kind(
+			mgr.GetCache(),
+			&v1alpha2.ClusterVirtualImage{},
+			handler.TypedEnqueueRequestForOwner[*v1alpha2.ClusterVirtualImage](
+				mgr.GetScheme(),
+				mgr.GetRESTMapper(),
+				&v1alpha2.ClusterVirtualImageCatalog{},
+				handler.OnlyControllerOwner(),
+			),
+			predicate.TypedFuncs[*v1alpha2.ClusterVirtualImage]{
+				UpdateFunc: func(e event.TypedUpdateEvent[*v1alpha2.ClusterVirtualImage]) bool {
+					return e.ObjectOld.Status.Phase != e.ObjectNew.Status.Phase
+				},
+			},
+		),
+	); err != nil {
+		return fmt.Errorf("error setting watch on ClusterVirtualImage: %w", err)
+	}
+	return nil
+}
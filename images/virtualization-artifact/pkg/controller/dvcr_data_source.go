@@ -30,12 +30,13 @@ import (
 )
 
 type DVCRDataSource struct {
-	size    v1alpha2.ImageStatusSize
-	meta    metav1.Object
-	uid     types.UID
-	format  string
-	target  string
-	isReady bool
+	size       v1alpha2.ImageStatusSize
+	meta       metav1.Object
+	uid        types.UID
+	format     string
+	inspection *v1alpha2.ImageStatusInspection
+	target     string
+	isReady    bool
 }
 
 func NewDVCRDataSourcesForCVMI(ctx context.Context, ds v1alpha2.ClusterVirtualImageDataSource, client client.Client) (DVCRDataSource, error) {
@@ -59,6 +60,7 @@ func NewDVCRDataSourcesForCVMI(ctx context.Context, ds v1alpha2.ClusterVirtualIm
 				dsDVCR.uid = vmi.UID
 				dsDVCR.size = vmi.Status.Size
 				dsDVCR.format = vmi.Status.Format
+				dsDVCR.inspection = vmi.Status.Inspection
 				dsDVCR.meta = vmi.GetObjectMeta()
 				dsDVCR.isReady = vmi.Status.Phase == v1alpha2.ImageReady
 				dsDVCR.target = vmi.Status.Target.RegistryURL
@@ -77,6 +79,7 @@ func NewDVCRDataSourcesForCVMI(ctx context.Context, ds v1alpha2.ClusterVirtualIm
 				dsDVCR.size = cvmi.Status.Size
 				dsDVCR.meta = cvmi.GetObjectMeta()
 				dsDVCR.format = cvmi.Status.Format
+				dsDVCR.inspection = cvmi.Status.Inspection
 				dsDVCR.isReady = cvmi.Status.Phase == v1alpha2.ImageReady
 				dsDVCR.target = cvmi.Status.Target.RegistryURL
 			}
@@ -111,6 +114,7 @@ func NewDVCRDataSourcesForVMI(ctx context.Context, ds v1alpha2.VirtualImageDataS
 				dsDVCR.uid = vmi.UID
 				dsDVCR.size = vmi.Status.Size
 				dsDVCR.format = vmi.Status.Format
+				dsDVCR.inspection = vmi.Status.Inspection
 				dsDVCR.meta = vmi.GetObjectMeta()
 				dsDVCR.isReady = vmi.Status.Phase == v1alpha2.ImageReady
 				dsDVCR.target = vmi.Status.Target.RegistryURL
@@ -129,6 +133,7 @@ func NewDVCRDataSourcesForVMI(ctx context.Context, ds v1alpha2.VirtualImageDataS
 				dsDVCR.size = cvmi.Status.Size
 				dsDVCR.meta = cvmi.GetObjectMeta()
 				dsDVCR.format = cvmi.Status.Format
+				dsDVCR.inspection = cvmi.Status.Inspection
 				dsDVCR.isReady = cvmi.Status.Phase == v1alpha2.ImageReady
 				dsDVCR.target = cvmi.Status.Target.RegistryURL
 			}
@@ -159,6 +164,7 @@ func NewDVCRDataSourcesForVMD(ctx context.Context, ds *v1alpha2.VirtualDiskDataS
 				dsDVCR.uid = vmi.UID
 				dsDVCR.size = vmi.Status.Size
 				dsDVCR.format = vmi.Status.Format
+				dsDVCR.inspection = vmi.Status.Inspection
 				dsDVCR.meta = vmi.GetObjectMeta()
 				dsDVCR.isReady = vmi.Status.Phase == v1alpha2.ImageReady
 				dsDVCR.target = vmi.Status.Target.RegistryURL
@@ -177,6 +183,7 @@ func NewDVCRDataSourcesForVMD(ctx context.Context, ds *v1alpha2.VirtualDiskDataS
 				dsDVCR.size = cvmi.Status.Size
 				dsDVCR.meta = cvmi.GetObjectMeta()
 				dsDVCR.format = cvmi.Status.Format
+				dsDVCR.inspection = cvmi.Status.Inspection
 				dsDVCR.isReady = cvmi.Status.Phase == v1alpha2.ImageReady
 				dsDVCR.target = cvmi.Status.Target.RegistryURL
 			}
@@ -210,6 +217,10 @@ func (ds *DVCRDataSource) GetFormat() string {
 	return ds.format
 }
 
+func (ds *DVCRDataSource) GetInspection() *v1alpha2.ImageStatusInspection {
+	return ds.inspection
+}
+
 func (ds *DVCRDataSource) IsReady() bool {
 	return ds.isReady
 }
@@ -18,7 +18,9 @@ package importer
 
 import (
 	"context"
+	"encoding/json"
 	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -153,6 +155,10 @@ func (imp *Importer) makeImporterPodSpec() (*corev1.Pod, error) {
 		}
 	}
 
+	if imp.EnvSettings.CacheFillKey != "" {
+		pod.Labels[annotations.LabelDVCRCacheFill] = imp.EnvSettings.CacheFillKey
+	}
+
 	container := imp.makeImporterContainerSpec()
 	imp.addVolumes(&pod, container)
 	pod.Spec.Containers = append(pod.Spec.Containers, *container)
@@ -271,6 +277,20 @@ func (imp *Importer) makeImporterContainerEnv() []corev1.EnvVar {
 		},
 	}...)
 
+	if imp.EnvSettings.DestinationCompression != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  common.DestinationCompressionVar,
+			Value: imp.EnvSettings.DestinationCompression,
+		})
+	}
+
+	if imp.EnvSettings.DestinationChunked {
+		env = append(env, corev1.EnvVar{
+			Name:  common.DestinationChunkedVar,
+			Value: strconv.FormatBool(imp.EnvSettings.DestinationChunked),
+		})
+	}
+
 	// HTTP source checksum settings.
 	if checksums := datasource.FormatChecksums(imp.EnvSettings.Checksums); checksums != "" {
 		env = append(env, corev1.EnvVar{
@@ -279,6 +299,37 @@ func (imp *Importer) makeImporterContainerEnv() []corev1.EnvVar {
 		})
 	}
 
+	// Registry source pull-through cache settings.
+	if imp.EnvSettings.CacheImage != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  common.ImporterCacheImage,
+			Value: imp.EnvSettings.CacheImage,
+		}, corev1.EnvVar{
+			Name:  common.ImporterCacheFill,
+			Value: strconv.FormatBool(imp.EnvSettings.CacheFill),
+		})
+	}
+
+	// Registry source signature verification settings.
+	if len(imp.EnvSettings.TrustedKeys) > 0 {
+		env = append(env, corev1.EnvVar{
+			Name:  common.ImporterTrustedKeys,
+			Value: strings.Join(imp.EnvSettings.TrustedKeys, "\n"),
+		}, corev1.EnvVar{
+			Name:  common.ImporterSignatureRequired,
+			Value: strconv.FormatBool(imp.EnvSettings.SignatureRequired),
+		})
+	}
+
+	if imp.EnvSettings.Customization != nil {
+		// The customization consists of strings only, so encoding it cannot fail.
+		customization, _ := json.Marshal(imp.EnvSettings.Customization)
+		env = append(env, corev1.EnvVar{
+			Name:  common.ImporterCustomization,
+			Value: string(customization),
+		})
+	}
+
 	// Pass basic auth configuration from Secret with downward API.
 	if imp.EnvSettings.SecretName != "" {
 		env = append(env, corev1.EnvVar{
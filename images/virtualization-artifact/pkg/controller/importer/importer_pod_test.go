@@ -23,6 +23,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common"
 )
 
 func Test_MakePodSpec(t *testing.T) {
@@ -109,3 +111,36 @@ func Test_MakePodSpec_CABundle(t *testing.T) {
 		t.Fatalf("should add %s volume to Pod", caBundleVolName)
 	}
 }
+
+func Test_MakePodSpec_DestinationChunked(t *testing.T) {
+	podSettings := &PodSettings{
+		Name:           "importer-pod",
+		Image:          "localhost:5000/importer:latest",
+		PullPolicy:     string(corev1.PullAlways),
+		Namespace:      "virt-controller",
+		ControllerName: "test-controller",
+	}
+
+	settings := &Settings{
+		Source:                 "HTTP",
+		DestinationEndpoint:    "dvcr:5000/test-image:latest",
+		DestinationInsecureTLS: "false",
+	}
+
+	hasChunkedEnv := func() bool {
+		pod, err := NewImporter(podSettings, settings).makeImporterPodSpec()
+		require.NoError(t, err)
+
+		for _, env := range pod.Spec.Containers[0].Env {
+			if env.Name == common.DestinationChunkedVar {
+				return env.Value == "true"
+			}
+		}
+		return false
+	}
+
+	require.False(t, hasChunkedEnv(), "should not store image as chunks by default")
+
+	ApplyChunkedDestinationSettings(settings)
+	require.True(t, hasChunkedEnv(), "should pass %s to the importer", common.DestinationChunkedVar)
+}
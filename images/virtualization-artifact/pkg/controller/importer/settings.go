@@ -43,6 +43,8 @@ type Settings struct {
 	Verbose                string
 	Endpoint               string
 	Checksums              map[string]string
+	TrustedKeys            []string
+	SignatureRequired      bool
 	SecretName             string
 	Source                 string
 	ContentType            string
@@ -65,6 +67,16 @@ type Settings struct {
 	DestinationEndpoint    string
 	DestinationInsecureTLS string
 	DestinationAuthSecret  string
+	DestinationChunked     bool
+	DestinationCompression string
+	// CacheImage is the DVCR pull-through cache image of the registry source, referenced by digest.
+	CacheImage string
+	// CacheFill makes the importer copy the registry source to CacheImage first.
+	CacheFill bool
+	// CacheFillKey identifies the registry source among the importers filling the cache.
+	CacheFillKey string
+	// Customization is the offline customization the importer performs on the image before storing it.
+	Customization *v1alpha2.ImageCustomization
 }
 
 func ApplyDVCRDestinationSettings(podEnvVars *Settings, dvcrSettings *dvcr.Settings, supGen supplements.Generator, dvcrImageName string) {
@@ -73,6 +85,21 @@ func ApplyDVCRDestinationSettings(podEnvVars *Settings, dvcrSettings *dvcr.Setti
 	podEnvVars.DestinationEndpoint = dvcrImageName
 }
 
+// ApplyDVCRCompressionSettings sets the compression of the image stored in DVCR: the one requested
+// for the resource or, if not requested, the default one from the module settings.
+func ApplyDVCRCompressionSettings(podEnvVars *Settings, dvcrSettings *dvcr.Settings, compression v1alpha2.ImageCompression) {
+	podEnvVars.DestinationCompression = dvcrSettings.ImageCompression(compression)
+}
+
+// ApplyChunkedDestinationSettings makes the importer store the image in DVCR as content-defined chunks.
+// Such images are deduplicated against each other but can be read only by the pvc-importer,
+// so it is applicable only when the image in DVCR is an intermediate copy written to a PVC afterwards:
+// for VirtualDisks and for VirtualImages on a PVC. Images kept in DVCR are attached to virtual machines
+// as container disks and must stay regular.
+func ApplyChunkedDestinationSettings(podEnvVars *Settings) {
+	podEnvVars.DestinationChunked = true
+}
+
 // ApplyHTTPSourceSettings updates importer Pod settings to use http source.
 func ApplyHTTPSourceSettings(podEnvVars *Settings, http *v1alpha2.DataSourceHTTP, supGen supplements.Generator) {
 	podEnvVars.Source = SourceHTTP
@@ -110,6 +137,35 @@ func ApplyRegistrySourceSettings(podEnvVars *Settings, ctrImg *datasource.Contai
 	}
 }
 
+// ApplyPullThroughCacheSettings makes the importer read the registry source from the DVCR pull-through cache.
+// The source is pinned to the digest the cache image was resolved for. If fill is set, the importer copies
+// the source to the cache first, and its Pod is labeled with fillKey for other imports of the source to wait for it.
+func ApplyPullThroughCacheSettings(podEnvVars *Settings, pinnedImage, cacheImage string, fill bool, fillKey string) {
+	podEnvVars.Endpoint = common.DockerRegistrySchemePrefix + pinnedImage
+	podEnvVars.CacheImage = cacheImage
+	podEnvVars.CacheFill = fill
+	if fill {
+		podEnvVars.CacheFillKey = fillKey
+	}
+}
+
+// ApplySignatureVerificationSettings updates importer Pod settings to verify
+// the signature of the container image before importing it.
+func ApplySignatureVerificationSettings(podEnvVars *Settings, verification *v1alpha2.ImageSignatureVerification) {
+	if verification == nil {
+		return
+	}
+
+	podEnvVars.TrustedKeys = verification.TrustedKeys
+	podEnvVars.SignatureRequired = verification.Required
+}
+
+// ApplyCustomizationSettings updates importer Pod settings to customize
+// the image before storing it in DVCR.
+func ApplyCustomizationSettings(podEnvVars *Settings, customization *v1alpha2.ImageCustomization) {
+	podEnvVars.Customization = customization
+}
+
 // ApplyDVCRSourceSettings updates importer Pod settings to use dvcr registry source.
 // NOTE: no auth secret required, it will be taken from DVCR destination settings.
 func ApplyDVCRSourceSettings(podEnvVars *Settings, dvcrImageName string) {
@@ -17,6 +17,7 @@ limitations under the License.
 package importer
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -83,3 +84,78 @@ func Test_ImporterContainerEnv_Checksums(t *testing.T) {
 			"an import without checksums must not set the variable at all")
 	}
 }
+
+// Test_ImporterContainerEnv_SignatureVerification makes sure the trusted keys
+// reach the Pod as a single PEM bundle along with the required flag, and that an
+// import without a verification policy does not ask for one.
+func Test_ImporterContainerEnv_SignatureVerification(t *testing.T) {
+	const (
+		firstKey  = "-----BEGIN PUBLIC KEY-----\nMFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE\n-----END PUBLIC KEY-----"
+		secondKey = "-----BEGIN CERTIFICATE-----\nMIIBszCCAVmgAwIBAgIUJ\n-----END CERTIFICATE-----"
+	)
+
+	var settings Settings
+	ApplySignatureVerificationSettings(&settings, &v1alpha2.ImageSignatureVerification{
+		Required:    true,
+		TrustedKeys: []string{firstKey, secondKey},
+	})
+
+	withVerification := Importer{PodSettings: &PodSettings{}, EnvSettings: &settings}
+
+	env := make(map[string]string)
+	for _, e := range withVerification.makeImporterContainerEnv() {
+		env[e.Name] = e.Value
+	}
+	require.Equal(t, firstKey+"\n"+secondKey, env[common.ImporterTrustedKeys])
+	require.Equal(t, "true", env[common.ImporterSignatureRequired])
+
+	var withoutPolicy Settings
+	ApplySignatureVerificationSettings(&withoutPolicy, nil)
+
+	withoutVerification := Importer{PodSettings: &PodSettings{}, EnvSettings: &withoutPolicy}
+	for _, e := range withoutVerification.makeImporterContainerEnv() {
+		require.NotEqual(t, common.ImporterTrustedKeys, e.Name,
+			"an import without a verification policy must not set the variable at all")
+	}
+}
+
+// Test_ImporterContainerEnv_Customization makes sure the customization reaches
+// the Pod as JSON the importer decodes back, and that an import without one
+// does not ask for it.
+func Test_ImporterContainerEnv_Customization(t *testing.T) {
+	customization := &v1alpha2.ImageCustomization{
+		Files: []v1alpha2.ImageCustomizationFile{
+			{Path: "/etc/motd", Content: "Welcome\n", Permissions: "0644"},
+		},
+		SSHAuthorizedKeys: []v1alpha2.ImageCustomizationSSHAuthorizedKeys{
+			{User: "root", Keys: []string{"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIG admin"}},
+		},
+		Sysprep: &v1alpha2.ImageCustomizationSysprep{},
+	}
+
+	var settings Settings
+	ApplyCustomizationSettings(&settings, customization)
+
+	withCustomization := Importer{PodSettings: &PodSettings{}, EnvSettings: &settings}
+
+	var value string
+	for _, env := range withCustomization.makeImporterContainerEnv() {
+		if env.Name == common.ImporterCustomization {
+			value = env.Value
+		}
+	}
+	require.NotEmpty(t, value)
+
+	var decoded v1alpha2.ImageCustomization
+	require.NoError(t, json.Unmarshal([]byte(value), &decoded))
+	require.Equal(t, *customization, decoded)
+
+	var withoutCustomization Settings
+	ApplyCustomizationSettings(&withoutCustomization, nil)
+
+	withoutEnv := Importer{PodSettings: &PodSettings{}, EnvSettings: &withoutCustomization}
+	for _, env := range withoutEnv.makeImporterContainerEnv() {
+		require.NotEqual(t, common.ImporterCustomization, env.Name,
+			"an import without a customization must not set the variable at all")
+	}
+}
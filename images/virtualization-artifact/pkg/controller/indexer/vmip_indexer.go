@@ -62,10 +62,14 @@ func IndexVMIPByAddress() (obj client.Object, field string, extractValue client.
 			addresses = append(addresses, vmip.Spec.StaticIP)
 		}
 
+		addresses = append(addresses, vmip.Spec.StaticIPs...)
+
 		if vmip.Status.Address != "" {
 			addresses = append(addresses, vmip.Status.Address)
 		}
 
+		addresses = append(addresses, vmip.Status.Addresses...)
+
 		return addresses
 	}
 }
@@ -0,0 +1,264 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization-controller/pkg/common/ip"
+	"github.com/deckhouse/virtualization-controller/pkg/common/network"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/service"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2/ippoolcondition"
+)
+
+// SyncHandler assigns the addresses of the pool to the interfaces of the virtual machines it serves
+// and records the assignments in the status. The status of the pool is the only source of the assignments,
+// so the addresses of an interface are kept as long as the pool serves it and they remain assignable.
+type SyncHandler struct {
+	client client.Client
+}
+
+func NewSyncHandler(client client.Client) *SyncHandler {
+	return &SyncHandler{
+		client: client,
+	}
+}
+
+// poolInterface is an interface of a virtual machine served by the pool.
+type poolInterface struct {
+	namespace  string
+	vmName     string
+	network    v1alpha2.IPAddressPoolNetwork
+	macAddress string
+}
+
+func (i poolInterface) allocation(address string) v1alpha2.IPAddressPoolAllocation {
+	return v1alpha2.IPAddressPoolAllocation{
+		Address:        address,
+		MACAddress:     i.macAddress,
+		Namespace:      i.namespace,
+		VirtualMachine: i.vmName,
+		Network:        i.network,
+	}
+}
+
+func (i poolInterface) owns(a v1alpha2.IPAddressPoolAllocation) bool {
+	return a.Namespace == i.namespace && a.VirtualMachine == i.vmName && a.Network == i.network
+}
+
+func (h SyncHandler) Handle(ctx context.Context, pool *v1alpha2.IPAddressPool) (reconcile.Result, error) {
+	if !pool.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	cb := conditions.NewConditionBuilder(ippoolcondition.ReadyType).Generation(pool.Generation)
+
+	addresses, err := ip.NewPool(pool.Spec)
+	if err != nil {
+		// Keep the assignments: the addresses are still in use by the guests until the spec is fixed.
+		pool.Status.Capacity = 0
+		cb.Status(metav1.ConditionFalse).Reason(ippoolcondition.InvalidSpec).Message(service.CapitalizeFirstLetter(err.Error()) + ".")
+		conditions.SetCondition(cb, &pool.Status.Conditions)
+		return reconcile.Result{}, nil
+	}
+
+	interfaces, err := h.servedInterfaces(ctx, pool)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	allocations, pending := assign(addresses, interfaces, pool.Status.Allocations)
+
+	pool.Status.Allocations = allocations
+	pool.Status.Capacity = int64(addresses.Size())
+	pool.Status.Allocated = int64(len(allocations))
+
+	if pending > 0 {
+		cb.Status(metav1.ConditionFalse).Reason(ippoolcondition.Exhausted).Message(fmt.Sprintf("All addresses of the pool are assigned, %d interface(s) are waiting for an address.", pending))
+	} else {
+		cb.Status(metav1.ConditionTrue).Reason(ippoolcondition.Ready).Message("")
+	}
+	conditions.SetCondition(cb, &pool.Status.Conditions)
+
+	return reconcile.Result{}, nil
+}
+
+// servedInterfaces returns the interfaces of the virtual machines for which the pool is selected.
+func (h SyncHandler) servedInterfaces(ctx context.Context, pool *v1alpha2.IPAddressPool) ([]poolInterface, error) {
+	var pools v1alpha2.IPAddressPoolList
+	if err := h.client.List(ctx, &pools); err != nil {
+		return nil, fmt.Errorf("list ip address pools: %w", err)
+	}
+
+	var namespaces corev1.NamespaceList
+	if err := h.client.List(ctx, &namespaces); err != nil {
+		return nil, fmt.Errorf("list namespaces: %w", err)
+	}
+	namespaceByName := make(map[string]*corev1.Namespace, len(namespaces.Items))
+	for i := range namespaces.Items {
+		namespaceByName[namespaces.Items[i].Name] = &namespaces.Items[i]
+	}
+
+	var vms v1alpha2.VirtualMachineList
+	if err := h.client.List(ctx, &vms); err != nil {
+		return nil, fmt.Errorf("list virtual machines: %w", err)
+	}
+
+	var vmmacs v1alpha2.VirtualMachineMACAddressList
+	if err := h.client.List(ctx, &vmmacs); err != nil {
+		return nil, fmt.Errorf("list virtual machine mac addresses: %w", err)
+	}
+
+	var interfaces []poolInterface
+	for i := range vms.Items {
+		vm := &vms.Items[i]
+		namespace, ok := namespaceByName[vm.Namespace]
+		if !ok {
+			continue
+		}
+
+		for _, spec := range network.CreateNetworkSpec(vm, vmMACAddresses(vm, vmmacs.Items)) {
+			if spec.Type == v1alpha2.NetworksTypeMain || spec.MAC == "" {
+				continue
+			}
+
+			netSpec := v1alpha2.NetworksSpec{Type: spec.Type, Name: spec.Name}
+			selected := network.SelectIPAddressPool(pools.Items, namespace, vm.Name, netSpec)
+			if selected == nil || selected.Name != pool.Name {
+				continue
+			}
+
+			hasIPAM, err := network.HasIPAM(ctx, h.client, vm.Namespace, netSpec)
+			if err != nil && !meta.IsNoMatchError(err) {
+				return nil, err
+			}
+			if hasIPAM {
+				continue
+			}
+
+			interfaces = append(interfaces, poolInterface{
+				namespace:  vm.Namespace,
+				vmName:     vm.Name,
+				network:    v1alpha2.IPAddressPoolNetwork{Type: spec.Type, Name: spec.Name},
+				macAddress: spec.MAC,
+			})
+		}
+	}
+
+	return interfaces, nil
+}
+
+// vmMACAddresses returns the VirtualMachineMACAddress resources of the virtual machine:
+// the ones referenced in the spec and the ones labeled with the virtual machine UID.
+func vmMACAddresses(vm *v1alpha2.VirtualMachine, vmmacs []v1alpha2.VirtualMachineMACAddress) []*v1alpha2.VirtualMachineMACAddress {
+	var result []*v1alpha2.VirtualMachineMACAddress
+	for i := range vmmacs {
+		vmmac := &vmmacs[i]
+		if vmmac.Namespace != vm.Namespace {
+			continue
+		}
+		referenced := slices.ContainsFunc(vm.Spec.Networks, func(n v1alpha2.NetworksSpec) bool {
+			return n.VirtualMachineMACAddressName == vmmac.Name
+		})
+		if referenced || vmmac.Labels[annotations.LabelVirtualMachineUID] == string(vm.UID) {
+			result = append(result, vmmac)
+		}
+	}
+	return result
+}
+
+// assign returns the assignments of the addresses to the interfaces, one address per IP family of the pool,
+// and the number of the interfaces left without an address. The reserved addresses are assigned first,
+// then the previously assigned addresses are kept, then the free addresses are assigned to the rest.
+func assign(addresses ip.Pool, interfaces []poolInterface, previous []v1alpha2.IPAddressPoolAllocation) ([]v1alpha2.IPAddressPoolAllocation, int) {
+	type slot struct {
+		iface  poolInterface
+		family corev1.IPFamily
+	}
+
+	var allocations []v1alpha2.IPAddressPoolAllocation
+	taken := make(ip.AllocatedIPs)
+	take := func(s slot, address string) bool {
+		if _, ok := taken[address]; ok {
+			return false
+		}
+		taken[address] = struct{}{}
+		allocations = append(allocations, s.iface.allocation(address))
+		return true
+	}
+
+	var unassigned []slot
+	for _, iface := range interfaces {
+		for _, family := range addresses.Families() {
+			s := slot{iface: iface, family: family}
+			if reserved := addresses.Reserved(iface.macAddress, family); reserved != "" && take(s, reserved) {
+				continue
+			}
+			unassigned = append(unassigned, s)
+		}
+	}
+
+	var rest []slot
+	for _, s := range unassigned {
+		idx := slices.IndexFunc(previous, func(a v1alpha2.IPAddressPoolAllocation) bool {
+			return s.iface.owns(a) && ip.Family(a.Address) == s.family && addresses.IsAssignable(a.Address, s.iface.macAddress)
+		})
+		if idx >= 0 && take(s, previous[idx].Address) {
+			continue
+		}
+		rest = append(rest, s)
+	}
+
+	var pending int
+	counted := make(map[poolInterface]struct{})
+	for _, s := range rest {
+		address, err := addresses.Allocate(taken, s.family)
+		if err == nil {
+			take(s, address)
+			continue
+		}
+		// The pool is exhausted.
+		if _, ok := counted[s.iface]; !ok {
+			counted[s.iface] = struct{}{}
+			pending++
+		}
+	}
+
+	slices.SortFunc(allocations, func(a, b v1alpha2.IPAddressPoolAllocation) int {
+		return cmp.Or(
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.VirtualMachine, b.VirtualMachine),
+			cmp.Compare(a.Network.Type, b.Network.Type),
+			cmp.Compare(a.Network.Name, b.Network.Name),
+			cmp.Compare(a.Address, b.Address),
+		)
+	})
+
+	return allocations, pending
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization-controller/pkg/common/network"
+	"github.com/deckhouse/virtualization-controller/pkg/common/testutil"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2/ippoolcondition"
+)
+
+var _ = Describe("SyncHandler", func() {
+	const namespace = "ns"
+
+	var (
+		ctx  context.Context
+		pool *v1alpha2.IPAddressPool
+	)
+
+	userNet := v1alpha2.IPAddressPoolNetwork{Type: v1alpha2.NetworksTypeNetwork, Name: "user-net"}
+
+	BeforeEach(func() {
+		ctx = testutil.ContextBackgroundWithNoOpLogger()
+		pool = &v1alpha2.IPAddressPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool", Generation: 1},
+			Spec: v1alpha2.IPAddressPoolSpec{
+				Networks: []v1alpha2.IPAddressPoolNetwork{userNet},
+				Subnets:  []v1alpha2.IPAddressPoolSubnet{{CIDR: "192.168.10.0/29", Gateway: "192.168.10.1"}},
+			},
+		}
+	})
+
+	newVM := func(name, macAddress string) []client.Object {
+		vm := &v1alpha2.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, UID: types.UID(name + "-uid")},
+			Spec: v1alpha2.VirtualMachineSpec{
+				Networks: []v1alpha2.NetworksSpec{
+					{Type: v1alpha2.NetworksTypeMain, ID: ptr.To(network.ReservedMainID)},
+					{Type: userNet.Type, Name: userNet.Name, ID: ptr.To(2)},
+				},
+			},
+		}
+		vmmac := &v1alpha2.VirtualMachineMACAddress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name + "-mac",
+				Namespace: namespace,
+				Labels:    map[string]string{annotations.LabelVirtualMachineUID: string(vm.UID)},
+			},
+			Status: v1alpha2.VirtualMachineMACAddressStatus{Address: macAddress},
+		}
+		return []client.Object{vm, vmmac}
+	}
+
+	allocation := func(address, vmName, macAddress string) v1alpha2.IPAddressPoolAllocation {
+		return v1alpha2.IPAddressPoolAllocation{
+			Address:        address,
+			MACAddress:     macAddress,
+			Namespace:      namespace,
+			VirtualMachine: vmName,
+			Network:        userNet,
+		}
+	}
+
+	handle := func(objs ...client.Object) {
+		GinkgoHelper()
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace, Labels: map[string]string{"tenant": "a"}}}
+		fakeClient, err := testutil.NewFakeClientWithObjects(append(objs, ns, pool)...)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = NewSyncHandler(fakeClient).Handle(ctx, pool)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	expectReady := func(status metav1.ConditionStatus, reason ippoolcondition.ReadyReason) {
+		GinkgoHelper()
+		ready, _ := conditions.GetCondition(ippoolcondition.ReadyType, pool.Status.Conditions)
+		Expect(ready.Status).To(Equal(status))
+		Expect(ready.Reason).To(Equal(reason.String()))
+	}
+
+	It("should assign the first free address", func() {
+		handle(newVM("vm", "02:00:00:00:00:01")...)
+
+		Expect(pool.Status.Allocations).To(Equal([]v1alpha2.IPAddressPoolAllocation{
+			allocation("192.168.10.2", "vm", "02:00:00:00:00:01"),
+		}))
+		Expect(pool.Status.Capacity).To(Equal(int64(5)))
+		Expect(pool.Status.Allocated).To(Equal(int64(1)))
+		expectReady(metav1.ConditionTrue, ippoolcondition.Ready)
+	})
+
+	It("should assign the reserved address", func() {
+		pool.Spec.Reservations = []v1alpha2.IPAddressPoolReservation{{MACAddress: "02:00:00:00:00:01", Address: "192.168.10.6"}}
+
+		handle(append(newVM("a", "02:00:00:00:00:02"), newVM("b", "02:00:00:00:00:01")...)...)
+
+		Expect(pool.Status.Allocations).To(Equal([]v1alpha2.IPAddressPoolAllocation{
+			allocation("192.168.10.2", "a", "02:00:00:00:00:02"),
+			allocation("192.168.10.6", "b", "02:00:00:00:00:01"),
+		}))
+	})
+
+	It("should keep the assigned addresses and release the addresses of the removed virtual machines", func() {
+		pool.Status.Allocations = []v1alpha2.IPAddressPoolAllocation{
+			allocation("192.168.10.2", "removed", "02:00:00:00:00:09"),
+			allocation("192.168.10.5", "vm", "02:00:00:00:00:01"),
+		}
+
+		handle(newVM("vm", "02:00:00:00:00:01")...)
+
+		Expect(pool.Status.Allocations).To(Equal([]v1alpha2.IPAddressPoolAllocation{
+			allocation("192.168.10.5", "vm", "02:00:00:00:00:01"),
+		}))
+	})
+
+	It("should report the exhausted pool", func() {
+		pool.Spec.Subnets[0].CIDR = "192.168.10.0/30"
+
+		handle(append(newVM("a", "02:00:00:00:00:01"), newVM("b", "02:00:00:00:00:02")...)...)
+
+		Expect(pool.Status.Allocations).To(HaveLen(1))
+		expectReady(metav1.ConditionFalse, ippoolcondition.Exhausted)
+	})
+
+	It("should skip the namespaces not selected by the pool", func() {
+		pool.Spec.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "b"}}
+
+		handle(newVM("vm", "02:00:00:00:00:01")...)
+
+		Expect(pool.Status.Allocations).To(BeEmpty())
+		expectReady(metav1.ConditionTrue, ippoolcondition.Ready)
+	})
+
+	It("should skip the networks with the IPAM of the SDN module", func() {
+		sdnNetwork := &unstructured.Unstructured{}
+		sdnNetwork.SetGroupVersionKind(network.NetworkGVK)
+		sdnNetwork.SetName(userNet.Name)
+		sdnNetwork.SetNamespace(namespace)
+		Expect(unstructured.SetNestedField(sdnNetwork.Object, "demo-pool", "spec", "ipam", "ipAddressPoolRef", "name")).To(Succeed())
+
+		handle(append(newVM("vm", "02:00:00:00:00:01"), sdnNetwork)...)
+
+		Expect(pool.Status.Allocations).To(BeEmpty())
+	})
+
+	It("should keep the assigned addresses of the invalid pool", func() {
+		pool.Spec.Subnets[0].Gateway = "10.0.0.1"
+		pool.Status.Allocations = []v1alpha2.IPAddressPoolAllocation{allocation("192.168.10.5", "vm", "02:00:00:00:00:01")}
+
+		handle(newVM("vm", "02:00:00:00:00:01")...)
+
+		Expect(pool.Status.Allocations).To(HaveLen(1))
+		Expect(pool.Status.Capacity).To(BeZero())
+		expectReady(metav1.ConditionFalse, ippoolcondition.InvalidSpec)
+	})
+})
@@ -0,0 +1,67 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watcher
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+func NewNamespaceWatcher() *NamespaceWatcher {
+	return &NamespaceWatcher{}
+}
+
+// NamespaceWatcher enqueues all pools when the labels of a namespace change: the pools select namespaces by labels.
+type NamespaceWatcher struct{}
+
+func (w NamespaceWatcher) Watch(mgr manager.Manager, ctr controller.Controller) error {
+	c := mgr.GetClient()
+	err := ctr.Watch(
+		source.Kind(
+			mgr.GetCache(),
+			&corev1.Namespace{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, _ *corev1.Namespace) []reconcile.Request {
+				return allPools(ctx, c)
+			}),
+			predicate.TypedFuncs[*corev1.Namespace]{
+				CreateFunc: func(_ event.TypedCreateEvent[*corev1.Namespace]) bool {
+					return false
+				},
+				DeleteFunc: func(_ event.TypedDeleteEvent[*corev1.Namespace]) bool {
+					return false
+				},
+				UpdateFunc: func(e event.TypedUpdateEvent[*corev1.Namespace]) bool {
+					return !equality.Semantic.DeepEqual(e.ObjectOld.Labels, e.ObjectNew.Labels)
+				},
+			},
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("error setting watch on Namespace: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watcher
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+func NewVirtualMachineWatcher() *VirtualMachineWatcher {
+	return &VirtualMachineWatcher{}
+}
+
+// VirtualMachineWatcher enqueues all pools when the networks of a virtual machine change
+// or the virtual machine is created or deleted.
+type VirtualMachineWatcher struct{}
+
+func (w VirtualMachineWatcher) Watch(mgr manager.Manager, ctr controller.Controller) error {
+	c := mgr.GetClient()
+	err := ctr.Watch(
+		source.Kind(
+			mgr.GetCache(),
+			&v1alpha2.VirtualMachine{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, _ *v1alpha2.VirtualMachine) []reconcile.Request {
+				return allPools(ctx, c)
+			}),
+			predicate.TypedFuncs[*v1alpha2.VirtualMachine]{
+				UpdateFunc: func(e event.TypedUpdateEvent[*v1alpha2.VirtualMachine]) bool {
+					return !equality.Semantic.DeepEqual(e.ObjectOld.Spec.Networks, e.ObjectNew.Spec.Networks)
+				},
+			},
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("error setting watch on VirtualMachine: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watcher
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+func NewVirtualMachineMACAddressWatcher() *VirtualMachineMACAddressWatcher {
+	return &VirtualMachineMACAddressWatcher{}
+}
+
+// VirtualMachineMACAddressWatcher enqueues all pools when the MAC address of an interface changes:
+// the reservations and the assignments of the pools are bound to the MAC addresses.
+type VirtualMachineMACAddressWatcher struct{}
+
+func (w VirtualMachineMACAddressWatcher) Watch(mgr manager.Manager, ctr controller.Controller) error {
+	c := mgr.GetClient()
+	err := ctr.Watch(
+		source.Kind(
+			mgr.GetCache(),
+			&v1alpha2.VirtualMachineMACAddress{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, _ *v1alpha2.VirtualMachineMACAddress) []reconcile.Request {
+				return allPools(ctx, c)
+			}),
+			predicate.TypedFuncs[*v1alpha2.VirtualMachineMACAddress]{
+				UpdateFunc: func(e event.TypedUpdateEvent[*v1alpha2.VirtualMachineMACAddress]) bool {
+					return e.ObjectOld.Status.Address != e.ObjectNew.Status.Address ||
+						!equality.Semantic.DeepEqual(e.ObjectOld.Labels, e.ObjectNew.Labels)
+				},
+			},
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("error setting watch on VirtualMachineMACAddress: %w", err)
+	}
+	return nil
+}
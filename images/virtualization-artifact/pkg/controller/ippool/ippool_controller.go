@@ -0,0 +1,65 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ippool
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/deckhouse/deckhouse/pkg/log"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/ippool/internal/handler"
+	"github.com/deckhouse/virtualization-controller/pkg/logger"
+)
+
+const ControllerName = "ippool-controller"
+
+func SetupController(
+	ctx context.Context,
+	mgr manager.Manager,
+	log *log.Logger,
+) error {
+	l := log.With(logger.SlogController(ControllerName))
+	client := mgr.GetClient()
+	reconciler := NewReconciler(client,
+		handler.NewSyncHandler(client),
+	)
+
+	c, err := controller.New(ControllerName, mgr, controller.Options{
+		Reconciler:       reconciler,
+		RateLimiter:      workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](time.Second, 32*time.Second),
+		RecoverPanic:     ptr.To(true),
+		LogConstructor:   logger.NewConstructor(l),
+		CacheSyncTimeout: 10 * time.Minute,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = reconciler.SetupController(ctx, mgr, c)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Initialized IPAddressPool controller")
+	return nil
+}
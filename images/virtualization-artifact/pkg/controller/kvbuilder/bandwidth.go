@@ -0,0 +1,164 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kvbuilder
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization-controller/pkg/common/network"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+// InterfaceBandwidthAnnotation holds the libvirt bandwidth settings of the network interfaces as a JSON object keyed by the interface name.
+// It is set only while the AdditionalNetworkBandwidth feature gate is enabled.
+const InterfaceBandwidthAnnotation = "internal.virtualization.deckhouse.io/interface-bandwidth"
+
+// MainBandwidthAnnotations are the pod annotations limiting the traffic of the Main network interface.
+// The CNI plugin reads them when the pod sandbox is created, so a change takes effect on the next start.
+var MainBandwidthAnnotations = []string{
+	annotations.AnnIngressBandwidth,
+	annotations.AnnEgressBandwidth,
+}
+
+// InterfaceBandwidth mirrors the libvirt bandwidth element of an interface.
+// Inbound is the traffic received by the guest, outbound is the traffic sent by the guest.
+type InterfaceBandwidth struct {
+	Inbound  *BandwidthRate `json:"inbound,omitempty"`
+	Outbound *BandwidthRate `json:"outbound,omitempty"`
+}
+
+// BandwidthRate mirrors the inbound and outbound elements of the libvirt bandwidth element:
+// the average rate is in kibibytes per second, the burst is in kibibytes.
+type BandwidthRate struct {
+	Average int64 `json:"average"`
+	Burst   int64 `json:"burst,omitempty"`
+}
+
+func NewInterfaceBandwidth(bandwidth *v1alpha2.NetworkBandwidth) InterfaceBandwidth {
+	if bandwidth == nil {
+		return InterfaceBandwidth{}
+	}
+	return InterfaceBandwidth{
+		Inbound:  newBandwidthRate(bandwidth.Ingress),
+		Outbound: newBandwidthRate(bandwidth.Egress),
+	}
+}
+
+func newBandwidthRate(limit *v1alpha2.NetworkBandwidthLimit) *BandwidthRate {
+	if limit == nil {
+		return nil
+	}
+	// libvirt counts in kibibytes, so round up to keep a small limit from becoming zero, which means no limit.
+	rate := &BandwidthRate{Average: ceilDiv(limit.Rate.Value(), 8*1024)}
+	if limit.Burst != nil {
+		rate.Burst = ceilDiv(limit.Burst.Value(), 1024)
+	}
+	return rate
+}
+
+func ceilDiv(value, divisor int64) int64 {
+	return (value + divisor - 1) / divisor
+}
+
+// InterfaceBandwidths returns the bandwidth settings of the additional network interfaces, keyed by the interface name.
+func InterfaceBandwidths(vm *v1alpha2.VirtualMachine, networkSpec network.InterfaceSpecList) map[string]InterfaceBandwidth {
+	bandwidthByKey := make(map[string]*v1alpha2.NetworkBandwidth, len(vm.Spec.Networks))
+	for _, n := range vm.Spec.Networks {
+		if n.Bandwidth != nil {
+			bandwidthByKey[network.SpecKey(n)] = n.Bandwidth
+		}
+	}
+
+	bandwidths := make(map[string]InterfaceBandwidth)
+	for _, spec := range networkSpec {
+		if spec.Type == v1alpha2.NetworksTypeMain {
+			continue
+		}
+		if bandwidth := bandwidthByKey[network.SpecKey(v1alpha2.NetworksSpec{Type: spec.Type, Name: spec.Name})]; bandwidth != nil {
+			bandwidths[spec.InterfaceName] = NewInterfaceBandwidth(bandwidth)
+		}
+	}
+	return bandwidths
+}
+
+// MainBandwidth returns the values of the MainBandwidthAnnotations, an empty value means the annotation is absent.
+// Without limits of the Main network in the spec, the annotations set on the virtual machine are used.
+func MainBandwidth(vm *v1alpha2.VirtualMachine) map[string]string {
+	values := map[string]string{
+		annotations.AnnIngressBandwidth: vm.GetAnnotations()[annotations.AnnIngressBandwidth],
+		annotations.AnnEgressBandwidth:  vm.GetAnnotations()[annotations.AnnEgressBandwidth],
+	}
+
+	main := network.GetMainNetworkSpec(vm.Spec.Networks)
+	if main == nil || main.Bandwidth == nil {
+		return values
+	}
+	values[annotations.AnnIngressBandwidth] = bandwidthRateValue(main.Bandwidth.Ingress)
+	values[annotations.AnnEgressBandwidth] = bandwidthRateValue(main.Bandwidth.Egress)
+	return values
+}
+
+func bandwidthRateValue(limit *v1alpha2.NetworkBandwidthLimit) string {
+	if limit == nil {
+		return ""
+	}
+	return limit.Rate.String()
+}
+
+// MarshalInterfaceBandwidths returns the InterfaceBandwidthAnnotation value, or an empty string if no interface is limited.
+func MarshalInterfaceBandwidths(bandwidths map[string]InterfaceBandwidth) (string, error) {
+	if len(bandwidths) == 0 {
+		return "", nil
+	}
+	raw, err := json.Marshal(bandwidths)
+	if err != nil {
+		return "", fmt.Errorf("marshal interface bandwidth: %w", err)
+	}
+	return string(raw), nil
+}
+
+// SetNetworkBandwidth sets the bandwidth settings of the interfaces present in the KVVM
+// and the annotations limiting the Main network interface.
+func (b *KVVM) SetNetworkBandwidth(bandwidths map[string]InterfaceBandwidth, main map[string]string) error {
+	present := make(map[string]InterfaceBandwidth, len(bandwidths))
+	for _, iface := range b.Resource.Spec.Template.Spec.Domain.Devices.Interfaces {
+		if bandwidth, ok := bandwidths[iface.Name]; ok {
+			present[iface.Name] = bandwidth
+		}
+	}
+
+	value, err := MarshalInterfaceBandwidths(present)
+	if err != nil {
+		return err
+	}
+	if value == "" {
+		b.RemoveKVVMIAnnotation(InterfaceBandwidthAnnotation)
+	} else {
+		b.SetKVVMIAnnotation(InterfaceBandwidthAnnotation, value)
+	}
+
+	for _, key := range MainBandwidthAnnotations {
+		if main[key] == "" {
+			b.RemoveKVVMIAnnotation(key)
+		} else {
+			b.SetKVVMIAnnotation(key, main[key])
+		}
+	}
+	return nil
+}
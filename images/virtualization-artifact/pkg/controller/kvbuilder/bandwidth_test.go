@@ -0,0 +1,112 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kvbuilder
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization-controller/pkg/common/network"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+var _ = Describe("Network bandwidth", func() {
+	It("should convert limits into libvirt bandwidth rounding up to kibibytes", func() {
+		bandwidth := NewInterfaceBandwidth(&v1alpha2.NetworkBandwidth{
+			Ingress: &v1alpha2.NetworkBandwidthLimit{Rate: resource.MustParse("100M"), Burst: ptr.To(resource.MustParse("1Mi"))},
+			Egress:  &v1alpha2.NetworkBandwidthLimit{Rate: resource.MustParse("1k")},
+		})
+
+		Expect(bandwidth).To(Equal(InterfaceBandwidth{
+			Inbound:  &BandwidthRate{Average: 12208, Burst: 1024},
+			Outbound: &BandwidthRate{Average: 1},
+		}))
+	})
+
+	It("should return the settings of the limited additional interfaces only", func() {
+		limits := &v1alpha2.NetworkBandwidth{Egress: &v1alpha2.NetworkBandwidthLimit{Rate: resource.MustParse("8Ki")}}
+		vm := &v1alpha2.VirtualMachine{
+			Spec: v1alpha2.VirtualMachineSpec{
+				Networks: []v1alpha2.NetworksSpec{
+					{Type: v1alpha2.NetworksTypeMain, Bandwidth: limits},
+					{Type: v1alpha2.NetworksTypeNetwork, Name: "limited", Bandwidth: limits},
+					{Type: v1alpha2.NetworksTypeNetwork, Name: "unlimited"},
+				},
+			},
+		}
+		specs := network.InterfaceSpecList{
+			{Type: v1alpha2.NetworksTypeMain, InterfaceName: "default"},
+			{Type: v1alpha2.NetworksTypeNetwork, Name: "limited", InterfaceName: "veth_n1"},
+			{Type: v1alpha2.NetworksTypeNetwork, Name: "unlimited", InterfaceName: "veth_n2"},
+		}
+
+		Expect(InterfaceBandwidths(vm, specs)).To(Equal(map[string]InterfaceBandwidth{
+			"veth_n1": {Outbound: &BandwidthRate{Average: 1}},
+		}))
+	})
+
+	It("should prefer limits of the Main network over the annotations of the virtual machine", func() {
+		vm := &v1alpha2.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				annotations.AnnIngressBandwidth: "10M",
+				annotations.AnnEgressBandwidth:  "10M",
+			}},
+		}
+		Expect(MainBandwidth(vm)).To(Equal(map[string]string{
+			annotations.AnnIngressBandwidth: "10M",
+			annotations.AnnEgressBandwidth:  "10M",
+		}))
+
+		vm.Spec.Networks = []v1alpha2.NetworksSpec{{
+			Type:      v1alpha2.NetworksTypeMain,
+			Bandwidth: &v1alpha2.NetworkBandwidth{Egress: &v1alpha2.NetworkBandwidthLimit{Rate: resource.MustParse("100M")}},
+		}}
+		Expect(MainBandwidth(vm)).To(Equal(map[string]string{
+			annotations.AnnIngressBandwidth: "",
+			annotations.AnnEgressBandwidth:  "100M",
+		}))
+	})
+
+	It("should set the annotations only for interfaces present in the KVVM", func() {
+		kvvm := NewEmptyKVVM(namespacedName("vm", "default"), KVVMOptions{})
+		kvvm.Resource.Spec.Template.Spec.Domain.Devices.Interfaces = []virtv1.Interface{{Name: "veth_n1"}}
+
+		Expect(kvvm.SetNetworkBandwidth(map[string]InterfaceBandwidth{
+			"veth_n1": {Outbound: &BandwidthRate{Average: 1}},
+			"veth_n2": {Outbound: &BandwidthRate{Average: 1}},
+		}, map[string]string{annotations.AnnEgressBandwidth: "100M"})).To(Succeed())
+
+		anno := kvvm.Resource.Spec.Template.ObjectMeta.Annotations
+		var bandwidths map[string]InterfaceBandwidth
+		Expect(json.Unmarshal([]byte(anno[InterfaceBandwidthAnnotation]), &bandwidths)).To(Succeed())
+		Expect(bandwidths).To(HaveLen(1))
+		Expect(bandwidths).To(HaveKey("veth_n1"))
+		Expect(anno).To(HaveKeyWithValue(annotations.AnnEgressBandwidth, "100M"))
+		Expect(anno).NotTo(HaveKey(annotations.AnnIngressBandwidth))
+
+		Expect(kvvm.SetNetworkBandwidth(nil, nil)).To(Succeed())
+		Expect(kvvm.Resource.Spec.Template.ObjectMeta.Annotations).NotTo(HaveKey(InterfaceBandwidthAnnotation))
+		Expect(kvvm.Resource.Spec.Template.ObjectMeta.Annotations).NotTo(HaveKey(annotations.AnnEgressBandwidth))
+	})
+})
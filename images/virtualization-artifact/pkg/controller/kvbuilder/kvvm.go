@@ -731,6 +731,16 @@ func (b *KVVM) SetProvisioning(p *v1alpha2.Provisioning) error {
 	}
 }
 
+// SetCloudInitNetworkData sets the cloud-init network configuration of the cloud-init disk, if any.
+func (b *KVVM) SetCloudInitNetworkData(networkData string) {
+	for i, v := range b.Resource.Spec.Template.Spec.Volumes {
+		if v.Name == CloudInitDiskName && v.CloudInitNoCloud != nil {
+			b.Resource.Spec.Template.Spec.Volumes[i].CloudInitNoCloud.NetworkData = networkData
+			return
+		}
+	}
+}
+
 func (b *KVVM) SetOSType(osType v1alpha2.OsType) error {
 	switch osType {
 	case v1alpha2.Windows:
@@ -490,6 +490,20 @@ func TestSetProvisioning(t *testing.T) {
 			t.Error("sysprep disk and volume should be present after switching to sysprep")
 		}
 	})
+
+	t.Run("sets network data of the cloudinit volume", func(t *testing.T) {
+		b := newTestKVVM()
+		if err := b.SetProvisioning(cloudInit); err != nil {
+			t.Fatalf("SetProvisioning(cloudInit) failed: %v", err)
+		}
+		b.SetCloudInitNetworkData("version: 2\n")
+
+		for _, v := range b.Resource.Spec.Template.Spec.Volumes {
+			if v.Name == CloudInitDiskName && v.CloudInitNoCloud.NetworkData != "version: 2\n" {
+				t.Errorf("unexpected network data %q", v.CloudInitNoCloud.NetworkData)
+			}
+		}
+	})
 }
 
 func newTestKVVM() *KVVM {
@@ -24,6 +24,7 @@ import (
 	"slices"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	kvalidation "k8s.io/apimachinery/pkg/util/validation"
@@ -33,9 +34,11 @@ import (
 	"github.com/deckhouse/virtualization-controller/pkg/common"
 	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
 	"github.com/deckhouse/virtualization-controller/pkg/common/imageformat"
+	"github.com/deckhouse/virtualization-controller/pkg/common/ip"
 	"github.com/deckhouse/virtualization-controller/pkg/common/network"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/netmanager"
+	"github.com/deckhouse/virtualization-controller/pkg/featuregates"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2/vdcondition"
 )
@@ -213,7 +216,7 @@ func ApplyVirtualMachineSpec(
 	cviByName map[string]*v1alpha2.ClusterVirtualImage,
 	vmbdaByBlockDeviceRef map[v1alpha2.VMBDAObjectRef][]*v1alpha2.VirtualMachineBlockDeviceAttachment,
 	class *v1alpha2.VirtualMachineClass,
-	ipAddress string,
+	ipAddresses []string,
 	networkSpec network.InterfaceSpecList,
 	isVmRunning bool,
 ) error {
@@ -250,6 +253,14 @@ func ApplyVirtualMachineSpec(
 		return err
 	}
 
+	var bandwidths map[string]InterfaceBandwidth
+	if featuregates.Default().Enabled(featuregates.AdditionalNetworkBandwidth) {
+		bandwidths = InterfaceBandwidths(vm, networkSpec)
+	}
+	if err := kvvm.SetNetworkBandwidth(bandwidths, MainBandwidth(vm)); err != nil {
+		return err
+	}
+
 	kvvm.SetGPUDevices(vm.Name, vm.Spec.GPUs)
 
 	if err := kvvm.SetProvisioning(vm.Spec.Provisioning); err != nil {
@@ -262,11 +273,19 @@ func ApplyVirtualMachineSpec(
 		Kind:    "VirtualMachine",
 	})
 
-	if ipAddress != "" {
-		// Set ip address cni request annotation.
-		kvvm.SetKVVMIAnnotation(netmanager.AnnoIPAddressCNIRequest, ipAddress)
-	} else {
-		kvvm.RemoveKVVMIAnnotation(netmanager.AnnoIPAddressCNIRequest)
+	// Set ip address cni request annotations, one per IP family.
+	kvvm.RemoveKVVMIAnnotation(netmanager.AnnoIPAddressCNIRequest)
+	kvvm.RemoveKVVMIAnnotation(netmanager.AnnoIPv6AddressCNIRequest)
+	for _, ipAddress := range ipAddresses {
+		switch ip.Family(ipAddress) {
+		case corev1.IPv4Protocol:
+			kvvm.SetKVVMIAnnotation(netmanager.AnnoIPAddressCNIRequest, ipAddress)
+		case corev1.IPv6Protocol:
+			if !featuregates.Default().Enabled(featuregates.IPv6Addresses) {
+				continue
+			}
+			kvvm.SetKVVMIAnnotation(netmanager.AnnoIPv6AddressCNIRequest, ipAddress)
+		}
 	}
 
 	// Set live migration annotation.
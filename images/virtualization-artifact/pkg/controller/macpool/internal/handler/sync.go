@@ -0,0 +1,133 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/mac"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/service"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2/macpoolcondition"
+)
+
+// SyncHandler validates the pool and reports the number of its addresses, the number of the allocated ones
+// and the pools it overlaps with.
+type SyncHandler struct {
+	client client.Client
+}
+
+func NewSyncHandler(client client.Client) *SyncHandler {
+	return &SyncHandler{
+		client: client,
+	}
+}
+
+func (h SyncHandler) Handle(ctx context.Context, pool *v1alpha2.MACAddressPool) (reconcile.Result, error) {
+	if !pool.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	cb := conditions.NewConditionBuilder(macpoolcondition.ReadyType).Generation(pool.Generation)
+
+	addresses, err := mac.NewPool(pool.Spec.OUI, pool.Spec.Ranges)
+	if err != nil {
+		pool.Status.Capacity = 0
+		pool.Status.Allocated = 0
+		cb.Status(metav1.ConditionFalse).Reason(macpoolcondition.InvalidSpec).Message(service.CapitalizeFirstLetter(err.Error()) + ".")
+		conditions.SetCondition(cb, &pool.Status.Conditions)
+		return reconcile.Result{}, nil
+	}
+
+	allocated, err := h.countAllocated(ctx, addresses)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	overlapped, err := h.findOverlapped(ctx, pool, addresses)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	pool.Status.Capacity = int64(addresses.Size())
+	pool.Status.Allocated = allocated
+
+	switch {
+	case uint64(allocated) >= addresses.Size():
+		cb.Status(metav1.ConditionFalse).Reason(macpoolcondition.Exhausted).Message("All addresses of the pool are allocated.")
+	case len(overlapped) > 0:
+		cb.Status(metav1.ConditionTrue).Reason(macpoolcondition.Overlapped).Message(fmt.Sprintf("The addresses overlap with the MACAddressPool resources: %s.", strings.Join(overlapped, ", ")))
+	default:
+		cb.Status(metav1.ConditionTrue).Reason(macpoolcondition.Ready).Message("")
+	}
+	conditions.SetCondition(cb, &pool.Status.Conditions)
+
+	return reconcile.Result{}, nil
+}
+
+func (h SyncHandler) countAllocated(ctx context.Context, addresses mac.Pool) (int64, error) {
+	var leases v1alpha2.VirtualMachineMACAddressLeaseList
+	err := h.client.List(ctx, &leases)
+	if err != nil {
+		return 0, fmt.Errorf("list leases: %w", err)
+	}
+
+	var allocated int64
+	for _, lease := range leases.Items {
+		if addresses.Contains(mac.LeaseNameToAddress(lease.Name)) {
+			allocated++
+		}
+	}
+
+	return allocated, nil
+}
+
+// findOverlapped returns the names of the other valid pools having common addresses with the pool.
+func (h SyncHandler) findOverlapped(ctx context.Context, pool *v1alpha2.MACAddressPool, addresses mac.Pool) ([]string, error) {
+	var pools v1alpha2.MACAddressPoolList
+	err := h.client.List(ctx, &pools)
+	if err != nil {
+		return nil, fmt.Errorf("list mac address pools: %w", err)
+	}
+
+	var names []string
+	for _, other := range pools.Items {
+		if other.Name == pool.Name {
+			continue
+		}
+
+		otherAddresses, err := mac.NewPool(other.Spec.OUI, other.Spec.Ranges)
+		if err != nil {
+			continue
+		}
+
+		if addresses.Overlaps(otherAddresses) {
+			names = append(names, other.Name)
+		}
+	}
+	slices.Sort(names)
+
+	return names, nil
+}
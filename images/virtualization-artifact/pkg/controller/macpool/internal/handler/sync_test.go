@@ -0,0 +1,117 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/mac"
+	"github.com/deckhouse/virtualization-controller/pkg/common/testutil"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2/macpoolcondition"
+)
+
+var _ = Describe("SyncHandler", func() {
+	var (
+		ctx  context.Context
+		pool *v1alpha2.MACAddressPool
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.ContextBackgroundWithNoOpLogger()
+		pool = &v1alpha2.MACAddressPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "corp", Generation: 1},
+			Spec: v1alpha2.MACAddressPoolSpec{
+				OUI:    "00:1a:2b",
+				Ranges: []v1alpha2.MACAddressPoolRange{{Start: "00:00:00", End: "00:00:03"}},
+			},
+		}
+	})
+
+	newLease := func(address string) *v1alpha2.VirtualMachineMACAddressLease {
+		return &v1alpha2.VirtualMachineMACAddressLease{ObjectMeta: metav1.ObjectMeta{Name: mac.AddressToLeaseName(address)}}
+	}
+
+	handle := func(objs ...client.Object) {
+		GinkgoHelper()
+		fakeClient, err := testutil.NewFakeClientWithObjects(append(objs, pool)...)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = NewSyncHandler(fakeClient).Handle(ctx, pool)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	expectReady := func(status metav1.ConditionStatus, reason macpoolcondition.ReadyReason) {
+		GinkgoHelper()
+		ready, _ := conditions.GetCondition(macpoolcondition.ReadyType, pool.Status.Conditions)
+		Expect(ready.Status).To(Equal(status))
+		Expect(ready.Reason).To(Equal(reason.String()))
+	}
+
+	It("should count the allocated addresses of the pool", func() {
+		handle(newLease("00:1a:2b:00:00:01"), newLease("00:1a:2b:00:00:10"), newLease("5e:e6:19:00:00:01"))
+
+		Expect(pool.Status.Capacity).To(Equal(int64(4)))
+		Expect(pool.Status.Allocated).To(Equal(int64(1)))
+		expectReady(metav1.ConditionTrue, macpoolcondition.Ready)
+	})
+
+	It("should report the exhausted pool", func() {
+		handle(
+			newLease("00:1a:2b:00:00:00"),
+			newLease("00:1a:2b:00:00:01"),
+			newLease("00:1a:2b:00:00:02"),
+			newLease("00:1a:2b:00:00:03"),
+		)
+
+		Expect(pool.Status.Allocated).To(Equal(int64(4)))
+		expectReady(metav1.ConditionFalse, macpoolcondition.Exhausted)
+	})
+
+	It("should report the overlapping pools", func() {
+		handle(
+			&v1alpha2.MACAddressPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "whole"},
+				Spec:       v1alpha2.MACAddressPoolSpec{OUI: "00:1a:2b"},
+			},
+			&v1alpha2.MACAddressPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "other"},
+				Spec:       v1alpha2.MACAddressPoolSpec{OUI: "00:1a:2c"},
+			},
+		)
+
+		ready, _ := conditions.GetCondition(macpoolcondition.ReadyType, pool.Status.Conditions)
+		Expect(ready.Message).To(ContainSubstring("whole"))
+		Expect(ready.Message).NotTo(ContainSubstring("other"))
+		expectReady(metav1.ConditionTrue, macpoolcondition.Overlapped)
+	})
+
+	It("should report the invalid spec", func() {
+		pool.Spec.OUI = "01:1a:2b"
+
+		handle()
+
+		Expect(pool.Status.Capacity).To(BeZero())
+		expectReady(metav1.ConditionFalse, macpoolcondition.InvalidSpec)
+	})
+})
@@ -0,0 +1,76 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watcher
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+func NewPoolWatcher() *PoolWatcher {
+	return &PoolWatcher{}
+}
+
+// PoolWatcher enqueues all pools on a change of any pool: the pools are checked for overlapping with each other.
+type PoolWatcher struct{}
+
+func (w PoolWatcher) Watch(mgr manager.Manager, ctr controller.Controller) error {
+	c := mgr.GetClient()
+	err := ctr.Watch(
+		source.Kind(
+			mgr.GetCache(),
+			&v1alpha2.MACAddressPool{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, _ *v1alpha2.MACAddressPool) []reconcile.Request {
+				return allPools(ctx, c)
+			}),
+			predicate.TypedFuncs[*v1alpha2.MACAddressPool]{
+				UpdateFunc: func(e event.TypedUpdateEvent[*v1alpha2.MACAddressPool]) bool {
+					return e.ObjectOld.Generation != e.ObjectNew.Generation
+				},
+			},
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("error setting watch on MACAddressPool: %w", err)
+	}
+	return nil
+}
+
+func allPools(ctx context.Context, c client.Client) []reconcile.Request {
+	var pools v1alpha2.MACAddressPoolList
+	if err := c.List(ctx, &pools); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(pools.Items))
+	for _, pool := range pools.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&pool)})
+	}
+
+	return requests
+}
@@ -20,28 +20,34 @@ import (
 	"context"
 	"fmt"
 	"net/netip"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/component-base/featuregate"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	"github.com/deckhouse/virtualization-controller/pkg/common/ip"
 	appconfig "github.com/deckhouse/virtualization-controller/pkg/config"
 	mcapi "github.com/deckhouse/virtualization-controller/pkg/controller/moduleconfig/api"
+	"github.com/deckhouse/virtualization-controller/pkg/featuregates"
 )
 
 type cidrsValidator struct {
 	client         client.Client
 	clusterSubnets *appconfig.ClusterSubnets
+	featureGate    featuregate.FeatureGate
 }
 
-func newCIDRsValidator(client client.Client, clusterSubnets *appconfig.ClusterSubnets) *cidrsValidator {
+func newCIDRsValidator(client client.Client, clusterSubnets *appconfig.ClusterSubnets, featureGate featuregate.FeatureGate) *cidrsValidator {
 	return &cidrsValidator{
 		client:         client,
 		clusterSubnets: clusterSubnets,
+		featureGate:    featureGate,
 	}
 }
 
-func (v cidrsValidator) ValidateUpdate(ctx context.Context, _, newMC *mcapi.ModuleConfig) (admission.Warnings, error) {
+func (v cidrsValidator) ValidateUpdate(ctx context.Context, oldMC, newMC *mcapi.ModuleConfig) (admission.Warnings, error) {
 	cidrs, err := ParseCIDRs(newMC.Spec.Settings)
 	if err != nil {
 		return admission.Warnings{}, err
@@ -50,29 +56,60 @@ func (v cidrsValidator) ValidateUpdate(ctx context.Context, _, newMC *mcapi.Modu
 		return admission.Warnings{}, nil
 	}
 
-	err = CheckCIDRsOverlap(cidrs)
+	err = v.checkIPv6Allowed(oldMC, cidrs)
 	if err != nil {
 		return admission.Warnings{}, err
 	}
 
-	err = v.checkOverlapWithNodeAddresses(ctx, cidrs)
+	err = CheckCIDRsOverlap(cidrs)
 	if err != nil {
 		return admission.Warnings{}, err
 	}
 
-	err = CheckCIDRsOverlapWithPodSubnet(cidrs, v.clusterSubnets.PodSubnet)
+	err = v.checkOverlapWithNodeAddresses(ctx, cidrs)
 	if err != nil {
 		return admission.Warnings{}, err
 	}
 
-	err = CheckCIDRsOverlapWithServiceSubnet(cidrs, v.clusterSubnets.ServiceSubnet)
-	if err != nil {
-		return admission.Warnings{}, err
+	for _, podSubnet := range v.clusterSubnets.PodSubnets {
+		err = CheckCIDRsOverlapWithPodSubnet(cidrs, podSubnet)
+		if err != nil {
+			return admission.Warnings{}, err
+		}
+	}
+
+	for _, serviceSubnet := range v.clusterSubnets.ServiceSubnets {
+		err = CheckCIDRsOverlapWithServiceSubnet(cidrs, serviceSubnet)
+		if err != nil {
+			return admission.Warnings{}, err
+		}
 	}
 
 	return admission.Warnings{}, nil
 }
 
+// checkIPv6Allowed rejects added IPv6 subnets unless the IPv6Addresses feature gate is enabled.
+// Subnets that are already configured are kept, so the rest of the settings stays editable.
+func (v cidrsValidator) checkIPv6Allowed(oldMC *mcapi.ModuleConfig, cidrs []netip.Prefix) error {
+	if v.featureGate.Enabled(featuregates.IPv6Addresses) {
+		return nil
+	}
+
+	var oldCIDRs []netip.Prefix
+	if oldMC != nil {
+		// The old settings have already been validated.
+		oldCIDRs, _ = ParseCIDRs(oldMC.Spec.Settings)
+	}
+
+	for _, cidr := range cidrs {
+		if ip.PrefixFamily(cidr) == corev1.IPv6Protocol && !slices.Contains(oldCIDRs, cidr) {
+			return fmt.Errorf("the IPv6 subnet %s requires the %s feature gate", cidr, featuregates.IPv6Addresses)
+		}
+	}
+
+	return nil
+}
+
 func (v cidrsValidator) checkOverlapWithNodeAddresses(ctx context.Context, cidrs []netip.Prefix) error {
 	nodes := &corev1.NodeList{}
 	err := v.client.List(ctx, nodes)
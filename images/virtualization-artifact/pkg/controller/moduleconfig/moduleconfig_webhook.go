@@ -28,6 +28,7 @@ import (
 	appconfig "github.com/deckhouse/virtualization-controller/pkg/config"
 	mcapi "github.com/deckhouse/virtualization-controller/pkg/controller/moduleconfig/api"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/validator"
+	"github.com/deckhouse/virtualization-controller/pkg/featuregates"
 )
 
 const moduleConfigName = "virtualization"
@@ -46,7 +47,7 @@ func SetupWebhookWithManager(mgr manager.Manager, clusterSubnets *appconfig.Clus
 func NewModuleConfigValidator(client client.Client, clusterSubnets *appconfig.ClusterSubnets, kubernetesVersion *k8sversion.Version) *validator.Validator[*mcapi.ModuleConfig] {
 	logger := log.Default().With(slog.String("validator", "moduleconfig"))
 
-	cidrs := newCIDRsValidator(client, clusterSubnets)
+	cidrs := newCIDRsValidator(client, clusterSubnets, featuregates.Default())
 	reduceCIDRs := newRemoveCIDRsValidator(client)
 	viStorageClasses := newViStorageClassValidator(client)
 	dvcrValidator := newDvcrValidator(client)
@@ -29,6 +29,7 @@ import (
 
 	appconfig "github.com/deckhouse/virtualization-controller/pkg/config"
 	mcapi "github.com/deckhouse/virtualization-controller/pkg/controller/moduleconfig/api"
+	"github.com/deckhouse/virtualization-controller/pkg/featuregates"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 )
 
@@ -38,13 +39,18 @@ func TestCIDRsValidatorValidateUpdate(t *testing.T) {
 		t.Fatalf("AddToScheme: %v", err)
 	}
 
-	podSubnet := netip.MustParsePrefix("10.111.0.0/16")
-	serviceSubnet := netip.MustParsePrefix("10.222.0.0/16")
+	podSubnets := []netip.Prefix{netip.MustParsePrefix("10.111.0.0/16"), netip.MustParsePrefix("fd00:111::/56")}
+	serviceSubnets := []netip.Prefix{netip.MustParsePrefix("10.222.0.0/16"), netip.MustParsePrefix("fd00:222::/112")}
+
+	gate, setFromMap, err := featuregates.NewUnlocked()
+	if err != nil {
+		t.Fatalf("NewUnlocked: %v", err)
+	}
 
 	validator := newCIDRsValidator(fake.NewClientBuilder().WithScheme(scheme).Build(), &appconfig.ClusterSubnets{
-		PodSubnet:     podSubnet,
-		ServiceSubnet: serviceSubnet,
-	})
+		PodSubnets:     podSubnets,
+		ServiceSubnets: serviceSubnets,
+	}, gate)
 
 	newMC := &mcapi.ModuleConfig{Spec: mcapi.ModuleConfigSpec{Settings: mcapi.SettingsValues{"dvcr": map[string]any{}}}}
 	if _, err := validator.ValidateUpdate(context.Background(), nil, newMC); err != nil {
@@ -55,6 +61,31 @@ func TestCIDRsValidatorValidateUpdate(t *testing.T) {
 	if _, err := validator.ValidateUpdate(context.Background(), nil, newMC); err == nil {
 		t.Fatalf("expected overlap validation error")
 	}
+
+	newMC.Spec.Settings["virtualMachineCIDRs"] = []any{"10.10.0.0/24", "fd00:10::/64"}
+	if _, err := validator.ValidateUpdate(context.Background(), nil, newMC); err == nil {
+		t.Fatalf("expected IPv6 subnet validation error with the feature gate disabled")
+	}
+
+	oldMC := newMC.DeepCopy()
+	newMC.Spec.Settings["virtualMachineCIDRs"] = []any{"10.10.0.0/24", "10.20.0.0/24", "fd00:10::/64"}
+	if _, err := validator.ValidateUpdate(context.Background(), oldMC, newMC); err != nil {
+		t.Fatalf("expected no error for an already configured IPv6 subnet, got: %v", err)
+	}
+
+	if err = setFromMap(map[string]bool{string(featuregates.IPv6Addresses): true}); err != nil {
+		t.Fatalf("setFromMap: %v", err)
+	}
+
+	newMC.Spec.Settings["virtualMachineCIDRs"] = []any{"10.10.0.0/24", "fd00:111::/64"}
+	if _, err := validator.ValidateUpdate(context.Background(), nil, newMC); err == nil {
+		t.Fatalf("expected overlap with the IPv6 pod subnet validation error")
+	}
+
+	newMC.Spec.Settings["virtualMachineCIDRs"] = []any{"10.10.0.0/24", "fd00:10::/64"}
+	if _, err := validator.ValidateUpdate(context.Background(), nil, newMC); err != nil {
+		t.Fatalf("expected no error for dual-stack CIDRs, got: %v", err)
+	}
 }
 
 func TestRemoveCIDRsValidatorValidateUpdate(t *testing.T) {
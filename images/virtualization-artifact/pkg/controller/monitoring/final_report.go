@@ -29,12 +29,18 @@ import (
 
 // FinalReport example: { "source-image-size": 1111, "source-image-virtual-size": 8888, "source-image-format": "qcow2"}
 type FinalReport struct {
-	StoredSizeBytes   uint64        `json:"source-image-size,omitempty"`
-	UnpackedSizeBytes uint64        `json:"source-image-virtual-size,omitempty"`
-	Format            string        `json:"source-image-format,omitempty"`
-	Duration          time.Duration `json:"duration,omitempty"`
-	AverageSpeed      uint64        `json:"average-speed,omitempty"`
-	ErrMessage        string        `json:"error-message,omitempty"`
+	StoredSizeBytes    uint64        `json:"source-image-size,omitempty"`
+	UnpackedSizeBytes  uint64        `json:"source-image-virtual-size,omitempty"`
+	Format             string        `json:"source-image-format,omitempty"`
+	OSFamily           string        `json:"source-image-os-family,omitempty"`
+	OSVersion          string        `json:"source-image-os-version,omitempty"`
+	PartitionTable     string        `json:"source-image-partition-table,omitempty"`
+	EFISystemPartition bool          `json:"source-image-efi-system-partition,omitempty"`
+	BIOSBootable       bool          `json:"source-image-bios-bootable,omitempty"`
+	Duration           time.Duration `json:"duration,omitempty"`
+	AverageSpeed       uint64        `json:"average-speed,omitempty"`
+	ErrMessage         string        `json:"error-message,omitempty"`
+	ErrReason          string        `json:"error-reason,omitempty"`
 }
 
 func (r *FinalReport) GetAverageSpeed() string {
@@ -30,7 +30,12 @@ import (
 	"github.com/deckhouse/virtualization/api/core/v1alpha2/vmipcondition"
 )
 
-const AnnoIPAddressCNIRequest = "cni.cilium.io/ipAddress"
+const (
+	AnnoIPAddressCNIRequest = "cni.cilium.io/ipAddress"
+	// AnnoIPv6AddressCNIRequest is not part of upstream Cilium, which reads only the IPv4 annotation.
+	// It is set only while the IPv6Addresses feature gate is enabled.
+	AnnoIPv6AddressCNIRequest = "cni.cilium.io/ipv6Address"
+)
 
 func NewIPAM() *IPAM {
 	return &IPAM{}
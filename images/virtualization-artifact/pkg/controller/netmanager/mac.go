@@ -53,7 +53,9 @@ func (m MACManager) CheckMACAddressAvailableForBinding(vmmac *v1alpha2.VirtualMa
 	return nil
 }
 
-func (m MACManager) CreateMACAddress(ctx context.Context, vm *v1alpha2.VirtualMachine, client client.Client, macAddress string) error {
+// CreateMACAddress creates a VirtualMachineMACAddress for the virtual machine. The networkKey, if specified,
+// is the key of the network the address is created for: it selects the MACAddressPool to allocate the address from.
+func (m MACManager) CreateMACAddress(ctx context.Context, vm *v1alpha2.VirtualMachine, client client.Client, macAddress, networkKey string) error {
 	ownerRef := metav1.NewControllerRef(vm, vm.GroupVersionKind())
 	vmmac := &v1alpha2.VirtualMachineMACAddress{
 		ObjectMeta: metav1.ObjectMeta{
@@ -68,6 +70,11 @@ func (m MACManager) CreateMACAddress(ctx context.Context, vm *v1alpha2.VirtualMa
 	if macAddress != "" {
 		vmmac.Spec.Address = macAddress
 	}
+	if networkKey != "" {
+		vmmac.Annotations = map[string]string{
+			annotations.AnnMACAddressNetwork: networkKey,
+		}
+	}
 
 	return client.Create(ctx, vmmac)
 }
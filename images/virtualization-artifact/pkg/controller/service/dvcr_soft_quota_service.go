@@ -0,0 +1,136 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+// DVCRSoftQuotaService accounts the DVCR space occupied by VirtualImages of a namespace.
+// The usage is the sum of status.size.storedBytes of the namespace VirtualImages stored in DVCR,
+// the limit is taken from the virtualization.deckhouse.io/dvcr-soft-quota annotation of the namespace.
+//
+// The quota is soft: it is checked only before an import starts, when the size of most images is
+// not known yet, and imports running in parallel do not see each other. The usage may therefore
+// end up above the limit; it then blocks new imports until space is freed.
+type DVCRSoftQuotaService struct {
+	client client.Client
+}
+
+func NewDVCRSoftQuotaService(client client.Client) *DVCRSoftQuotaService {
+	return &DVCRSoftQuotaService{
+		client: client,
+	}
+}
+
+// DVCRSoftQuotaStatus describes the DVCR soft quota of a namespace.
+type DVCRSoftQuotaStatus struct {
+	// Limit is the quota in bytes, it is meaningful only if Limited is true.
+	Limit   int64
+	Limited bool
+	// Used is the number of bytes stored in DVCR by the namespace VirtualImages.
+	Used int64
+}
+
+// Exceeded returns true if the namespace has no more room in DVCR.
+func (s DVCRSoftQuotaStatus) Exceeded() bool {
+	return s.Limited && s.Used >= s.Limit
+}
+
+func (s DVCRSoftQuotaStatus) String() string {
+	return fmt.Sprintf("%s of %s used",
+		resource.NewQuantity(s.Used, resource.BinarySI).String(),
+		resource.NewQuantity(s.Limit, resource.BinarySI).String(),
+	)
+}
+
+// GetQuota returns the DVCR soft quota of the namespace. Images with the skip UID are not accounted:
+// pass the UID of the image being checked to exclude the image itself from the usage.
+func (s *DVCRSoftQuotaService) GetQuota(ctx context.Context, namespace string, skip types.UID) (DVCRSoftQuotaStatus, error) {
+	var ns corev1.Namespace
+	err := s.client.Get(ctx, types.NamespacedName{Name: namespace}, &ns)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return DVCRSoftQuotaStatus{}, nil
+		}
+		return DVCRSoftQuotaStatus{}, fmt.Errorf("get namespace %q: %w", namespace, err)
+	}
+
+	limit, limited, err := ParseDVCRSoftQuota(&ns)
+	if err != nil || !limited {
+		return DVCRSoftQuotaStatus{}, err
+	}
+
+	var viList v1alpha2.VirtualImageList
+	err = s.client.List(ctx, &viList, client.InNamespace(namespace))
+	if err != nil {
+		return DVCRSoftQuotaStatus{}, fmt.Errorf("list virtual images in namespace %q: %w", namespace, err)
+	}
+
+	status := DVCRSoftQuotaStatus{
+		Limit:   limit,
+		Limited: true,
+	}
+	for i := range viList.Items {
+		if viList.Items[i].UID == skip {
+			continue
+		}
+		status.Used += DVCRStoredBytes(&viList.Items[i])
+	}
+
+	return status, nil
+}
+
+// ParseDVCRSoftQuota returns the DVCR soft quota in bytes set on the namespace.
+func ParseDVCRSoftQuota(ns *corev1.Namespace) (int64, bool, error) {
+	value, ok := ns.GetAnnotations()[annotations.AnnDVCRSoftQuota]
+	if !ok {
+		return 0, false, nil
+	}
+
+	quota, err := resource.ParseQuantity(value)
+	if err != nil {
+		return 0, false, fmt.Errorf("parse annotation %s=%q on namespace %q: %w", annotations.AnnDVCRSoftQuota, value, ns.Name, err)
+	}
+
+	return quota.Value(), true, nil
+}
+
+// DVCRStoredBytes returns the number of bytes the VirtualImage occupies in DVCR.
+func DVCRStoredBytes(vi *v1alpha2.VirtualImage) int64 {
+	if vi.Spec.Storage != v1alpha2.StorageContainerRegistry || vi.Status.Size.StoredBytes == "" {
+		return 0
+	}
+
+	size, err := strconv.ParseInt(vi.Status.Size.StoredBytes, 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return size
+}
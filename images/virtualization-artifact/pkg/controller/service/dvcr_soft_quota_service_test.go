@@ -0,0 +1,103 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization-controller/pkg/common/testutil"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+var _ = Describe("DVCRSoftQuotaService", func() {
+	newNamespace := func(quota string) *corev1.Namespace {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns"}}
+		if quota != "" {
+			ns.Annotations = map[string]string{annotations.AnnDVCRSoftQuota: quota}
+		}
+		return ns
+	}
+
+	newVI := func(name string, storage v1alpha2.StorageType, storedBytes string) *v1alpha2.VirtualImage {
+		return &v1alpha2.VirtualImage{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", UID: types.UID("uid-" + name)},
+			Spec:       v1alpha2.VirtualImageSpec{Storage: storage},
+			Status: v1alpha2.VirtualImageStatus{
+				Size: v1alpha2.ImageStatusSize{StoredBytes: storedBytes},
+			},
+		}
+	}
+
+	It("sums stored bytes of the namespace images in DVCR", func() {
+		fakeClient, err := testutil.NewFakeClientWithObjects(
+			newNamespace("1Ki"),
+			newVI("first", v1alpha2.StorageContainerRegistry, "600"),
+			newVI("second", v1alpha2.StorageContainerRegistry, "500"),
+			newVI("on-pvc", v1alpha2.StoragePersistentVolumeClaim, "5000"),
+			newVI("importing", v1alpha2.StorageContainerRegistry, ""),
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		quota, err := NewDVCRSoftQuotaService(fakeClient).GetQuota(context.Background(), "ns", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(quota).To(Equal(DVCRSoftQuotaStatus{Limit: 1024, Limited: true, Used: 1100}))
+		Expect(quota.Exceeded()).To(BeTrue())
+		Expect(quota.String()).To(Equal("1100 of 1Ki used"))
+	})
+
+	It("does not account the skipped image", func() {
+		fakeClient, err := testutil.NewFakeClientWithObjects(
+			newNamespace("1Ki"),
+			newVI("first", v1alpha2.StorageContainerRegistry, "600"),
+			newVI("second", v1alpha2.StorageContainerRegistry, "500"),
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		quota, err := NewDVCRSoftQuotaService(fakeClient).GetQuota(context.Background(), "ns", "uid-second")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(quota.Used).To(BeEquivalentTo(600))
+		Expect(quota.Exceeded()).To(BeFalse())
+	})
+
+	It("is not limited without the annotation", func() {
+		fakeClient, err := testutil.NewFakeClientWithObjects(
+			newNamespace(""),
+			newVI("first", v1alpha2.StorageContainerRegistry, "600"),
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		quota, err := NewDVCRSoftQuotaService(fakeClient).GetQuota(context.Background(), "ns", "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(quota.Limited).To(BeFalse())
+		Expect(quota.Exceeded()).To(BeFalse())
+	})
+
+	It("fails on a malformed annotation", func() {
+		fakeClient, err := testutil.NewFakeClientWithObjects(newNamespace("a lot"))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = NewDVCRSoftQuotaService(fakeClient).GetQuota(context.Background(), "ns", "")
+		Expect(err).To(MatchError(ContainSubstring(annotations.AnnDVCRSoftQuota)))
+	})
+})
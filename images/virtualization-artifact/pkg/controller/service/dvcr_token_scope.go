@@ -25,11 +25,20 @@ import (
 // importerTokenScope is the DVCR access an importer Pod needs: push+pull on its
 // destination repository, plus pull on the source repository when the source is
 // itself a DVCR image (dvcr-artifact copies both through the same credential).
+// A registry source read through the pull-through cache adds pull on the cache
+// repository, and push if the importer fills it.
 func importerTokenScope(s *dvcr.Settings, settings *importer.Settings) []registrytoken.Access {
 	access := []registrytoken.Access{repoAccess(s.RepoPath(settings.DestinationEndpoint), "pull", "push")}
 	if settings.Source == importer.SourceDVCR && settings.Endpoint != "" {
 		access = append(access, repoAccess(s.RepoPath(settings.Endpoint), "pull"))
 	}
+	if settings.CacheImage != "" {
+		if settings.CacheFill {
+			access = append(access, repoAccess(s.RepoPath(settings.CacheImage), "pull", "push"))
+		} else {
+			access = append(access, repoAccess(s.RepoPath(settings.CacheImage), "pull"))
+		}
+	}
 	return access
 }
 
@@ -0,0 +1,230 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package exporter reconciles the set of objects that expose a disk exporter:
+// the Factory (factory.go) builds the desired specs without touching the API,
+// and the Exporter service applies them. All objects are owned by the export
+// resource and garbage-collected together with it.
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/object"
+	"github.com/deckhouse/virtualization-controller/pkg/common/pwgen"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/supplements"
+	"github.com/deckhouse/virtualization-controller/pkg/dvcr"
+)
+
+// tokenLength is the length of the random access token in the download path.
+const tokenLength = 48
+
+// Settings describes what a single exporter serves.
+type Settings struct {
+	// SourceClaim is the PersistentVolumeClaim holding the disk.
+	SourceClaim string
+	// BlockMode reports whether SourceClaim is a Block volume. A Filesystem
+	// volume holds the disk as an image file.
+	BlockMode bool
+	// Format and Compression are the dvcr-exporter values: raw|qcow2 and none|gzip.
+	Format      string
+	Compression string
+	FileName    string
+	ExpiresAt   time.Time
+}
+
+// URLs are the links the exported image is downloaded by.
+type URLs struct {
+	External  string
+	InCluster string
+}
+
+// Exporter reconciles the set of objects that expose a disk exporter.
+type Exporter interface {
+	// Apply idempotently ensures the token Secret, the exporter Pod, its Service
+	// and, when a public host is configured, the Ingress exist.
+	Apply(ctx context.Context, obj client.Object, sup supplements.Generator, settings Settings) error
+	GetPod(ctx context.Context, sup supplements.Generator) (*corev1.Pod, error)
+	// DeletePod stops serving the image. The other objects stay until the owner is deleted.
+	DeletePod(ctx context.Context, sup supplements.Generator) error
+	// GetURLs returns the download links, empty until the objects serving them exist.
+	GetURLs(ctx context.Context, sup supplements.Generator) (URLs, error)
+}
+
+type exporterService struct {
+	client         client.Client
+	dvcrSettings   *dvcr.Settings
+	image          string
+	pullPolicy     string
+	controllerName string
+	requirements   corev1.ResourceRequirements
+}
+
+func NewExporter(
+	c client.Client,
+	dvcrSettings *dvcr.Settings,
+	image string,
+	requirements corev1.ResourceRequirements,
+	pullPolicy string,
+	controllerName string,
+) Exporter {
+	return &exporterService{
+		client:         c,
+		dvcrSettings:   dvcrSettings,
+		image:          image,
+		requirements:   requirements,
+		pullPolicy:     pullPolicy,
+		controllerName: controllerName,
+	}
+}
+
+func (e *exporterService) Apply(ctx context.Context, obj client.Object, sup supplements.Generator, settings Settings) error {
+	ownerRef := metav1.NewControllerRef(obj, obj.GetObjectKind().GroupVersionKind())
+	f := e.newFactory(sup, *ownerRef, settings)
+
+	token, err := e.getToken(ctx, sup)
+	if err != nil {
+		return err
+	}
+	if token == "" {
+		token = pwgen.AlphaNum(tokenLength)
+		if err = e.createIfAbsent(ctx, f.Secret(token)); err != nil {
+			return fmt.Errorf("ensure exporter token secret: %w", err)
+		}
+	}
+
+	if err = e.createIfAbsent(ctx, f.Pod()); err != nil {
+		return fmt.Errorf("ensure exporter pod: %w", err)
+	}
+
+	if err = e.createIfAbsent(ctx, f.Service()); err != nil {
+		return fmt.Errorf("ensure exporter service: %w", err)
+	}
+
+	// Without a public host the export is downloadable through the in-cluster
+	// Service URL only.
+	if e.dvcrSettings.UploaderIngressSettings.Host == "" {
+		return nil
+	}
+
+	ing := f.Ingress(token)
+	if err = e.createIfAbsent(ctx, ing); err != nil {
+		return fmt.Errorf("ensure exporter ingress: %w", err)
+	}
+
+	return supplements.EnsureForIngress(ctx, e.client, sup, ing, e.dvcrSettings)
+}
+
+func (e *exporterService) GetPod(ctx context.Context, sup supplements.Generator) (*corev1.Pod, error) {
+	return object.FetchObject(ctx, sup.ExporterPod(), e.client, &corev1.Pod{})
+}
+
+func (e *exporterService) DeletePod(ctx context.Context, sup supplements.Generator) error {
+	pod, err := e.GetPod(ctx, sup)
+	if err != nil {
+		return err
+	}
+
+	if err = object.DeleteObject(ctx, e.client, pod); err != nil {
+		return fmt.Errorf("delete exporter pod: %w", err)
+	}
+
+	return nil
+}
+
+func (e *exporterService) GetURLs(ctx context.Context, sup supplements.Generator) (URLs, error) {
+	token, err := e.getToken(ctx, sup)
+	if err != nil || token == "" {
+		return URLs{}, err
+	}
+
+	var urls URLs
+
+	svc, err := object.FetchObject(ctx, sup.CommonSupplement(), e.client, &corev1.Service{})
+	if err != nil {
+		return URLs{}, err
+	}
+	if svc != nil && svc.Spec.ClusterIP != "" {
+		urls.InCluster = downloadURL("http", svc.Spec.ClusterIP, token)
+	}
+
+	ing, err := object.FetchObject(ctx, sup.CommonSupplement(), e.client, &netv1.Ingress{})
+	if err != nil {
+		return URLs{}, err
+	}
+	if ing != nil && len(ing.Spec.Rules) > 0 {
+		scheme := "http"
+		if len(ing.Spec.TLS) > 0 {
+			scheme = "https"
+		}
+		urls.External = downloadURL(scheme, ing.Spec.Rules[0].Host, token)
+	}
+
+	return urls, nil
+}
+
+func (e *exporterService) getToken(ctx context.Context, sup supplements.Generator) (string, error) {
+	secret, err := object.FetchObject(ctx, sup.CommonSupplement(), e.client, &corev1.Secret{})
+	if err != nil {
+		return "", fmt.Errorf("fetch exporter token secret: %w", err)
+	}
+	if secret == nil {
+		return "", nil
+	}
+
+	return string(secret.Data[tokenSecretKey]), nil
+}
+
+func (e *exporterService) newFactory(sup supplements.Generator, ownerRef metav1.OwnerReference, settings Settings) Factory {
+	podSettings := PodSettings{
+		Image:                e.image,
+		PullPolicy:           e.pullPolicy,
+		ControllerName:       e.controllerName,
+		ResourceRequirements: &e.requirements,
+		Settings:             settings,
+	}
+
+	return NewFactory(sup, podSettings, e.ingressSettings(sup), ownerRef)
+}
+
+func (e *exporterService) ingressSettings(sup supplements.Generator) IngressSettings {
+	secretName := e.dvcrSettings.UploaderIngressSettings.TLSSecret
+	if supplements.ShouldCopyUploaderTLSSecret(e.dvcrSettings, sup) {
+		secretName = sup.UploaderTLSSecretForIngress().Name
+	}
+
+	var class *string
+	if c := e.dvcrSettings.UploaderIngressSettings.Class; c != "" {
+		class = &c
+	}
+
+	return IngressSettings{
+		Host:          e.dvcrSettings.UploaderIngressSettings.Host,
+		ClassName:     class,
+		TLSSecretName: secretName,
+	}
+}
+
+func (e *exporterService) createIfAbsent(ctx context.Context, obj client.Object) error {
+	return client.IgnoreAlreadyExists(e.client.Create(ctx, obj))
+}
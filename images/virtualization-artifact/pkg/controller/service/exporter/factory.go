@@ -0,0 +1,419 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package exporter
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common"
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	podutil "github.com/deckhouse/virtualization-controller/pkg/common/pod"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/supplements"
+)
+
+const (
+	// downloadPathPrefix is followed by the access token: dvcr-exporter serves
+	// the image on this path only.
+	downloadPathPrefix = "/download/"
+
+	containerName = "exporter"
+	portName      = "exporter"
+
+	healthzPortName = "healthz"
+	healthzPort     = 8080
+	healthzPath     = "/healthz"
+
+	// exporterPodPort is the container port the dvcr-exporter serves downloads on.
+	exporterPodPort = 8445
+
+	sourceVolumeName = "source"
+	// sourceDevicePath is where a Block volume is attached in the exporter Pod.
+	sourceDevicePath = "/dev/source"
+	// sourceMountPath is where a Filesystem volume is mounted in the exporter Pod.
+	sourceMountPath = "/source"
+	// sourceImageFile is the name of the disk image on a Filesystem volume.
+	sourceImageFile = "disk.img"
+
+	tokenSecretKey = "token"
+
+	envSourcePath  = "EXPORTER_SOURCE_PATH"
+	envFormat      = "EXPORTER_FORMAT"
+	envCompression = "EXPORTER_COMPRESSION"
+	envToken       = "EXPORTER_TOKEN"
+	envFileName    = "EXPORTER_FILE_NAME"
+	envExpiresAt   = "EXPORTER_EXPIRES_AT"
+)
+
+// Factory builds the set of objects that expose an exporter: the token Secret,
+// the Pod, its Service and the Ingress. All objects share the same owner
+// reference and common labels.
+type Factory interface {
+	Secret(token string) *corev1.Secret
+	Pod() *corev1.Pod
+	Service() *corev1.Service
+	Ingress(token string) *netv1.Ingress
+}
+
+type factory struct {
+	sup             supplements.Generator
+	ownerReference  metav1.OwnerReference
+	podSettings     PodSettings
+	ingressSettings IngressSettings
+}
+
+// PodSettings carries the exporter Pod parameters that are not derived from the
+// supplements generator or the owner reference.
+type PodSettings struct {
+	Image                string
+	PullPolicy           string
+	ControllerName       string
+	ResourceRequirements *corev1.ResourceRequirements
+
+	Settings
+}
+
+// IngressSettings carries the host the exports are published on. Exports share
+// the public host of the uploader.
+type IngressSettings struct {
+	Host          string
+	ClassName     *string
+	TLSSecretName string
+}
+
+func NewFactory(
+	sup supplements.Generator,
+	podSettings PodSettings,
+	ingressSettings IngressSettings,
+	ownerReference metav1.OwnerReference,
+) Factory {
+	return &factory{
+		sup:             sup,
+		podSettings:     podSettings,
+		ingressSettings: ingressSettings,
+		ownerReference:  ownerReference,
+	}
+}
+
+// Secret keeps the access token out of the Pod spec.
+func (f factory) Secret(token string) *corev1.Secret {
+	name := f.sup.CommonSupplement()
+
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.Name,
+			Namespace: name.Namespace,
+			Labels:    f.commonLabels(),
+			OwnerReferences: []metav1.OwnerReference{
+				f.ownerReference,
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			tokenSecretKey: []byte(token),
+		},
+	}
+}
+
+func (f factory) Pod() *corev1.Pod {
+	supPod := f.sup.ExporterPod()
+	supService := f.sup.CommonSupplement()
+
+	labels := f.commonLabels()
+	labels[annotations.UploaderServiceLabel] = supService.Name
+	labels[annotations.QuotaExcludeLabel] = annotations.QuotaExcludeValue
+
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Pod",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      supPod.Name,
+			Namespace: supPod.Namespace,
+			Annotations: map[string]string{
+				annotations.AnnCreatedBy: "yes",
+			},
+			Labels: labels,
+			OwnerReferences: []metav1.OwnerReference{
+				f.ownerReference,
+			},
+		},
+		Spec: corev1.PodSpec{
+			Containers:    []corev1.Container{},
+			RestartPolicy: corev1.RestartPolicyOnFailure,
+			Volumes: []corev1.Volume{
+				{
+					Name: sourceVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: f.podSettings.SourceClaim,
+							ReadOnly:  true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	container := f.exporterContainer()
+	podutil.AddEmptyDirVolume(pod, container, "tmp", "/tmp")
+
+	if f.podSettings.BlockMode {
+		container.VolumeDevices = append(container.VolumeDevices, corev1.VolumeDevice{
+			Name:       sourceVolumeName,
+			DevicePath: sourceDevicePath,
+		})
+	} else {
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      sourceVolumeName,
+			MountPath: sourceMountPath,
+			ReadOnly:  true,
+		})
+	}
+
+	pod.Spec.Containers = append(pod.Spec.Containers, *container)
+
+	annotations.SetRecommendedLabels(pod, map[string]string{}, f.podSettings.ControllerName)
+	podutil.SetRestrictedSecurityContext(&pod.Spec)
+
+	return pod
+}
+
+func (f factory) exporterContainer() *corev1.Container {
+	container := &corev1.Container{
+		Name:            containerName,
+		Image:           f.podSettings.Image,
+		ImagePullPolicy: corev1.PullPolicy(f.podSettings.PullPolicy),
+		Command:         []string{"/usr/local/bin/dvcr-exporter"},
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          portName,
+				ContainerPort: exporterPodPort,
+				Protocol:      corev1.ProtocolTCP,
+			},
+			{
+				Name:          healthzPortName,
+				ContainerPort: healthzPort,
+				Protocol:      corev1.ProtocolTCP,
+			},
+		},
+		Env: f.exporterContainerEnv(),
+		SecurityContext: &corev1.SecurityContext{
+			ReadOnlyRootFilesystem: ptr.To(true),
+		},
+		ReadinessProbe: &corev1.Probe{
+			ProbeHandler: corev1.ProbeHandler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path: healthzPath,
+					Port: intstr.IntOrString{
+						Type:   intstr.String,
+						StrVal: healthzPortName,
+					},
+				},
+			},
+			InitialDelaySeconds: 2,
+			PeriodSeconds:       5,
+		},
+	}
+
+	if f.podSettings.ResourceRequirements != nil {
+		container.Resources = *f.podSettings.ResourceRequirements
+	}
+
+	return container
+}
+
+func (f factory) exporterContainerEnv() []corev1.EnvVar {
+	sourcePath := sourceDevicePath
+	if !f.podSettings.BlockMode {
+		sourcePath = sourceMountPath + "/" + sourceImageFile
+	}
+
+	env := []corev1.EnvVar{
+		{
+			Name:  common.OwnerUID,
+			Value: string(f.ownerReference.UID),
+		},
+		{
+			Name:  envSourcePath,
+			Value: sourcePath,
+		},
+		{
+			Name: envToken,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: f.sup.CommonSupplement().Name,
+					},
+					Key: tokenSecretKey,
+				},
+			},
+		},
+	}
+
+	// Unset parameters keep the dvcr-exporter defaults.
+	for _, v := range []corev1.EnvVar{
+		{Name: envFormat, Value: f.podSettings.Format},
+		{Name: envCompression, Value: f.podSettings.Compression},
+		{Name: envFileName, Value: f.podSettings.FileName},
+	} {
+		if v.Value != "" {
+			env = append(env, v)
+		}
+	}
+
+	if !f.podSettings.ExpiresAt.IsZero() {
+		env = append(env, corev1.EnvVar{
+			Name:  envExpiresAt,
+			Value: f.podSettings.ExpiresAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return env
+}
+
+func (f factory) Service() *corev1.Service {
+	supService := f.sup.CommonSupplement()
+
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      supService.Name,
+			Namespace: supService.Namespace,
+			Annotations: map[string]string{
+				annotations.AnnCreatedBy: "yes",
+			},
+			Labels: f.commonLabels(),
+			OwnerReferences: []metav1.OwnerReference{
+				f.ownerReference,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{
+					Name:     portName,
+					Protocol: corev1.ProtocolTCP,
+					Port:     common.UploaderPort,
+					TargetPort: intstr.IntOrString{
+						Type:   intstr.Int,
+						IntVal: exporterPodPort,
+					},
+				},
+			},
+			Selector: map[string]string{
+				annotations.UploaderServiceLabel: supService.Name,
+			},
+			Type: corev1.ServiceTypeClusterIP,
+		},
+	}
+}
+
+// Ingress publishes the download path only: the token is the path itself, so
+// the Ingress rule matches exactly and nothing else of the exporter is exposed.
+func (f factory) Ingress(token string) *netv1.Ingress {
+	path := downloadPath(token)
+	supIngress := f.sup.CommonSupplement()
+	supService := f.sup.CommonSupplement()
+
+	ingress := &netv1.Ingress{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Ingress",
+			APIVersion: netv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      supIngress.Name,
+			Namespace: supIngress.Namespace,
+			Annotations: map[string]string{
+				"nginx.ingress.kubernetes.io/proxy-buffering":    "off",
+				"nginx.ingress.kubernetes.io/proxy-read-timeout": "3600",
+				"nginx.ingress.kubernetes.io/proxy-send-timeout": "3600",
+			},
+			Labels: f.commonLabels(),
+			OwnerReferences: []metav1.OwnerReference{
+				f.ownerReference,
+			},
+		},
+		Spec: netv1.IngressSpec{
+			IngressClassName: f.ingressSettings.ClassName,
+			Rules: []netv1.IngressRule{
+				{
+					Host: f.ingressSettings.Host,
+					IngressRuleValue: netv1.IngressRuleValue{
+						HTTP: &netv1.HTTPIngressRuleValue{
+							Paths: []netv1.HTTPIngressPath{
+								{
+									Path:     path,
+									PathType: ptr.To(netv1.PathTypeExact),
+									Backend: netv1.IngressBackend{
+										Service: &netv1.IngressServiceBackend{
+											Name: supService.Name,
+											Port: netv1.ServiceBackendPort{
+												Number: common.UploaderPort,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if f.ingressSettings.TLSSecretName != "" {
+		ingress.Spec.TLS = []netv1.IngressTLS{
+			{
+				Hosts:      []string{f.ingressSettings.Host},
+				SecretName: f.ingressSettings.TLSSecretName,
+			},
+		}
+		ingress.Annotations["nginx.ingress.kubernetes.io/ssl-redirect"] = "true"
+	}
+
+	return ingress
+}
+
+// commonLabels returns the labels shared by every object the factory builds.
+func (f factory) commonLabels() map[string]string {
+	return map[string]string{
+		annotations.HeritageLabel: annotations.HeritageValue,
+		annotations.AppLabel:      annotations.DVCRLabelValue,
+	}
+}
+
+func downloadPath(token string) string {
+	return downloadPathPrefix + token
+}
+
+func downloadURL(scheme, host, token string) string {
+	return fmt.Sprintf("%s://%s%s", scheme, host, downloadPath(token))
+}
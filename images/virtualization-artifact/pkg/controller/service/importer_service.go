@@ -43,6 +43,7 @@ type ImporterService struct {
 	verbose        string
 	controllerName string
 	protection     *ProtectionService
+	cache          *PullThroughCacheService
 }
 
 func NewImporterService(
@@ -55,7 +56,7 @@ func NewImporterService(
 	controllerName string,
 	protection *ProtectionService,
 ) *ImporterService {
-	return &ImporterService{
+	s := &ImporterService{
 		dvcrSettings:   dvcrSettings,
 		client:         client,
 		image:          image,
@@ -65,6 +66,12 @@ func NewImporterService(
 		controllerName: controllerName,
 		protection:     protection,
 	}
+
+	if dvcrSettings.PullThroughCache {
+		s.cache = NewPullThroughCacheService(client, dvcrSettings, NewRegistryDigestResolver(client), dvcr.NewImageChecker(client, dvcrSettings))
+	}
+
+	return s
 }
 
 func (s ImporterService) Start(
@@ -79,6 +86,18 @@ func (s ImporterService) Start(
 	ownerRef := metav1.NewControllerRef(obj, obj.GetObjectKind().GroupVersionKind())
 	settings.Verbose = s.verbose
 
+	if s.cache != nil && settings.Source == importer.SourceRegistry && caBundle != nil && caBundle.GetContainerImage() != nil {
+		wait, err := s.cache.Apply(ctx, settings, caBundle.GetContainerImage())
+		if err != nil {
+			return err
+		}
+
+		// Another importer is copying the image to the cache: start later, without a Pod the import is retried.
+		if wait {
+			return nil
+		}
+	}
+
 	podSettings := s.getPodSettings(ownerRef, sup)
 	if options.nodePlacement != nil {
 		podSettings.NodePlacement = options.nodePlacement
@@ -808,6 +808,9 @@ var _ VirtClient = &VirtClientMock{}
 //			CertificatesV1beta1Func: func() certificatesv1beta1.CertificatesV1beta1Interface {
 //				panic("mock out the CertificatesV1beta1 method")
 //			},
+//			ClusterVirtualImageCatalogsFunc: func() corev1alpha2.ClusterVirtualImageCatalogInterface {
+//				panic("mock out the ClusterVirtualImageCatalogs method")
+//			},
 //			ClusterVirtualImagesFunc: func() corev1alpha2.ClusterVirtualImageInterface {
 //				panic("mock out the ClusterVirtualImages method")
 //			},
@@ -925,6 +928,9 @@ var _ VirtClient = &VirtClientMock{}
 //			USBDevicesFunc: func(namespace string) corev1alpha2.USBDeviceInterface {
 //				panic("mock out the USBDevices method")
 //			},
+//			VirtualDiskExportsFunc: func(namespace string) corev1alpha2.VirtualDiskExportInterface {
+//				panic("mock out the VirtualDiskExports method")
+//			},
 //			VirtualDiskSnapshotsFunc: func(namespace string) corev1alpha2.VirtualDiskSnapshotInterface {
 //				panic("mock out the VirtualDiskSnapshots method")
 //			},
@@ -1034,6 +1040,9 @@ type VirtClientMock struct {
 	// CertificatesV1beta1Func mocks the CertificatesV1beta1 method.
 	CertificatesV1beta1Func func() certificatesv1beta1.CertificatesV1beta1Interface
 
+	// ClusterVirtualImageCatalogsFunc mocks the ClusterVirtualImageCatalogs method.
+	ClusterVirtualImageCatalogsFunc func() corev1alpha2.ClusterVirtualImageCatalogInterface
+
 	// ClusterVirtualImagesFunc mocks the ClusterVirtualImages method.
 	ClusterVirtualImagesFunc func() corev1alpha2.ClusterVirtualImageInterface
 
@@ -1151,6 +1160,9 @@ type VirtClientMock struct {
 	// USBDevicesFunc mocks the USBDevices method.
 	USBDevicesFunc func(namespace string) corev1alpha2.USBDeviceInterface
 
+	// VirtualDiskExportsFunc mocks the VirtualDiskExports method.
+	VirtualDiskExportsFunc func(namespace string) corev1alpha2.VirtualDiskExportInterface
+
 	// VirtualDiskSnapshotsFunc mocks the VirtualDiskSnapshots method.
 	VirtualDiskSnapshotsFunc func(namespace string) corev1alpha2.VirtualDiskSnapshotInterface
 
@@ -1255,6 +1267,9 @@ type VirtClientMock struct {
 		// CertificatesV1beta1 holds details about calls to the CertificatesV1beta1 method.
 		CertificatesV1beta1 []struct {
 		}
+		// ClusterVirtualImageCatalogs holds details about calls to the ClusterVirtualImageCatalogs method.
+		ClusterVirtualImageCatalogs []struct {
+		}
 		// ClusterVirtualImages holds details about calls to the ClusterVirtualImages method.
 		ClusterVirtualImages []struct {
 		}
@@ -1374,6 +1389,11 @@ type VirtClientMock struct {
 			// Namespace is the namespace argument value.
 			Namespace string
 		}
+		// VirtualDiskExports holds details about calls to the VirtualDiskExports method.
+		VirtualDiskExports []struct {
+			// Namespace is the namespace argument value.
+			Namespace string
+		}
 		// VirtualDiskSnapshots holds details about calls to the VirtualDiskSnapshots method.
 		VirtualDiskSnapshots []struct {
 			// Namespace is the namespace argument value.
@@ -1459,6 +1479,7 @@ type VirtClientMock struct {
 	lockCertificatesV1                       sync.RWMutex
 	lockCertificatesV1alpha1                 sync.RWMutex
 	lockCertificatesV1beta1                  sync.RWMutex
+	lockClusterVirtualImageCatalogs          sync.RWMutex
 	lockClusterVirtualImages                 sync.RWMutex
 	lockCoordinationV1                       sync.RWMutex
 	lockCoordinationV1alpha2                 sync.RWMutex
@@ -1498,6 +1519,7 @@ type VirtClientMock struct {
 	lockStorageV1beta1                       sync.RWMutex
 	lockStoragemigrationV1alpha1             sync.RWMutex
 	lockUSBDevices                           sync.RWMutex
+	lockVirtualDiskExports                   sync.RWMutex
 	lockVirtualDiskSnapshots                 sync.RWMutex
 	lockVirtualDisks                         sync.RWMutex
 	lockVirtualImages                        sync.RWMutex
@@ -2054,6 +2076,33 @@ func (mock *VirtClientMock) CertificatesV1beta1Calls() []struct {
 	return calls
 }
 
+// ClusterVirtualImageCatalogs calls ClusterVirtualImageCatalogsFunc.
+func (mock *VirtClientMock) ClusterVirtualImageCatalogs() corev1alpha2.ClusterVirtualImageCatalogInterface {
+	if mock.ClusterVirtualImageCatalogsFunc == nil {
+		panic("VirtClientMock.ClusterVirtualImageCatalogsFunc: method is nil but VirtClient.ClusterVirtualImageCatalogs was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockClusterVirtualImageCatalogs.Lock()
+	mock.calls.ClusterVirtualImageCatalogs = append(mock.calls.ClusterVirtualImageCatalogs, callInfo)
+	mock.lockClusterVirtualImageCatalogs.Unlock()
+	return mock.ClusterVirtualImageCatalogsFunc()
+}
+
+// ClusterVirtualImageCatalogsCalls gets all the calls that were made to ClusterVirtualImageCatalogs.
+// Check the length with:
+//
+//	len(mockedVirtClient.ClusterVirtualImageCatalogsCalls())
+func (mock *VirtClientMock) ClusterVirtualImageCatalogsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockClusterVirtualImageCatalogs.RLock()
+	calls = mock.calls.ClusterVirtualImageCatalogs
+	mock.lockClusterVirtualImageCatalogs.RUnlock()
+	return calls
+}
+
 // ClusterVirtualImages calls ClusterVirtualImagesFunc.
 func (mock *VirtClientMock) ClusterVirtualImages() corev1alpha2.ClusterVirtualImageInterface {
 	if mock.ClusterVirtualImagesFunc == nil {
@@ -3112,6 +3161,38 @@ func (mock *VirtClientMock) USBDevicesCalls() []struct {
 	return calls
 }
 
+// VirtualDiskExports calls VirtualDiskExportsFunc.
+func (mock *VirtClientMock) VirtualDiskExports(namespace string) corev1alpha2.VirtualDiskExportInterface {
+	if mock.VirtualDiskExportsFunc == nil {
+		panic("VirtClientMock.VirtualDiskExportsFunc: method is nil but VirtClient.VirtualDiskExports was just called")
+	}
+	callInfo := struct {
+		Namespace string
+	}{
+		Namespace: namespace,
+	}
+	mock.lockVirtualDiskExports.Lock()
+	mock.calls.VirtualDiskExports = append(mock.calls.VirtualDiskExports, callInfo)
+	mock.lockVirtualDiskExports.Unlock()
+	return mock.VirtualDiskExportsFunc(namespace)
+}
+
+// VirtualDiskExportsCalls gets all the calls that were made to VirtualDiskExports.
+// Check the length with:
+//
+//	len(mockedVirtClient.VirtualDiskExportsCalls())
+func (mock *VirtClientMock) VirtualDiskExportsCalls() []struct {
+	Namespace string
+} {
+	var calls []struct {
+		Namespace string
+	}
+	mock.lockVirtualDiskExports.RLock()
+	calls = mock.calls.VirtualDiskExports
+	mock.lockVirtualDiskExports.RUnlock()
+	return calls
+}
+
 // VirtualDiskSnapshots calls VirtualDiskSnapshotsFunc.
 func (mock *VirtClientMock) VirtualDiskSnapshots(namespace string) corev1alpha2.VirtualDiskSnapshotInterface {
 	if mock.VirtualDiskSnapshotsFunc == nil {
@@ -0,0 +1,215 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/go-containerregistry/pkg/authn/kubernetes"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization-controller/pkg/common/datasource"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/importer"
+	"github.com/deckhouse/virtualization-controller/pkg/dvcr"
+	"github.com/deckhouse/virtualization-controller/pkg/logger"
+)
+
+// DigestResolver resolves the digest of the container image in its registry.
+type DigestResolver interface {
+	ResolveDigest(ctx context.Context, ctrImg *datasource.ContainerRegistry) (string, error)
+}
+
+// PullThroughCacheService serves the imports of the ContainerImage data sources from the DVCR cache.
+//
+// The cache is keyed by the image digest, so the imports of the same image share one cache entry
+// whatever tag they use. The digest is resolved in the source registry with the credentials of the
+// importing resource: an entry is used only by those who are allowed to pull the image anyway.
+// The first import of a digest copies the image to the cache, and the imports of the same image
+// started meanwhile wait for it instead of pulling the image from the source registry again.
+type PullThroughCacheService struct {
+	client       client.Client
+	dvcrSettings *dvcr.Settings
+	resolver     DigestResolver
+	checker      dvcr.ImageChecker
+}
+
+func NewPullThroughCacheService(
+	client client.Client,
+	dvcrSettings *dvcr.Settings,
+	resolver DigestResolver,
+	checker dvcr.ImageChecker,
+) *PullThroughCacheService {
+	return &PullThroughCacheService{
+		client:       client,
+		dvcrSettings: dvcrSettings,
+		resolver:     resolver,
+		checker:      checker,
+	}
+}
+
+// Apply makes the importer read the registry source from the cache. It returns true if another importer
+// is copying the same image to the cache at the moment: the import should be started later.
+// The cache is an optimization, so if the digest or the cache entry cannot be checked, the import goes
+// to the source registry as usual.
+func (s PullThroughCacheService) Apply(ctx context.Context, settings *importer.Settings, ctrImg *datasource.ContainerRegistry) (bool, error) {
+	log := logger.FromContext(ctx)
+
+	ref, err := name.ParseReference(ctrImg.Image)
+	if err != nil {
+		log.Debug("Skip the cache for the unparsable image", "image", ctrImg.Image, logger.SlogErr(err))
+		return false, nil
+	}
+
+	fillKey := CacheFillKey(ref)
+
+	filling, err := s.isFilling(ctx, fillKey)
+	if err != nil {
+		return false, err
+	}
+	if filling {
+		log.Info("Waiting for the image to be copied to the cache", "image", ctrImg.Image)
+		return true, nil
+	}
+
+	digest, err := s.resolver.ResolveDigest(ctx, ctrImg)
+	if err != nil {
+		log.Warn("Skip the cache: failed to resolve the image digest", "image", ctrImg.Image, logger.SlogErr(err))
+		return false, nil
+	}
+
+	cacheImage := s.dvcrSettings.RegistryImageForCache(digest)
+
+	cached, err := s.checker.CheckImageExists(ctx, cacheImage)
+	if err != nil {
+		log.Warn("Skip the cache: failed to check the cache image", "image", cacheImage, logger.SlogErr(err))
+		return false, nil
+	}
+
+	importer.ApplyPullThroughCacheSettings(settings, ref.Context().Name()+"@"+digest, cacheImage, !cached, fillKey)
+
+	return false, nil
+}
+
+// isFilling returns true if an importer Pod is copying the image to the cache. A Pod that has restarted
+// is not waited for: it may never succeed, and the waiting imports would never start.
+func (s PullThroughCacheService) isFilling(ctx context.Context, fillKey string) (bool, error) {
+	var pods corev1.PodList
+	err := s.client.List(ctx, &pods, client.MatchingLabels{annotations.LabelDVCRCacheFill: fillKey})
+	if err != nil {
+		return false, fmt.Errorf("list the importer pods filling the cache: %w", err)
+	}
+
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		if pod.Status.Phase != corev1.PodPending && pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+
+		restarted := false
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.RestartCount > 0 {
+				restarted = true
+				break
+			}
+		}
+
+		if !restarted {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// CacheFillKey returns the label value identifying the image among the importers filling the cache.
+func CacheFillKey(ref name.Reference) string {
+	sum := sha256.Sum256([]byte(ref.Name()))
+	// A label value is limited to 63 characters.
+	return hex.EncodeToString(sum[:])[:63]
+}
+
+// RegistryDigestResolver resolves the digest with a HEAD request to the registry of the image.
+type RegistryDigestResolver struct {
+	client client.Client
+}
+
+func NewRegistryDigestResolver(client client.Client) *RegistryDigestResolver {
+	return &RegistryDigestResolver{client: client}
+}
+
+func (r RegistryDigestResolver) ResolveDigest(ctx context.Context, ctrImg *datasource.ContainerRegistry) (string, error) {
+	ref, err := name.ParseReference(ctrImg.Image)
+	if err != nil {
+		return "", fmt.Errorf("parse image reference %q: %w", ctrImg.Image, err)
+	}
+
+	if digest, ok := ref.(name.Digest); ok {
+		return digest.DigestStr(), nil
+	}
+
+	opts := []remote.Option{remote.WithContext(ctx)}
+
+	if ctrImg.ImagePullSecret.Name != "" {
+		var secret corev1.Secret
+		err = r.client.Get(ctx, ctrImg.ImagePullSecret, &secret)
+		if err != nil {
+			return "", fmt.Errorf("get image pull secret %s: %w", ctrImg.ImagePullSecret, err)
+		}
+
+		keychain, err := kubernetes.NewFromPullSecrets(ctx, []corev1.Secret{secret})
+		if err != nil {
+			return "", fmt.Errorf("create keychain from image pull secret: %w", err)
+		}
+
+		opts = append(opts, remote.WithAuthFromKeychain(keychain))
+	}
+
+	if len(ctrImg.CABundle) > 0 {
+		certPool, err := x509.SystemCertPool()
+		if err != nil {
+			certPool = x509.NewCertPool()
+		}
+		if !certPool.AppendCertsFromPEM(ctrImg.CABundle) {
+			return "", errors.New("failed to append CA bundle to pool")
+		}
+
+		httpTransport := http.DefaultTransport.(*http.Transport).Clone()
+		httpTransport.TLSClientConfig = &tls.Config{RootCAs: certPool}
+		opts = append(opts, remote.WithTransport(httpTransport))
+	}
+
+	desc, err := remote.Head(ref, opts...)
+	if err != nil {
+		return "", fmt.Errorf("resolve digest of %q: %w", ctrImg.Image, err)
+	}
+
+	return desc.Digest.String(), nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization-controller/pkg/common/datasource"
+	"github.com/deckhouse/virtualization-controller/pkg/common/testutil"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/importer"
+	"github.com/deckhouse/virtualization-controller/pkg/dvcr"
+)
+
+type digestResolverStub struct {
+	digest string
+	err    error
+}
+
+func (r digestResolverStub) ResolveDigest(_ context.Context, _ *datasource.ContainerRegistry) (string, error) {
+	return r.digest, r.err
+}
+
+var _ = Describe("PullThroughCacheService", func() {
+	const image = "registry.example.com/os/ubuntu:24.04"
+
+	var (
+		digest       = "sha256:" + strings.Repeat("a", 64)
+		cacheImage   = "dvcr.example.com/cache/sha256/" + strings.Repeat("a", 64) + "@" + digest
+		dvcrSettings *dvcr.Settings
+		ctrImg       *datasource.ContainerRegistry
+		settings     *importer.Settings
+		cached       bool
+	)
+
+	BeforeEach(func() {
+		dvcrSettings = &dvcr.Settings{RegistryURL: "dvcr.example.com", PullThroughCache: true}
+		ctrImg = &datasource.ContainerRegistry{Image: image}
+		settings = &importer.Settings{Source: importer.SourceRegistry, Endpoint: "docker://" + image}
+		cached = false
+	})
+
+	newService := func(resolver DigestResolver, objs ...client.Object) *PullThroughCacheService {
+		fakeClient, err := testutil.NewFakeClientWithObjects(objs...)
+		Expect(err).NotTo(HaveOccurred())
+
+		checker := &dvcr.ImageCheckerMock{
+			CheckImageExistsFunc: func(_ context.Context, imageURL string) (bool, error) {
+				Expect(imageURL).To(Equal(cacheImage))
+				return cached, nil
+			},
+		}
+
+		return NewPullThroughCacheService(fakeClient, dvcrSettings, resolver, checker)
+	}
+
+	fillerPod := func(phase corev1.PodPhase, restarts int32) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "importer-other",
+				Namespace: "other",
+				Labels:    map[string]string{annotations.LabelDVCRCacheFill: CacheFillKey(name.MustParseReference(image))},
+			},
+			Status: corev1.PodStatus{
+				Phase:             phase,
+				ContainerStatuses: []corev1.ContainerStatus{{RestartCount: restarts}},
+			},
+		}
+	}
+
+	It("should fill the cache on the first import of the digest", func() {
+		wait, err := newService(digestResolverStub{digest: digest}).Apply(testContext(), settings, ctrImg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeFalse())
+
+		Expect(settings.Endpoint).To(Equal("docker://registry.example.com/os/ubuntu@" + digest))
+		Expect(settings.CacheImage).To(Equal(cacheImage))
+		Expect(settings.CacheFill).To(BeTrue())
+		Expect(settings.CacheFillKey).To(HaveLen(63))
+	})
+
+	It("should read the cached digest without filling", func() {
+		cached = true
+
+		wait, err := newService(digestResolverStub{digest: digest}).Apply(testContext(), settings, ctrImg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeFalse())
+
+		Expect(settings.CacheImage).To(Equal(cacheImage))
+		Expect(settings.CacheFill).To(BeFalse())
+		Expect(settings.CacheFillKey).To(BeEmpty())
+	})
+
+	It("should wait for another importer filling the cache", func() {
+		resolver := digestResolverStub{err: errors.New("must not be resolved")}
+
+		wait, err := newService(resolver, fillerPod(corev1.PodRunning, 0)).Apply(testContext(), settings, ctrImg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeTrue())
+		Expect(settings.Endpoint).To(Equal("docker://" + image))
+	})
+
+	DescribeTable("should not wait for the importer that cannot fill the cache",
+		func(pod *corev1.Pod) {
+			wait, err := newService(digestResolverStub{digest: digest}, pod).Apply(testContext(), settings, ctrImg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wait).To(BeFalse())
+			Expect(settings.CacheFill).To(BeTrue())
+		},
+		Entry("succeeded", fillerPod(corev1.PodSucceeded, 0)),
+		Entry("restarting", fillerPod(corev1.PodRunning, 2)),
+	)
+
+	It("should import from the source registry if the digest is not resolved", func() {
+		wait, err := newService(digestResolverStub{err: errors.New("unauthorized")}).Apply(testContext(), settings, ctrImg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wait).To(BeFalse())
+
+		Expect(settings.Endpoint).To(Equal("docker://" + image))
+		Expect(settings.CacheImage).To(BeEmpty())
+	})
+})
@@ -86,11 +86,16 @@ func (v *VirtualMachineIPHandler) ValidateRestore(ctx context.Context) error {
 		}
 	}
 
-	if v.vmip.Spec.StaticIP != "" {
+	staticIPs := v.vmip.Spec.StaticIPs
+	if len(staticIPs) == 0 && v.vmip.Spec.StaticIP != "" {
+		staticIPs = []string{v.vmip.Spec.StaticIP}
+	}
+
+	for _, staticIP := range staticIPs {
 		var vmips v1alpha2.VirtualMachineIPAddressList
 		err = v.client.List(ctx, &vmips, &client.ListOptions{
 			Namespace:     v.vmip.Namespace,
-			FieldSelector: fields.OneTermEqualSelector(indexer.IndexFieldVMIPByAddress, v.vmip.Spec.StaticIP),
+			FieldSelector: fields.OneTermEqualSelector(indexer.IndexFieldVMIPByAddress, staticIP),
 		})
 		if err != nil {
 			return err
@@ -103,7 +108,7 @@ func (v *VirtualMachineIPHandler) ValidateRestore(ctx context.Context) error {
 
 			return fmt.Errorf(
 				"the IP Address %q cannot be used for restore: it is taken by VirtualMachineIPAddress/%s and %w by the different virtual machine",
-				v.vmip.Spec.StaticIP, vmip.Name, common.ErrAlreadyInUse,
+				staticIP, vmip.Name, common.ErrAlreadyInUse,
 			)
 		}
 	}
@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -244,6 +245,9 @@ func (r SecretRestorer) setVirtualMachineIPAddress(ctx context.Context, secret *
 		case v1alpha2.VirtualMachineIPAddressTypeAuto:
 			vmip.Spec.Type = v1alpha2.VirtualMachineIPAddressTypeStatic
 			vmip.Spec.StaticIP = vmip.Status.Address
+			if len(vmip.Status.Addresses) > 1 {
+				vmip.Spec.StaticIPs = slices.Clone(vmip.Status.Addresses)
+			}
 			// Put to secret.
 		}
 	}
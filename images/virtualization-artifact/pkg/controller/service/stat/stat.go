@@ -43,6 +43,7 @@ import (
 	"github.com/deckhouse/virtualization-controller/pkg/controller/monitoring"
 	serviceuploader "github.com/deckhouse/virtualization-controller/pkg/controller/service/uploader"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	dvcrrepo "github.com/deckhouse/virtualization/api/dvcr"
 )
 
 type StatService struct {
@@ -83,6 +84,34 @@ func (s StatService) GetCDROM(pod *corev1.Pod) bool {
 	return imageformat.IsISO(finalReport.Format)
 }
 
+// GetInspection returns the results of the image content inspection performed by the importer,
+// or nil if nothing has been detected.
+func (s StatService) GetInspection(pod *corev1.Pod) *v1alpha2.ImageStatusInspection {
+	finalReport, err := monitoring.GetFinalReportFromPod(pod)
+	if err != nil {
+		s.logger.Error("GetInspection: Cannot get final report from pod", "err", err)
+		return nil
+	}
+
+	if finalReport == nil {
+		return nil
+	}
+
+	inspection := v1alpha2.ImageStatusInspection{
+		OSFamily:           v1alpha2.ImageOSFamily(finalReport.OSFamily),
+		OSVersion:          finalReport.OSVersion,
+		PartitionTable:     v1alpha2.ImagePartitionTable(finalReport.PartitionTable),
+		EFISystemPartition: finalReport.EFISystemPartition,
+		BIOSBootable:       finalReport.BIOSBootable,
+	}
+
+	if inspection == (v1alpha2.ImageStatusInspection{}) {
+		return nil
+	}
+
+	return &inspection
+}
+
 func (s StatService) GetSize(pod *corev1.Pod) v1alpha2.ImageStatusSize {
 	finalReport, err := monitoring.GetFinalReportFromPod(pod)
 	if err != nil {
@@ -111,6 +140,10 @@ var (
 	ErrDVCRNoSpaceImageError = errors.New("DVCR is out of space; please contact the cluster administrator")
 	// ErrDVCRNoSpaceDiskError is intended to avoid confusion by clarifying that DVCR is needed as an intermediary when creating a virtual disk.
 	ErrDVCRNoSpaceDiskError = errors.New("DVCR is out of space to create the virtual disk; please contact the cluster administrator")
+	// ErrSignatureVerificationFailed marks a provisioning failure caused by an image without a trusted signature.
+	ErrSignatureVerificationFailed = errors.New("image signature verification failed")
+	// ErrCustomizationFailed marks a provisioning failure caused by the offline customization of the image.
+	ErrCustomizationFailed = errors.New("image customization failed")
 )
 
 func (s StatService) CheckPod(pod *corev1.Pod) error {
@@ -140,6 +173,12 @@ func (s StatService) CheckPod(pod *corev1.Pod) error {
 			}
 			return fmt.Errorf("%w: %w", ErrProvisioningFailed, ErrDVCRNoSpaceImageError)
 		}
+		if report.ErrReason == dvcrrepo.SignatureVerificationFailedReason {
+			return terminationMessageError{fmt.Errorf("%w: %w: %s", ErrProvisioningFailed, ErrSignatureVerificationFailed, report.ErrMessage)}
+		}
+		if report.ErrReason == dvcrrepo.CustomizationFailedReason {
+			return terminationMessageError{fmt.Errorf("%w: %w: %s", ErrProvisioningFailed, ErrCustomizationFailed, report.ErrMessage)}
+		}
 		return terminationMessageError{fmt.Errorf("%w: Pod %s/%s reported: %s", ErrProvisioningFailed, pod.Namespace, pod.Name, report.ErrMessage)}
 	}
 
@@ -37,6 +37,7 @@ import (
 
 	"github.com/deckhouse/deckhouse/pkg/log"
 	serviceuploader "github.com/deckhouse/virtualization-controller/pkg/controller/service/uploader"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 )
 
 func TestStat(t *testing.T) {
@@ -182,3 +183,78 @@ var _ = Describe("StatService.IsUploaderReady", func() {
 		Expect(ready).To(BeTrue())
 	})
 })
+
+var _ = Describe("StatService.CheckPod", func() {
+	failedPod := func(message string) *corev1.Pod {
+		return &corev1.Pod{
+			Status: corev1.PodStatus{
+				Phase: corev1.PodFailed,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{Message: message},
+					},
+				}},
+			},
+		}
+	}
+
+	It("recognizes the signature verification failure reported by the importer", func() {
+		s := NewStatService(log.NewNop())
+
+		err := s.CheckPod(failedPod(`{"error-message":"signature verification failed for image registry.example.com/os:1: the image is not signed","error-reason":"SignatureVerificationFailed"}`))
+		Expect(err).To(MatchError(ErrProvisioningFailed))
+		Expect(err).To(MatchError(ErrSignatureVerificationFailed))
+		Expect(IsTerminationMessageError(err)).To(BeTrue())
+	})
+
+	It("does not mistake other import failures for a signature verification failure", func() {
+		s := NewStatService(log.NewNop())
+
+		err := s.CheckPod(failedPod(`{"error-message":"sha256 sum mismatch","error-reason":"BadImageChecksum"}`))
+		Expect(err).To(MatchError(ErrProvisioningFailed))
+		Expect(err).NotTo(MatchError(ErrSignatureVerificationFailed))
+		Expect(err).NotTo(MatchError(ErrCustomizationFailed))
+	})
+
+	It("recognizes the customization failure reported by the importer", func() {
+		s := NewStatService(log.NewNop())
+
+		err := s.CheckPod(failedPod(`{"error-message":"customization failed: install packages: exit status 100","error-reason":"CustomizationFailed"}`))
+		Expect(err).To(MatchError(ErrProvisioningFailed))
+		Expect(err).To(MatchError(ErrCustomizationFailed))
+		Expect(IsTerminationMessageError(err)).To(BeTrue())
+	})
+})
+
+var _ = Describe("StatService.GetInspection", func() {
+	completedPod := func(message string) *corev1.Pod {
+		return &corev1.Pod{
+			Status: corev1.PodStatus{
+				Phase: corev1.PodSucceeded,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					State: corev1.ContainerState{
+						Terminated: &corev1.ContainerStateTerminated{Message: message},
+					},
+				}},
+			},
+		}
+	}
+
+	It("returns the inspection results reported by the importer", func() {
+		s := NewStatService(log.NewNop())
+
+		inspection := s.GetInspection(completedPod(`{"source-image-format":"raw","source-image-os-family":"Linux","source-image-os-version":"24.04.1","source-image-partition-table":"GPT","source-image-efi-system-partition":true}`))
+		Expect(inspection).To(Equal(&v1alpha2.ImageStatusInspection{
+			OSFamily:           v1alpha2.ImageOSFamilyLinux,
+			OSVersion:          "24.04.1",
+			PartitionTable:     v1alpha2.ImagePartitionTableGPT,
+			EFISystemPartition: true,
+		}))
+	})
+
+	It("returns nil if nothing has been detected", func() {
+		s := NewStatService(log.NewNop())
+
+		Expect(s.GetInspection(completedPod(`{"source-image-format":"qcow2"}`))).To(BeNil())
+	})
+})
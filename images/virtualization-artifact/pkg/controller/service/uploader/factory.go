@@ -18,6 +18,7 @@ package uploader
 
 import (
 	"fmt"
+	"strconv"
 
 	corev1 "k8s.io/api/core/v1"
 	netv1 "k8s.io/api/networking/v1"
@@ -104,6 +105,8 @@ type PodSettings struct {
 	DestinationEndpoint    string
 	DestinationInsecureTLS string
 	DestinationAuthSecret  string
+	DestinationChunked     bool
+	DestinationCompression string
 	Checksums              map[string]string
 }
 
@@ -468,6 +471,20 @@ func (f factory) uploaderContainerEnv() []corev1.EnvVar {
 		},
 	}
 
+	if f.podSettings.DestinationCompression != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  common.DestinationCompressionVar,
+			Value: f.podSettings.DestinationCompression,
+		})
+	}
+
+	if f.podSettings.DestinationChunked {
+		env = append(env, corev1.EnvVar{
+			Name:  common.DestinationChunkedVar,
+			Value: strconv.FormatBool(f.podSettings.DestinationChunked),
+		})
+	}
+
 	// Upload source checksum settings.
 	if checksums := datasource.FormatChecksums(f.podSettings.Checksums); checksums != "" {
 		env = append(env, corev1.EnvVar{
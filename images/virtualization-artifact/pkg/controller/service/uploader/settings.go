@@ -29,6 +29,8 @@ type Settings struct {
 	DestinationEndpoint    string
 	DestinationInsecureTLS string
 	DestinationAuthSecret  string
+	DestinationChunked     bool
+	DestinationCompression string
 	Checksums              map[string]string
 }
 
@@ -48,3 +50,16 @@ func ApplyDVCRDestinationSettings(podEnvVars *Settings, dvcrSettings *dvcr.Setti
 	podEnvVars.DestinationInsecureTLS = dvcrSettings.InsecureTLS
 	podEnvVars.DestinationEndpoint = dvcrImageName
 }
+
+// ApplyDVCRCompressionSettings sets the compression of the image stored in DVCR: the one requested
+// for the resource or, if not requested, the default one from the module settings.
+func ApplyDVCRCompressionSettings(podEnvVars *Settings, dvcrSettings *dvcr.Settings, compression v1alpha2.ImageCompression) {
+	podEnvVars.DestinationCompression = dvcrSettings.ImageCompression(compression)
+}
+
+// ApplyChunkedDestinationSettings makes the uploader store the image in DVCR as content-defined chunks.
+// Such images can be read only by the pvc-importer, so it is applicable only when the image in DVCR is
+// an intermediate copy written to a PVC afterwards: for VirtualDisks and for VirtualImages on a PVC.
+func ApplyChunkedDestinationSettings(podEnvVars *Settings) {
+	podEnvVars.DestinationChunked = true
+}
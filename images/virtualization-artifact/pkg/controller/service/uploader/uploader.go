@@ -472,6 +472,8 @@ func (u *uploaderService) newFactory(sup supplements.Generator, ownerRef metav1.
 		DestinationEndpoint:    settings.DestinationEndpoint,
 		DestinationInsecureTLS: settings.DestinationInsecureTLS,
 		DestinationAuthSecret:  settings.DestinationAuthSecret,
+		DestinationChunked:     settings.DestinationChunked,
+		DestinationCompression: settings.DestinationCompression,
 		Checksums:              settings.Checksums,
 	}
 
@@ -39,6 +39,7 @@ const (
 	tplBounderPod                   = "d8v-%s-bounder-%s-%s"
 	tplUploaderPod                  = "d8v-%s-uploader-%s-%s"
 	tplUploaderTLSSecret            = "d8v-%s-tls-%s-%s"
+	tplExporterPod                  = "d8v-%s-exporter-%s-%s"
 )
 
 type Generator interface {
@@ -56,6 +57,7 @@ type Generator interface {
 	UploaderService() types.NamespacedName
 	UploaderIngress() types.NamespacedName
 	UploaderHTTPRoute() types.NamespacedName
+	ExporterPod() types.NamespacedName
 	PersistentVolumeClaim() types.NamespacedName
 	CABundleConfigMap() types.NamespacedName
 	DVCRAuthSecret() types.NamespacedName
@@ -263,6 +265,11 @@ func (g *generator) UploaderTLSSecretForIngress() types.NamespacedName {
 	return g.generateName(tplUploaderTLSSecret, kvalidation.DNS1123SubdomainMaxLength)
 }
 
+// ExporterPod generates name for exporter Pod.
+func (g *generator) ExporterPod() types.NamespacedName {
+	return g.generateName(tplExporterPod, g.podNameMaxLen())
+}
+
 // CommonResourceName generates the shared resource name used by older resource layouts.
 func (g *generator) CommonResourceName() types.NamespacedName {
 	return g.generateName(tplCommon, kvalidation.DNS1123SubdomainMaxLength)
@@ -68,6 +68,7 @@ var _ = Describe("Generator", func() {
 			Entry("UploaderService", func(g Generator) types.NamespacedName { return g.UploaderService() }, "vi"),
 			Entry("UploaderIngress", func(g Generator) types.NamespacedName { return g.UploaderIngress() }, "vi"),
 			Entry("UploaderTLSSecret", func(g Generator) types.NamespacedName { return g.UploaderTLSSecretForIngress() }, "tls"),
+			Entry("ExporterPod", func(g Generator) types.NamespacedName { return g.ExporterPod() }, "exporter"),
 			Entry("CommonResourceName", func(g Generator) types.NamespacedName { return g.CommonResourceName() }, "vi"),
 			Entry("PersistentVolumeClaim", func(g Generator) types.NamespacedName { return g.PersistentVolumeClaim() }, "vi"),
 			Entry("NetworkPolicy", func(g Generator) types.NamespacedName { return g.NetworkPolicy() }, "vi"),
@@ -117,6 +118,7 @@ var _ = Describe("Generator", func() {
 			},
 			Entry("BounderPod", func(g Generator) types.NamespacedName { return g.BounderPod() }),
 			Entry("UploaderPod", func(g Generator) types.NamespacedName { return g.UploaderPod() }),
+			Entry("ExporterPod", func(g Generator) types.NamespacedName { return g.ExporterPod() }),
 			Entry("ImporterPod", func(g Generator) types.NamespacedName { return g.ImporterPod() }),
 			Entry("PVCImporterPod", func(g Generator) types.NamespacedName { return g.PVCImporterPod() }),
 			Entry("PVCSourceImporterPod", func(g Generator) types.NamespacedName { return g.PVCSourceImporterPod() }),
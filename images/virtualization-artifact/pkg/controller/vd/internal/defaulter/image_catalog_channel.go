@@ -0,0 +1,115 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaulter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization-controller/pkg/common/object"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+// ImageCatalogChannelDefaulter replaces a reference to a channel of a ClusterVirtualImageCatalog
+// with a reference to the ClusterVirtualImage the channel points to. The disk is created from
+// the version of the moment and does not follow the channel afterwards.
+type ImageCatalogChannelDefaulter struct {
+	client client.Client
+}
+
+func NewImageCatalogChannelDefaulter(client client.Client) *ImageCatalogChannelDefaulter {
+	return &ImageCatalogChannelDefaulter{client: client}
+}
+
+func (d *ImageCatalogChannelDefaulter) Default(ctx context.Context, vd *v1alpha2.VirtualDisk) error {
+	ds := vd.Spec.DataSource
+	if ds == nil || ds.Type != v1alpha2.DataSourceTypeObjectRef || ds.ObjectRef == nil || ds.ObjectRef.Kind != v1alpha2.VirtualDiskObjectRefKindClusterVirtualImageCatalog {
+		return nil
+	}
+
+	channel := ds.ObjectRef.Channel
+	if channel == "" {
+		channel = v1alpha2.ImageCatalogChannelLatest
+	}
+	catalogChannel := ds.ObjectRef.Name + "/" + channel
+
+	// Re-applying the manifest of an existing disk must not move it to another version:
+	// keep the image the disk has been created from.
+	oldVD, err := oldVirtualDisk(ctx)
+	if err != nil {
+		return err
+	}
+	if oldVD != nil && oldVD.Annotations[annotations.AnnImageCatalogChannel] == catalogChannel && oldVD.Spec.DataSource != nil && oldVD.Spec.DataSource.ObjectRef != nil {
+		ref := *oldVD.Spec.DataSource.ObjectRef
+		ds.ObjectRef = &ref
+		annotations.AddAnnotation(vd, annotations.AnnImageCatalogChannel, catalogChannel)
+		annotations.AddAnnotation(vd, annotations.AnnImageCatalogVersion, oldVD.Annotations[annotations.AnnImageCatalogVersion])
+		return nil
+	}
+
+	catalog, err := object.FetchObject(ctx, types.NamespacedName{Name: ds.ObjectRef.Name}, d.client, &v1alpha2.ClusterVirtualImageCatalog{})
+	if err != nil {
+		return fmt.Errorf("failed to get the ClusterVirtualImageCatalog %q: %w", ds.ObjectRef.Name, err)
+	}
+	if catalog == nil {
+		return fmt.Errorf("the ClusterVirtualImageCatalog %q does not exist", ds.ObjectRef.Name)
+	}
+
+	for _, status := range catalog.Status.Channels {
+		if status.Name != channel {
+			continue
+		}
+
+		if status.ClusterVirtualImage == "" {
+			return fmt.Errorf("the channel %q of the ClusterVirtualImageCatalog %q does not point to a ready version yet", channel, catalog.Name)
+		}
+
+		ds.ObjectRef = &v1alpha2.VirtualDiskObjectRef{
+			Kind: v1alpha2.VirtualDiskObjectRefKindClusterVirtualImage,
+			Name: status.ClusterVirtualImage,
+		}
+		annotations.AddAnnotation(vd, annotations.AnnImageCatalogChannel, catalogChannel)
+		annotations.AddAnnotation(vd, annotations.AnnImageCatalogVersion, status.Version)
+
+		return nil
+	}
+
+	return fmt.Errorf("the ClusterVirtualImageCatalog %q has no channel %q", catalog.Name, channel)
+}
+
+func oldVirtualDisk(ctx context.Context) (*v1alpha2.VirtualDisk, error) {
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil || req.Operation != admissionv1.Update || len(req.OldObject.Raw) == 0 {
+		return nil, nil
+	}
+
+	var vd v1alpha2.VirtualDisk
+	err = json.Unmarshal(req.OldObject.Raw, &vd)
+	if err != nil {
+		return nil, errors.Join(errors.New("failed to decode the old VirtualDisk"), err)
+	}
+
+	return &vd, nil
+}
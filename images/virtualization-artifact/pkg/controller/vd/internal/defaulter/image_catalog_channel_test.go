@@ -0,0 +1,147 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaulter_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization-controller/pkg/common/testutil"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/vd/internal/defaulter"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+var _ = Describe("ImageCatalogChannelDefaulter", func() {
+	var (
+		ctx              context.Context
+		channelDefaulter *defaulter.ImageCatalogChannelDefaulter
+	)
+
+	setup := func(objs ...client.Object) {
+		GinkgoHelper()
+		fakeClient, err := testutil.NewFakeClientWithObjects(objs...)
+		Expect(err).NotTo(HaveOccurred())
+		channelDefaulter = defaulter.NewImageCatalogChannelDefaulter(fakeClient)
+	}
+
+	newCatalog := func(channels ...v1alpha2.ImageCatalogChannelStatus) *v1alpha2.ClusterVirtualImageCatalog {
+		return &v1alpha2.ClusterVirtualImageCatalog{
+			ObjectMeta: metav1.ObjectMeta{Name: "ubuntu"},
+			Status:     v1alpha2.ClusterVirtualImageCatalogStatus{Channels: channels},
+		}
+	}
+
+	newVD := func(kind v1alpha2.VirtualDiskObjectRefKind, name, channel string) *v1alpha2.VirtualDisk {
+		return &v1alpha2.VirtualDisk{
+			ObjectMeta: metav1.ObjectMeta{Name: "vd", Namespace: "default"},
+			Spec: v1alpha2.VirtualDiskSpec{
+				DataSource: &v1alpha2.VirtualDiskDataSource{
+					Type: v1alpha2.DataSourceTypeObjectRef,
+					ObjectRef: &v1alpha2.VirtualDiskObjectRef{
+						Kind:    kind,
+						Name:    name,
+						Channel: channel,
+					},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = testutil.ContextBackgroundWithNoOpLogger()
+	})
+
+	It("should not touch a disk without a catalog reference", func() {
+		setup()
+		vd := newVD(v1alpha2.VirtualDiskObjectRefKindClusterVirtualImage, "cvi", "")
+
+		Expect(channelDefaulter.Default(ctx, vd)).To(Succeed())
+		Expect(vd.Spec.DataSource.ObjectRef.Name).To(Equal("cvi"))
+		Expect(vd.Annotations).To(BeEmpty())
+	})
+
+	It("should resolve the latest channel by default", func() {
+		setup(newCatalog(
+			v1alpha2.ImageCatalogChannelStatus{Name: v1alpha2.ImageCatalogChannelLatest, Version: "24.04.2", ClusterVirtualImage: "ubuntu-24.04.2"},
+			v1alpha2.ImageCatalogChannelStatus{Name: "stable", Version: "24.04.1", ClusterVirtualImage: "ubuntu-24.04.1"},
+		))
+		vd := newVD(v1alpha2.VirtualDiskObjectRefKindClusterVirtualImageCatalog, "ubuntu", "")
+
+		Expect(channelDefaulter.Default(ctx, vd)).To(Succeed())
+		Expect(vd.Spec.DataSource.ObjectRef).To(Equal(&v1alpha2.VirtualDiskObjectRef{
+			Kind: v1alpha2.VirtualDiskObjectRefKindClusterVirtualImage,
+			Name: "ubuntu-24.04.2",
+		}))
+		Expect(vd.Annotations).To(HaveKeyWithValue(annotations.AnnImageCatalogChannel, "ubuntu/latest"))
+		Expect(vd.Annotations).To(HaveKeyWithValue(annotations.AnnImageCatalogVersion, "24.04.2"))
+	})
+
+	It("should resolve a named channel", func() {
+		setup(newCatalog(
+			v1alpha2.ImageCatalogChannelStatus{Name: "stable", Version: "24.04.1", ClusterVirtualImage: "ubuntu-24.04.1"},
+		))
+		vd := newVD(v1alpha2.VirtualDiskObjectRefKindClusterVirtualImageCatalog, "ubuntu", "stable")
+
+		Expect(channelDefaulter.Default(ctx, vd)).To(Succeed())
+		Expect(vd.Spec.DataSource.ObjectRef.Name).To(Equal("ubuntu-24.04.1"))
+	})
+
+	DescribeTable("should reject unresolvable references",
+		func(channel string, objs ...client.Object) {
+			setup(objs...)
+			vd := newVD(v1alpha2.VirtualDiskObjectRefKindClusterVirtualImageCatalog, "ubuntu", channel)
+
+			Expect(channelDefaulter.Default(ctx, vd)).NotTo(Succeed())
+		},
+		Entry("missing catalog", "stable"),
+		Entry("missing channel", "beta", newCatalog(v1alpha2.ImageCatalogChannelStatus{Name: "stable", ClusterVirtualImage: "ubuntu-24.04.1"})),
+		Entry("channel without a ready version", "stable", newCatalog(v1alpha2.ImageCatalogChannelStatus{Name: "stable"})),
+	)
+
+	It("should keep the image of an existing disk on update", func() {
+		setup(newCatalog(
+			v1alpha2.ImageCatalogChannelStatus{Name: "stable", Version: "24.04.2", ClusterVirtualImage: "ubuntu-24.04.2"},
+		))
+
+		oldVD := newVD(v1alpha2.VirtualDiskObjectRefKindClusterVirtualImage, "ubuntu-24.04.1", "")
+		oldVD.Annotations = map[string]string{
+			annotations.AnnImageCatalogChannel: "ubuntu/stable",
+			annotations.AnnImageCatalogVersion: "24.04.1",
+		}
+		raw, err := json.Marshal(oldVD)
+		Expect(err).NotTo(HaveOccurred())
+		ctx = admission.NewContextWithRequest(ctx, admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Update,
+			OldObject: runtime.RawExtension{Raw: raw},
+		}})
+
+		vd := newVD(v1alpha2.VirtualDiskObjectRefKindClusterVirtualImageCatalog, "ubuntu", "stable")
+
+		Expect(channelDefaulter.Default(ctx, vd)).To(Succeed())
+		Expect(vd.Spec.DataSource.ObjectRef.Name).To(Equal("ubuntu-24.04.1"))
+		Expect(vd.Annotations).To(HaveKeyWithValue(annotations.AnnImageCatalogVersion, "24.04.1"))
+	})
+})
@@ -28,7 +28,7 @@ import (
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 )
 
-//go:generate go tool moq -rm -out mock.go . Handler Sources DiskService StorageClassService
+//go:generate go tool moq -rm -out mock.go . Handler Sources DiskService StorageClassService SourceImageService
 
 type Handler = source.Handler
 
@@ -51,3 +51,7 @@ type StorageClassService interface {
 	GetPersistentVolumeClaim(ctx context.Context, sup supplements.Generator) (*corev1.PersistentVolumeClaim, error)
 	IsStorageClassDeprecated(sc *storagev1.StorageClass) bool
 }
+
+type SourceImageService interface {
+	GetDigest(ctx context.Context, vd *v1alpha2.VirtualDisk) (string, error)
+}
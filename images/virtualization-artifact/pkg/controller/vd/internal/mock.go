@@ -815,3 +815,75 @@ func (mock *StorageClassServiceMock) IsStorageClassDeprecatedCalls() []struct {
 	mock.lockIsStorageClassDeprecated.RUnlock()
 	return calls
 }
+
+// Ensure, that SourceImageServiceMock does implement SourceImageService.
+// If this is not the case, regenerate this file with moq.
+var _ SourceImageService = &SourceImageServiceMock{}
+
+// SourceImageServiceMock is a mock implementation of SourceImageService.
+//
+//	func TestSomethingThatUsesSourceImageService(t *testing.T) {
+//
+//		// make and configure a mocked SourceImageService
+//		mockedSourceImageService := &SourceImageServiceMock{
+//			GetDigestFunc: func(ctx context.Context, vd *v1alpha2.VirtualDisk) (string, error) {
+//				panic("mock out the GetDigest method")
+//			},
+//		}
+//
+//		// use mockedSourceImageService in code that requires SourceImageService
+//		// and then make assertions.
+//
+//	}
+type SourceImageServiceMock struct {
+	// GetDigestFunc mocks the GetDigest method.
+	GetDigestFunc func(ctx context.Context, vd *v1alpha2.VirtualDisk) (string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetDigest holds details about calls to the GetDigest method.
+		GetDigest []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Vd is the vd argument value.
+			Vd *v1alpha2.VirtualDisk
+		}
+	}
+	lockGetDigest sync.RWMutex
+}
+
+// GetDigest calls GetDigestFunc.
+func (mock *SourceImageServiceMock) GetDigest(ctx context.Context, vd *v1alpha2.VirtualDisk) (string, error) {
+	if mock.GetDigestFunc == nil {
+		panic("SourceImageServiceMock.GetDigestFunc: method is nil but SourceImageService.GetDigest was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Vd  *v1alpha2.VirtualDisk
+	}{
+		Ctx: ctx,
+		Vd:  vd,
+	}
+	mock.lockGetDigest.Lock()
+	mock.calls.GetDigest = append(mock.calls.GetDigest, callInfo)
+	mock.lockGetDigest.Unlock()
+	return mock.GetDigestFunc(ctx, vd)
+}
+
+// GetDigestCalls gets all the calls that were made to GetDigest.
+// Check the length with:
+//
+//	len(mockedSourceImageService.GetDigestCalls())
+func (mock *SourceImageServiceMock) GetDigestCalls() []struct {
+	Ctx context.Context
+	Vd  *v1alpha2.VirtualDisk
+} {
+	var calls []struct {
+		Ctx context.Context
+		Vd  *v1alpha2.VirtualDisk
+	}
+	mock.lockGetDigest.RLock()
+	calls = mock.calls.GetDigest
+	mock.lockGetDigest.RUnlock()
+	return calls
+}
@@ -26,9 +26,13 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	virtv1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/deckhouse/virtualization-controller/pkg/common"
+	"github.com/deckhouse/virtualization-controller/pkg/common/object"
 	commonvd "github.com/deckhouse/virtualization-controller/pkg/common/vd"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/service"
@@ -41,12 +45,14 @@ import (
 
 type ResizingHandler struct {
 	diskService DiskService
+	client      client.Client
 	recorder    eventrecord.EventRecorderLogger
 }
 
-func NewResizingHandler(recorder eventrecord.EventRecorderLogger, diskService DiskService) *ResizingHandler {
+func NewResizingHandler(recorder eventrecord.EventRecorderLogger, diskService DiskService, client client.Client) *ResizingHandler {
 	return &ResizingHandler{
 		diskService: diskService,
+		client:      client,
 		recorder:    recorder,
 	}
 }
@@ -118,10 +124,32 @@ func (h ResizingHandler) Handle(ctx context.Context, vd *v1alpha2.VirtualDisk) (
 	if isResizeNeeded(vdSpecSize, &pvcSpecSize) {
 		// Expected disk size is GREATER THAN expected pvc size: resize needed, resizing to a larger size.
 		return h.ResizeNeeded(ctx, vd, pvc, cb, log)
-	} else {
-		// Expected disk size is NOT GREATER THAN expected pvc size: no resize needed since downsizing is not possible, and resizing to the same value makes no sense.
-		return h.ResizeNotNeeded(vd, resizingCondition, cb)
 	}
+
+	// The underlying PersistentVolumeClaim has been expanded, but the running virtual machine
+	// still uses the previous size: KubeVirt resizes the block device of the running domain
+	// once it has propagated the new capacity to the volume status of the instance.
+	if resizingCondition.Status == metav1.ConditionTrue {
+		waiting, err := h.isWaitingForVirtualMachine(ctx, vd, pvc)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if waiting {
+			log.Info("The disk has been expanded, waiting for the running virtual machine to apply the new size")
+
+			vd.Status.Phase = v1alpha2.DiskResizing
+			cb.
+				Status(metav1.ConditionTrue).
+				Reason(vdcondition.WaitingForVirtualMachine).
+				Message("The disk has been expanded: waiting for the running virtual machine to apply the new size.")
+			conditions.SetCondition(cb, &vd.Status.Conditions)
+			return reconcile.Result{}, nil
+		}
+	}
+
+	// Expected disk size is NOT GREATER THAN expected pvc size: no resize needed since downsizing is not possible, and resizing to the same value makes no sense.
+	return h.ResizeNotNeeded(vd, resizingCondition, cb)
 }
 
 func (h ResizingHandler) ResizeNeeded(
@@ -239,6 +267,40 @@ func (h ResizingHandler) ResizeNotNeeded(
 	return reconcile.Result{}, nil
 }
 
+// isWaitingForVirtualMachine reports whether a running virtual machine the disk is mounted to
+// has not yet applied the current capacity of the PersistentVolumeClaim.
+func (h ResizingHandler) isWaitingForVirtualMachine(ctx context.Context, vd *v1alpha2.VirtualDisk, pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	pvcStatusSize := pvc.Status.Capacity[corev1.ResourceStorage]
+
+	for _, attached := range vd.Status.AttachedToVirtualMachines {
+		if !attached.Mounted {
+			continue
+		}
+
+		kvvmi, err := object.FetchObject(ctx, types.NamespacedName{Name: attached.Name, Namespace: vd.Namespace}, h.client, &virtv1.VirtualMachineInstance{})
+		if err != nil {
+			return false, fmt.Errorf("fetch kvvmi: %w", err)
+		}
+
+		if kvvmi == nil {
+			continue
+		}
+
+		for _, vs := range kvvmi.Status.VolumeStatus {
+			if vs.PersistentVolumeClaimInfo == nil || vs.PersistentVolumeClaimInfo.ClaimName != pvc.Name {
+				continue
+			}
+
+			capacity, ok := vs.PersistentVolumeClaimInfo.Capacity[corev1.ResourceStorage]
+			if ok && capacity.Cmp(pvcStatusSize) == common.CmpLesser {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
 func isResizeNeeded(vdSpecSize, pvcSpecSize *resource.Quantity) bool {
 	return vdSpecSize != nil && pvcSpecSize != nil && vdSpecSize.Cmp(*pvcSpecSize) == common.CmpGreater
 }
@@ -27,9 +27,11 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	virtv1 "kubevirt.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/deckhouse/virtualization-controller/pkg/common/testutil"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/supplements"
 	"github.com/deckhouse/virtualization-controller/pkg/eventrecord"
@@ -109,7 +111,7 @@ var _ = Describe("Resizing handler Run", func() {
 			return pvc, nil
 		}
 
-		h := NewResizingHandler(recorder, diskService)
+		h := NewResizingHandler(recorder, diskService, nil)
 
 		_, err := h.Handle(testContext(), vd)
 		Expect(err).To(BeNil())
@@ -121,7 +123,7 @@ var _ = Describe("Resizing handler Run", func() {
 	It("Resize is not requested (vd.spec.size == nil)", func() {
 		vd.Spec.PersistentVolumeClaim.Size = nil
 
-		h := NewResizingHandler(recorder, diskService)
+		h := NewResizingHandler(recorder, diskService, nil)
 
 		_, err := h.Handle(testContext(), vd)
 		Expect(err).To(BeNil())
@@ -132,7 +134,7 @@ var _ = Describe("Resizing handler Run", func() {
 	It("Resize is not requested (vd.spec.size < pvc.spec.size)", func() {
 		vd.Spec.PersistentVolumeClaim.Size.Sub(resource.MustParse("1G"))
 
-		h := NewResizingHandler(recorder, diskService)
+		h := NewResizingHandler(recorder, diskService, nil)
 
 		_, err := h.Handle(testContext(), vd)
 		Expect(err).To(BeNil())
@@ -141,7 +143,7 @@ var _ = Describe("Resizing handler Run", func() {
 	})
 
 	It("Resize is not requested (vd.spec.size == pvc.spec.size)", func() {
-		h := NewResizingHandler(recorder, diskService)
+		h := NewResizingHandler(recorder, diskService, nil)
 
 		_, err := h.Handle(testContext(), vd)
 		Expect(err).To(BeNil())
@@ -152,7 +154,7 @@ var _ = Describe("Resizing handler Run", func() {
 	It("Resize has started (vd.spec.size > pvc.spec.size)", func() {
 		vd.Spec.PersistentVolumeClaim.Size.Add(size)
 
-		h := NewResizingHandler(recorder, diskService)
+		h := NewResizingHandler(recorder, diskService, nil)
 
 		_, err := h.Handle(testContext(), vd)
 		Expect(err).To(BeNil())
@@ -168,7 +170,7 @@ var _ = Describe("Resizing handler Run", func() {
 			Reason: vdcondition.InProgress.String(),
 		})
 
-		h := NewResizingHandler(recorder, diskService)
+		h := NewResizingHandler(recorder, diskService, nil)
 
 		_, err := h.Handle(testContext(), vd)
 		Expect(err).To(BeNil())
@@ -176,6 +178,80 @@ var _ = Describe("Resizing handler Run", func() {
 		Expect(ok).Should(BeFalse())
 	})
 
+	Context("The disk is mounted to a running virtual machine", func() {
+		newSize := resource.MustParse("20G")
+
+		var kvvmi *virtv1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vd.Name = "vd"
+			vd.Namespace = "ns"
+			vd.Status.AttachedToVirtualMachines = []v1alpha2.AttachedVirtualMachine{{Name: "vm", Mounted: true}}
+			vd.Status.Conditions = append(vd.Status.Conditions, metav1.Condition{
+				Type:   vdcondition.ResizingType.String(),
+				Status: metav1.ConditionTrue,
+				Reason: vdcondition.InProgress.String(),
+			})
+			vd.Spec.PersistentVolumeClaim.Size = &newSize
+
+			pvc.Name = "pvc"
+			pvc.Spec.Resources.Requests[corev1.ResourceStorage] = newSize
+			pvc.Status.Capacity[corev1.ResourceStorage] = newSize
+
+			kvvmi = &virtv1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "vm", Namespace: "ns"},
+				Status: virtv1.VirtualMachineInstanceStatus{
+					VolumeStatus: []virtv1.VolumeStatus{{
+						Name: "vd-vd",
+						PersistentVolumeClaimInfo: &virtv1.PersistentVolumeClaimInfo{
+							ClaimName: "pvc",
+							Capacity:  corev1.ResourceList{corev1.ResourceStorage: size},
+						},
+					}},
+				},
+			}
+		})
+
+		It("waits for the virtual machine to apply the new size", func() {
+			fakeClient, err := testutil.NewFakeClientWithObjects(kvvmi)
+			Expect(err).NotTo(HaveOccurred())
+
+			h := NewResizingHandler(recorder, diskService, fakeClient)
+
+			_, err = h.Handle(testContext(), vd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vd.Status.Phase).To(Equal(v1alpha2.DiskResizing))
+			resized, _ := conditions.GetCondition(vdcondition.ResizingType, vd.Status.Conditions)
+			Expect(resized.Status).To(Equal(metav1.ConditionTrue))
+			Expect(resized.Reason).To(Equal(vdcondition.WaitingForVirtualMachine.String()))
+		})
+
+		It("completes the resizing once the virtual machine has applied the new size", func() {
+			kvvmi.Status.VolumeStatus[0].PersistentVolumeClaimInfo.Capacity[corev1.ResourceStorage] = newSize
+			fakeClient, err := testutil.NewFakeClientWithObjects(kvvmi)
+			Expect(err).NotTo(HaveOccurred())
+
+			h := NewResizingHandler(recorder, diskService, fakeClient)
+
+			_, err = h.Handle(testContext(), vd)
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := conditions.GetCondition(vdcondition.ResizingType, vd.Status.Conditions)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("completes the resizing if the virtual machine is not running", func() {
+			fakeClient, err := testutil.NewFakeClientWithObjects()
+			Expect(err).NotTo(HaveOccurred())
+
+			h := NewResizingHandler(recorder, diskService, fakeClient)
+
+			_, err = h.Handle(testContext(), vd)
+			Expect(err).NotTo(HaveOccurred())
+			_, ok := conditions.GetCondition(vdcondition.ResizingType, vd.Status.Conditions)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
 	DescribeTable("Resizing handler Handle", func(args handleTestArgs) {
 		vd := &v1alpha2.VirtualDisk{
 			Spec: v1alpha2.VirtualDiskSpec{},
@@ -221,7 +297,7 @@ var _ = Describe("Resizing handler Run", func() {
 			EventFunc: func(_ client.Object, _, _, _ string) {},
 		}
 
-		handler := NewResizingHandler(recorder, diskService)
+		handler := NewResizingHandler(recorder, diskService, nil)
 		result, err := handler.Handle(testContext(), vd)
 		Expect(result).To(Equal(reconcile.Result{}))
 		if args.isErrorNil {
@@ -335,7 +411,7 @@ var _ = Describe("Resizing handler Run", func() {
 
 		log := logger.FromContext(testContext()).With(logger.SlogHandler("resizing"))
 
-		handler := NewResizingHandler(recorder, diskService)
+		handler := NewResizingHandler(recorder, diskService, nil)
 		cb := conditions.NewConditionBuilder(vdcondition.ResizingType)
 
 		result, err := handler.ResizeNeeded(testContext(), vd, pvc, cb, log)
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/datasource"
+	"github.com/deckhouse/virtualization-controller/pkg/common/object"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/service"
+	"github.com/deckhouse/virtualization-controller/pkg/dvcr"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+var (
+	ErrSourceImageNotSupported = errors.New("the data source of the disk does not support updates")
+	ErrSourceImageNotReady     = errors.New("the ClusterVirtualImage is not ready")
+)
+
+// SourceImageService resolves the digest of the image the disk is created from.
+type SourceImageService struct {
+	client       client.Client
+	resolver     service.DigestResolver
+	imageChecker dvcr.ImageChecker
+}
+
+func NewSourceImageService(client client.Client, resolver service.DigestResolver, imageChecker dvcr.ImageChecker) *SourceImageService {
+	return &SourceImageService{
+		client:       client,
+		resolver:     resolver,
+		imageChecker: imageChecker,
+	}
+}
+
+// GetDigest returns the current digest of the source image:
+//   - the digest of the tag in the source registry for the ContainerImage data source;
+//   - the digest of the ClusterVirtualImage in DVCR for the ObjectRef data source. It changes only when the
+//     ClusterVirtualImage is recreated, as the image of every ClusterVirtualImage is stored under its own tag.
+func (s SourceImageService) GetDigest(ctx context.Context, vd *v1alpha2.VirtualDisk) (string, error) {
+	ds := vd.Spec.DataSource
+	if ds == nil {
+		return "", ErrSourceImageNotSupported
+	}
+
+	switch ds.Type {
+	case v1alpha2.DataSourceTypeContainerImage:
+		if ds.ContainerImage == nil {
+			return "", ErrSourceImageNotSupported
+		}
+
+		return s.resolver.ResolveDigest(ctx, datasource.NewCABundleForVMD(vd.Namespace, ds).GetContainerImage())
+	case v1alpha2.DataSourceTypeObjectRef:
+		if ds.ObjectRef == nil || ds.ObjectRef.Kind != v1alpha2.VirtualDiskObjectRefKindClusterVirtualImage {
+			return "", ErrSourceImageNotSupported
+		}
+
+		cvi, err := object.FetchObject(ctx, types.NamespacedName{Name: ds.ObjectRef.Name}, s.client, &v1alpha2.ClusterVirtualImage{})
+		if err != nil {
+			return "", fmt.Errorf("fetch cluster virtual image %q: %w", ds.ObjectRef.Name, err)
+		}
+
+		if cvi == nil || cvi.Status.Phase != v1alpha2.ImageReady || cvi.Status.Target.RegistryURL == "" {
+			return "", ErrSourceImageNotReady
+		}
+
+		return s.imageChecker.GetImageDigest(ctx, cvi.Status.Target.RegistryURL)
+	default:
+		return "", ErrSourceImageNotSupported
+	}
+}
@@ -136,6 +136,7 @@ func (ds HTTPDataSource) buildEnvSettings(vd *v1alpha2.VirtualDisk, supgen suppl
 		supgen,
 		ds.dvcrSettings.RegistryImageForVD(vd),
 	)
+	importer.ApplyChunkedDestinationSettings(&settings)
 
 	return &settings
 }
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/google/go-containerregistry/pkg/name"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -154,19 +155,38 @@ func (ds RegistryDataSource) buildEnvSettings(vd *v1alpha2.VirtualDisk, supgen s
 	var settings importer.Settings
 
 	containerImage := &datasource.ContainerRegistry{
-		Image: vd.Spec.DataSource.ContainerImage.Image,
+		Image: getPinnedImage(vd),
 		ImagePullSecret: types.NamespacedName{
 			Name:      vd.Spec.DataSource.ContainerImage.ImagePullSecret.Name,
 			Namespace: vd.GetNamespace(),
 		},
 	}
 	importer.ApplyRegistrySourceSettings(&settings, containerImage, supgen)
+	importer.ApplySignatureVerificationSettings(&settings, ds.dvcrSettings.ImageSignatureVerification(nil))
 	importer.ApplyDVCRDestinationSettings(
 		&settings,
 		ds.dvcrSettings,
 		supgen,
 		ds.dvcrSettings.RegistryImageForVD(vd),
 	)
+	importer.ApplyChunkedDestinationSettings(&settings)
 
 	return &settings
 }
+
+// getPinnedImage returns the image of the data source pinned to the digest the disk with the `RecreateOnStop`
+// update policy is recreated for, so the disk gets exactly the version of the image that has been checked.
+func getPinnedImage(vd *v1alpha2.VirtualDisk) string {
+	image := vd.Spec.DataSource.ContainerImage.Image
+
+	if vd.Spec.UpdatePolicy != v1alpha2.VirtualDiskUpdatePolicyRecreateOnStop || vd.Status.SourceImage == nil || vd.Status.SourceImage.ProvisionedDigest == "" {
+		return image
+	}
+
+	ref, err := name.ParseReference(image)
+	if err != nil {
+		return image
+	}
+
+	return ref.Context().Name() + "@" + vd.Status.SourceImage.ProvisionedDigest
+}
@@ -210,6 +210,24 @@ var _ = Describe("RegistryDataSource", func() {
 			Expect(vd.Status.Phase).To(Equal(v1alpha2.DiskProvisioning))
 		})
 
+		It("imports the image pinned to the provisioned digest for the RecreateOnStop update policy", func() {
+			vd.Spec.UpdatePolicy = v1alpha2.VirtualDiskUpdatePolicyRecreateOnStop
+			vd.Status.SourceImage = &v1alpha2.VirtualDiskSourceImage{ProvisionedDigest: "sha256:1111"}
+			disk.GetPersistentVolumeClaimFunc = func(_ context.Context, _ supplements.Generator) (*corev1.PersistentVolumeClaim, error) {
+				return nil, nil
+			}
+			var endpoint string
+			importerSvc.StartFunc = func(_ context.Context, settings *importer.Settings, _ client.Object, _ supplements.Generator, _ *datasource.CABundle, _ ...service.Option) error {
+				endpoint = settings.Endpoint
+				return nil
+			}
+
+			cl := fake.NewClientBuilder().WithScheme(scheme).Build()
+			_, err := newSyncer(cl).Sync(ctx, vd)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(endpoint).To(Equal("docker://registry.example.com/images/slackware@sha256:1111"))
+		})
+
 		It("propagates QuotaExceeded as DiskFailed/QuotaExceeded", func() {
 			disk.GetPersistentVolumeClaimFunc = func(_ context.Context, _ supplements.Generator) (*corev1.PersistentVolumeClaim, error) {
 				return nil, nil
@@ -146,6 +146,7 @@ func (s CreateUploaderStep) getEnvSettings(vd *v1alpha2.VirtualDisk, supgen supp
 		supgen,
 		s.dvcrSettings.RegistryImageForVD(vd),
 	)
+	serviceuploader.ApplyChunkedDestinationSettings(&settings)
 
 	serviceuploader.ApplyUploadSourceSettings(&settings, vd.Spec.DataSource.Upload)
 
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/reconciler"
+	intsvc "github.com/deckhouse/virtualization-controller/pkg/controller/vd/internal/service"
+	vdsupplements "github.com/deckhouse/virtualization-controller/pkg/controller/vd/internal/supplements"
+	"github.com/deckhouse/virtualization-controller/pkg/eventrecord"
+	"github.com/deckhouse/virtualization-controller/pkg/logger"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2/vdcondition"
+)
+
+const (
+	sourceImageCheckInterval      = 10 * time.Minute
+	sourceImageCheckRetryInterval = time.Minute
+)
+
+// UpdatePolicyHandler rebuilds the disks with the `RecreateOnStop` update policy when their source image changes.
+//
+// The digest of the source image is checked every sourceImageCheckInterval. When it differs from the digest
+// the disk has been created from, the disk is recreated as soon as nothing uses it: its supplements
+// are cleaned up and the status is reset, so the disk is provisioned again under a new PersistentVolumeClaim name:
+// the old PersistentVolumeClaim may still be terminating.
+// The VirtualDisk object itself is kept, so its owners, labels and references stay intact.
+type UpdatePolicyHandler struct {
+	sourceImage SourceImageService
+	sources     Sources
+	recorder    eventrecord.EventRecorderLogger
+}
+
+func NewUpdatePolicyHandler(recorder eventrecord.EventRecorderLogger, sourceImage SourceImageService, sources Sources) *UpdatePolicyHandler {
+	return &UpdatePolicyHandler{
+		sourceImage: sourceImage,
+		sources:     sources,
+		recorder:    recorder,
+	}
+}
+
+func (h UpdatePolicyHandler) Handle(ctx context.Context, vd *v1alpha2.VirtualDisk) (reconcile.Result, error) {
+	cb := conditions.NewConditionBuilder(vdcondition.SourceImageUpToDateType).Generation(vd.Generation)
+
+	if vd.Spec.UpdatePolicy != v1alpha2.VirtualDiskUpdatePolicyRecreateOnStop {
+		vd.Status.SourceImage = nil
+		conditions.RemoveCondition(cb.GetType(), &vd.Status.Conditions)
+		return reconcile.Result{}, nil
+	}
+
+	if vd.DeletionTimestamp != nil || vd.Status.Phase != v1alpha2.DiskReady {
+		return reconcile.Result{}, nil
+	}
+
+	if vd.Status.SourceImage == nil {
+		vd.Status.SourceImage = &v1alpha2.VirtualDiskSourceImage{}
+	}
+	sourceImage := vd.Status.SourceImage
+
+	now := time.Now()
+	if sourceImage.LastCheckTime == nil || !now.Before(sourceImage.LastCheckTime.Add(sourceImageCheckInterval)) {
+		digest, err := h.sourceImage.GetDigest(ctx, vd)
+		if err != nil {
+			logger.FromContext(ctx).Warn("Failed to check the source image of the disk", logger.SlogErr(err))
+
+			message := fmt.Sprintf("Failed to check the source image: %s.", err)
+			if errors.Is(err, intsvc.ErrSourceImageNotReady) {
+				message = "Waiting for the ClusterVirtualImage to become ready to check the source image."
+			}
+
+			cb.
+				Status(metav1.ConditionFalse).
+				Reason(vdcondition.SourceImageCheckFailed).
+				Message(message)
+			conditions.SetCondition(cb, &vd.Status.Conditions)
+
+			return reconcile.Result{RequeueAfter: sourceImageCheckRetryInterval}, nil
+		}
+
+		sourceImage.LatestDigest = digest
+		sourceImage.LastCheckTime = &metav1.Time{Time: now}
+
+		// The disk has been created before the policy was enabled: consider it up to date.
+		if sourceImage.ProvisionedDigest == "" {
+			sourceImage.ProvisionedDigest = digest
+		}
+	}
+
+	nextCheck := reconcile.Result{RequeueAfter: sourceImageCheckInterval - now.Sub(sourceImage.LastCheckTime.Time)}
+
+	if sourceImage.LatestDigest == sourceImage.ProvisionedDigest {
+		cb.
+			Status(metav1.ConditionTrue).
+			Reason(vdcondition.SourceImageUpToDate).
+			Message("")
+		conditions.SetCondition(cb, &vd.Status.Conditions)
+
+		return nextCheck, nil
+	}
+
+	if reason := h.getRecreationBlocker(vd); reason != "" {
+		cb.
+			Status(metav1.ConditionFalse).
+			Reason(vdcondition.SourceImageUpdatePending).
+			Message(fmt.Sprintf("The source image has changed: the disk will be recreated once it is not %s.", reason))
+		conditions.SetCondition(cb, &vd.Status.Conditions)
+
+		return nextCheck, nil
+	}
+
+	h.recorder.Event(
+		vd,
+		corev1.EventTypeNormal,
+		v1alpha2.ReasonVDSourceImageChanged,
+		fmt.Sprintf("The source image has changed from %s to %s: the disk is recreated from the new image.", sourceImage.ProvisionedDigest, sourceImage.LatestDigest),
+	)
+
+	// Clean up before resetting the status: the supplements are found by the PersistentVolumeClaim name from the status.
+	_, _, err := h.sources.CleanUp(ctx, vd)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to clean up to recreate the disk: %w", err)
+	}
+
+	// Reset status and provision the disk again from the new version of the image.
+	vd.Status = v1alpha2.VirtualDiskStatus{
+		Phase: v1alpha2.DiskPending,
+		SourceImage: &v1alpha2.VirtualDiskSourceImage{
+			ProvisionedDigest: sourceImage.LatestDigest,
+			LatestDigest:      sourceImage.LatestDigest,
+			LastCheckTime:     sourceImage.LastCheckTime,
+		},
+	}
+	vdsupplements.SetPVCName(vd, newPVCName(vd))
+
+	return reconcile.Result{Requeue: true}, reconciler.ErrStopHandlerChain
+}
+
+// getRecreationBlocker returns what prevents the disk from being recreated, or an empty string if nothing does.
+func (h UpdatePolicyHandler) getRecreationBlocker(vd *v1alpha2.VirtualDisk) string {
+	blockers := []struct {
+		condition vdcondition.Type
+		reason    string
+	}{
+		{vdcondition.InUseType, "in use"},
+		{vdcondition.SnapshottingType, "being snapshotted"},
+		{vdcondition.ResizingType, "being resized"},
+		{vdcondition.MigratingType, "being migrated"},
+	}
+
+	for _, blocker := range blockers {
+		cond, _ := conditions.GetCondition(blocker.condition, vd.Status.Conditions)
+		if cond.Status == metav1.ConditionTrue {
+			return blocker.reason
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/reconciler"
+	intsvc "github.com/deckhouse/virtualization-controller/pkg/controller/vd/internal/service"
+	"github.com/deckhouse/virtualization-controller/pkg/eventrecord"
+	"github.com/deckhouse/virtualization-controller/pkg/logger"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2/vdcondition"
+)
+
+var _ = Describe("UpdatePolicyHandler", func() {
+	var (
+		ctx         context.Context
+		vd          *v1alpha2.VirtualDisk
+		sourceImage *SourceImageServiceMock
+		sources     *SourcesMock
+		recorder    *eventrecord.EventRecorderLoggerMock
+		handler     *UpdatePolicyHandler
+		digest      string
+		cleanedUp   bool
+	)
+
+	BeforeEach(func() {
+		ctx = logger.ToContext(context.TODO(), slog.Default())
+		digest = "sha256:new"
+		cleanedUp = false
+
+		vd = &v1alpha2.VirtualDisk{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "vd",
+				Namespace:  "ns",
+				Generation: 1,
+			},
+			Spec: v1alpha2.VirtualDiskSpec{
+				DataSource: &v1alpha2.VirtualDiskDataSource{
+					Type:           v1alpha2.DataSourceTypeContainerImage,
+					ContainerImage: &v1alpha2.VirtualDiskContainerImage{Image: "registry.example.com/images/ubuntu:24.04"},
+				},
+				UpdatePolicy: v1alpha2.VirtualDiskUpdatePolicyRecreateOnStop,
+			},
+			Status: v1alpha2.VirtualDiskStatus{
+				Phase:  v1alpha2.DiskReady,
+				Target: v1alpha2.DiskTarget{PersistentVolumeClaim: "d8v-vd-pvc"},
+			},
+		}
+
+		sourceImage = &SourceImageServiceMock{
+			GetDigestFunc: func(_ context.Context, _ *v1alpha2.VirtualDisk) (string, error) {
+				return digest, nil
+			},
+		}
+		sources = &SourcesMock{
+			CleanUpFunc: func(_ context.Context, vd *v1alpha2.VirtualDisk) (bool, string, error) {
+				// The supplements must be cleaned up before the status is reset.
+				Expect(vd.Status.Target.PersistentVolumeClaim).To(Equal("d8v-vd-pvc"))
+				cleanedUp = true
+				return false, "", nil
+			},
+		}
+		recorder = &eventrecord.EventRecorderLoggerMock{
+			EventFunc: func(_ client.Object, _, _, _ string) {},
+		}
+
+		handler = NewUpdatePolicyHandler(recorder, sourceImage, sources)
+	})
+
+	setInUse := func(status metav1.ConditionStatus) {
+		vd.Status.Conditions = append(vd.Status.Conditions, metav1.Condition{
+			Type:   vdcondition.InUseType.String(),
+			Status: status,
+		})
+	}
+
+	It("removes the status of the source image if the policy is not set", func() {
+		vd.Spec.UpdatePolicy = v1alpha2.VirtualDiskUpdatePolicyNone
+		vd.Status.SourceImage = &v1alpha2.VirtualDiskSourceImage{ProvisionedDigest: "sha256:old"}
+		vd.Status.Conditions = []metav1.Condition{{Type: vdcondition.SourceImageUpToDateType.String(), Status: metav1.ConditionTrue}}
+
+		res, err := handler.Handle(ctx, vd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.IsZero()).To(BeTrue())
+		Expect(vd.Status.SourceImage).To(BeNil())
+		_, ok := conditions.GetCondition(vdcondition.SourceImageUpToDateType, vd.Status.Conditions)
+		Expect(ok).To(BeFalse())
+		Expect(sourceImage.GetDigestCalls()).To(BeEmpty())
+	})
+
+	It("does nothing until the disk is ready", func() {
+		vd.Status.Phase = v1alpha2.DiskProvisioning
+
+		res, err := handler.Handle(ctx, vd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.IsZero()).To(BeTrue())
+		Expect(sourceImage.GetDigestCalls()).To(BeEmpty())
+	})
+
+	It("considers the disk up to date on the first check", func() {
+		res, err := handler.Handle(ctx, vd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.RequeueAfter).To(BeNumerically("~", sourceImageCheckInterval, time.Second))
+
+		Expect(vd.Status.SourceImage.ProvisionedDigest).To(Equal(digest))
+		Expect(vd.Status.SourceImage.LatestDigest).To(Equal(digest))
+		Expect(vd.Status.SourceImage.LastCheckTime).NotTo(BeNil())
+
+		cond, _ := conditions.GetCondition(vdcondition.SourceImageUpToDateType, vd.Status.Conditions)
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal(vdcondition.SourceImageUpToDate.String()))
+	})
+
+	It("does not check the source image before the check interval passes", func() {
+		vd.Status.SourceImage = &v1alpha2.VirtualDiskSourceImage{
+			ProvisionedDigest: "sha256:old",
+			LatestDigest:      "sha256:old",
+			LastCheckTime:     &metav1.Time{Time: time.Now().Add(-time.Minute)},
+		}
+
+		res, err := handler.Handle(ctx, vd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.RequeueAfter).To(BeNumerically("~", sourceImageCheckInterval-time.Minute, time.Second))
+		Expect(sourceImage.GetDigestCalls()).To(BeEmpty())
+	})
+
+	It("keeps the update pending while the disk is in use", func() {
+		vd.Status.SourceImage = &v1alpha2.VirtualDiskSourceImage{ProvisionedDigest: "sha256:old"}
+		setInUse(metav1.ConditionTrue)
+
+		_, err := handler.Handle(ctx, vd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cleanedUp).To(BeFalse())
+		Expect(vd.Status.Phase).To(Equal(v1alpha2.DiskReady))
+		Expect(vd.Status.SourceImage.LatestDigest).To(Equal(digest))
+
+		cond, _ := conditions.GetCondition(vdcondition.SourceImageUpToDateType, vd.Status.Conditions)
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(vdcondition.SourceImageUpdatePending.String()))
+	})
+
+	It("recreates the disk once it is not in use", func() {
+		vd.Status.SourceImage = &v1alpha2.VirtualDiskSourceImage{ProvisionedDigest: "sha256:old"}
+		setInUse(metav1.ConditionFalse)
+
+		res, err := handler.Handle(ctx, vd)
+		Expect(err).To(MatchError(reconciler.ErrStopHandlerChain))
+		Expect(res.Requeue).To(BeTrue())
+		Expect(cleanedUp).To(BeTrue())
+		Expect(recorder.EventCalls()).To(HaveLen(1))
+
+		Expect(vd.Status.Phase).To(Equal(v1alpha2.DiskPending))
+		Expect(vd.Status.Target.PersistentVolumeClaim).NotTo(BeEmpty())
+		Expect(vd.Status.Target.PersistentVolumeClaim).NotTo(Equal("d8v-vd-pvc"))
+		Expect(vd.Status.Conditions).To(BeEmpty())
+		Expect(vd.Status.SourceImage.ProvisionedDigest).To(Equal(digest))
+		Expect(vd.Status.SourceImage.LatestDigest).To(Equal(digest))
+	})
+
+	It("reports the failed check and retries it", func() {
+		sourceImage.GetDigestFunc = func(_ context.Context, _ *v1alpha2.VirtualDisk) (string, error) {
+			return "", errors.New("unauthorized")
+		}
+
+		res, err := handler.Handle(ctx, vd)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.RequeueAfter).To(Equal(sourceImageCheckRetryInterval))
+
+		cond, _ := conditions.GetCondition(vdcondition.SourceImageUpToDateType, vd.Status.Conditions)
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(vdcondition.SourceImageCheckFailed.String()))
+		Expect(cond.Message).To(ContainSubstring("unauthorized"))
+	})
+
+	It("waits for the ClusterVirtualImage to become ready", func() {
+		sourceImage.GetDigestFunc = func(_ context.Context, _ *v1alpha2.VirtualDisk) (string, error) {
+			return "", intsvc.ErrSourceImageNotReady
+		}
+
+		_, err := handler.Handle(ctx, vd)
+		Expect(err).NotTo(HaveOccurred())
+
+		cond, _ := conditions.GetCondition(vdcondition.SourceImageUpToDateType, vd.Status.Conditions)
+		Expect(cond.Reason).To(Equal(vdcondition.SourceImageCheckFailed.String()))
+		Expect(cond.Message).To(ContainSubstring("ClusterVirtualImage"))
+	})
+})
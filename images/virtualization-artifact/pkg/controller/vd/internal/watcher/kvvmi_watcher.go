@@ -0,0 +1,129 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	virtv1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/deckhouse/deckhouse/pkg/log"
+	"github.com/deckhouse/virtualization-controller/pkg/common/object"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+// KVVMIWatcher tracks the capacity of the volumes of running virtual machines
+// to complete the online resizing of the disks.
+type KVVMIWatcher struct {
+	logger *log.Logger
+	client client.Client
+}
+
+func NewKVVMIWatcher(client client.Client) *KVVMIWatcher {
+	return &KVVMIWatcher{
+		logger: log.Default().With("watcher", strings.ToLower("KVVMI")),
+		client: client,
+	}
+}
+
+func (w KVVMIWatcher) Watch(mgr manager.Manager, ctr controller.Controller) error {
+	if err := ctr.Watch(
+		source.Kind(mgr.GetCache(), &virtv1.VirtualMachineInstance{},
+			handler.TypedEnqueueRequestsFromMapFunc(w.enqueueDisksWithChangedCapacity),
+			predicate.TypedFuncs[*virtv1.VirtualMachineInstance]{
+				CreateFunc: func(_ event.TypedCreateEvent[*virtv1.VirtualMachineInstance]) bool { return false },
+				DeleteFunc: func(_ event.TypedDeleteEvent[*virtv1.VirtualMachineInstance]) bool { return false },
+				UpdateFunc: func(e event.TypedUpdateEvent[*virtv1.VirtualMachineInstance]) bool {
+					return len(changedClaims(e.ObjectOld, e.ObjectNew)) > 0
+				},
+			},
+		),
+	); err != nil {
+		return fmt.Errorf("error setting watch on KVVMI: %w", err)
+	}
+	return nil
+}
+
+func (w KVVMIWatcher) enqueueDisksWithChangedCapacity(ctx context.Context, kvvmi *virtv1.VirtualMachineInstance) []reconcile.Request {
+	var requests []reconcile.Request
+
+	for _, vs := range kvvmi.Status.VolumeStatus {
+		if vs.PersistentVolumeClaimInfo == nil || vs.PersistentVolumeClaimInfo.ClaimName == "" {
+			continue
+		}
+
+		pvc, err := object.FetchObject(ctx, types.NamespacedName{Name: vs.PersistentVolumeClaimInfo.ClaimName, Namespace: kvvmi.Namespace}, w.client, &corev1.PersistentVolumeClaim{})
+		if err != nil {
+			w.logger.Error(fmt.Sprintf("failed to get pvc: %s", err))
+			continue
+		}
+
+		if pvc == nil {
+			continue
+		}
+
+		for _, ownerRef := range pvc.OwnerReferences {
+			if ownerRef.Kind == v1alpha2.VirtualDiskKind {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{
+						Name:      ownerRef.Name,
+						Namespace: kvvmi.Namespace,
+					},
+				})
+			}
+		}
+	}
+
+	return requests
+}
+
+// changedClaims returns the claims of the volumes whose capacity has changed.
+func changedClaims(oldKVVMI, newKVVMI *virtv1.VirtualMachineInstance) []string {
+	oldCapacities := make(map[string]string, len(oldKVVMI.Status.VolumeStatus))
+	for _, vs := range oldKVVMI.Status.VolumeStatus {
+		if vs.PersistentVolumeClaimInfo != nil {
+			capacity := vs.PersistentVolumeClaimInfo.Capacity[corev1.ResourceStorage]
+			oldCapacities[vs.PersistentVolumeClaimInfo.ClaimName] = capacity.String()
+		}
+	}
+
+	var claims []string
+	for _, vs := range newKVVMI.Status.VolumeStatus {
+		if vs.PersistentVolumeClaimInfo == nil {
+			continue
+		}
+
+		capacity := vs.PersistentVolumeClaimInfo.Capacity[corev1.ResourceStorage]
+		if oldCapacity, ok := oldCapacities[vs.PersistentVolumeClaimInfo.ClaimName]; ok && oldCapacity != capacity.String() {
+			claims = append(claims, vs.PersistentVolumeClaimInfo.ClaimName)
+		}
+	}
+
+	return claims
+}
@@ -63,14 +63,14 @@ func NewController(
 	diskImporterImage string,
 	uploaderImage string,
 	requirements corev1.ResourceRequirements,
-	dvcr *dvcr.Settings,
+	dvcrSettings *dvcr.Settings,
 	storageClassSettings config.VirtualDiskStorageClassSettings,
 ) (controller.Controller, error) {
 	stat := servicestat.NewStatService(log)
 	protection := service.NewProtectionService(mgr.GetClient(), v1alpha2.FinalizerVDProtection)
-	importer := service.NewImporterService(dvcr, mgr.GetClient(), importerImage, requirements, PodPullPolicy, PodVerbose, ControllerName, protection)
-	uploader := serviceuploader.NewUploader(mgr.GetClient(), dvcr, uploaderImage, requirements, PodPullPolicy, PodVerbose, ControllerName, featuregates.Default())
-	disk := service.NewDiskService(mgr.GetClient(), dvcr, protection, ControllerName, service.DiskImporterConfig{
+	importer := service.NewImporterService(dvcrSettings, mgr.GetClient(), importerImage, requirements, PodPullPolicy, PodVerbose, ControllerName, protection)
+	uploader := serviceuploader.NewUploader(mgr.GetClient(), dvcrSettings, uploaderImage, requirements, PodPullPolicy, PodVerbose, ControllerName, featuregates.Default())
+	disk := service.NewDiskService(mgr.GetClient(), dvcrSettings, protection, ControllerName, service.DiskImporterConfig{
 		Image:                diskImporterImage,
 		ResourceRequirements: requirements,
 		PullPolicy:           PodPullPolicy,
@@ -79,14 +79,15 @@ func NewController(
 	scService := intsvc.NewVirtualDiskStorageClassService(service.NewBaseStorageClassService(mgr.GetClient()), storageClassSettings)
 	dvcrService := service.NewDVCRService(mgr.GetClient())
 	recorder := eventrecord.NewEventRecorderLogger(mgr, ControllerName)
+	sourceImage := intsvc.NewSourceImageService(mgr.GetClient(), service.NewRegistryDigestResolver(mgr.GetClient()), dvcr.NewImageChecker(mgr.GetClient(), dvcrSettings))
 
 	sources := source.NewSources()
 	pvcSvc := disk.PersistentVolumeClaim()
 	blank := source.NewBlankDataSource(recorder, disk, pvcSvc, mgr.GetClient())
-	sources.Set(v1alpha2.DataSourceTypeHTTP, source.NewHTTPDataSource(recorder, stat, importer, disk, pvcSvc, dvcr, mgr.GetClient()))
-	sources.Set(v1alpha2.DataSourceTypeContainerImage, source.NewRegistryDataSource(recorder, stat, importer, disk, pvcSvc, dvcr, mgr.GetClient()))
+	sources.Set(v1alpha2.DataSourceTypeHTTP, source.NewHTTPDataSource(recorder, stat, importer, disk, pvcSvc, dvcrSettings, mgr.GetClient()))
+	sources.Set(v1alpha2.DataSourceTypeContainerImage, source.NewRegistryDataSource(recorder, stat, importer, disk, pvcSvc, dvcrSettings, mgr.GetClient()))
 	sources.Set(v1alpha2.DataSourceTypeObjectRef, source.NewObjectRefDataSource(recorder, stat, disk, mgr.GetClient()))
-	sources.Set(v1alpha2.DataSourceTypeUpload, source.NewUploadDataSource(recorder, stat, uploader, disk, pvcSvc, dvcr, mgr.GetClient()))
+	sources.Set(v1alpha2.DataSourceTypeUpload, source.NewUploadDataSource(recorder, stat, uploader, disk, pvcSvc, dvcrSettings, mgr.GetClient()))
 
 	reconciler := NewReconciler(
 		mgr.GetClient(),
@@ -100,9 +101,11 @@ func NewController(
 		internal.NewStatsHandler(stat, importer, uploader),
 		internal.NewLifeCycleHandler(recorder, blank, sources, mgr.GetClient()),
 		internal.NewSnapshottingHandler(disk),
-		internal.NewResizingHandler(recorder, disk),
+		internal.NewResizingHandler(recorder, disk, mgr.GetClient()),
 		internal.NewDeletionHandler(sources, mgr.GetClient()),
 		internal.NewInUseHandler(mgr.GetClient()),
+		// UpdatePolicyHandler should be executed after InUseHandler: it recreates the disk only if it is not in use.
+		internal.NewUpdatePolicyHandler(recorder, sourceImage, sources),
 		internal.NewMigrationHandler(mgr.GetClient(), scService, disk, featuregates.Default()),
 		internal.NewProtectionHandler(),
 	)
@@ -126,6 +129,7 @@ func NewController(
 	if err = builder.WebhookManagedBy(mgr).
 		For(&v1alpha2.VirtualDisk{}).
 		WithValidator(NewValidator(mgr.GetClient(), scService, disk)).
+		WithDefaulter(NewDefaulter(mgr.GetClient())).
 		Complete(); err != nil {
 		return nil, err
 	}
@@ -108,6 +108,7 @@ func (r *Reconciler) SetupController(_ context.Context, mgr manager.Manager, ctr
 		watcher.NewStorageClassWatcher(mgrClient),
 		watcher.NewVirtualMachineWatcher(),
 		watcher.NewResourceQuotaWatcher(mgrClient),
+		watcher.NewKVVMIWatcher(mgrClient),
 		postponeimporter.NewWatcher[*v1alpha2.VirtualDisk](mgrClient, logger),
 	} {
 		err := w.Watch(mgr, ctr)
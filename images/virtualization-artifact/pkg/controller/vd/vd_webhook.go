@@ -25,6 +25,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/deckhouse/virtualization-controller/pkg/controller/service/volumemode"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/vd/internal/defaulter"
 	intsvc "github.com/deckhouse/virtualization-controller/pkg/controller/vd/internal/service"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/vd/internal/validator"
 	"github.com/deckhouse/virtualization-controller/pkg/featuregates"
@@ -122,3 +123,37 @@ func (v *Validator) ValidateDelete(ctx context.Context, _ runtime.Object) (admis
 	logger.FromContext(ctx).Error("Ensure the correctness of ValidatingWebhookConfiguration", "err", err)
 	return nil, nil
 }
+
+type VirtualDiskDefaulter interface {
+	Default(ctx context.Context, vd *v1alpha2.VirtualDisk) error
+}
+
+type Defaulter struct {
+	defaulters []VirtualDiskDefaulter
+}
+
+var _ admission.CustomDefaulter = &Defaulter{}
+
+func NewDefaulter(client client.Client) *Defaulter {
+	return &Defaulter{
+		defaulters: []VirtualDiskDefaulter{
+			defaulter.NewImageCatalogChannelDefaulter(client),
+		},
+	}
+}
+
+func (d *Defaulter) Default(ctx context.Context, obj runtime.Object) error {
+	vd, ok := obj.(*v1alpha2.VirtualDisk)
+	if !ok {
+		return fmt.Errorf("expected a VirtualDisk but got a %T", obj)
+	}
+
+	for _, defaulter := range d.defaulters {
+		err := defaulter.Default(ctx, vd)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
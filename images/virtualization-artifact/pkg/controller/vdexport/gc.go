@@ -0,0 +1,90 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdexport
+
+import (
+	"context"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/deckhouse/deckhouse/pkg/log"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/gc"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+const gcControllerName = "vdexport-gc-controller"
+
+// SetupGC deletes the exports whose download link has expired. The exporter
+// refuses downloads after the expiration by itself, so the schedule only
+// bounds how long the exporter objects outlive the link.
+func SetupGC(mgr manager.Manager, log *log.Logger, schedule string) error {
+	return gc.SetupGcController(gcControllerName,
+		mgr,
+		log.With("resource", "vdexport"),
+		schedule,
+		newVDExportGCManager(mgr.GetClient()),
+	)
+}
+
+func newVDExportGCManager(client client.Client) *vdexportGCManager {
+	return &vdexportGCManager{
+		client: client,
+		now:    time.Now,
+	}
+}
+
+var _ gc.ReconcileGCManager = &vdexportGCManager{}
+
+type vdexportGCManager struct {
+	client client.Client
+	now    func() time.Time
+}
+
+func (m *vdexportGCManager) New() client.Object {
+	return &v1alpha2.VirtualDiskExport{}
+}
+
+func (m *vdexportGCManager) ShouldBeDeleted(obj client.Object) bool {
+	export, ok := obj.(*v1alpha2.VirtualDiskExport)
+	if !ok {
+		return false
+	}
+	return isExpired(export, m.now())
+}
+
+func (m *vdexportGCManager) ListForDelete(ctx context.Context, now time.Time) ([]client.Object, error) {
+	exportList := &v1alpha2.VirtualDiskExportList{}
+	err := m.client.List(ctx, exportList)
+	if err != nil {
+		return nil, err
+	}
+
+	var objs []client.Object
+	for _, export := range exportList.Items {
+		if isExpired(&export, now) {
+			objs = append(objs, &export)
+		}
+	}
+
+	return objs, nil
+}
+
+func isExpired(export *v1alpha2.VirtualDiskExport, now time.Time) bool {
+	return export.Status.ExpiresAt != nil && !now.Before(export.Status.ExpiresAt.Time)
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/virtualization-controller/pkg/controller/service/exporter"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/supplements"
+)
+
+type Exporter interface {
+	Apply(ctx context.Context, obj client.Object, sup supplements.Generator, settings exporter.Settings) error
+	GetPod(ctx context.Context, sup supplements.Generator) (*corev1.Pod, error)
+	DeletePod(ctx context.Context, sup supplements.Generator) error
+	GetURLs(ctx context.Context, sup supplements.Generator) (exporter.URLs, error)
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	podutil "github.com/deckhouse/virtualization-controller/pkg/common/pod"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/service/exporter"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/supplements"
+	"github.com/deckhouse/virtualization-controller/pkg/eventrecord"
+	"github.com/deckhouse/virtualization-controller/pkg/logger"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2/vdexportcondition"
+)
+
+const lifecycleHandlerName = "LifecycleHandler"
+
+// defaultTTL is used when the export is created without the defaulting of the CRD.
+const defaultTTL = 24 * time.Hour
+
+type LifecycleHandler struct {
+	client   client.Client
+	exporter Exporter
+	recorder eventrecord.EventRecorderLogger
+}
+
+func NewLifecycleHandler(client client.Client, exporter Exporter, recorder eventrecord.EventRecorderLogger) *LifecycleHandler {
+	return &LifecycleHandler{
+		client:   client,
+		exporter: exporter,
+		recorder: recorder,
+	}
+}
+
+func (h *LifecycleHandler) Handle(ctx context.Context, export *v1alpha2.VirtualDiskExport) (reconcile.Result, error) {
+	log := logger.FromContext(ctx).With(logger.SlogHandler(lifecycleHandlerName))
+
+	sourceCB := conditions.NewConditionBuilder(vdexportcondition.SourceReadyType).Generation(export.Generation)
+	readyCB := conditions.NewConditionBuilder(vdexportcondition.ReadyType).Generation(export.Generation)
+
+	if !export.DeletionTimestamp.IsZero() {
+		export.Status.Phase = v1alpha2.VirtualDiskExportPhaseTerminating
+		return reconcile.Result{}, nil
+	}
+
+	if export.Status.Phase == v1alpha2.VirtualDiskExportPhaseFailed {
+		return reconcile.Result{}, nil
+	}
+
+	sup := supplements.NewGenerator(annotations.VDExportShortName, export.Name, export.Namespace, export.UID)
+
+	pod, err := h.exporter.GetPod(ctx, sup)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// The source is resolved once, before the exporter starts. After that only
+	// the usage of the disk is checked: the image must not be served while a
+	// virtual machine writes to the disk.
+	if pod == nil {
+		source, status, err := resolveSource(ctx, h.client, export, sup)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if source == nil {
+			export.Status.Phase = v1alpha2.VirtualDiskExportPhasePending
+			conditions.SetCondition(sourceCB.Status(metav1.ConditionFalse).Reason(status.reason).Message(status.message), &export.Status.Conditions)
+			conditions.SetCondition(
+				readyCB.Status(metav1.ConditionFalse).
+					Reason(vdexportcondition.WaitingForTheSource).
+					Message("Waiting for the source to be ready to export."),
+				&export.Status.Conditions,
+			)
+			return reconcile.Result{}, nil
+		}
+
+		conditions.SetCondition(sourceCB.Status(metav1.ConditionTrue).Reason(vdexportcondition.SourceReady).Message(""), &export.Status.Conditions)
+
+		ttl := defaultTTL
+		if export.Spec.TTL != nil {
+			ttl = export.Spec.TTL.Duration
+		}
+
+		export.Status.ExpiresAt = &metav1.Time{Time: time.Now().Add(ttl).Truncate(time.Second)}
+		export.Status.FileName = fileName(export)
+
+		err = h.exporter.Apply(ctx, export, sup, exporter.Settings{
+			SourceClaim: source.claim,
+			BlockMode:   source.blockMode,
+			// The API values are the capitalized dvcr-exporter values.
+			Format:      strings.ToLower(string(export.Spec.Format)),
+			Compression: strings.ToLower(string(export.Spec.Compression)),
+			FileName:    export.Status.FileName,
+			ExpiresAt:   export.Status.ExpiresAt.Time,
+		})
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		log.Info("The exporter has been started", "source", export.Spec.Source.Name, "expiresAt", export.Status.ExpiresAt)
+
+		export.Status.Phase = v1alpha2.VirtualDiskExportPhaseInProgress
+		conditions.SetCondition(
+			readyCB.Status(metav1.ConditionFalse).
+				Reason(vdexportcondition.ExporterStarting).
+				Message("The exporter is starting."),
+			&export.Status.Conditions,
+		)
+		return reconcile.Result{}, nil
+	}
+
+	inUse, err := checkSourceInUse(ctx, h.client, export)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	switch {
+	case inUse != nil:
+		// The image being served is no longer consistent with the disk.
+		err = h.exporter.DeletePod(ctx, sup)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		export.Status.Phase = v1alpha2.VirtualDiskExportPhaseFailed
+		export.Status.DownloadURLs = nil
+		msg := inUse.message + " Stop the virtual machine and recreate the export."
+		conditions.SetCondition(sourceCB.Status(metav1.ConditionFalse).Reason(inUse.reason).Message(inUse.message), &export.Status.Conditions)
+		conditions.SetCondition(readyCB.Status(metav1.ConditionFalse).Reason(vdexportcondition.ExportFailed).Message(msg), &export.Status.Conditions)
+		h.recorder.Event(export, corev1.EventTypeWarning, v1alpha2.ReasonErrVDExportFailed, msg)
+	case pod.Status.Phase == corev1.PodFailed:
+		export.Status.Phase = v1alpha2.VirtualDiskExportPhaseFailed
+		export.Status.DownloadURLs = nil
+		msg := fmt.Sprintf("The exporter has failed: %s.", podFailureMessage(pod))
+		conditions.SetCondition(readyCB.Status(metav1.ConditionFalse).Reason(vdexportcondition.ExportFailed).Message(msg), &export.Status.Conditions)
+		h.recorder.Event(export, corev1.EventTypeWarning, v1alpha2.ReasonErrVDExportFailed, msg)
+	case podutil.IsPodReady(pod):
+		urls, err := h.exporter.GetURLs(ctx, sup)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if urls.InCluster == "" {
+			// The Service has not got its ClusterIP yet.
+			return reconcile.Result{RequeueAfter: time.Second}, nil
+		}
+
+		if export.Status.Phase != v1alpha2.VirtualDiskExportPhaseReady {
+			h.recorder.Event(export, corev1.EventTypeNormal, v1alpha2.ReasonVDExportReady, "The exported image can be downloaded.")
+		}
+
+		export.Status.Phase = v1alpha2.VirtualDiskExportPhaseReady
+		export.Status.DownloadURLs = &v1alpha2.VirtualDiskExportURLs{
+			External:  urls.External,
+			InCluster: urls.InCluster,
+		}
+		conditions.SetCondition(readyCB.Status(metav1.ConditionTrue).Reason(vdexportcondition.Exported).Message(""), &export.Status.Conditions)
+	default:
+		export.Status.Phase = v1alpha2.VirtualDiskExportPhaseInProgress
+		conditions.SetCondition(
+			readyCB.Status(metav1.ConditionFalse).
+				Reason(vdexportcondition.ExporterStarting).
+				Message("The exporter is preparing the image."),
+			&export.Status.Conditions,
+		)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (h *LifecycleHandler) Name() string {
+	return lifecycleHandlerName
+}
+
+func fileName(export *v1alpha2.VirtualDiskExport) string {
+	name := export.Spec.Source.Name + ".raw"
+	if export.Spec.Format == v1alpha2.VirtualDiskExportFormatQcow2 {
+		name = export.Spec.Source.Name + ".qcow2"
+	}
+
+	if export.Spec.Compression == v1alpha2.VirtualDiskExportCompressionGzip {
+		name += ".gz"
+	}
+
+	return name
+}
+
+func podFailureMessage(pod *corev1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated != nil && status.State.Terminated.Message != "" {
+			return status.State.Terminated.Message
+		}
+	}
+
+	if pod.Status.Message != "" {
+		return pod.Status.Message
+	}
+
+	return "unknown error"
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization-controller/pkg/common/testutil"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/service/exporter"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/supplements"
+	"github.com/deckhouse/virtualization-controller/pkg/dvcr"
+	"github.com/deckhouse/virtualization-controller/pkg/eventrecord"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2/vdexportcondition"
+)
+
+const (
+	name      = "export"
+	namespace = "default"
+	diskName  = "disk"
+	claimName = "disk-pvc"
+)
+
+var _ = Describe("LifecycleHandler", func() {
+	var (
+		ctx          context.Context
+		recorderMock *eventrecord.EventRecorderLoggerMock
+		dvcrSettings *dvcr.Settings
+
+		export *v1alpha2.VirtualDiskExport
+		vd     *v1alpha2.VirtualDisk
+		pvc    *corev1.PersistentVolumeClaim
+	)
+
+	newHandler := func(c client.Client) *LifecycleHandler {
+		return NewLifecycleHandler(c, exporter.NewExporter(c, dvcrSettings, "exporter:latest", corev1.ResourceRequirements{}, "IfNotPresent", "vdexport-controller"), recorderMock)
+	}
+
+	BeforeEach(func() {
+		ctx = testutil.ContextBackgroundWithNoOpLogger()
+		recorderMock = &eventrecord.EventRecorderLoggerMock{
+			EventFunc:       func(_ client.Object, _, _, _ string) {},
+			EventfFunc:      func(_ client.Object, _, _, _ string, _ ...any) {},
+			WithLoggingFunc: func(logger eventrecord.InfoLogger) eventrecord.EventRecorderLogger { return recorderMock },
+		}
+		dvcrSettings = &dvcr.Settings{
+			UploaderIngressSettings: dvcr.UploaderIngressSettings{
+				Host:               "virtualization.example.com",
+				TLSSecret:          "ingress-tls",
+				TLSSecretNamespace: namespace,
+			},
+		}
+
+		export = &v1alpha2.VirtualDiskExport{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       v1alpha2.VirtualDiskExportKind,
+				APIVersion: v1alpha2.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				UID:       "11111111-1111-1111-1111-111111111111",
+			},
+			Spec: v1alpha2.VirtualDiskExportSpec{
+				Source: v1alpha2.VirtualDiskExportSource{
+					Kind: v1alpha2.VirtualDiskExportSourceKindVirtualDisk,
+					Name: diskName,
+				},
+				Format:      v1alpha2.VirtualDiskExportFormatQcow2,
+				Compression: v1alpha2.VirtualDiskExportCompressionGzip,
+			},
+		}
+
+		vd = &v1alpha2.VirtualDisk{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      diskName,
+				Namespace: namespace,
+			},
+			Status: v1alpha2.VirtualDiskStatus{
+				Phase:  v1alpha2.DiskReady,
+				Target: v1alpha2.DiskTarget{PersistentVolumeClaim: claimName},
+			},
+		}
+
+		pvc = &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      claimName,
+				Namespace: namespace,
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				VolumeMode: ptr.To(corev1.PersistentVolumeBlock),
+			},
+		}
+	})
+
+	It("should set terminating phase when object is being deleted", func() {
+		fakeClient, srv := setupEnvironment(export)
+		srv.Changed().DeletionTimestamp = ptr.To(metav1.Now())
+
+		_, err := newHandler(fakeClient).Handle(ctx, srv.Changed())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(srv.Changed().Status.Phase).To(Equal(v1alpha2.VirtualDiskExportPhaseTerminating))
+	})
+
+	It("should wait for a missing virtual disk", func() {
+		fakeClient, srv := setupEnvironment(export)
+
+		_, err := newHandler(fakeClient).Handle(ctx, srv.Changed())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(srv.Changed().Status.Phase).To(Equal(v1alpha2.VirtualDiskExportPhasePending))
+		cond, _ := conditions.GetCondition(vdexportcondition.SourceReadyType, srv.Changed().Status.Conditions)
+		Expect(cond.Reason).To(Equal(vdexportcondition.SourceNotFound.String()))
+	})
+
+	It("should not export a virtual disk used by a running virtual machine", func() {
+		vd.Status.AttachedToVirtualMachines = []v1alpha2.AttachedVirtualMachine{{Name: "vm", Mounted: true}}
+		fakeClient, srv := setupEnvironment(export, vd, pvc)
+
+		_, err := newHandler(fakeClient).Handle(ctx, srv.Changed())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(srv.Changed().Status.Phase).To(Equal(v1alpha2.VirtualDiskExportPhasePending))
+		cond, _ := conditions.GetCondition(vdexportcondition.SourceReadyType, srv.Changed().Status.Conditions)
+		Expect(cond.Reason).To(Equal(vdexportcondition.SourceInUse.String()))
+
+		sup := supplements.NewGenerator(annotations.VDExportShortName, export.Name, export.Namespace, export.UID)
+		pod := &corev1.Pod{}
+		Expect(fakeClient.Get(ctx, sup.ExporterPod(), pod)).NotTo(Succeed())
+	})
+
+	It("should start the exporter and become ready with the exporter pod", func() {
+		fakeClient, srv := setupEnvironment(export, vd, pvc)
+		h := newHandler(fakeClient)
+
+		_, err := h.Handle(ctx, srv.Changed())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(srv.Changed().Status.Phase).To(Equal(v1alpha2.VirtualDiskExportPhaseInProgress))
+		Expect(srv.Changed().Status.FileName).To(Equal("disk.qcow2.gz"))
+		Expect(srv.Changed().Status.ExpiresAt).NotTo(BeNil())
+
+		sup := supplements.NewGenerator(annotations.VDExportShortName, export.Name, export.Namespace, export.UID)
+
+		pod := &corev1.Pod{}
+		Expect(fakeClient.Get(ctx, sup.ExporterPod(), pod)).To(Succeed())
+		Expect(pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(claimName))
+		Expect(pod.Spec.Containers[0].VolumeDevices).To(HaveLen(1))
+
+		secret := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, sup.CommonSupplement(), secret)).To(Succeed())
+		token := string(secret.Data["token"])
+		Expect(token).NotTo(BeEmpty())
+
+		ing := &netv1.Ingress{}
+		Expect(fakeClient.Get(ctx, sup.CommonSupplement(), ing)).To(Succeed())
+		Expect(ing.Spec.Rules[0].HTTP.Paths[0].Path).To(Equal("/download/" + token))
+
+		svc := &corev1.Service{}
+		Expect(fakeClient.Get(ctx, sup.CommonSupplement(), svc)).To(Succeed())
+		svc.Spec.ClusterIP = "10.0.0.1"
+		Expect(fakeClient.Update(ctx, svc)).To(Succeed())
+
+		pod.Status.Phase = corev1.PodRunning
+		pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		Expect(fakeClient.Status().Update(ctx, pod)).To(Succeed())
+
+		_, err = h.Handle(ctx, srv.Changed())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(srv.Changed().Status.Phase).To(Equal(v1alpha2.VirtualDiskExportPhaseReady))
+		Expect(srv.Changed().Status.DownloadURLs).NotTo(BeNil())
+		Expect(srv.Changed().Status.DownloadURLs.InCluster).To(Equal("http://10.0.0.1/download/" + token))
+		Expect(srv.Changed().Status.DownloadURLs.External).To(Equal("https://virtualization.example.com/download/" + token))
+		cond, _ := conditions.GetCondition(vdexportcondition.ReadyType, srv.Changed().Status.Conditions)
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("should fail with the exporter pod", func() {
+		fakeClient, srv := setupEnvironment(export, vd, pvc)
+		h := newHandler(fakeClient)
+
+		_, err := h.Handle(ctx, srv.Changed())
+		Expect(err).NotTo(HaveOccurred())
+
+		sup := supplements.NewGenerator(annotations.VDExportShortName, export.Name, export.Namespace, export.UID)
+		pod := &corev1.Pod{}
+		Expect(fakeClient.Get(ctx, sup.ExporterPod(), pod)).To(Succeed())
+		pod.Status.Phase = corev1.PodFailed
+		pod.Status.Message = "open source: permission denied"
+		Expect(fakeClient.Status().Update(ctx, pod)).To(Succeed())
+
+		_, err = h.Handle(ctx, srv.Changed())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(srv.Changed().Status.Phase).To(Equal(v1alpha2.VirtualDiskExportPhaseFailed))
+		cond, _ := conditions.GetCondition(vdexportcondition.ReadyType, srv.Changed().Status.Conditions)
+		Expect(cond.Reason).To(Equal(vdexportcondition.ExportFailed.String()))
+		Expect(strings.Contains(cond.Message, "permission denied")).To(BeTrue())
+	})
+
+	It("should fail and stop the exporter when the virtual disk gets attached to a running virtual machine", func() {
+		fakeClient, srv := setupEnvironment(export, vd, pvc)
+		h := newHandler(fakeClient)
+
+		_, err := h.Handle(ctx, srv.Changed())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(srv.Changed().Status.Phase).To(Equal(v1alpha2.VirtualDiskExportPhaseInProgress))
+
+		vd.Status.AttachedToVirtualMachines = []v1alpha2.AttachedVirtualMachine{{Name: "vm", Mounted: true}}
+		Expect(fakeClient.Status().Update(ctx, vd)).To(Succeed())
+
+		_, err = h.Handle(ctx, srv.Changed())
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(srv.Changed().Status.Phase).To(Equal(v1alpha2.VirtualDiskExportPhaseFailed))
+		Expect(srv.Changed().Status.DownloadURLs).To(BeNil())
+		cond, _ := conditions.GetCondition(vdexportcondition.SourceReadyType, srv.Changed().Status.Conditions)
+		Expect(cond.Reason).To(Equal(vdexportcondition.SourceInUse.String()))
+		cond, _ = conditions.GetCondition(vdexportcondition.ReadyType, srv.Changed().Status.Conditions)
+		Expect(cond.Reason).To(Equal(vdexportcondition.ExportFailed.String()))
+
+		sup := supplements.NewGenerator(annotations.VDExportShortName, export.Name, export.Namespace, export.UID)
+		pod := &corev1.Pod{}
+		Expect(fakeClient.Get(ctx, sup.ExporterPod(), pod)).NotTo(Succeed())
+	})
+})
@@ -0,0 +1,258 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v6/apis/volumesnapshot/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization-controller/pkg/common/object"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/service"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/supplements"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2/vdexportcondition"
+)
+
+// exportSource is the volume the exporter serves.
+type exportSource struct {
+	claim     string
+	blockMode bool
+}
+
+// sourceStatus explains why the source cannot be exported yet.
+type sourceStatus struct {
+	reason  vdexportcondition.SourceReadyReason
+	message string
+}
+
+// resolveSource returns the volume to export, or the status explaining why
+// there is none yet.
+func resolveSource(ctx context.Context, c client.Client, export *v1alpha2.VirtualDiskExport, sup supplements.Generator) (*exportSource, sourceStatus, error) {
+	switch export.Spec.Source.Kind {
+	case v1alpha2.VirtualDiskExportSourceKindVirtualDisk:
+		return resolveVirtualDisk(ctx, c, export)
+	case v1alpha2.VirtualDiskExportSourceKindVirtualDiskSnapshot:
+		return resolveVirtualDiskSnapshot(ctx, c, export, sup)
+	default:
+		return nil, sourceStatus{}, fmt.Errorf("unexpected source kind %q", export.Spec.Source.Kind)
+	}
+}
+
+func resolveVirtualDisk(ctx context.Context, c client.Client, export *v1alpha2.VirtualDiskExport) (*exportSource, sourceStatus, error) {
+	name := export.Spec.Source.Name
+
+	vd, err := object.FetchObject(ctx, types.NamespacedName{Name: name, Namespace: export.Namespace}, c, &v1alpha2.VirtualDisk{})
+	if err != nil {
+		return nil, sourceStatus{}, fmt.Errorf("fetch virtual disk: %w", err)
+	}
+
+	if vd == nil {
+		return nil, sourceStatus{
+			reason:  vdexportcondition.SourceNotFound,
+			message: fmt.Sprintf("The VirtualDisk %q is not found.", name),
+		}, nil
+	}
+
+	if vd.Status.Phase != v1alpha2.DiskReady || vd.Status.Target.PersistentVolumeClaim == "" {
+		return nil, sourceStatus{
+			reason:  vdexportcondition.SourceNotReady,
+			message: fmt.Sprintf("The VirtualDisk %q is not ready yet.", name),
+		}, nil
+	}
+
+	if vmName := mountedBy(vd); vmName != "" {
+		return nil, sourceStatus{
+			reason:  vdexportcondition.SourceInUse,
+			message: fmt.Sprintf("The VirtualDisk %q is used by the virtual machine %q: stop the virtual machine to export the disk.", name, vmName),
+		}, nil
+	}
+
+	pvc, err := object.FetchObject(ctx, types.NamespacedName{Name: vd.Status.Target.PersistentVolumeClaim, Namespace: vd.Namespace}, c, &corev1.PersistentVolumeClaim{})
+	if err != nil {
+		return nil, sourceStatus{}, fmt.Errorf("fetch pvc: %w", err)
+	}
+
+	if pvc == nil {
+		return nil, sourceStatus{
+			reason:  vdexportcondition.SourceNotReady,
+			message: fmt.Sprintf("The underlying PersistentVolumeClaim of the VirtualDisk %q is not found.", name),
+		}, nil
+	}
+
+	return &exportSource{
+		claim:     pvc.Name,
+		blockMode: ptr.Deref(pvc.Spec.VolumeMode, corev1.PersistentVolumeFilesystem) == corev1.PersistentVolumeBlock,
+	}, sourceStatus{}, nil
+}
+
+// checkSourceInUse reports the disk being exported as in use once a virtual
+// machine mounts it. A running exporter does not stop the virtual machine from
+// starting with the disk, so the source is re-checked on every reconcile.
+func checkSourceInUse(ctx context.Context, c client.Client, export *v1alpha2.VirtualDiskExport) (*sourceStatus, error) {
+	if export.Spec.Source.Kind != v1alpha2.VirtualDiskExportSourceKindVirtualDisk {
+		return nil, nil
+	}
+
+	name := export.Spec.Source.Name
+
+	vd, err := object.FetchObject(ctx, types.NamespacedName{Name: name, Namespace: export.Namespace}, c, &v1alpha2.VirtualDisk{})
+	if err != nil {
+		return nil, fmt.Errorf("fetch virtual disk: %w", err)
+	}
+
+	if vd == nil {
+		return nil, nil
+	}
+
+	vmName := mountedBy(vd)
+	if vmName == "" {
+		return nil, nil
+	}
+
+	return &sourceStatus{
+		reason:  vdexportcondition.SourceInUse,
+		message: fmt.Sprintf("The VirtualDisk %q has been attached to the virtual machine %q during the export.", name, vmName),
+	}, nil
+}
+
+// mountedBy returns the name of the virtual machine that mounts the disk, if any.
+func mountedBy(vd *v1alpha2.VirtualDisk) string {
+	for _, vm := range vd.Status.AttachedToVirtualMachines {
+		if vm.Mounted {
+			return vm.Name
+		}
+	}
+
+	return ""
+}
+
+// resolveVirtualDiskSnapshot restores the snapshot into a temporary
+// PersistentVolumeClaim owned by the export.
+func resolveVirtualDiskSnapshot(ctx context.Context, c client.Client, export *v1alpha2.VirtualDiskExport, sup supplements.Generator) (*exportSource, sourceStatus, error) {
+	name := export.Spec.Source.Name
+
+	pvc, err := object.FetchObject(ctx, sup.PersistentVolumeClaim(), c, &corev1.PersistentVolumeClaim{})
+	if err != nil {
+		return nil, sourceStatus{}, fmt.Errorf("fetch pvc: %w", err)
+	}
+
+	if pvc == nil {
+		vdSnapshot, err := object.FetchObject(ctx, types.NamespacedName{Name: name, Namespace: export.Namespace}, c, &v1alpha2.VirtualDiskSnapshot{})
+		if err != nil {
+			return nil, sourceStatus{}, fmt.Errorf("fetch virtual disk snapshot: %w", err)
+		}
+
+		if vdSnapshot == nil {
+			return nil, sourceStatus{
+				reason:  vdexportcondition.SourceNotFound,
+				message: fmt.Sprintf("The VirtualDiskSnapshot %q is not found.", name),
+			}, nil
+		}
+
+		vs, err := object.FetchObject(ctx, types.NamespacedName{Name: vdSnapshot.Status.VolumeSnapshotName, Namespace: vdSnapshot.Namespace}, c, &vsv1.VolumeSnapshot{})
+		if err != nil {
+			return nil, sourceStatus{}, fmt.Errorf("fetch volume snapshot: %w", err)
+		}
+
+		if vdSnapshot.Status.Phase != v1alpha2.VirtualDiskSnapshotPhaseReady || vs == nil || vs.Status == nil || vs.Status.ReadyToUse == nil || !*vs.Status.ReadyToUse {
+			return nil, sourceStatus{
+				reason:  vdexportcondition.SourceNotReady,
+				message: fmt.Sprintf("The VirtualDiskSnapshot %q is not ready to use.", name),
+			}, nil
+		}
+
+		pvc = newPVCFromVolumeSnapshot(sup.PersistentVolumeClaim(), export, vs)
+		if err = c.Create(ctx, pvc); err != nil {
+			return nil, sourceStatus{}, fmt.Errorf("create pvc: %w", err)
+		}
+	}
+
+	return &exportSource{
+		claim:     pvc.Name,
+		blockMode: ptr.Deref(pvc.Spec.VolumeMode, corev1.PersistentVolumeFilesystem) == corev1.PersistentVolumeBlock,
+	}, sourceStatus{}, nil
+}
+
+func newPVCFromVolumeSnapshot(key types.NamespacedName, owner client.Object, vs *vsv1.VolumeSnapshot) *corev1.PersistentVolumeClaim {
+	storageClassName := vs.Annotations[annotations.AnnStorageClassName]
+	if storageClassName == "" {
+		storageClassName = vs.Annotations[annotations.AnnStorageClassNameDeprecated]
+	}
+	volumeMode := vs.Annotations[annotations.AnnVolumeMode]
+	if volumeMode == "" {
+		volumeMode = vs.Annotations[annotations.AnnVolumeModeDeprecated]
+	}
+	accessModesRaw := vs.Annotations[annotations.AnnAccessModes]
+	if accessModesRaw == "" {
+		accessModesRaw = vs.Annotations[annotations.AnnAccessModesDeprecated]
+	}
+
+	accessModes := make([]corev1.PersistentVolumeAccessMode, 0, 1)
+	for _, accessMode := range strings.Split(accessModesRaw, ",") {
+		if accessMode != "" {
+			accessModes = append(accessModes, corev1.PersistentVolumeAccessMode(accessMode))
+		}
+	}
+	if len(accessModes) == 0 {
+		accessModes = append(accessModes, corev1.ReadWriteOnce)
+	}
+
+	spec := corev1.PersistentVolumeClaimSpec{
+		AccessModes: accessModes,
+		DataSource: &corev1.TypedLocalObjectReference{
+			APIGroup: ptr.To(vsv1.SchemeGroupVersion.Group),
+			Kind:     "VolumeSnapshot",
+			Name:     vs.Name,
+		},
+	}
+
+	if storageClassName != "" {
+		spec.StorageClassName = &storageClassName
+	}
+
+	if volumeMode != "" {
+		spec.VolumeMode = ptr.To(corev1.PersistentVolumeMode(volumeMode))
+	}
+
+	if vs.Status != nil && vs.Status.RestoreSize != nil {
+		spec.Resources = corev1.VolumeResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceStorage: *vs.Status.RestoreSize,
+			},
+		}
+	}
+
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				service.MakeControllerOwnerReference(owner),
+			},
+		},
+		Spec: spec,
+	}
+}
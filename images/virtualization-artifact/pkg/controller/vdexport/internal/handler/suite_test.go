@@ -0,0 +1,55 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/testutil"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/reconciler"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+func TestVDExportHandlers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "VirtualDiskExport handlers Suite")
+}
+
+func setupEnvironment(export *v1alpha2.VirtualDiskExport, objs ...client.Object) (client.WithWatch, *reconciler.Resource[*v1alpha2.VirtualDiskExport, v1alpha2.VirtualDiskExportStatus]) {
+	GinkgoHelper()
+	Expect(export).ToNot(BeNil())
+
+	fakeClient, err := testutil.NewFakeClientWithObjects(append([]client.Object{export}, objs...)...)
+	Expect(err).NotTo(HaveOccurred())
+
+	srv := reconciler.NewResource(client.ObjectKeyFromObject(export), fakeClient,
+		func() *v1alpha2.VirtualDiskExport {
+			return &v1alpha2.VirtualDiskExport{}
+		},
+		func(obj *v1alpha2.VirtualDiskExport) v1alpha2.VirtualDiskExportStatus {
+			return obj.Status
+		})
+	err = srv.Fetch(context.Background())
+	Expect(err).NotTo(HaveOccurred())
+
+	return fakeClient, srv
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watcher
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+func NewVirtualDiskWatcher() *VirtualDiskWatcher {
+	return &VirtualDiskWatcher{}
+}
+
+// VirtualDiskWatcher wakes up the exports of a disk once it becomes ready or
+// is mounted or released by a virtual machine.
+type VirtualDiskWatcher struct{}
+
+func (w VirtualDiskWatcher) Watch(mgr manager.Manager, ctr controller.Controller) error {
+	c := mgr.GetClient()
+	if err := ctr.Watch(
+		source.Kind(mgr.GetCache(), &v1alpha2.VirtualDisk{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, vd *v1alpha2.VirtualDisk) []reconcile.Request {
+				return activeExports(ctx, c, vd.Namespace, v1alpha2.VirtualDiskExportSourceKindVirtualDisk, vd.Name)
+			}),
+			predicate.TypedFuncs[*v1alpha2.VirtualDisk]{
+				DeleteFunc: func(e event.TypedDeleteEvent[*v1alpha2.VirtualDisk]) bool { return false },
+				UpdateFunc: func(e event.TypedUpdateEvent[*v1alpha2.VirtualDisk]) bool {
+					return e.ObjectOld.Status.Phase != e.ObjectNew.Status.Phase ||
+						isMounted(e.ObjectOld) != isMounted(e.ObjectNew)
+				},
+			},
+		),
+	); err != nil {
+		return fmt.Errorf("error setting watch on VirtualDisk: %w", err)
+	}
+	return nil
+}
+
+func NewVirtualDiskSnapshotWatcher() *VirtualDiskSnapshotWatcher {
+	return &VirtualDiskSnapshotWatcher{}
+}
+
+// VirtualDiskSnapshotWatcher wakes up the exports of a snapshot once it becomes ready.
+type VirtualDiskSnapshotWatcher struct{}
+
+func (w VirtualDiskSnapshotWatcher) Watch(mgr manager.Manager, ctr controller.Controller) error {
+	c := mgr.GetClient()
+	if err := ctr.Watch(
+		source.Kind(mgr.GetCache(), &v1alpha2.VirtualDiskSnapshot{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, vdSnapshot *v1alpha2.VirtualDiskSnapshot) []reconcile.Request {
+				return activeExports(ctx, c, vdSnapshot.Namespace, v1alpha2.VirtualDiskExportSourceKindVirtualDiskSnapshot, vdSnapshot.Name)
+			}),
+			predicate.TypedFuncs[*v1alpha2.VirtualDiskSnapshot]{
+				DeleteFunc: func(e event.TypedDeleteEvent[*v1alpha2.VirtualDiskSnapshot]) bool { return false },
+				UpdateFunc: func(e event.TypedUpdateEvent[*v1alpha2.VirtualDiskSnapshot]) bool {
+					return e.ObjectOld.Status.Phase != e.ObjectNew.Status.Phase
+				},
+			},
+		),
+	); err != nil {
+		return fmt.Errorf("error setting watch on VirtualDiskSnapshot: %w", err)
+	}
+	return nil
+}
+
+// activeExports returns the exports of the source that have not failed yet.
+func activeExports(ctx context.Context, c client.Client, namespace string, kind v1alpha2.VirtualDiskExportSourceKind, name string) []reconcile.Request {
+	var exports v1alpha2.VirtualDiskExportList
+	if err := c.List(ctx, &exports, client.InNamespace(namespace)); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, export := range exports.Items {
+		if export.Spec.Source.Kind != kind || export.Spec.Source.Name != name {
+			continue
+		}
+		if export.Status.Phase == v1alpha2.VirtualDiskExportPhaseFailed {
+			continue
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&export)})
+	}
+
+	return requests
+}
+
+func isMounted(vd *v1alpha2.VirtualDisk) bool {
+	for _, vm := range vd.Status.AttachedToVirtualMachines {
+		if vm.Mounted {
+			return true
+		}
+	}
+	return false
+}
@@ -21,14 +21,16 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	storagev1alpha1 "github.com/deckhouse/virtualization-controller/pkg/apis/storage/v1alpha1"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/service"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/supplements"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/vi/internal/source"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 )
 
-//go:generate go tool moq -rm -out mock.go . DiskService Sources StorageClassService
+//go:generate go tool moq -rm -out mock.go . DiskService Sources StorageClassService DVCRSoftQuotaService
 
 type Sources interface {
 	Changed(ctx context.Context, vi *v1alpha2.VirtualImage) bool
@@ -51,3 +53,7 @@ type StorageClassService interface {
 	IsStorageClassDeprecated(sc *storagev1.StorageClass) bool
 	ValidateClaimPropertySets(sp *storagev1alpha1.StorageProfile) error
 }
+
+type DVCRSoftQuotaService interface {
+	GetQuota(ctx context.Context, namespace string, skip types.UID) (service.DVCRSoftQuotaStatus, error)
+}
@@ -19,6 +19,7 @@ package internal
 import (
 	"context"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,17 +34,22 @@ import (
 	"github.com/deckhouse/virtualization/api/core/v1alpha2/vicondition"
 )
 
+// dvcrSoftQuotaRecheckInterval is how often an image waiting for the DVCR soft quota checks whether space has been freed.
+const dvcrSoftQuotaRecheckInterval = time.Minute
+
 type LifeCycleHandler struct {
 	client   client.Client
 	sources  Sources
+	quota    DVCRSoftQuotaService
 	recorder eventrecord.EventRecorderLogger
 }
 
-func NewLifeCycleHandler(recorder eventrecord.EventRecorderLogger, sources Sources, client client.Client) *LifeCycleHandler {
+func NewLifeCycleHandler(recorder eventrecord.EventRecorderLogger, sources Sources, quota DVCRSoftQuotaService, client client.Client) *LifeCycleHandler {
 	return &LifeCycleHandler{
 		recorder: recorder,
 		client:   client,
 		sources:  sources,
+		quota:    quota,
 	}
 }
 
@@ -124,6 +130,26 @@ func (h LifeCycleHandler) Handle(ctx context.Context, vi *v1alpha2.VirtualImage)
 		}
 	}
 
+	// Do not start a new import into DVCR while the namespace is over its DVCR soft quota.
+	// Imports that have already started are not interrupted, even if they go over the quota.
+	if vi.Spec.Storage == v1alpha2.StorageContainerRegistry && vi.Status.Phase == v1alpha2.ImagePending {
+		quota, err := h.quota.GetQuota(ctx, vi.Namespace, vi.UID)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if quota.Exceeded() {
+			cb.
+				Status(metav1.ConditionFalse).
+				Reason(vicondition.DVCRSoftQuotaExceeded).
+				Message(fmt.Sprintf("The DVCR soft quota of the namespace is exceeded (%s): free up space by deleting unused images or ask the administrator to raise the quota.", quota))
+			conditions.SetCondition(cb, &vi.Status.Conditions)
+			normalizeProgress(vi)
+
+			return reconcile.Result{RequeueAfter: dvcrSoftQuotaRecheckInterval}, nil
+		}
+	}
+
 	ds, exists := h.sources.For(vi.Spec.DataSource.Type)
 	if !exists {
 		return reconcile.Result{}, fmt.Errorf("data source runner not found for type: %s", vi.Spec.DataSource.Type)
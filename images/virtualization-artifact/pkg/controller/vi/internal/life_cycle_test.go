@@ -23,10 +23,12 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/service"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/vi/internal/source"
 	"github.com/deckhouse/virtualization-controller/pkg/eventrecord"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
@@ -79,7 +81,7 @@ var _ = Describe("LifeCycleHandler Run", func() {
 				EventFunc: func(_ client.Object, _, _, _ string) {},
 			}
 
-			handler := NewLifeCycleHandler(recorder, &sourcesMock, nil)
+			handler := NewLifeCycleHandler(recorder, &sourcesMock, noDVCRSoftQuota(), nil)
 
 			_, _ = handler.Handle(context.TODO(), &vi)
 
@@ -160,7 +162,7 @@ var _ = Describe("LifeCycleHandler Run", func() {
 				return &handler, false
 			}
 
-			handler := NewLifeCycleHandler(nil, &sourcesMock, nil)
+			handler := NewLifeCycleHandler(nil, &sourcesMock, noDVCRSoftQuota(), nil)
 
 			_, _ = handler.Handle(context.TODO(), &vi)
 
@@ -255,7 +257,7 @@ var _ = Describe("LifeCycleHandler Run", func() {
 				},
 			}
 
-			handler := NewLifeCycleHandler(recorder, &sourcesMock, nil)
+			handler := NewLifeCycleHandler(recorder, &sourcesMock, noDVCRSoftQuota(), nil)
 			_, err := handler.Handle(context.TODO(), &vi)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(vi.Status.Phase).To(Equal(phase))
@@ -298,7 +300,7 @@ var _ = Describe("LifeCycleHandler Run", func() {
 			},
 		}
 
-		handler := NewLifeCycleHandler(recorder, &sourcesMock, nil)
+		handler := NewLifeCycleHandler(recorder, &sourcesMock, noDVCRSoftQuota(), nil)
 		_, err := handler.Handle(context.TODO(), &vi)
 		Expect(err).NotTo(HaveOccurred())
 
@@ -307,8 +309,68 @@ var _ = Describe("LifeCycleHandler Run", func() {
 		Expect(readyCond.Status).To(Equal(metav1.ConditionFalse))
 		Expect(readyCond.Reason).To(Equal(vicondition.Provisioning.String()))
 	})
+
+	It("does not start the import while the namespace is over its DVCR soft quota", func() {
+		var sourcesMock SourcesMock
+		sourcesMock.ChangedFunc = func(_ context.Context, _ *v1alpha2.VirtualImage) bool {
+			return false
+		}
+		storeCalled := false
+		sourcesMock.ForFunc = func(_ v1alpha2.DataSourceType) (source.Handler, bool) {
+			return &source.HandlerMock{StoreToDVCRFunc: func(_ context.Context, _ *v1alpha2.VirtualImage) (reconcile.Result, error) {
+				storeCalled = true
+				return reconcile.Result{}, nil
+			}}, true
+		}
+		quota := &DVCRSoftQuotaServiceMock{
+			GetQuotaFunc: func(_ context.Context, namespace string, skip types.UID) (service.DVCRSoftQuotaStatus, error) {
+				Expect(namespace).To(Equal("ns"))
+				Expect(skip).To(Equal(types.UID("vi-uid")))
+				return service.DVCRSoftQuotaStatus{Limit: 10 << 30, Limited: true, Used: 12 << 30}, nil
+			},
+		}
+		vi := v1alpha2.VirtualImage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "vi",
+				Namespace: "ns",
+				UID:       "vi-uid",
+			},
+			Spec: v1alpha2.VirtualImageSpec{
+				Storage: v1alpha2.StorageContainerRegistry,
+			},
+			Status: v1alpha2.VirtualImageStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:   vicondition.DatasourceReadyType.String(),
+						Status: metav1.ConditionTrue,
+					},
+				},
+			},
+		}
+
+		handler := NewLifeCycleHandler(nil, &sourcesMock, quota, nil)
+		res, err := handler.Handle(context.TODO(), &vi)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(res.RequeueAfter).To(Equal(dvcrSoftQuotaRecheckInterval))
+		Expect(storeCalled).To(BeFalse())
+		Expect(vi.Status.Phase).To(Equal(v1alpha2.ImagePending))
+
+		readyCond, ok := conditions.GetCondition(vicondition.ReadyType, vi.Status.Conditions)
+		Expect(ok).To(BeTrue())
+		Expect(readyCond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(readyCond.Reason).To(Equal(vicondition.DVCRSoftQuotaExceeded.String()))
+		Expect(readyCond.Message).To(ContainSubstring("12Gi of 10Gi used"))
+	})
 })
 
+func noDVCRSoftQuota() *DVCRSoftQuotaServiceMock {
+	return &DVCRSoftQuotaServiceMock{
+		GetQuotaFunc: func(_ context.Context, _ string, _ types.UID) (service.DVCRSoftQuotaStatus, error) {
+			return service.DVCRSoftQuotaStatus{}, nil
+		},
+	}
+}
+
 type cleanupAfterSpecChangeTestArgs struct {
 	ReadyCondition metav1.Condition
 	SpecChanged    bool
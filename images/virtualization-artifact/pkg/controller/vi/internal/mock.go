@@ -6,11 +6,13 @@ package internal
 import (
 	"context"
 	storagev1alpha1 "github.com/deckhouse/virtualization-controller/pkg/apis/storage/v1alpha1"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/service"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/supplements"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/vi/internal/source"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sync"
 )
 
@@ -693,3 +695,81 @@ func (mock *StorageClassServiceMock) ValidateClaimPropertySetsCalls() []struct {
 	mock.lockValidateClaimPropertySets.RUnlock()
 	return calls
 }
+
+// Ensure, that DVCRSoftQuotaServiceMock does implement DVCRSoftQuotaService.
+// If this is not the case, regenerate this file with moq.
+var _ DVCRSoftQuotaService = &DVCRSoftQuotaServiceMock{}
+
+// DVCRSoftQuotaServiceMock is a mock implementation of DVCRSoftQuotaService.
+//
+//	func TestSomethingThatUsesDVCRSoftQuotaService(t *testing.T) {
+//
+//		// make and configure a mocked DVCRSoftQuotaService
+//		mockedDVCRSoftQuotaService := &DVCRSoftQuotaServiceMock{
+//			GetQuotaFunc: func(ctx context.Context, namespace string, skip types.UID) (service.DVCRSoftQuotaStatus, error) {
+//				panic("mock out the GetQuota method")
+//			},
+//		}
+//
+//		// use mockedDVCRSoftQuotaService in code that requires DVCRSoftQuotaService
+//		// and then make assertions.
+//
+//	}
+type DVCRSoftQuotaServiceMock struct {
+	// GetQuotaFunc mocks the GetQuota method.
+	GetQuotaFunc func(ctx context.Context, namespace string, skip types.UID) (service.DVCRSoftQuotaStatus, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetQuota holds details about calls to the GetQuota method.
+		GetQuota []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Namespace is the namespace argument value.
+			Namespace string
+			// Skip is the skip argument value.
+			Skip types.UID
+		}
+	}
+	lockGetQuota sync.RWMutex
+}
+
+// GetQuota calls GetQuotaFunc.
+func (mock *DVCRSoftQuotaServiceMock) GetQuota(ctx context.Context, namespace string, skip types.UID) (service.DVCRSoftQuotaStatus, error) {
+	if mock.GetQuotaFunc == nil {
+		panic("DVCRSoftQuotaServiceMock.GetQuotaFunc: method is nil but DVCRSoftQuotaService.GetQuota was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Namespace string
+		Skip      types.UID
+	}{
+		Ctx:       ctx,
+		Namespace: namespace,
+		Skip:      skip,
+	}
+	mock.lockGetQuota.Lock()
+	mock.calls.GetQuota = append(mock.calls.GetQuota, callInfo)
+	mock.lockGetQuota.Unlock()
+	return mock.GetQuotaFunc(ctx, namespace, skip)
+}
+
+// GetQuotaCalls gets all the calls that were made to GetQuota.
+// Check the length with:
+//
+//	len(mockedDVCRSoftQuotaService.GetQuotaCalls())
+func (mock *DVCRSoftQuotaServiceMock) GetQuotaCalls() []struct {
+	Ctx       context.Context
+	Namespace string
+	Skip      types.UID
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Namespace string
+		Skip      types.UID
+	}
+	mock.lockGetQuota.RLock()
+	calls = mock.calls.GetQuota
+	mock.lockGetQuota.RUnlock()
+	return calls
+}
@@ -137,6 +137,13 @@ func (ds HTTPDataSource) StoreToDVCR(ctx context.Context, vi *v1alpha2.VirtualIm
 			vi.Status.Phase = v1alpha2.ImageFailed
 
 			switch {
+			case errors.Is(err, servicestat.ErrCustomizationFailed):
+				ds.recorder.Event(vi, corev1.EventTypeWarning, v1alpha2.ReasonDataSourceDiskProvisioningFailed, "Image customization failed")
+				cb.
+					Status(metav1.ConditionFalse).
+					Reason(vicondition.CustomizationFailed).
+					Message(service.CapitalizeFirstLetter(err.Error() + "."))
+				return reconcile.Result{}, nil
 			case errors.Is(err, servicestat.ErrProvisioningFailed):
 				ds.recorder.Event(vi, corev1.EventTypeWarning, v1alpha2.ReasonDataSourceDiskProvisioningFailed, "Disk provisioning failed")
 				cb.
@@ -157,6 +164,7 @@ func (ds HTTPDataSource) StoreToDVCR(ctx context.Context, vi *v1alpha2.VirtualIm
 		vi.Status.Phase = v1alpha2.ImageReady
 		vi.Status.Size = ds.statService.GetSize(pod)
 		vi.Status.CDROM = ds.statService.GetCDROM(pod)
+		vi.Status.Inspection = ds.statService.GetInspection(pod)
 		vi.Status.Format = ds.statService.GetFormat(pod)
 		vi.Status.Progress = "100%"
 		vi.Status.Target.RegistryURL = ds.statService.GetDVCRImageName(pod)
@@ -233,6 +241,7 @@ func (ds HTTPDataSource) StoreToPVC(ctx context.Context, vi *v1alpha2.VirtualIma
 		}
 
 		envSettings := ds.getEnvSettings(vi, supgen)
+		importer.ApplyChunkedDestinationSettings(envSettings)
 		err = ds.importerService.Start(ctx, envSettings, vi, supgen, datasource.NewCABundleForVMI(vi.GetNamespace(), vi.Spec.DataSource), service.WithSystemNodeToleration())
 		switch {
 		case err == nil:
@@ -339,6 +348,8 @@ func (ds HTTPDataSource) getEnvSettings(vi *v1alpha2.VirtualImage, supgen supple
 		supgen,
 		ds.dvcrSettings.RegistryImageForVI(vi),
 	)
+	importer.ApplyDVCRCompressionSettings(&settings, ds.dvcrSettings, vi.Spec.Compression)
+	importer.ApplyCustomizationSettings(&settings, vi.Spec.Customization)
 
 	return &settings
 }
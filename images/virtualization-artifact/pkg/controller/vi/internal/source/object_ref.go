@@ -198,6 +198,7 @@ func (ds ObjectRefDataSource) StoreToPVC(ctx context.Context, vi *v1alpha2.Virtu
 			ds.recorder.Event(vi, corev1.EventTypeNormal, v1alpha2.ReasonDataSourceSyncCompleted, "The ObjectRef DataSource import has completed")
 			vi.Status.Size = dvcrDataSource.GetSize()
 			vi.Status.CDROM = dvcrDataSource.IsCDROM()
+			vi.Status.Inspection = dvcrDataSource.GetInspection()
 		})
 	}
 
@@ -349,6 +350,7 @@ func (ds ObjectRefDataSource) StoreToDVCR(ctx context.Context, vi *v1alpha2.Virt
 		vi.Status.Phase = v1alpha2.ImageReady
 		vi.Status.Size = dvcrDataSource.GetSize()
 		vi.Status.CDROM = dvcrDataSource.IsCDROM()
+		vi.Status.Inspection = dvcrDataSource.GetInspection()
 		vi.Status.Format = dvcrDataSource.GetFormat()
 		vi.Status.Progress = "100%"
 		vi.Status.Target.RegistryURL = ds.statService.GetDVCRImageName(pod)
@@ -173,6 +173,7 @@ func (ds ObjectRefVirtualDisk) StoreToDVCR(ctx context.Context, vi *v1alpha2.Vir
 		vi.Status.Phase = v1alpha2.ImageReady
 		vi.Status.Size = ds.statService.GetSize(pod)
 		vi.Status.CDROM = ds.statService.GetCDROM(pod)
+		vi.Status.Inspection = ds.statService.GetInspection(pod)
 		vi.Status.Format = ds.statService.GetFormat(pod)
 		vi.Status.Progress = "100%"
 		vi.Status.Target.RegistryURL = ds.statService.GetDVCRImageName(pod)
@@ -372,6 +373,7 @@ func (ds ObjectRefVirtualDisk) getEnvSettings(vi *v1alpha2.VirtualImage, sup sup
 		sup,
 		ds.dvcrSettings.RegistryImageForVI(vi),
 	)
+	importer.ApplyDVCRCompressionSettings(&settings, ds.dvcrSettings, vi.Spec.Compression)
 
 	return &settings
 }
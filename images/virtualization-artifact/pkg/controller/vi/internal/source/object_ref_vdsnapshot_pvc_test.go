@@ -99,6 +99,9 @@ var _ = Describe("ObjectRef VirtualImageSnapshot PersistentVolumeClaim", func()
 			GetCDROMFunc: func(_ *corev1.Pod) bool {
 				return false
 			},
+			GetInspectionFunc: func(_ *corev1.Pod) *v1alpha2.ImageStatusInspection {
+				return nil
+			},
 			GetFormatFunc: func(_ *corev1.Pod) string {
 				return "iso"
 			},
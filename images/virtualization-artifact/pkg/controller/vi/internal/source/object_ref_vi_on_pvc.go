@@ -177,6 +177,7 @@ func (ds ObjectRefDataVirtualImageOnPVC) StoreToDVCR(ctx context.Context, vi, vi
 		// size; copying the source size would under-size any downstream PVC.
 		vi.Status.Size = ds.statService.GetSize(pod)
 		vi.Status.CDROM = viRef.Status.CDROM
+		vi.Status.Inspection = viRef.Status.Inspection
 		vi.Status.Format = viRef.Status.Format
 		vi.Status.Progress = "100%"
 		vi.Status.Target.RegistryURL = ds.statService.GetDVCRImageName(pod)
@@ -271,6 +272,7 @@ func (ds ObjectRefDataVirtualImageOnPVC) StoreToPVC(ctx context.Context, vi, viR
 			ds.recorder.Event(vi, corev1.EventTypeNormal, v1alpha2.ReasonDataSourceSyncCompleted, "The ObjectRef DataSource import has completed")
 			vi.Status.Size = viRef.Status.Size
 			vi.Status.CDROM = viRef.Status.CDROM
+			vi.Status.Inspection = viRef.Status.Inspection
 		})
 	}
 
@@ -325,6 +327,7 @@ func (ds ObjectRefDataVirtualImageOnPVC) getEnvSettings(vi *v1alpha2.VirtualImag
 		sup,
 		ds.dvcrSettings.RegistryImageForVI(vi),
 	)
+	importer.ApplyDVCRCompressionSettings(&settings, ds.dvcrSettings, vi.Spec.Compression)
 
 	return &settings
 }
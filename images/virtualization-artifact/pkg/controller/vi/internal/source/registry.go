@@ -118,6 +118,7 @@ func (ds RegistryDataSource) StoreToPVC(ctx context.Context, vi *v1alpha2.Virtua
 		vi.Status.Progress = "0%"
 
 		envSettings := ds.getEnvSettings(vi, supgen)
+		importer.ApplyChunkedDestinationSettings(envSettings)
 		err = ds.importerService.Start(ctx, envSettings, vi, supgen, datasource.NewCABundleForVMI(vi.GetNamespace(), vi.Spec.DataSource), service.WithSystemNodeToleration())
 		switch {
 		case err == nil:
@@ -232,6 +233,20 @@ func (ds RegistryDataSource) StoreToDVCR(ctx context.Context, vi *v1alpha2.Virtu
 			vi.Status.Phase = v1alpha2.ImageFailed
 
 			switch {
+			case errors.Is(err, servicestat.ErrSignatureVerificationFailed):
+				ds.recorder.Event(vi, corev1.EventTypeWarning, v1alpha2.ReasonDataSourceDiskProvisioningFailed, "Image signature verification failed")
+				cb.
+					Status(metav1.ConditionFalse).
+					Reason(vicondition.SignatureVerificationFailed).
+					Message(service.CapitalizeFirstLetter(err.Error() + "."))
+				return reconcile.Result{}, nil
+			case errors.Is(err, servicestat.ErrCustomizationFailed):
+				ds.recorder.Event(vi, corev1.EventTypeWarning, v1alpha2.ReasonDataSourceDiskProvisioningFailed, "Image customization failed")
+				cb.
+					Status(metav1.ConditionFalse).
+					Reason(vicondition.CustomizationFailed).
+					Message(service.CapitalizeFirstLetter(err.Error() + "."))
+				return reconcile.Result{}, nil
 			case errors.Is(err, servicestat.ErrProvisioningFailed):
 				ds.recorder.Event(vi, corev1.EventTypeWarning, v1alpha2.ReasonDataSourceDiskProvisioningFailed, "Disk provisioning failed")
 				cb.
@@ -259,6 +274,7 @@ func (ds RegistryDataSource) StoreToDVCR(ctx context.Context, vi *v1alpha2.Virtu
 		vi.Status.Phase = v1alpha2.ImageReady
 		vi.Status.Size = ds.statService.GetSize(pod)
 		vi.Status.CDROM = ds.statService.GetCDROM(pod)
+		vi.Status.Inspection = ds.statService.GetInspection(pod)
 		vi.Status.Format = ds.statService.GetFormat(pod)
 		vi.Status.Progress = "100%"
 		vi.Status.Target.RegistryURL = ds.statService.GetDVCRImageName(pod)
@@ -332,12 +348,15 @@ func (ds RegistryDataSource) getEnvSettings(vi *v1alpha2.VirtualImage, supgen su
 		CABundle: vi.Spec.DataSource.ContainerImage.CABundle,
 	}
 	importer.ApplyRegistrySourceSettings(&settings, containerImage, supgen)
+	importer.ApplySignatureVerificationSettings(&settings, ds.dvcrSettings.ImageSignatureVerification(vi.Spec.DataSource.ContainerImage.Verification))
 	importer.ApplyDVCRDestinationSettings(
 		&settings,
 		ds.dvcrSettings,
 		supgen,
 		ds.dvcrSettings.RegistryImageForVI(vi),
 	)
+	importer.ApplyDVCRCompressionSettings(&settings, ds.dvcrSettings, vi.Spec.Compression)
+	importer.ApplyCustomizationSettings(&settings, vi.Spec.Customization)
 
 	return &settings
 }
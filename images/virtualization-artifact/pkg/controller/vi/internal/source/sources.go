@@ -253,6 +253,18 @@ func setPhaseConditionFromPodError(cb *conditions.ConditionBuilder, vi *v1alpha2
 			Reason(vicondition.ProvisioningNotStarted).
 			Message(service.CapitalizeFirstLetter(err.Error() + "."))
 		return nil
+	case errors.Is(err, servicestat.ErrSignatureVerificationFailed):
+		cb.
+			Status(metav1.ConditionFalse).
+			Reason(vicondition.SignatureVerificationFailed).
+			Message(service.CapitalizeFirstLetter(err.Error() + "."))
+		return nil
+	case errors.Is(err, servicestat.ErrCustomizationFailed):
+		cb.
+			Status(metav1.ConditionFalse).
+			Reason(vicondition.CustomizationFailed).
+			Message(service.CapitalizeFirstLetter(err.Error() + "."))
+		return nil
 	case errors.Is(err, servicestat.ErrProvisioningFailed):
 		cb.
 			Status(metav1.ConditionFalse).
@@ -356,6 +368,7 @@ func reconcilePVCImportFromDVCR(
 		vi.Status.Progress = "100%"
 		vi.Status.Size = statSvc.GetSize(pod)
 		vi.Status.CDROM = statSvc.GetCDROM(pod)
+		vi.Status.Inspection = statSvc.GetInspection(pod)
 		vi.Status.Format = imageformat.StorageFormat(pvc)
 		vi.Status.DownloadSpeed = statSvc.GetDownloadSpeed(vi.GetUID(), pod)
 		return reconcile.Result{RequeueAfter: time.Second}, nil
@@ -148,6 +148,7 @@ func (s CreatePodStep) getEnvSettings(vi *v1alpha2.VirtualImage, sup supplements
 		sup,
 		s.dvcrSettings.RegistryImageForVI(vi),
 	)
+	importer.ApplyDVCRCompressionSettings(&settings, s.dvcrSettings, vi.Spec.Compression)
 
 	return &settings
 }
@@ -51,6 +51,7 @@ type ReadyContainerRegistryStepStat interface {
 	GetFormat(pod *corev1.Pod) string
 	CheckPod(pod *corev1.Pod) error
 	GetCDROM(pod *corev1.Pod) bool
+	GetInspection(pod *corev1.Pod) *v1alpha2.ImageStatusInspection
 }
 
 type ReadyContainerRegistryStep struct {
@@ -140,6 +141,7 @@ func (s ReadyContainerRegistryStep) Take(ctx context.Context, vi *v1alpha2.Virtu
 	vi.Status.Phase = v1alpha2.ImageReady
 	vi.Status.Size = s.stat.GetSize(s.pod)
 	vi.Status.CDROM = s.stat.GetCDROM(s.pod)
+	vi.Status.Inspection = s.stat.GetInspection(s.pod)
 	vi.Status.Format = s.stat.GetFormat(s.pod)
 	vi.Status.Progress = "100%"
 	vi.Status.Target.RegistryURL = s.stat.GetDVCRImageName(s.pod)
@@ -56,6 +56,7 @@ type readyContainerRegistryStepStatStub struct {
 	dvcrImageName string
 	format        string
 	cdrom         bool
+	inspection    *v1alpha2.ImageStatusInspection
 }
 
 func (s readyContainerRegistryStepStatStub) GetSize(_ *corev1.Pod) v1alpha2.ImageStatusSize {
@@ -78,6 +79,10 @@ func (s readyContainerRegistryStepStatStub) GetCDROM(_ *corev1.Pod) bool {
 	return s.cdrom
 }
 
+func (s readyContainerRegistryStepStatStub) GetInspection(_ *corev1.Pod) *v1alpha2.ImageStatusInspection {
+	return s.inspection
+}
+
 var _ = Describe("ReadyContainerRegistryStep", func() {
 	newRecorder := func() *eventrecord.EventRecorderLoggerMock {
 		var recorder *eventrecord.EventRecorderLoggerMock
@@ -245,6 +250,11 @@ var _ = Describe("ReadyContainerRegistryStep", func() {
 			dvcrImageName: "registry.example.com/image:tag",
 			format:        "qcow2",
 			cdrom:         true,
+			inspection: &v1alpha2.ImageStatusInspection{
+				OSFamily:           v1alpha2.ImageOSFamilyLinux,
+				PartitionTable:     v1alpha2.ImagePartitionTableGPT,
+				EFISystemPartition: true,
+			},
 		}
 
 		result, err := NewReadyContainerRegistryStep(pod, diskService, importer, stat, recorder, cb).Take(context.Background(), vi)
@@ -261,6 +271,7 @@ var _ = Describe("ReadyContainerRegistryStep", func() {
 		Expect(vi.Status.Size).To(Equal(stat.size))
 		Expect(vi.Status.CDROM).To(BeTrue())
 		Expect(vi.Status.Format).To(Equal("qcow2"))
+		Expect(vi.Status.Inspection).To(Equal(stat.inspection))
 		Expect(vi.Status.Progress).To(Equal("100%"))
 		Expect(vi.Status.Target.RegistryURL).To(Equal("registry.example.com/image:tag"))
 		Expect(cb.Condition().Status).To(Equal(metav1.ConditionTrue))
@@ -167,6 +167,7 @@ func (ds UploadDataSource) StoreToPVC(ctx context.Context, vi *v1alpha2.VirtualI
 		vi.Status.Progress = "0%"
 
 		envSettings := ds.getEnvSettings(vi, supgen)
+		serviceuploader.ApplyChunkedDestinationSettings(&envSettings)
 		err = ds.uploaderService.Apply(ctx, vi, supgen, envSettings, datasource.NewCABundleForVMI(vi.GetNamespace(), vi.Spec.DataSource), serviceuploader.WithSystemNodeToleration())
 		switch {
 		case err == nil:
@@ -393,6 +394,7 @@ func (ds UploadDataSource) StoreToDVCR(ctx context.Context, vi *v1alpha2.Virtual
 		vi.Status.Phase = v1alpha2.ImageReady
 		vi.Status.Size = ds.statService.GetSize(pod)
 		vi.Status.CDROM = ds.statService.GetCDROM(pod)
+		vi.Status.Inspection = ds.statService.GetInspection(pod)
 		vi.Status.Format = ds.statService.GetFormat(pod)
 		vi.Status.Progress = "100%"
 		vi.Status.Target.RegistryURL = ds.statService.GetDVCRImageName(pod)
@@ -541,6 +543,7 @@ func (ds UploadDataSource) getEnvSettings(vi *v1alpha2.VirtualImage, supgen supp
 		supgen,
 		ds.dvcrSettings.RegistryImageForVI(vi),
 	)
+	serviceuploader.ApplyDVCRCompressionSettings(&settings, ds.dvcrSettings, vi.Spec.Compression)
 
 	serviceuploader.ApplyUploadSourceSettings(&settings, vi.Spec.DataSource.Upload)
 
@@ -30,6 +30,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/deckhouse/virtualization-controller/pkg/common/datasource"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/service"
 	servicestat "github.com/deckhouse/virtualization-controller/pkg/controller/service/stat"
 	serviceuploader "github.com/deckhouse/virtualization-controller/pkg/controller/service/uploader"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/supplements"
@@ -203,3 +204,77 @@ var _ = Describe("Upload DataSource StoreToDVCR", func() {
 		Expect(vi.Status.DownloadSpeed).ToNot(BeNil())
 	})
 })
+
+var _ = Describe("Upload DataSource destination format", func() {
+	var (
+		ctx          context.Context
+		vi           *v1alpha2.VirtualImage
+		uploaderMock *UploaderMock
+		applied      []serviceuploader.Settings
+	)
+
+	newUploadDataSource := func() *UploadDataSource {
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		return NewUploadDataSource(
+			&eventrecord.EventRecorderLoggerMock{
+				EventFunc: func(_ client.Object, _, _, _ string) {},
+			},
+			&StatMock{
+				IsUploaderReadyFunc: func(_ *corev1.Pod, _ *corev1.Service, _ serviceuploader.UploaderExposure) (bool, error) {
+					return false, nil
+				},
+			},
+			uploaderMock,
+			&dvcr.Settings{},
+			service.NewDiskService(c, nil, nil, "vi-controller"),
+			c,
+		)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		applied = nil
+
+		vi = &v1alpha2.VirtualImage{
+			ObjectMeta: metav1.ObjectMeta{Name: "vi", Namespace: "default", UID: "33333333-3333-3333-3333-333333333333"},
+			Spec: v1alpha2.VirtualImageSpec{
+				DataSource: v1alpha2.VirtualImageDataSource{Type: v1alpha2.DataSourceTypeUpload},
+			},
+		}
+
+		uploaderMock = &UploaderMock{
+			GetPodFunc: func(_ context.Context, _ supplements.Generator) (*corev1.Pod, error) {
+				return nil, nil
+			},
+			GetServiceFunc: func(_ context.Context, _ supplements.Generator) (*corev1.Service, error) {
+				return nil, nil
+			},
+			GetExposureFunc: func(_ context.Context, _ supplements.Generator) (serviceuploader.UploaderExposure, error) {
+				return serviceuploader.UploaderExposure{}, nil
+			},
+			ApplyFunc: func(_ context.Context, _ client.Object, _ supplements.Generator, settings serviceuploader.Settings, _ *datasource.CABundle, _ ...serviceuploader.Option) error {
+				applied = append(applied, settings)
+				return nil
+			},
+		}
+	})
+
+	It("stores the intermediate image of a VirtualImage on a PVC as chunks", func() {
+		_, err := newUploadDataSource().StoreToPVC(ctx, vi)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(applied).To(HaveLen(1))
+		Expect(applied[0].DestinationChunked).To(BeTrue())
+	})
+
+	It("keeps a VirtualImage stored in DVCR regular, as it is attached as a container disk", func() {
+		_, err := newUploadDataSource().StoreToDVCR(ctx, vi)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(applied).To(HaveLen(1))
+		Expect(applied[0].DestinationChunked).To(BeFalse())
+	})
+})
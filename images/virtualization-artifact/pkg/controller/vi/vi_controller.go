@@ -45,6 +45,7 @@ import (
 	"github.com/deckhouse/virtualization-controller/pkg/eventrecord"
 	"github.com/deckhouse/virtualization-controller/pkg/featuregates"
 	"github.com/deckhouse/virtualization-controller/pkg/logger"
+	dvcrquotacollector "github.com/deckhouse/virtualization-controller/pkg/monitoring/metrics/dvcrquota"
 	vicollector "github.com/deckhouse/virtualization-controller/pkg/monitoring/metrics/vi"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 )
@@ -85,6 +86,7 @@ func NewController(
 	})
 	scService := intsvc.NewVirtualImageStorageClassService(service.NewBaseStorageClassService(mgr.GetClient()), storageClassSettings)
 	dvcrService := service.NewDVCRService(mgr.GetClient())
+	quotaService := service.NewDVCRSoftQuotaService(mgr.GetClient())
 	recorder := eventrecord.NewEventRecorderLogger(mgr, ControllerName)
 
 	sources := source.NewSources()
@@ -98,7 +100,7 @@ func NewController(
 		postponeimporter.NewHandler[*v1alpha2.VirtualImage](dvcrService, recorder),
 		internal.NewStorageClassReadyHandler(recorder, scService),
 		internal.NewDatasourceReadyHandler(sources),
-		internal.NewLifeCycleHandler(recorder, sources, mgr.GetClient()),
+		internal.NewLifeCycleHandler(recorder, sources, quotaService, mgr.GetClient()),
 		internal.NewImagePresenceHandler(recorder, mgr.GetClient(), dvcr),
 		// Order matters: AttacheeHandler must run before DeletionHandler.
 		// AttacheeHandler owns the protection finalizer (it drops it once no VirtualMachine
@@ -152,12 +154,13 @@ func NewController(
 
 	if err = builder.WebhookManagedBy(mgr).
 		For(&v1alpha2.VirtualImage{}).
-		WithValidator(NewValidator(log, mgr.GetClient(), scService)).
+		WithValidator(NewValidator(log, mgr.GetClient(), scService, quotaService)).
 		Complete(); err != nil {
 		return nil, err
 	}
 
 	vicollector.SetupCollector(mgr.GetCache(), metrics.Registry, log)
+	dvcrquotacollector.SetupCollector(mgr.GetCache(), metrics.Registry, log)
 
 	log.Info("Initialized VirtualImage controller", "image", importerImage)
 
@@ -21,33 +21,40 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 
 	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/deckhouse/deckhouse/pkg/log"
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
 	"github.com/deckhouse/virtualization-controller/pkg/common/storageclass"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/service"
 	intsvc "github.com/deckhouse/virtualization-controller/pkg/controller/vi/internal/service"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2/vicondition"
 )
 
 type Validator struct {
-	logger    *log.Logger
-	client    client.Client
-	scService *intsvc.VirtualImageStorageClassService
+	logger       *log.Logger
+	client       client.Client
+	scService    *intsvc.VirtualImageStorageClassService
+	quotaService *service.DVCRSoftQuotaService
 }
 
-func NewValidator(logger *log.Logger, client client.Client, scService *intsvc.VirtualImageStorageClassService) *Validator {
+func NewValidator(logger *log.Logger, client client.Client, scService *intsvc.VirtualImageStorageClassService, quotaService *service.DVCRSoftQuotaService) *Validator {
 	return &Validator{
-		logger:    logger.With("webhook", "validator"),
-		client:    client,
-		scService: scService,
+		logger:       logger.With("webhook", "validator"),
+		client:       client,
+		scService:    scService,
+		quotaService: quotaService,
 	}
 }
 
@@ -65,6 +72,10 @@ func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (adm
 		return nil, err
 	}
 
+	if err := v.validateDVCRSoftQuota(ctx, vi); err != nil {
+		return nil, err
+	}
+
 	if vi.Spec.Storage == v1alpha2.StorageKubernetes {
 		warnings := admission.Warnings{
 			fmt.Sprintf("Using the `%s` storage type is deprecated. It is recommended to use `%s` instead.",
@@ -140,6 +151,10 @@ func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.O
 			return nil, errors.New("data source cannot be changed if the VirtualImage has already been provisioned")
 		}
 
+		if !reflect.DeepEqual(oldVI.Spec.Customization, newVI.Spec.Customization) {
+			return nil, errors.New("customization cannot be changed if the VirtualImage has already been provisioned")
+		}
+
 		if !reflect.DeepEqual(oldVI.Spec.PersistentVolumeClaim.StorageClass, newVI.Spec.PersistentVolumeClaim.StorageClass) {
 			return nil, errors.New("storage class cannot be changed if the VirtualImage has already been provisioned")
 		}
@@ -262,6 +277,81 @@ func (v *Validator) validateSourceStorageClassProvisionerCompatibility(ctx conte
 	return nil
 }
 
+// validateDVCRSoftQuota forbids importing a new image into DVCR if the namespace has already used up
+// its DVCR soft quota, or if the known size of the source image does not fit into the rest of the quota.
+// The size of other sources is known only after the import, so they are let through while there is
+// any room left: the quota is soft and may be overshot by them.
+func (v *Validator) validateDVCRSoftQuota(ctx context.Context, vi *v1alpha2.VirtualImage) error {
+	if vi.Spec.Storage != v1alpha2.StorageContainerRegistry {
+		return nil
+	}
+
+	quota, err := v.quotaService.GetQuota(ctx, vi.Namespace, "")
+	if err != nil {
+		return err
+	}
+	if !quota.Limited {
+		return nil
+	}
+
+	if quota.Exceeded() {
+		return fmt.Errorf("the DVCR soft quota of the namespace %q is exceeded (%s): delete unused VirtualImages or ask the administrator to raise the %s annotation", vi.Namespace, quota, annotations.AnnDVCRSoftQuota)
+	}
+
+	size, err := v.sourceStoredBytes(ctx, vi)
+	if err != nil {
+		return err
+	}
+
+	if quota.Used+size > quota.Limit {
+		return fmt.Errorf("the VirtualImage of %s does not fit into the DVCR soft quota of the namespace %q (%s)", resource.NewQuantity(size, resource.BinarySI), vi.Namespace, quota)
+	}
+
+	return nil
+}
+
+// sourceStoredBytes returns the size of the source image if it is known before the import, i.e. the image is copied from another image in DVCR.
+func (v *Validator) sourceStoredBytes(ctx context.Context, vi *v1alpha2.VirtualImage) (int64, error) {
+	ref := vi.Spec.DataSource.ObjectRef
+	if vi.Spec.DataSource.Type != v1alpha2.DataSourceTypeObjectRef || ref == nil {
+		return 0, nil
+	}
+
+	var size v1alpha2.ImageStatusSize
+	switch ref.Kind {
+	case v1alpha2.VirtualImageObjectRefKindVirtualImage:
+		var source v1alpha2.VirtualImage
+		err := v.client.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: vi.Namespace}, &source)
+		if err != nil {
+			return 0, client.IgnoreNotFound(err)
+		}
+		if source.Spec.Storage != v1alpha2.StorageContainerRegistry {
+			return 0, nil
+		}
+		size = source.Status.Size
+	case v1alpha2.VirtualImageObjectRefKindClusterVirtualImage:
+		var source v1alpha2.ClusterVirtualImage
+		err := v.client.Get(ctx, types.NamespacedName{Name: ref.Name}, &source)
+		if err != nil {
+			return 0, client.IgnoreNotFound(err)
+		}
+		size = source.Status.Size
+	default:
+		return 0, nil
+	}
+
+	if size.StoredBytes == "" {
+		return 0, nil
+	}
+
+	storedBytes, err := strconv.ParseInt(size.StoredBytes, 10, 64)
+	if err != nil {
+		return 0, nil
+	}
+
+	return storedBytes, nil
+}
+
 // resolveTargetStorageClassName resolves the storage class name a PVC-backed
 // VirtualImage will use: the explicit spec value, otherwise the module default,
 // otherwise the cluster default. Returns an empty name when it cannot be resolved.
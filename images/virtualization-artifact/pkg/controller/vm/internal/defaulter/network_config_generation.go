@@ -0,0 +1,75 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaulter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+// NetworkConfigGenerationDefaulter enables the generation of the cloud-init network configuration
+// for virtual machines being created. The field is left empty on existing virtual machines, so the
+// network configuration of their guests does not change; re-applying a manifest without the field
+// keeps the value the virtual machine has.
+type NetworkConfigGenerationDefaulter struct{}
+
+func NewNetworkConfigGenerationDefaulter() *NetworkConfigGenerationDefaulter {
+	return &NetworkConfigGenerationDefaulter{}
+}
+
+func (d *NetworkConfigGenerationDefaulter) Default(ctx context.Context, vm *v1alpha2.VirtualMachine) error {
+	p := vm.Spec.Provisioning
+	if p == nil || p.NetworkConfigGeneration != "" {
+		return nil
+	}
+
+	switch p.Type {
+	case v1alpha2.ProvisioningTypeUserData, v1alpha2.ProvisioningTypeUserDataRef:
+	default:
+		return nil
+	}
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return nil
+	}
+
+	switch req.Operation {
+	case admissionv1.Create:
+		p.NetworkConfigGeneration = v1alpha2.NetworkConfigGenerationEnabled
+	case admissionv1.Update:
+		if len(req.OldObject.Raw) == 0 {
+			return nil
+		}
+		var oldVM v1alpha2.VirtualMachine
+		err = json.Unmarshal(req.OldObject.Raw, &oldVM)
+		if err != nil {
+			return errors.Join(errors.New("failed to decode the old VirtualMachine"), err)
+		}
+		if oldVM.Spec.Provisioning != nil {
+			p.NetworkConfigGeneration = oldVM.Spec.Provisioning.NetworkConfigGeneration
+		}
+	}
+
+	return nil
+}
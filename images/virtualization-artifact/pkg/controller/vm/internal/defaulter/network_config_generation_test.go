@@ -0,0 +1,84 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaulter_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/testutil"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/vm/internal/defaulter"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+var _ = Describe("NetworkConfigGenerationDefaulter", func() {
+	var vm *v1alpha2.VirtualMachine
+
+	BeforeEach(func() {
+		vm = &v1alpha2.VirtualMachine{
+			Spec: v1alpha2.VirtualMachineSpec{
+				Provisioning: &v1alpha2.Provisioning{Type: v1alpha2.ProvisioningTypeUserData, UserData: "#cloud-config\n"},
+			},
+		}
+	})
+
+	withOperation := func(operation admissionv1.Operation, oldVM ...*v1alpha2.VirtualMachine) {
+		GinkgoHelper()
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: operation}}
+		if len(oldVM) > 0 {
+			raw, err := json.Marshal(oldVM[0])
+			Expect(err).NotTo(HaveOccurred())
+			req.OldObject.Raw = raw
+		}
+		Expect(defaulter.NewNetworkConfigGenerationDefaulter().Default(
+			admission.NewContextWithRequest(testutil.ContextBackgroundWithNoOpLogger(), req), vm,
+		)).To(Succeed())
+	}
+
+	It("enables the generation for a virtual machine being created", func() {
+		withOperation(admissionv1.Create)
+		Expect(vm.Spec.Provisioning.NetworkConfigGeneration).To(Equal(v1alpha2.NetworkConfigGenerationEnabled))
+	})
+
+	It("keeps the generation disabled by the user", func() {
+		vm.Spec.Provisioning.NetworkConfigGeneration = v1alpha2.NetworkConfigGenerationDisabled
+		withOperation(admissionv1.Create)
+		Expect(vm.Spec.Provisioning.NetworkConfigGeneration).To(Equal(v1alpha2.NetworkConfigGenerationDisabled))
+	})
+
+	It("leaves an existing virtual machine as is", func() {
+		withOperation(admissionv1.Update, vm.DeepCopy())
+		Expect(vm.Spec.Provisioning.NetworkConfigGeneration).To(BeEmpty())
+	})
+
+	It("keeps the value of the virtual machine if the field is omitted on update", func() {
+		oldVM := vm.DeepCopy()
+		oldVM.Spec.Provisioning.NetworkConfigGeneration = v1alpha2.NetworkConfigGenerationEnabled
+		withOperation(admissionv1.Update, oldVM)
+		Expect(vm.Spec.Provisioning.NetworkConfigGeneration).To(Equal(v1alpha2.NetworkConfigGenerationEnabled))
+	})
+
+	It("leaves sysprep provisioning as is", func() {
+		vm.Spec.Provisioning = &v1alpha2.Provisioning{Type: v1alpha2.ProvisioningTypeSysprepRef}
+		withOperation(admissionv1.Create)
+		Expect(vm.Spec.Provisioning.NetworkConfigGeneration).To(BeEmpty())
+	})
+})
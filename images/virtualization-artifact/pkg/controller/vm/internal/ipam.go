@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -30,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization-controller/pkg/common/ip"
 	"github.com/deckhouse/virtualization-controller/pkg/common/network"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/vm/internal/state"
@@ -84,6 +86,7 @@ func (h *IPAMHandler) Handle(ctx context.Context, s state.VirtualMachineState) (
 
 	if !hasDefaultNetwork(vm.Status.Networks) {
 		vm.Status.IPAddress = ""
+		vm.Status.Addresses = nil
 		vm.Status.VirtualMachineIPAddress = ""
 		if err := h.deleteManagedVMIP(ctx, s, vm); err != nil {
 			cb.Status(metav1.ConditionFalse).Reason(vmcondition.ReasonIPAddressNotReady).
@@ -106,6 +109,7 @@ func (h *IPAMHandler) Handle(ctx context.Context, s state.VirtualMachineState) (
 		vm.Status.VirtualMachineIPAddress = ipAddress.GetName()
 		if vm.Status.Phase != v1alpha2.MachineRunning && vm.Status.Phase != v1alpha2.MachineStopping {
 			vm.Status.IPAddress = ipAddress.Status.Address
+			vm.Status.Addresses = slices.Clone(ip.Addresses(ipAddress))
 		}
 		kvvmi, err := s.KVVMI(ctx)
 		if err != nil {
@@ -115,17 +119,14 @@ func (h *IPAMHandler) Handle(ctx context.Context, s state.VirtualMachineState) (
 		if kvvmi != nil && kvvmi.Status.Phase == virtv1.Running {
 			for _, iface := range kvvmi.Status.Interfaces {
 				if iface.Name == network.NameDefaultInterface {
-					hasClaimedIP := false
-					for _, ip := range iface.IPs {
-						if ip == ipAddress.Status.Address {
-							hasClaimedIP = true
+					for _, claimedIP := range ip.Addresses(ipAddress) {
+						if !slices.Contains(iface.IPs, claimedIP) {
+							msg := fmt.Sprintf("IP address (%s) is not among addresses assigned to '%s' network interface (%s)", claimedIP, network.NameDefaultInterface, strings.Join(iface.IPs, ", "))
+							cb.Status(metav1.ConditionFalse).Reason(vmcondition.ReasonIPAddressNotAssigned).Message(msg)
+							log.Warn(msg)
+							break
 						}
 					}
-					if !hasClaimedIP {
-						msg := fmt.Sprintf("IP address (%s) is not among addresses assigned to '%s' network interface (%s)", ipAddress.Status.Address, network.NameDefaultInterface, strings.Join(iface.IPs, ", "))
-						cb.Status(metav1.ConditionFalse).Reason(vmcondition.ReasonIPAddressNotAssigned).Message(msg)
-						log.Warn(msg)
-					}
 					break
 				}
 			}
@@ -19,6 +19,7 @@ package internal
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
@@ -26,6 +27,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization-controller/pkg/common/network"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/vm/internal/state"
 	"github.com/deckhouse/virtualization-controller/pkg/eventrecord"
@@ -39,7 +42,7 @@ const nameMACHandler = "MACHandler"
 type MACManager interface {
 	IsBound(vmName string, vmmac *v1alpha2.VirtualMachineMACAddress) bool
 	CheckMACAddressAvailableForBinding(vmmac *v1alpha2.VirtualMachineMACAddress) error
-	CreateMACAddress(ctx context.Context, vm *v1alpha2.VirtualMachine, client client.Client, macAddress string) error
+	CreateMACAddress(ctx context.Context, vm *v1alpha2.VirtualMachine, client client.Client, macAddress, networkKey string) error
 }
 
 func NewMACHandler(mac MACManager, cl client.Client, recorder eventrecord.EventRecorderLogger) *MACHandler {
@@ -87,9 +90,9 @@ func (h *MACHandler) Handle(ctx context.Context, s state.VirtualMachineState) (r
 	}
 
 	expectedMACAddresses := 0
-	for _, network := range vm.Spec.Networks {
+	for _, ns := range vm.Spec.Networks {
 		// 'Main' network not require a MAC address.
-		if network.Type == v1alpha2.NetworksTypeMain {
+		if ns.Type == v1alpha2.NetworksTypeMain {
 			continue
 		}
 		expectedMACAddresses++
@@ -105,7 +108,7 @@ func (h *MACHandler) Handle(ctx context.Context, s state.VirtualMachineState) (r
 		if kvvm != nil && len(vmmacs) == 0 {
 			for _, iface := range kvvm.Spec.Template.Spec.Domain.Devices.Interfaces {
 				if strings.HasPrefix(iface.Name, "veth_") {
-					err = h.macManager.CreateMACAddress(ctx, vm, h.client, iface.MacAddress)
+					err = h.macManager.CreateMACAddress(ctx, vm, h.client, iface.MacAddress, "")
 					createdCount++
 					if err != nil {
 						cb.Status(metav1.ConditionFalse).Reason(vmcondition.ReasonMACAddressNotReady).Message(fmt.Sprintf("Failed to create VirtualMachineMACAddress: %s", err))
@@ -119,8 +122,13 @@ func (h *MACHandler) Handle(ctx context.Context, s state.VirtualMachineState) (r
 			if macsToCreate < 0 {
 				macsToCreate = 0
 			}
+			networks := networksWithoutMACAddress(vm, vmmacs)
 			for i := 0; i < macsToCreate; i++ {
-				err = h.macManager.CreateMACAddress(ctx, vm, h.client, "")
+				var key string
+				if i < len(networks) {
+					key = network.SpecKey(networks[i])
+				}
+				err = h.macManager.CreateMACAddress(ctx, vm, h.client, "", key)
 				if err != nil {
 					cb.Status(metav1.ConditionFalse).Reason(vmcondition.ReasonMACAddressNotReady).Message(fmt.Sprintf("Failed to create VirtualMachineMACAddress: %s", err))
 					return reconcile.Result{}, err
@@ -180,3 +188,37 @@ func (h *MACHandler) Handle(ctx context.Context, s state.VirtualMachineState) (r
 func (h *MACHandler) Name() string {
 	return nameMACHandler
 }
+
+// networksWithoutMACAddress returns the additional networks of the virtual machine that are not covered by the existing
+// VirtualMachineMACAddresses: the addresses referenced by the networks, the addresses created for the networks,
+// and the remaining addresses, in the order of the networks.
+func networksWithoutMACAddress(vm *v1alpha2.VirtualMachine, vmmacs []*v1alpha2.VirtualMachineMACAddress) []v1alpha2.NetworksSpec {
+	referenced := make(map[string]struct{})
+	var pending []v1alpha2.NetworksSpec
+	for _, ns := range vm.Spec.Networks {
+		switch {
+		case ns.Type == v1alpha2.NetworksTypeMain:
+		case ns.VirtualMachineMACAddressName != "":
+			referenced[ns.VirtualMachineMACAddressName] = struct{}{}
+		default:
+			pending = append(pending, ns)
+		}
+	}
+
+	var unassigned int
+	for _, vmmac := range vmmacs {
+		if _, ok := referenced[vmmac.Name]; ok {
+			continue
+		}
+
+		key := vmmac.GetAnnotations()[annotations.AnnMACAddressNetwork]
+		i := slices.IndexFunc(pending, func(ns v1alpha2.NetworksSpec) bool { return key != "" && network.SpecKey(ns) == key })
+		if i < 0 {
+			unassigned++
+			continue
+		}
+		pending = slices.Delete(pending, i, i+1)
+	}
+
+	return pending[min(unassigned, len(pending)):]
+}
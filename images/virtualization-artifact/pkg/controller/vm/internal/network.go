@@ -125,6 +125,16 @@ func (h *NetworkInterfaceHandler) evaluateAdditionalNetworks(ctx context.Context
 		return nil
 	}
 
+	waiting, err := waitingForIPAddressPools(ctx, s, vm)
+	if err != nil {
+		return err
+	}
+	if len(waiting) > 0 {
+		cb.Status(metav1.ConditionFalse).Reason(vmcondition.ReasonWaitingForIPAddressPool).
+			Message(fmt.Sprintf("Waiting for the IPAddressPool resources to assign addresses to the following networks: %s", strings.Join(waiting, ", ")))
+		return nil
+	}
+
 	pods, err := s.Pods(ctx)
 	if err != nil {
 		return err
@@ -153,6 +163,31 @@ func (h *NetworkInterfaceHandler) evaluateAdditionalNetworks(ctx context.Context
 	return nil
 }
 
+// waitingForIPAddressPools returns the keys of the networks whose interfaces are served by IPAddressPool resources
+// that have not assigned addresses to them yet. The addresses are delivered to the guest by cloud-init at the first boot,
+// so the virtual machine waits for them.
+func waitingForIPAddressPools(ctx context.Context, s state.VirtualMachineState, vm *v1alpha2.VirtualMachine) ([]string, error) {
+	vmmacs, err := s.VirtualMachineMACAddresses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pools, err := network.ResolveIPAddressPools(ctx, s.Client(), vm, network.CreateNetworkSpec(vm, vmmacs))
+	if err != nil {
+		return nil, err
+	}
+
+	var waiting []string
+	for key, addressing := range pools {
+		if !addressing.IsAssigned() {
+			waiting = append(waiting, key)
+		}
+	}
+	slices.Sort(waiting)
+
+	return waiting, nil
+}
+
 func hasOnlyDefaultNetwork(vm *v1alpha2.VirtualMachine) bool {
 	nets := vm.Spec.Networks
 	return len(nets) == 0 || (len(nets) == 1 && nets[0].Type == v1alpha2.NetworksTypeMain)
@@ -328,8 +363,14 @@ func (h *NetworkInterfaceHandler) UpdateNetworkStatus(ctx context.Context, s sta
 		ipAddressesByName = extractIPAddressesFromPods(pods)
 	}
 
+	interfaceSpecs := network.CreateNetworkSpec(vm, vmmacs)
+	pools, err := network.ResolveIPAddressPools(ctx, s.Client(), vm, interfaceSpecs)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	var networksStatus []v1alpha2.NetworksStatus
-	for _, interfaceSpec := range network.CreateNetworkSpec(vm, vmmacs) {
+	for _, interfaceSpec := range interfaceSpecs {
 		if interfaceSpec.Type == v1alpha2.NetworksTypeMain {
 			networksStatus = append(networksStatus, v1alpha2.NetworksStatus{
 				ID:   interfaceSpec.ID,
@@ -338,13 +379,18 @@ func (h *NetworkInterfaceHandler) UpdateNetworkStatus(ctx context.Context, s sta
 			continue
 		}
 
+		ipAddress := ipAddressesByName[interfaceSpec.Name]
+		if ipAddress == "" {
+			ipAddress = pools[network.SpecKey(v1alpha2.NetworksSpec{Type: interfaceSpec.Type, Name: interfaceSpec.Name})].IPAddress()
+		}
+
 		networksStatus = append(networksStatus, v1alpha2.NetworksStatus{
 			ID:                           interfaceSpec.ID,
 			Type:                         interfaceSpec.Type,
 			Name:                         interfaceSpec.Name,
 			MAC:                          macAddressesByInterfaceName[interfaceSpec.InterfaceName],
 			VirtualMachineMACAddressName: vmmacNamesByAddress[interfaceSpec.MAC],
-			IPAddress:                    ipAddressesByName[interfaceSpec.Name],
+			IPAddress:                    ipAddress,
 		})
 	}
 
@@ -308,4 +308,63 @@ var _ = Describe("NetworkInterfaceHandler", func() {
 			})
 		})
 	})
+
+	Describe("IPAddressPool serves an additional network", func() {
+		var (
+			pool *v1alpha2.IPAddressPool
+			ns   *corev1.Namespace
+		)
+
+		BeforeEach(func() {
+			vm.Spec.Networks = []v1alpha2.NetworksSpec{
+				{Type: v1alpha2.NetworksTypeMain},
+				{Type: v1alpha2.NetworksTypeNetwork, Name: "test-network"},
+			}
+			ns = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
+			pool = &v1alpha2.IPAddressPool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+				Spec: v1alpha2.IPAddressPoolSpec{
+					Networks: []v1alpha2.IPAddressPoolNetwork{{Type: v1alpha2.NetworksTypeNetwork, Name: "test-network"}},
+					Subnets:  []v1alpha2.IPAddressPoolSubnet{{CIDR: "192.168.10.0/24"}},
+				},
+			}
+		})
+
+		It("Condition should have status 'False' until the pool assigns an address", func() {
+			mac1 := newMACAddress("test-mac-address1", "aa:bb:cc:dd:ee:ff", v1alpha2.VirtualMachineMACAddressPhaseAttached, name)
+			fakeClient, resource, vmState = setupEnvironment(vm, vmPod, mac1, ns, pool, newReadyNetwork("test-network", namespace))
+			reconcile()
+
+			newVM := &v1alpha2.VirtualMachine{}
+			err := fakeClient.Get(ctx, client.ObjectKeyFromObject(vm), newVM)
+			Expect(err).NotTo(HaveOccurred())
+
+			cond, exists := conditions.GetCondition(vmcondition.TypeNetworkReady, newVM.Status.Conditions)
+			Expect(exists).To(BeTrue())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(vmcondition.ReasonWaitingForIPAddressPool.String()))
+			Expect(virtualMachineDependenciesAreReady(newVM)).To(BeFalse())
+		})
+
+		It("Network status should have the address assigned by the pool", func() {
+			mac1 := newMACAddress("test-mac-address1", "aa:bb:cc:dd:ee:ff", v1alpha2.VirtualMachineMACAddressPhaseAttached, name)
+			pool.Status.Allocations = []v1alpha2.IPAddressPoolAllocation{{
+				Address:        "192.168.10.5",
+				MACAddress:     "aa:bb:cc:dd:ee:ff",
+				Namespace:      namespace,
+				VirtualMachine: name,
+				Network:        v1alpha2.IPAddressPoolNetwork{Type: v1alpha2.NetworksTypeNetwork, Name: "test-network"},
+			}}
+			fakeClient, resource, vmState = setupEnvironment(vm, vmPod, mac1, ns, pool, newReadyNetwork("test-network", namespace))
+			reconcile()
+
+			newVM := &v1alpha2.VirtualMachine{}
+			err := fakeClient.Get(ctx, client.ObjectKeyFromObject(vm), newVM)
+			Expect(err).NotTo(HaveOccurred())
+
+			cond, _ := conditions.GetCondition(vmcondition.TypeNetworkReady, newVM.Status.Conditions)
+			Expect(cond.Reason).NotTo(Equal(vmcondition.ReasonWaitingForIPAddressPool.String()))
+			Expect(newVM.Status.Networks).To(ContainElement(HaveField("IPAddress", "192.168.10.5")))
+		})
+	})
 })
@@ -83,6 +83,9 @@ func (h *ProvisioningHandler) Handle(ctx context.Context, s state.VirtualMachine
 	case v1alpha2.ProvisioningTypeUserData:
 		if p.UserData != "" {
 			warnings = cloudinit.ValidateUserData([]byte(p.UserData))
+			if p.NetworkConfig != "" {
+				warnings = append(warnings, cloudinit.ValidateNetworkConfig([]byte(p.NetworkConfig))...)
+			}
 			cb.Status(metav1.ConditionTrue).Reason(vmcondition.ReasonProvisioningReady)
 		} else {
 			cb.Status(metav1.ConditionFalse).
@@ -134,11 +137,42 @@ func (h *ProvisioningHandler) Handle(ctx context.Context, s state.VirtualMachine
 		h.recorder.Event(current, corev1.EventTypeWarning, eventReasonProvisioningInvalid, message)
 	}
 
+	if cb.Condition().Status == metav1.ConditionTrue {
+		err := h.checkNetworkConfigMerge(ctx, current)
+		if err != nil {
+			cb.Status(metav1.ConditionFalse).
+				Reason(vmcondition.ReasonProvisioningNotReady).
+				Message(fmt.Sprintf("The network config cannot be merged with the generated one: %s.", err.Error()))
+		}
+	}
+
 	conditions.SetCondition(cb, &changed.Status.Conditions)
 
 	return reconcile.Result{}, nil
 }
 
+// checkNetworkConfigMerge returns the error the network config specified by the user fails to be merged
+// with the generated one with, unless the generation is disabled.
+func (h *ProvisioningHandler) checkNetworkConfigMerge(ctx context.Context, vm *v1alpha2.VirtualMachine) error {
+	p := vm.Spec.Provisioning
+	if p.NetworkConfigGeneration == v1alpha2.NetworkConfigGenerationDisabled {
+		return nil
+	}
+
+	switch p.Type {
+	case v1alpha2.ProvisioningTypeUserData:
+		return cloudinit.CheckNetworkConfigMerge(p.NetworkConfig)
+	case v1alpha2.ProvisioningTypeUserDataRef:
+		networkConfig, err := getSecretNetworkConfig(ctx, h.client, vm)
+		if err != nil {
+			return err
+		}
+		return cloudinit.CheckNetworkConfigMerge(networkConfig)
+	default:
+		return nil
+	}
+}
+
 func (h *ProvisioningHandler) Name() string {
 	return nameProvisioningHandler
 }
@@ -194,6 +228,12 @@ var cloudInitCheckKeys = []string{
 	"userData",
 }
 
+// networkConfigCheckKeys are the keys of the cloud-init network configuration in the secret.
+var networkConfigCheckKeys = []string{
+	"networkdata",
+	"networkData",
+}
+
 // sysprepCheckKeys are the answer files Windows setup looks for on the sysprep
 // disk. See https://learn.microsoft.com/en-us/windows-hardware/customize/desktop/wsim/windows-system-image-manager-technical-reference
 var sysprepCheckKeys = []string{
@@ -238,7 +278,11 @@ func (v provisioningValidator) validateCloudInitSecret(secret *corev1.Secret) ([
 	if !found {
 		return nil, fmt.Errorf("the secret should have one of data fields %v: %w", cloudInitCheckKeys, errSecretIsNotValid)
 	}
-	return cloudinit.ValidateUserData(secret.Data[key]), nil
+	warnings := cloudinit.ValidateUserData(secret.Data[key])
+	if key, found := v.findKey(secret, networkConfigCheckKeys...); found {
+		warnings = append(warnings, cloudinit.ValidateNetworkConfig(secret.Data[key])...)
+	}
+	return warnings, nil
 }
 
 func (v provisioningValidator) validateSysprepSecret(secret *corev1.Secret) ([]string, error) {
@@ -257,3 +301,31 @@ func (v provisioningValidator) findKey(secret *corev1.Secret, checkKeys ...strin
 	}
 	return "", false
 }
+
+// getSecretNetworkConfig returns the cloud-init network configuration from the secret referenced by
+// the UserDataRef provisioning, if any. A missing or unusable secret is reported by the ProvisioningHandler.
+func getSecretNetworkConfig(ctx context.Context, reader client.Reader, vm *v1alpha2.VirtualMachine) (string, error) {
+	ref := vm.Spec.Provisioning.UserDataRef
+	if ref == nil || ref.Kind != v1alpha2.UserDataRefKindSecret {
+		return "", nil
+	}
+
+	secret := &corev1.Secret{}
+	err := reader.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: vm.GetNamespace()}, secret)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if secret.Type != v1alpha2.SecretTypeCloudInit {
+		return "", nil
+	}
+
+	for _, key := range networkConfigCheckKeys {
+		if data, ok := secret.Data[key]; ok {
+			return string(data), nil
+		}
+	}
+	return "", nil
+}
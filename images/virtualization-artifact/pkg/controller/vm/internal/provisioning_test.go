@@ -150,6 +150,33 @@ var _ = Describe("ProvisioningHandler", func() {
 			Entry("no space after the colon", "## template:jinja\n#cloud-config\nhostname: vm\n"),
 		)
 
+		It("is not ready when the network config cannot be merged with the generated one", func() {
+			cond := reconcile(newVM(&v1alpha2.Provisioning{
+				Type:          v1alpha2.ProvisioningTypeUserData,
+				UserData:      validCloudConfig,
+				NetworkConfig: "- eth0\n",
+			}))
+
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(vmcondition.ReasonProvisioningNotReady.String()))
+			Expect(cond.Message).To(ContainSubstring("cannot be merged with the generated one"))
+			Expect(events).To(HaveLen(1))
+		})
+
+		It("stays ready but reports a network config cloud-init cannot parse if the generation is disabled", func() {
+			cond := reconcile(newVM(&v1alpha2.Provisioning{
+				Type:                    v1alpha2.ProvisioningTypeUserData,
+				UserData:                validCloudConfig,
+				NetworkConfig:           "- eth0\n",
+				NetworkConfigGeneration: v1alpha2.NetworkConfigGenerationDisabled,
+			}))
+
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(vmcondition.ReasonProvisioningReadyWithWarnings.String()))
+			Expect(cond.Message).To(ContainSubstring("is not a YAML mapping"))
+			Expect(events).To(HaveLen(1))
+		})
+
 		It("is not ready when user data is empty", func() {
 			cond := reconcile(newVM(&v1alpha2.Provisioning{
 				Type:     v1alpha2.ProvisioningTypeUserData,
@@ -205,6 +232,32 @@ var _ = Describe("ProvisioningHandler", func() {
 			Expect(cond.Message).To(ContainSubstring("cloud-init will ignore it"))
 		})
 
+		It("stays ready but reports a network config of an unknown version", func() {
+			secret := newSecret(v1alpha2.SecretTypeCloudInit, map[string][]byte{
+				"userData":    []byte(validCloudConfig),
+				"networkdata": []byte("version: 3\n"),
+			})
+
+			cond := reconcile(newVM(userDataRef), secret)
+
+			Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(cond.Reason).To(Equal(vmcondition.ReasonProvisioningReadyWithWarnings.String()))
+			Expect(cond.Message).To(ContainSubstring("has version 3"))
+		})
+
+		It("is not ready when the network config in the secret cannot be merged with the generated one", func() {
+			secret := newSecret(v1alpha2.SecretTypeCloudInit, map[string][]byte{
+				"userData":    []byte(validCloudConfig),
+				"networkdata": []byte("version: 2\nethernets: []\n"),
+			})
+
+			cond := reconcile(newVM(userDataRef), secret)
+
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(cond.Reason).To(Equal(vmcondition.ReasonProvisioningNotReady.String()))
+			Expect(cond.Message).To(ContainSubstring("ethernets is not a mapping"))
+		})
+
 		It("is not ready when the secret carries no user data key", func() {
 			secret := newSecret(v1alpha2.SecretTypeCloudInit, map[string][]byte{"something-else": []byte("x")})
 
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	"time"
 
@@ -37,6 +38,8 @@ import (
 
 	"github.com/deckhouse/virtualization-controller/pkg/common"
 	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization-controller/pkg/common/cloudinit"
+	commonip "github.com/deckhouse/virtualization-controller/pkg/common/ip"
 	"github.com/deckhouse/virtualization-controller/pkg/common/network"
 	"github.com/deckhouse/virtualization-controller/pkg/common/object"
 	"github.com/deckhouse/virtualization-controller/pkg/common/patch"
@@ -535,6 +538,69 @@ func saveKVVMDomainMemoryForPatching(prevKVVM, newKVVM *virtv1.VirtualMachine) *
 	return nil
 }
 
+// makeCloudInitNetworkData returns the cloud-init network configuration of the guest: the configuration generated
+// from the network interfaces merged with the one specified by the user. The generated configuration replaces
+// the default one of cloud-init, so it also enables DHCP on the Main interface, matched by the MAC address
+// set on the returned interface specs.
+//
+// Virtual machines created before networkConfigGeneration was introduced have it empty: the configuration is
+// generated for them only if an interface is served by an IPAddressPool, so the Main interface keeps its MAC address.
+func makeCloudInitNetworkData(ctx context.Context, c client.Client, vm *v1alpha2.VirtualMachine, specs network.InterfaceSpecList, mainAddresses []string) (network.InterfaceSpecList, string, error) {
+	p := vm.Spec.Provisioning
+	if p == nil {
+		return specs, "", nil
+	}
+
+	var userNetworkData string
+	switch p.Type {
+	case v1alpha2.ProvisioningTypeUserData:
+		userNetworkData = p.NetworkConfig
+	case v1alpha2.ProvisioningTypeUserDataRef:
+		var err error
+		userNetworkData, err = getSecretNetworkConfig(ctx, c, vm)
+		if err != nil {
+			return nil, "", err
+		}
+	default:
+		return specs, "", nil
+	}
+
+	if p.NetworkConfigGeneration == v1alpha2.NetworkConfigGenerationDisabled {
+		return specs, userNetworkData, nil
+	}
+
+	pools, err := network.ResolveIPAddressPools(ctx, c, vm, specs)
+	if err != nil {
+		return nil, "", err
+	}
+	if p.NetworkConfigGeneration == "" && len(pools) == 0 {
+		return specs, userNetworkData, nil
+	}
+
+	withMainMAC := slices.Clone(specs)
+	for i := range withMainMAC {
+		if withMainMAC[i].Type == v1alpha2.NetworksTypeMain {
+			withMainMAC[i].MAC = network.MainInterfaceMAC(vm)
+		}
+	}
+
+	networkData, err := network.GenerateNetworkConfig(withMainMAC, mainAddresses, pools)
+	if err != nil {
+		return nil, "", err
+	}
+	if networkData == "" {
+		return specs, userNetworkData, nil
+	}
+
+	// The ProvisioningHandler reports a configuration that cannot be merged and holds the virtual machine until it is fixed.
+	networkData, err = cloudinit.MergeNetworkConfig(networkData, userNetworkData)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return withMainMAC, networkData, nil
+}
+
 func MakeKVVMFromVMSpec(ctx context.Context, s state.VirtualMachineState) (*virtv1.VirtualMachine, error) {
 	if s.VirtualMachine().IsEmpty() {
 		return nil, nil
@@ -570,12 +636,12 @@ func MakeKVVMFromVMSpec(ctx context.Context, s state.VirtualMachineState) (*virt
 		return nil, err
 	}
 
-	ipAddress := ""
+	var ipAddresses []string
 	if ip != nil {
 		if ip.Status.Address == "" {
 			return nil, fmt.Errorf("the IP address is not found for the virtual machine")
 		} else {
-			ipAddress = ip.Status.Address
+			ipAddresses = commonip.Addresses(ip)
 		}
 	}
 
@@ -593,6 +659,10 @@ func MakeKVVMFromVMSpec(ctx context.Context, s state.VirtualMachineState) (*virt
 	if err != nil {
 		return nil, err
 	}
+	networkSpec, networkData, err := makeCloudInitNetworkData(ctx, s.Client(), current, networkSpec, ipAddresses)
+	if err != nil {
+		return nil, err
+	}
 
 	kvvmi, err := s.KVVMI(ctx)
 	if err != nil {
@@ -608,13 +678,14 @@ func MakeKVVMFromVMSpec(ctx context.Context, s state.VirtualMachineState) (*virt
 		bdState.CVIByName,
 		bdState.VMBDAByBlockDeviceRef,
 		class,
-		ipAddress,
+		ipAddresses,
 		networkSpec,
 		kvvmi != nil && kvvmi.Status.Phase == virtv1.Running,
 	)
 	if err != nil {
 		return nil, err
 	}
+	kvvmBuilder.SetCloudInitNetworkData(networkData)
 
 	pvTerms, err := s.PVNodeAffinityTerms(ctx)
 	if err != nil {
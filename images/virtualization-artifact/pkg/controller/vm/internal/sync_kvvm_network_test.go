@@ -456,3 +456,169 @@ var _ = Describe("SyncKvvmHandler tap-provision-by-dvp pod annotation gating", f
 		Expect(got.Annotations[annotations.AnnTapProvisionByDVPSupported]).To(Equal("true"))
 	})
 })
+
+var _ = Describe("makeCloudInitNetworkData", func() {
+	const namespace = "default"
+
+	var (
+		ctx   = testutil.ContextBackgroundWithNoOpLogger()
+		vm    *v1alpha2.VirtualMachine
+		specs commonnetwork.InterfaceSpecList
+		pool  *v1alpha2.IPAddressPool
+	)
+
+	BeforeEach(func() {
+		vm = &v1alpha2.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "vm", Namespace: namespace, UID: "vm-uid"},
+			Spec: v1alpha2.VirtualMachineSpec{
+				Provisioning: &v1alpha2.Provisioning{
+					Type:                    v1alpha2.ProvisioningTypeUserData,
+					UserData:                "#cloud-config\n",
+					NetworkConfigGeneration: v1alpha2.NetworkConfigGenerationEnabled,
+				},
+			},
+		}
+		specs = commonnetwork.InterfaceSpecList{
+			{ID: 1, Type: v1alpha2.NetworksTypeMain, InterfaceName: commonnetwork.NameDefaultInterface},
+			{ID: 2, Type: v1alpha2.NetworksTypeNetwork, Name: "user-net", MAC: "aa:bb:cc:dd:ee:ff"},
+		}
+		pool = &v1alpha2.IPAddressPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "pool"},
+			Spec: v1alpha2.IPAddressPoolSpec{
+				Networks: []v1alpha2.IPAddressPoolNetwork{{Type: v1alpha2.NetworksTypeNetwork, Name: "user-net"}},
+				Subnets:  []v1alpha2.IPAddressPoolSubnet{{CIDR: "192.168.10.0/24", Gateway: "192.168.10.1"}},
+			},
+			Status: v1alpha2.IPAddressPoolStatus{
+				Allocations: []v1alpha2.IPAddressPoolAllocation{{
+					Address:        "192.168.10.5",
+					MACAddress:     "aa:bb:cc:dd:ee:ff",
+					Namespace:      namespace,
+					VirtualMachine: "vm",
+					Network:        v1alpha2.IPAddressPoolNetwork{Type: v1alpha2.NetworksTypeNetwork, Name: "user-net"},
+				}},
+			},
+		}
+	})
+
+	makeNetworkDataWithError := func(objs ...client.Object) (commonnetwork.InterfaceSpecList, string, error) {
+		GinkgoHelper()
+		objs = append(objs, vm, pool, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}})
+		c, err := testutil.NewFakeClientWithObjects(objs...)
+		Expect(err).NotTo(HaveOccurred())
+		return makeCloudInitNetworkData(ctx, c, vm, specs, []string{"10.66.10.5"})
+	}
+
+	makeNetworkData := func(objs ...client.Object) (commonnetwork.InterfaceSpecList, string) {
+		GinkgoHelper()
+		result, networkData, err := makeNetworkDataWithError(objs...)
+		Expect(err).NotTo(HaveOccurred())
+		return result, networkData
+	}
+
+	It("generates the network data and pins the MAC address of the Main interface", func() {
+		result, networkData := makeNetworkData()
+
+		Expect(networkData).To(ContainSubstring("192.168.10.5/24"))
+		Expect(result[0].MAC).To(Equal(commonnetwork.MainInterfaceMAC(vm)))
+		Expect(specs[0].MAC).To(BeEmpty())
+	})
+
+	It("uses DHCP on the additional interface until the pool assigns the address", func() {
+		pool.Status.Allocations = nil
+
+		result, networkData := makeNetworkData()
+
+		Expect(networkData).To(ContainSubstring("optional: true"))
+		Expect(networkData).NotTo(ContainSubstring("192.168.10.5/24"))
+		Expect(result[0].MAC).To(Equal(commonnetwork.MainInterfaceMAC(vm)))
+	})
+
+	It("generates nothing without additional interfaces", func() {
+		specs = specs[:1]
+
+		result, networkData := makeNetworkData()
+
+		Expect(networkData).To(BeEmpty())
+		Expect(result[0].MAC).To(BeEmpty())
+	})
+
+	It("merges the network config specified by the user", func() {
+		vm.Spec.Provisioning.NetworkConfig = "version: 2\nethernets:\n  net2:\n    addresses: [\"10.0.0.5/24\"]\n"
+
+		_, networkData := makeNetworkData()
+
+		Expect(networkData).To(ContainSubstring("10.0.0.5/24"))
+		Expect(networkData).NotTo(ContainSubstring("192.168.10.5/24"))
+		Expect(networkData).To(ContainSubstring(commonnetwork.MainInterfaceMAC(vm)))
+	})
+
+	It("merges the network config from the user data secret", func() {
+		vm.Spec.Provisioning = &v1alpha2.Provisioning{
+			Type:                    v1alpha2.ProvisioningTypeUserDataRef,
+			UserDataRef:             &v1alpha2.UserDataRef{Kind: v1alpha2.UserDataRefKindSecret, Name: "cloud-init"},
+			NetworkConfigGeneration: v1alpha2.NetworkConfigGenerationEnabled,
+		}
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloud-init", Namespace: namespace},
+			Type:       v1alpha2.SecretTypeCloudInit,
+			Data: map[string][]byte{
+				"userData":    []byte("#cloud-config\n"),
+				"networkData": []byte("version: 2\nvlans:\n  vlan10:\n    id: 10\n    link: net2\n"),
+			},
+		}
+
+		_, networkData := makeNetworkData(secret)
+
+		Expect(networkData).To(ContainSubstring("vlan10"))
+		Expect(networkData).To(ContainSubstring("192.168.10.5/24"))
+	})
+
+	It("passes only the network config specified by the user if the generation is disabled", func() {
+		vm.Spec.Provisioning.NetworkConfigGeneration = v1alpha2.NetworkConfigGenerationDisabled
+		vm.Spec.Provisioning.NetworkConfig = "version: 2\n"
+
+		result, networkData := makeNetworkData()
+
+		Expect(networkData).To(Equal("version: 2\n"))
+		Expect(result[0].MAC).To(BeEmpty())
+	})
+
+	It("fails if the network config specified by the user cannot be merged", func() {
+		vm.Spec.Provisioning.NetworkConfig = "- eth0\n"
+
+		_, _, err := makeNetworkDataWithError()
+
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("when the generation is not set, as for virtual machines created before it was introduced", func() {
+		BeforeEach(func() {
+			vm.Spec.Provisioning.NetworkConfigGeneration = ""
+		})
+
+		It("generates the network data if an interface is served by a pool", func() {
+			result, networkData := makeNetworkData()
+
+			Expect(networkData).To(ContainSubstring("192.168.10.5/24"))
+			Expect(result[0].MAC).To(Equal(commonnetwork.MainInterfaceMAC(vm)))
+		})
+
+		It("keeps the MAC address of the Main interface if no interface is served by a pool", func() {
+			pool.Spec.Networks = []v1alpha2.IPAddressPoolNetwork{{Type: v1alpha2.NetworksTypeNetwork, Name: "other-net"}}
+			vm.Spec.Provisioning.NetworkConfig = "version: 2\n"
+
+			result, networkData := makeNetworkData()
+
+			Expect(networkData).To(Equal("version: 2\n"))
+			Expect(result[0].MAC).To(BeEmpty())
+		})
+	})
+
+	It("generates nothing for sysprep provisioning", func() {
+		vm.Spec.Provisioning = &v1alpha2.Provisioning{Type: v1alpha2.ProvisioningTypeSysprepRef}
+
+		_, networkData := makeNetworkData()
+
+		Expect(networkData).To(BeEmpty())
+	})
+})
@@ -193,9 +193,13 @@ var annotationsToKeep = []string{
 	annotations.AnnNetworksSpec,
 	virtv1.AllowPodBridgeNetworkLiveMigrationAnnotation,
 	netmanager.AnnoIPAddressCNIRequest,
+	netmanager.AnnoIPv6AddressCNIRequest,
 	virtv1.USBMigrationStrategyAnn,
 	kvbuilder.CPUResourcesRequestsFractionAnnotation,
 	kvbuilder.VCPUTopologyDynamicCoresAnnotation,
+	kvbuilder.InterfaceBandwidthAnnotation,
+	annotations.AnnIngressBandwidth,
+	annotations.AnnEgressBandwidth,
 }
 
 // updateKVVMSpecTemplateMetadataAnnotations ensures that the special network annotation is present if it exists.
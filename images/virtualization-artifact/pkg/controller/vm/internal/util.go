@@ -353,7 +353,8 @@ func virtualMachineDependenciesAreReady(vm *v1alpha2.VirtualMachine) bool {
 			}
 
 		case vmcondition.TypeNetworkReady:
-			if c.Status == metav1.ConditionFalse && c.Reason == vmcondition.ReasonSDNModuleDisabled.String() {
+			if c.Status == metav1.ConditionFalse && (c.Reason == vmcondition.ReasonSDNModuleDisabled.String() ||
+				c.Reason == vmcondition.ReasonWaitingForIPAddressPool.String()) {
 				return false
 			}
 		}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/object"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+// BootImageValidator warns when the bootloader or the osType of the virtual machine does not match
+// the content of the boot device detected on image import. An EFI-only image booted with BIOS
+// (or the other way round) leaves the virtual machine with a black screen, while the platform
+// reports it as running, so the mismatch is worth pointing out before the virtual machine starts.
+// The inspection is best-effort, so a mismatch is a warning and never rejects the virtual machine.
+type BootImageValidator struct {
+	client client.Client
+}
+
+func NewBootImageValidator(client client.Client) *BootImageValidator {
+	return &BootImageValidator{client: client}
+}
+
+func (v *BootImageValidator) ValidateCreate(ctx context.Context, vm *v1alpha2.VirtualMachine) (admission.Warnings, error) {
+	return v.Validate(ctx, vm)
+}
+
+func (v *BootImageValidator) ValidateUpdate(ctx context.Context, oldVM, newVM *v1alpha2.VirtualMachine) (admission.Warnings, error) {
+	if oldVM.Spec.OsType == newVM.Spec.OsType &&
+		oldVM.Spec.Bootloader == newVM.Spec.Bootloader &&
+		reflect.DeepEqual(oldVM.Spec.BlockDeviceRefs, newVM.Spec.BlockDeviceRefs) {
+		return nil, nil
+	}
+
+	return v.Validate(ctx, newVM)
+}
+
+func (v *BootImageValidator) Validate(ctx context.Context, vm *v1alpha2.VirtualMachine) (admission.Warnings, error) {
+	ref := bootBlockDevice(vm)
+	if ref == nil {
+		return nil, nil
+	}
+
+	inspection, err := v.getInspection(ctx, *ref, vm.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	if inspection == nil {
+		return nil, nil
+	}
+
+	device := fmt.Sprintf("The boot device %s %q", ref.Kind, ref.Name)
+
+	var warnings admission.Warnings
+
+	switch vm.Spec.Bootloader {
+	case v1alpha2.EFI, v1alpha2.EFIWithSecureBoot:
+		if inspection.BIOSBootable && !inspection.EFISystemPartition {
+			warnings = append(warnings, fmt.Sprintf(
+				"%s can only be booted with BIOS: it has no EFI system partition. "+
+					"The virtual machine with the %s bootloader will not boot from it, set bootloader to BIOS.",
+				device, vm.Spec.Bootloader,
+			))
+		}
+	default:
+		if inspection.EFISystemPartition && !inspection.BIOSBootable {
+			warnings = append(warnings, fmt.Sprintf(
+				"%s can only be booted with EFI: it has an EFI system partition but no BIOS boot code. "+
+					"The virtual machine with the BIOS bootloader will not boot from it, set bootloader to EFI.",
+				device,
+			))
+		}
+	}
+
+	switch {
+	// Windows of the Legacy era is run with the Legacy osType on purpose.
+	case inspection.OSFamily == v1alpha2.ImageOSFamilyWindows && vm.Spec.OsType != v1alpha2.Windows && vm.Spec.OsType != v1alpha2.LegacyOs:
+		warnings = append(warnings, fmt.Sprintf(
+			"%s contains Windows, but the osType of the virtual machine is %s: set osType to Windows to get the devices and settings optimal for Windows.",
+			device, vm.Spec.OsType,
+		))
+	case inspection.OSFamily == v1alpha2.ImageOSFamilyLinux && vm.Spec.OsType == v1alpha2.Windows:
+		warnings = append(warnings, fmt.Sprintf(
+			"%s contains Linux, but the osType of the virtual machine is Windows: set osType to Generic.",
+			device,
+		))
+	}
+
+	return warnings, nil
+}
+
+// bootBlockDevice returns the block device the virtual machine boots from: the one with the lowest bootOrder
+// or, if the boot order is not set explicitly, the first one.
+func bootBlockDevice(vm *v1alpha2.VirtualMachine) *v1alpha2.BlockDeviceSpecRef {
+	var boot *v1alpha2.BlockDeviceSpecRef
+	for i, ref := range vm.Spec.BlockDeviceRefs {
+		if ref.BootOrder != nil && (boot == nil || *ref.BootOrder < *boot.BootOrder) {
+			boot = &vm.Spec.BlockDeviceRefs[i]
+		}
+	}
+
+	if boot == nil && len(vm.Spec.BlockDeviceRefs) > 0 {
+		boot = &vm.Spec.BlockDeviceRefs[0]
+	}
+
+	return boot
+}
+
+// getInspection returns the inspection of the image or, for a disk, of the image the disk has been created from.
+func (v *BootImageValidator) getInspection(ctx context.Context, ref v1alpha2.BlockDeviceSpecRef, namespace string) (*v1alpha2.ImageStatusInspection, error) {
+	switch ref.Kind {
+	case v1alpha2.ImageDevice:
+		return v.getVIInspection(ctx, ref.Name, namespace)
+	case v1alpha2.ClusterImageDevice:
+		return v.getCVIInspection(ctx, ref.Name)
+	case v1alpha2.DiskDevice:
+		vd, err := object.FetchObject(ctx, types.NamespacedName{Name: ref.Name, Namespace: namespace}, v.client, &v1alpha2.VirtualDisk{})
+		if err != nil {
+			return nil, err
+		}
+
+		if vd == nil || vd.Spec.DataSource == nil || vd.Spec.DataSource.ObjectRef == nil {
+			return nil, nil
+		}
+
+		switch vd.Spec.DataSource.ObjectRef.Kind {
+		case v1alpha2.VirtualDiskObjectRefKindVirtualImage:
+			return v.getVIInspection(ctx, vd.Spec.DataSource.ObjectRef.Name, namespace)
+		case v1alpha2.VirtualDiskObjectRefKindClusterVirtualImage:
+			return v.getCVIInspection(ctx, vd.Spec.DataSource.ObjectRef.Name)
+		}
+	}
+
+	return nil, nil
+}
+
+func (v *BootImageValidator) getVIInspection(ctx context.Context, name, namespace string) (*v1alpha2.ImageStatusInspection, error) {
+	vi, err := object.FetchObject(ctx, types.NamespacedName{Name: name, Namespace: namespace}, v.client, &v1alpha2.VirtualImage{})
+	if err != nil || vi == nil {
+		return nil, err
+	}
+
+	return vi.Status.Inspection, nil
+}
+
+func (v *BootImageValidator) getCVIInspection(ctx context.Context, name string) (*v1alpha2.ImageStatusInspection, error) {
+	cvi, err := object.FetchObject(ctx, types.NamespacedName{Name: name}, v.client, &v1alpha2.ClusterVirtualImage{})
+	if err != nil || cvi == nil {
+		return nil, err
+	}
+
+	return cvi.Status.Inspection, nil
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cvibuilder "github.com/deckhouse/virtualization-controller/pkg/builder/cvi"
+	vdbuilder "github.com/deckhouse/virtualization-controller/pkg/builder/vd"
+	vibuilder "github.com/deckhouse/virtualization-controller/pkg/builder/vi"
+	vmbuilder "github.com/deckhouse/virtualization-controller/pkg/builder/vm"
+	"github.com/deckhouse/virtualization-controller/pkg/common/testutil"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/vm/internal/validators"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+var _ = Describe("BootImageValidator", func() {
+	efiOnlyLinux := &v1alpha2.ImageStatusInspection{
+		OSFamily:           v1alpha2.ImageOSFamilyLinux,
+		PartitionTable:     v1alpha2.ImagePartitionTableGPT,
+		EFISystemPartition: true,
+	}
+	biosOnlyWindows := &v1alpha2.ImageStatusInspection{
+		OSFamily:       v1alpha2.ImageOSFamilyWindows,
+		PartitionTable: v1alpha2.ImagePartitionTableMBR,
+		BIOSBootable:   true,
+	}
+	hybridLinux := &v1alpha2.ImageStatusInspection{
+		OSFamily:           v1alpha2.ImageOSFamilyLinux,
+		PartitionTable:     v1alpha2.ImagePartitionTableGPT,
+		EFISystemPartition: true,
+		BIOSBootable:       true,
+	}
+
+	newVI := func(name string, inspection *v1alpha2.ImageStatusInspection) *v1alpha2.VirtualImage {
+		vi := vibuilder.New(vibuilder.WithName(name), vibuilder.WithNamespace("ns"), vibuilder.WithPhase(v1alpha2.ImageReady))
+		vi.Status.Inspection = inspection
+		return vi
+	}
+
+	newCVI := func(name string, inspection *v1alpha2.ImageStatusInspection) *v1alpha2.ClusterVirtualImage {
+		cvi := cvibuilder.New(cvibuilder.WithName(name), cvibuilder.WithPhase(v1alpha2.ImageReady))
+		cvi.Status.Inspection = inspection
+		return cvi
+	}
+
+	newVM := func(bootloader v1alpha2.BootloaderType, osType v1alpha2.OsType, refs ...v1alpha2.BlockDeviceSpecRef) *v1alpha2.VirtualMachine {
+		return vmbuilder.New(
+			vmbuilder.WithName("vm"),
+			vmbuilder.WithNamespace("ns"),
+			vmbuilder.WithBootloader(bootloader),
+			vmbuilder.WithOsType(osType),
+			vmbuilder.WithBlockDeviceRefs(refs...),
+		)
+	}
+
+	validate := func(vm *v1alpha2.VirtualMachine, objs ...client.Object) []string {
+		GinkgoHelper()
+
+		warnings, err := validators.NewBootImageValidator(setupEnvironment(objs...)).ValidateCreate(testutil.ContextBackgroundWithNoOpLogger(), vm)
+		Expect(err).NotTo(HaveOccurred())
+		return warnings
+	}
+
+	It("warns about an EFI-only image booted with BIOS", func() {
+		vm := newVM(v1alpha2.BIOS, v1alpha2.GenericOs, v1alpha2.BlockDeviceSpecRef{Kind: v1alpha2.ImageDevice, Name: "vi"})
+
+		warnings := validate(vm, newVI("vi", efiOnlyLinux))
+		Expect(warnings).To(HaveLen(1))
+		Expect(warnings[0]).To(ContainSubstring("can only be booted with EFI"))
+	})
+
+	It("warns about a BIOS-only Windows image booted with EFI on a Generic virtual machine", func() {
+		vm := newVM(v1alpha2.EFIWithSecureBoot, v1alpha2.GenericOs, v1alpha2.BlockDeviceSpecRef{Kind: v1alpha2.ClusterImageDevice, Name: "cvi"})
+
+		warnings := validate(vm, newCVI("cvi", biosOnlyWindows))
+		Expect(warnings).To(HaveLen(2))
+		Expect(warnings[0]).To(ContainSubstring("can only be booted with BIOS"))
+		Expect(warnings[1]).To(ContainSubstring("set osType to Windows"))
+	})
+
+	It("does not warn about a Windows image on a Legacy virtual machine", func() {
+		vm := newVM(v1alpha2.BIOS, v1alpha2.LegacyOs, v1alpha2.BlockDeviceSpecRef{Kind: v1alpha2.ImageDevice, Name: "vi"})
+
+		Expect(validate(vm, newVI("vi", biosOnlyWindows))).To(BeEmpty())
+	})
+
+	It("warns about a Linux image on a Windows virtual machine", func() {
+		vm := newVM(v1alpha2.EFI, v1alpha2.Windows, v1alpha2.BlockDeviceSpecRef{Kind: v1alpha2.ImageDevice, Name: "vi"})
+
+		warnings := validate(vm, newVI("vi", hybridLinux))
+		Expect(warnings).To(HaveLen(1))
+		Expect(warnings[0]).To(ContainSubstring("contains Linux"))
+	})
+
+	It("does not warn about an image that boots with both bootloaders", func() {
+		for _, bootloader := range []v1alpha2.BootloaderType{v1alpha2.BIOS, v1alpha2.EFI} {
+			vm := newVM(bootloader, v1alpha2.GenericOs, v1alpha2.BlockDeviceSpecRef{Kind: v1alpha2.ImageDevice, Name: "vi"})
+			Expect(validate(vm, newVI("vi", hybridLinux))).To(BeEmpty())
+		}
+	})
+
+	It("does not warn about an image that has not been inspected", func() {
+		vm := newVM(v1alpha2.BIOS, v1alpha2.Windows, v1alpha2.BlockDeviceSpecRef{Kind: v1alpha2.ImageDevice, Name: "vi"})
+
+		Expect(validate(vm, newVI("vi", nil))).To(BeEmpty())
+		Expect(validate(vm)).To(BeEmpty())
+	})
+
+	It("uses the image the boot disk has been created from", func() {
+		vd := vdbuilder.New(
+			vdbuilder.WithName("vd"),
+			vdbuilder.WithNamespace("ns"),
+			vdbuilder.WithDataSourceObjectRef(v1alpha2.VirtualDiskObjectRefKindClusterVirtualImage, "cvi"),
+		)
+		vm := newVM(v1alpha2.BIOS, v1alpha2.GenericOs, v1alpha2.BlockDeviceSpecRef{Kind: v1alpha2.DiskDevice, Name: "vd"})
+
+		warnings := validate(vm, vd, newCVI("cvi", efiOnlyLinux))
+		Expect(warnings).To(HaveLen(1))
+		Expect(warnings[0]).To(ContainSubstring(`The boot device VirtualDisk "vd"`))
+	})
+
+	It("uses the device with the lowest boot order", func() {
+		vm := newVM(v1alpha2.BIOS, v1alpha2.GenericOs,
+			v1alpha2.BlockDeviceSpecRef{Kind: v1alpha2.ImageDevice, Name: "hybrid", BootOrder: ptr.To[uint](2)},
+			v1alpha2.BlockDeviceSpecRef{Kind: v1alpha2.ImageDevice, Name: "efi", BootOrder: ptr.To[uint](1)},
+		)
+
+		warnings := validate(vm, newVI("hybrid", hybridLinux), newVI("efi", efiOnlyLinux))
+		Expect(warnings).To(HaveLen(1))
+		Expect(warnings[0]).To(ContainSubstring(`"efi"`))
+	})
+
+	It("skips the check on update if the relevant fields have not changed", func() {
+		vm := newVM(v1alpha2.BIOS, v1alpha2.GenericOs, v1alpha2.BlockDeviceSpecRef{Kind: v1alpha2.ImageDevice, Name: "vi"})
+		v := validators.NewBootImageValidator(setupEnvironment(newVI("vi", efiOnlyLinux)))
+
+		warnings, err := v.ValidateUpdate(testutil.ContextBackgroundWithNoOpLogger(), vm, vm.DeepCopy())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(BeEmpty())
+	})
+})
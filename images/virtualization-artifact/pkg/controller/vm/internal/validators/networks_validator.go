@@ -60,6 +60,10 @@ func (v *NetworksValidator) ValidateCreate(ctx context.Context, vm *v1alpha2.Vir
 		return nil, nil
 	}
 
+	if err := v.validateAdditionalNetworkBandwidth(networksSpec); err != nil {
+		return nil, err
+	}
+
 	return v.validateNetworksSpec(networksSpec)
 }
 
@@ -85,15 +89,23 @@ func (v *NetworksValidator) ValidateUpdate(ctx context.Context, oldVM, newVM *v1
 		return nil, err
 	}
 
-	if warn, err := v.validateNetworksSpec(newNetworksSpec); err != nil {
-		return warn, err
+	if !equality.Semantic.DeepEqual(bandwidthByAdditionalNetwork(oldNetworksSpec), bandwidthByAdditionalNetwork(newNetworksSpec)) {
+		if err := v.validateAdditionalNetworkBandwidth(newNetworksSpec); err != nil {
+			return nil, err
+		}
+	}
+
+	warnings, err := v.validateNetworksSpec(newNetworksSpec)
+	if err != nil {
+		return warnings, err
 	}
 
 	added := networksAdded(oldNetworksSpec, newNetworksSpec)
 	if len(added) == 0 {
-		return nil, nil
+		return warnings, nil
 	}
-	return v.validateNetworksExist(ctx, newVM.Namespace, added)
+	existWarnings, err := v.validateNetworksExist(ctx, newVM.Namespace, added)
+	return append(warnings, existWarnings...), err
 }
 
 func (v *NetworksValidator) validateNetworksExist(ctx context.Context, namespace string, networks []v1alpha2.NetworksSpec) (admission.Warnings, error) {
@@ -128,6 +140,7 @@ func (v *NetworksValidator) validateNetworksExist(ctx context.Context, namespace
 }
 
 func (v *NetworksValidator) validateNetworksSpec(networksSpec []v1alpha2.NetworksSpec) (admission.Warnings, error) {
+	var warnings admission.Warnings
 	namesSet := make(map[string]struct{})
 	idsSet := make(map[int]struct{})
 	for i, network := range networksSpec {
@@ -153,9 +166,48 @@ func (v *NetworksValidator) validateNetworksSpec(networksSpec []v1alpha2.Network
 		if err := v.validateNetworkID(network); err != nil {
 			return nil, err
 		}
+
+		if err := commonnetwork.ValidateBandwidth(fmt.Sprintf("spec.networks[%d].bandwidth", i), network.Bandwidth); err != nil {
+			return nil, err
+		}
+
+		if typ == v1alpha2.NetworksTypeMain && hasBandwidthBurst(network.Bandwidth) {
+			warnings = append(warnings, fmt.Sprintf("spec.networks[%d].bandwidth: burst is not applied to the network with type '%s'", i, v1alpha2.NetworksTypeMain))
+		}
 	}
 
-	return nil, nil
+	return warnings, nil
+}
+
+// validateAdditionalNetworkBandwidth rejects the limits of additional networks while nothing enforces them.
+func (v *NetworksValidator) validateAdditionalNetworkBandwidth(networksSpec []v1alpha2.NetworksSpec) error {
+	if v.featureGate.Enabled(featuregates.AdditionalNetworkBandwidth) {
+		return nil
+	}
+	for i, network := range networksSpec {
+		if network.Type != v1alpha2.NetworksTypeMain && network.Bandwidth != nil {
+			return fmt.Errorf("spec.networks[%d].bandwidth requires the %s feature gate", i, featuregates.AdditionalNetworkBandwidth)
+		}
+	}
+	return nil
+}
+
+func bandwidthByAdditionalNetwork(networksSpec []v1alpha2.NetworksSpec) map[string]*v1alpha2.NetworkBandwidth {
+	bandwidths := make(map[string]*v1alpha2.NetworkBandwidth)
+	for _, network := range networksSpec {
+		if network.Type != v1alpha2.NetworksTypeMain && network.Bandwidth != nil {
+			bandwidths[commonnetwork.SpecKey(network)] = network.Bandwidth
+		}
+	}
+	return bandwidths
+}
+
+func hasBandwidthBurst(bandwidth *v1alpha2.NetworkBandwidth) bool {
+	if bandwidth == nil {
+		return false
+	}
+	return bandwidth.Ingress != nil && bandwidth.Ingress.Burst != nil ||
+		bandwidth.Egress != nil && bandwidth.Egress.Burst != nil
 }
 
 func (v *NetworksValidator) validateNetworkName(networkType, networkName string) error {
@@ -21,6 +21,7 @@ import (
 	"strings"
 	"testing"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -43,6 +44,8 @@ type networkValidatorOpts struct {
 	virtualMachineCIDRs []string
 	objects             []client.Object
 	sdnEnabled          bool
+	// additionalNetworkBandwidthEnabled enables the AdditionalNetworkBandwidth feature gate.
+	additionalNetworkBandwidthEnabled bool
 }
 
 func newNetworksValidator(t *testing.T, opts networkValidatorOpts) *NetworksValidator {
@@ -57,10 +60,11 @@ func newNetworksValidator(t *testing.T, opts networkValidatorOpts) *NetworksVali
 	if err != nil {
 		t.Fatalf("featuregates.New: %v", err)
 	}
-	if opts.sdnEnabled {
-		if err := setFromMap(map[string]bool{string(featuregates.SDN): true}); err != nil {
-			t.Fatalf("setFromMap: %v", err)
-		}
+	if err := setFromMap(map[string]bool{
+		string(featuregates.SDN):                        opts.sdnEnabled,
+		string(featuregates.AdditionalNetworkBandwidth): opts.additionalNetworkBandwidthEnabled,
+	}); err != nil {
+		t.Fatalf("setFromMap: %v", err)
 	}
 
 	return NewNetworksValidator(builder.Build(), featureGate, opts.virtualMachineCIDRs)
@@ -596,3 +600,121 @@ func TestNetworksValidatesExistence(t *testing.T) {
 		}
 	})
 }
+
+func TestNetworksValidateBandwidth(t *testing.T) {
+	networkValidator := newNetworksValidator(t, networkValidatorOpts{
+		virtualMachineCIDRs:               []string{"10.0.0.0/24"},
+		sdnEnabled:                        true,
+		additionalNetworkBandwidthEnabled: true,
+	})
+
+	withBandwidth := func(network v1alpha2.NetworksSpec, rate string, burst *resource.Quantity) v1alpha2.NetworksSpec {
+		network.Bandwidth = &v1alpha2.NetworkBandwidth{
+			Egress: &v1alpha2.NetworkBandwidthLimit{Rate: resource.MustParse(rate), Burst: burst},
+		}
+		return network
+	}
+
+	t.Run("create: positive limits are allowed", func(t *testing.T) {
+		vm := &v1alpha2.VirtualMachine{Spec: v1alpha2.VirtualMachineSpec{Networks: []v1alpha2.NetworksSpec{
+			withBandwidth(mainNetwork, "100M", nil),
+			withBandwidth(networkTest, "1G", ptr.To(resource.MustParse("1Mi"))),
+		}}}
+		warnings, err := networkValidator.ValidateCreate(t.Context(), vm)
+		if err != nil {
+			t.Fatalf("expected positive limits to be allowed, got: %v", err)
+		}
+		if len(warnings) != 0 {
+			t.Fatalf("expected no warnings, got: %v", warnings)
+		}
+	})
+
+	t.Run("create: zero rate is rejected", func(t *testing.T) {
+		vm := &v1alpha2.VirtualMachine{Spec: v1alpha2.VirtualMachineSpec{Networks: []v1alpha2.NetworksSpec{
+			mainNetwork,
+			withBandwidth(networkTest, "0", nil),
+		}}}
+		_, err := networkValidator.ValidateCreate(t.Context(), vm)
+		if err == nil || !strings.Contains(err.Error(), "spec.networks[1].bandwidth.egress.rate") {
+			t.Fatalf("expected error for zero rate, got: %v", err)
+		}
+	})
+
+	t.Run("update: burst of the Main network produces a warning", func(t *testing.T) {
+		oldVM := &v1alpha2.VirtualMachine{Spec: v1alpha2.VirtualMachineSpec{Networks: []v1alpha2.NetworksSpec{mainNetwork}}}
+		newVM := &v1alpha2.VirtualMachine{Spec: v1alpha2.VirtualMachineSpec{Networks: []v1alpha2.NetworksSpec{
+			withBandwidth(mainNetwork, "100M", ptr.To(resource.MustParse("1Mi"))),
+		}}}
+		warnings, err := networkValidator.ValidateUpdate(t.Context(), oldVM, newVM)
+		if err != nil {
+			t.Fatalf("expected burst of the Main network to be allowed, got: %v", err)
+		}
+		if len(warnings) != 1 {
+			t.Fatalf("expected one warning, got: %v", warnings)
+		}
+	})
+}
+
+func TestNetworksValidateAdditionalNetworkBandwidthRequiresFeatureGate(t *testing.T) {
+	networkValidator := newNetworksValidator(t, networkValidatorOpts{
+		virtualMachineCIDRs: []string{"10.0.0.0/24"},
+		sdnEnabled:          true,
+	})
+
+	bandwidth := &v1alpha2.NetworkBandwidth{
+		Egress: &v1alpha2.NetworkBandwidthLimit{Rate: resource.MustParse("100M")},
+	}
+	withBandwidth := func(network v1alpha2.NetworksSpec) v1alpha2.NetworksSpec {
+		network.Bandwidth = bandwidth
+		return network
+	}
+
+	t.Run("create: limits of the Main network are allowed", func(t *testing.T) {
+		vm := &v1alpha2.VirtualMachine{Spec: v1alpha2.VirtualMachineSpec{Networks: []v1alpha2.NetworksSpec{
+			withBandwidth(mainNetwork),
+			networkTest,
+		}}}
+		if _, err := networkValidator.ValidateCreate(t.Context(), vm); err != nil {
+			t.Fatalf("expected limits of the Main network to be allowed, got: %v", err)
+		}
+	})
+
+	t.Run("create: limits of an additional network are rejected", func(t *testing.T) {
+		vm := &v1alpha2.VirtualMachine{Spec: v1alpha2.VirtualMachineSpec{Networks: []v1alpha2.NetworksSpec{
+			mainNetwork,
+			withBandwidth(networkTest),
+		}}}
+		_, err := networkValidator.ValidateCreate(t.Context(), vm)
+		if err == nil || !strings.Contains(err.Error(), string(featuregates.AdditionalNetworkBandwidth)) {
+			t.Fatalf("expected error for limits of an additional network, got: %v", err)
+		}
+	})
+
+	t.Run("update: unchanged limits of an additional network are kept", func(t *testing.T) {
+		oldVM := &v1alpha2.VirtualMachine{Spec: v1alpha2.VirtualMachineSpec{Networks: []v1alpha2.NetworksSpec{
+			mainNetwork,
+			withBandwidth(networkTest),
+		}}}
+		newVM := &v1alpha2.VirtualMachine{Spec: v1alpha2.VirtualMachineSpec{Networks: []v1alpha2.NetworksSpec{
+			withBandwidth(mainNetwork),
+			withBandwidth(networkTest),
+		}}}
+		if _, err := networkValidator.ValidateUpdate(t.Context(), oldVM, newVM); err != nil {
+			t.Fatalf("expected unchanged limits to be allowed, got: %v", err)
+		}
+	})
+
+	t.Run("update: added limits of an additional network are rejected", func(t *testing.T) {
+		oldVM := &v1alpha2.VirtualMachine{Spec: v1alpha2.VirtualMachineSpec{Networks: []v1alpha2.NetworksSpec{
+			mainNetwork,
+			networkTest,
+		}}}
+		newVM := &v1alpha2.VirtualMachine{Spec: v1alpha2.VirtualMachineSpec{Networks: []v1alpha2.NetworksSpec{
+			mainNetwork,
+			withBandwidth(networkTest),
+		}}}
+		if _, err := networkValidator.ValidateUpdate(t.Context(), oldVM, newVM); err == nil {
+			t.Fatalf("expected error for added limits of an additional network")
+		}
+	})
+}
@@ -25,7 +25,7 @@ import (
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 )
 
-// ProvisioningValidator warns about inline cloud-init user data that looks wrong,
+// ProvisioningValidator warns about inline cloud-init user data and network config that look wrong,
 // so the mistake surfaces on apply instead of silently failing inside the guest.
 // It only warns and never refuses a machine.
 //
@@ -51,14 +51,14 @@ func (v *ProvisioningValidator) Validate(vm *v1alpha2.VirtualMachine) (admission
 		return nil, nil
 	}
 
-	warnings := cloudinit.ValidateUserData([]byte(p.UserData))
-	if len(warnings) == 0 {
-		return nil, nil
-	}
-
-	admissionWarnings := make(admission.Warnings, 0, len(warnings))
-	for _, warning := range warnings {
+	var admissionWarnings admission.Warnings
+	for _, warning := range cloudinit.ValidateUserData([]byte(p.UserData)) {
 		admissionWarnings = append(admissionWarnings, "spec.provisioning.userData: "+warning)
 	}
+	if p.NetworkConfig != "" {
+		for _, warning := range cloudinit.ValidateNetworkConfig([]byte(p.NetworkConfig)) {
+			admissionWarnings = append(admissionWarnings, "spec.provisioning.networkConfig: "+warning)
+		}
+	}
 	return admissionWarnings, nil
 }
@@ -82,6 +82,18 @@ var _ = Describe("ProvisioningValidator", func() {
 		Expect(warnings[0]).To(ContainSubstring("empty"))
 	})
 
+	It("warns instead of refusing a network config cloud-init cannot parse", func() {
+		vm := inlineVM("#cloud-config\n")
+		vm.Spec.Provisioning.NetworkConfig = "ethernets: {}\n"
+
+		warnings, err := NewProvisioningValidator().Validate(vm)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(warnings).To(ConsistOf(
+			And(ContainSubstring("spec.provisioning.networkConfig"), ContainSubstring("has no version")),
+		))
+	})
+
 	DescribeTable("leaves data kept outside the machine to the provisioning handler",
 		func(provisioning *v1alpha2.Provisioning) {
 			warnings, err := NewProvisioningValidator().Validate(newVM(provisioning))
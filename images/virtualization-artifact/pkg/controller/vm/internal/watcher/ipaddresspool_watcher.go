@@ -0,0 +1,73 @@
+/*
+Copyright 2024 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watcher
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+// IPAddressPoolWatcher enqueues the virtual machines the pool has assigned addresses to,
+// when the assignments or the spec of the pool change.
+type IPAddressPoolWatcher struct{}
+
+func NewIPAddressPoolWatcher() *IPAddressPoolWatcher {
+	return &IPAddressPoolWatcher{}
+}
+
+func (w IPAddressPoolWatcher) Watch(mgr manager.Manager, ctr controller.Controller) error {
+	if err := ctr.Watch(
+		source.Kind(
+			mgr.GetCache(),
+			&v1alpha2.IPAddressPool{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(_ context.Context, pool *v1alpha2.IPAddressPool) []reconcile.Request {
+				seen := make(map[types.NamespacedName]struct{})
+				var requests []reconcile.Request
+				for _, a := range pool.Status.Allocations {
+					nn := types.NamespacedName{Name: a.VirtualMachine, Namespace: a.Namespace}
+					if _, ok := seen[nn]; ok {
+						continue
+					}
+					seen[nn] = struct{}{}
+					requests = append(requests, reconcile.Request{NamespacedName: nn})
+				}
+				return requests
+			}),
+			predicate.TypedFuncs[*v1alpha2.IPAddressPool]{
+				UpdateFunc: func(e event.TypedUpdateEvent[*v1alpha2.IPAddressPool]) bool {
+					return e.ObjectOld.Generation != e.ObjectNew.Generation ||
+						!equality.Semantic.DeepEqual(e.ObjectOld.Status.Allocations, e.ObjectNew.Status.Allocations)
+				},
+			},
+		),
+	); err != nil {
+		return fmt.Errorf("error setting watch on IPAddressPool: %w", err)
+	}
+	return nil
+}
@@ -77,6 +77,7 @@ func (r *Reconciler) SetupController(_ context.Context, mgr manager.Manager, ctr
 		watcher.NewVirtualMachineSnapshotWatcher(),
 		watcher.NewVMOPWatcher(),
 		watcher.NewVMMACWatcher(),
+		watcher.NewIPAddressPoolWatcher(),
 		watcher.NewSecretWatcher(mgr.GetClient()),
 		watcher.NewNetworkWatcher(mgr.GetClient(), featuregates.Default()),
 	} {
@@ -61,6 +61,7 @@ func NewValidator(client client.Client, blockDeviceService *service.BlockDeviceS
 			validators.NewVMBDAConflictValidator(client),
 			validators.NewPVNodeAffinityValidator(client, attachmentService),
 			validators.NewLegacyOSValidator(),
+			validators.NewBootImageValidator(client),
 			validators.NewProvisioningValidator(),
 		},
 		log: log.With("webhook", "validation"),
@@ -169,6 +170,7 @@ func NewDefaulter(client client.Client, vmClassService *service.VirtualMachineCl
 			defaulter.NewVirtualMachineClassNameDefaulter(client, vmClassService),
 			defaulter.NewCoreFractionDefaulter(client),
 			defaulter.NewNetworksDefaulter(),
+			defaulter.NewNetworkConfigGenerationDefaulter(),
 		},
 		log: log.With("webhook", "mutating"),
 	}
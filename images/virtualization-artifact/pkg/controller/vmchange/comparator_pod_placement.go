@@ -18,6 +18,9 @@ package vmchange
 
 import (
 	"reflect"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/equality"
 
 	"github.com/deckhouse/virtualization-controller/pkg/common/network"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
@@ -92,7 +95,7 @@ func compareNetworks(current, desired *v1alpha2.VirtualMachineSpec) []FieldChang
 	action := ActionRestart
 	if isOnlyNetworkIDAutofillChange(current.Networks, desired.Networks) {
 		action = ActionNone
-	} else if isOnlyNonMainNetworksChanged(current.Networks, desired.Networks) {
+	} else if isNetworksHotplugChange(current.Networks, desired.Networks) {
 		action = ActionApplyImmediate
 	}
 
@@ -105,11 +108,61 @@ func compareNetworks(current, desired *v1alpha2.VirtualMachineSpec) []FieldChang
 	)
 }
 
-// isOnlyNonMainNetworksChanged returns true when the Main network is unchanged
-// between current and desired (so only non-Main networks differ).
-// Empty networks list is equivalent to having an implicit default Main.
-func isOnlyNonMainNetworksChanged(current, desired []v1alpha2.NetworksSpec) bool {
-	return hasMainNetwork(current) == hasMainNetwork(desired)
+// isNetworksHotplugChange returns true when the change can be applied to a running VM
+// by plugging and unplugging additional interfaces: the Main network is unchanged
+// (an empty networks list is equivalent to an implicit default Main), and interfaces
+// present in both lists keep their order, IDs and MAC addresses.
+// Replacing one additional network with another is an unplug followed by a plug.
+func isNetworksHotplugChange(current, desired []v1alpha2.NetworksSpec) bool {
+	if hasMainNetwork(current) != hasMainNetwork(desired) {
+		return false
+	}
+
+	desiredByKey := make(map[string]v1alpha2.NetworksSpec, len(desired))
+	for _, n := range desired {
+		desiredByKey[network.SpecKey(n)] = n
+	}
+
+	var keptCurrent []string
+	for _, curr := range current {
+		key := network.SpecKey(curr)
+		next, kept := desiredByKey[key]
+		if !kept {
+			continue
+		}
+		if !isSameNetworkInterface(curr, next) {
+			return false
+		}
+		keptCurrent = append(keptCurrent, key)
+	}
+
+	currentKeys := make(map[string]struct{}, len(keptCurrent))
+	for _, key := range keptCurrent {
+		currentKeys[key] = struct{}{}
+	}
+
+	var keptDesired []string
+	for _, n := range desired {
+		key := network.SpecKey(n)
+		if _, kept := currentKeys[key]; kept {
+			keptDesired = append(keptDesired, key)
+		}
+	}
+
+	return slices.Equal(keptCurrent, keptDesired)
+}
+
+// isSameNetworkInterface returns true when the interface stays attached to the guest as is.
+// The IP address reference is delivered by SDN and may be changed on a running VM.
+// Bandwidth limits are set when the virt-launcher pod is created, so changing them requires a restart.
+func isSameNetworkInterface(current, desired v1alpha2.NetworksSpec) bool {
+	if current.VirtualMachineMACAddressName != desired.VirtualMachineMACAddressName {
+		return false
+	}
+	if !equality.Semantic.DeepEqual(current.Bandwidth, desired.Bandwidth) {
+		return false
+	}
+	return current.ID == nil || desired.ID == nil || *current.ID == *desired.ID
 }
 
 func hasMainNetwork(networks []v1alpha2.NetworksSpec) bool {
@@ -125,7 +178,8 @@ func isOnlyNetworkIDAutofillChange(current, desired []v1alpha2.NetworksSpec) boo
 		if current[i].Type != desired[i].Type ||
 			current[i].Name != desired[i].Name ||
 			current[i].VirtualMachineMACAddressName != desired[i].VirtualMachineMACAddressName ||
-			current[i].IPAddressName != desired[i].IPAddressName {
+			current[i].IPAddressName != desired[i].IPAddressName ||
+			!equality.Semantic.DeepEqual(current[i].Bandwidth, desired[i].Bandwidth) {
 			return false
 		}
 
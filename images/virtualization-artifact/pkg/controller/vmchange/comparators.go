@@ -191,13 +191,29 @@ func compareProvisioning(current, desired *v1alpha2.VirtualMachineSpec) []FieldC
 	}
 
 	if current.Provisioning.Type == v1alpha2.ProvisioningTypeUserData {
-		return compareStrings(
+		changes = compareStrings(
 			"provisioning.userData",
 			current.Provisioning.UserData,
 			desired.Provisioning.UserData,
 			"",
 			ActionRestart,
 		)
+		if len(changes) > 0 {
+			return changes
+		}
+
+		changes = compareStrings(
+			"provisioning.networkConfig",
+			current.Provisioning.NetworkConfig,
+			desired.Provisioning.NetworkConfig,
+			"",
+			ActionRestart,
+		)
+		if len(changes) > 0 {
+			return changes
+		}
+
+		return compareNetworkConfigGeneration(current.Provisioning, desired.Provisioning)
 	}
 
 	if current.Provisioning.Type == v1alpha2.ProvisioningTypeUserDataRef {
@@ -226,18 +242,35 @@ func compareProvisioning(current, desired *v1alpha2.VirtualMachineSpec) []FieldC
 		}
 
 		// UserDataSecretRef is not nil, compare names.
-		return compareStrings(
+		changes = compareStrings(
 			"provisioning.userDataRef.name",
 			currentSecret.Name,
 			desiredSecret.Name,
 			"",
 			ActionRestart,
 		)
+		if len(changes) > 0 {
+			return changes
+		}
+
+		return compareNetworkConfigGeneration(current.Provisioning, desired.Provisioning)
 	}
 
 	return nil
 }
 
+// compareNetworkConfigGeneration compares the values as is: an empty networkConfigGeneration,
+// kept by virtual machines created before it was introduced, is not the same as Enabled.
+func compareNetworkConfigGeneration(current, desired *v1alpha2.Provisioning) []FieldChange {
+	return compareStrings(
+		"provisioning.networkConfigGeneration",
+		string(current.NetworkConfigGeneration),
+		string(desired.NetworkConfigGeneration),
+		"",
+		ActionRestart,
+	)
+}
+
 func isCloudInitProvisioning(p *v1alpha2.Provisioning) bool {
 	if p == nil {
 		return false
@@ -547,6 +547,75 @@ provisioning:
 				requirePathOperation("provisioning", ChangeRemove),
 			),
 		},
+		{
+			"restart on provisioning networkConfig change",
+			`
+provisioning:
+  type: UserData
+  userData: |
+    #cloud-config
+`,
+			`
+provisioning:
+  type: UserData
+  userData: |
+    #cloud-config
+  networkConfig: |
+    version: 2
+`,
+			nil,
+			assertChanges(
+				actionRequired(ActionRestart),
+				requirePathOperation("provisioning.networkConfig", ChangeAdd),
+			),
+		},
+		{
+			"restart on enabling networkConfigGeneration of a virtual machine created before it was introduced",
+			`
+provisioning:
+  type: UserDataRef
+  userDataRef:
+    kind: Secret
+    name: cloud-init-secret
+`,
+			`
+provisioning:
+  type: UserDataRef
+  userDataRef:
+    kind: Secret
+    name: cloud-init-secret
+  networkConfigGeneration: Enabled
+`,
+			nil,
+			assertChanges(
+				actionRequired(ActionRestart),
+				requirePathOperation("provisioning.networkConfigGeneration", ChangeAdd),
+			),
+		},
+		{
+			"restart on disabled networkConfigGeneration",
+			`
+provisioning:
+  type: UserDataRef
+  userDataRef:
+    kind: Secret
+    name: cloud-init-secret
+  networkConfigGeneration: Enabled
+`,
+			`
+provisioning:
+  type: UserDataRef
+  userDataRef:
+    kind: Secret
+    name: cloud-init-secret
+  networkConfigGeneration: Disabled
+`,
+			nil,
+			assertChanges(
+				actionRequired(ActionRestart),
+				requirePathOperation("provisioning.networkConfigGeneration", ChangeReplace),
+			),
+		},
 		{
 			"restart on provisioning type change from UserData to Sysprep",
 			`
@@ -813,6 +882,66 @@ networks:
 				requirePathOperation("networks", ChangeReplace),
 			),
 		},
+		{
+			"restart when only bandwidth limits are changed",
+			`
+networks:
+- type: Main
+  id: 1
+- type: Network
+  name: net1
+  id: 2
+`,
+			`
+networks:
+- type: Main
+  id: 1
+  bandwidth:
+    egress:
+      rate: 100M
+- type: Network
+  name: net1
+  id: 2
+  bandwidth:
+    ingress:
+      rate: 1G
+      burst: 1Mi
+`,
+			nil,
+			assertChanges(
+				actionRequired(ActionRestart),
+				requirePathOperation("networks", ChangeReplace),
+			),
+		},
+		{
+			"restart when bandwidth limits are changed together with a network hotplug",
+			`
+networks:
+- type: Main
+  id: 1
+- type: Network
+  name: net1
+  id: 2
+`,
+			`
+networks:
+- type: Main
+  id: 1
+- type: Network
+  name: net1
+  id: 2
+  bandwidth:
+    egress:
+      rate: 100M
+- type: Network
+  name: net2
+`,
+			nil,
+			assertChanges(
+				actionRequired(ActionRestart),
+				requirePathOperation("networks", ChangeReplace),
+			),
+		},
 		{
 			"restart when only-non-main spec gains main network",
 			`
@@ -828,6 +957,143 @@ networks:
 - type: ClusterNetwork
   name: net1
   id: 2
+`,
+			nil,
+			assertChanges(
+				actionRequired(ActionRestart),
+				requirePathOperation("networks", ChangeReplace),
+			),
+		},
+		{
+			"apply immediate when additional network is replaced with another one",
+			`
+networks:
+- type: Main
+  id: 1
+- type: Network
+  name: vlan100
+  id: 2
+`,
+			`
+networks:
+- type: Main
+  id: 1
+- type: Network
+  name: vlan200
+  id: 3
+`,
+			nil,
+			assertChanges(
+				actionRequired(ActionApplyImmediate),
+				requirePathOperation("networks", ChangeReplace),
+			),
+		},
+		{
+			"apply immediate when additional network is plugged between existing ones",
+			`
+networks:
+- type: Main
+  id: 1
+- type: Network
+  name: net1
+  id: 2
+- type: ClusterNetwork
+  name: net2
+  id: 3
+`,
+			`
+networks:
+- type: Main
+  id: 1
+- type: Network
+  name: net1
+  id: 2
+- type: Network
+  name: net3
+  id: 4
+- type: ClusterNetwork
+  name: net2
+  id: 3
+`,
+			nil,
+			assertChanges(
+				actionRequired(ActionApplyImmediate),
+				requirePathOperation("networks", ChangeReplace),
+			),
+		},
+		{
+			"restart when existing additional networks are reordered",
+			`
+networks:
+- type: Main
+  id: 1
+- type: Network
+  name: net1
+  id: 2
+- type: ClusterNetwork
+  name: net2
+  id: 3
+`,
+			`
+networks:
+- type: Main
+  id: 1
+- type: ClusterNetwork
+  name: net2
+  id: 3
+- type: Network
+  name: net1
+  id: 2
+`,
+			nil,
+			assertChanges(
+				actionRequired(ActionRestart),
+				requirePathOperation("networks", ChangeReplace),
+			),
+		},
+		{
+			"restart when id of existing additional network changes",
+			`
+networks:
+- type: Main
+  id: 1
+- type: Network
+  name: net1
+  id: 2
+`,
+			`
+networks:
+- type: Main
+  id: 1
+- type: Network
+  name: net1
+  id: 5
+`,
+			nil,
+			assertChanges(
+				actionRequired(ActionRestart),
+				requirePathOperation("networks", ChangeReplace),
+			),
+		},
+		{
+			"restart when mac address of existing additional network changes",
+			`
+networks:
+- type: Main
+  id: 1
+- type: Network
+  name: net1
+  id: 2
+  virtualMachineMACAddressName: mac-1
+`,
+			`
+networks:
+- type: Main
+  id: 1
+- type: Network
+  name: net1
+  id: 2
+  virtualMachineMACAddressName: mac-2
 `,
 			nil,
 			assertChanges(
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -54,7 +55,30 @@ func (h *BoundHandler) Handle(ctx context.Context, vmip *v1alpha2.VirtualMachine
 	cb := conditions.NewConditionBuilder(vmipcondition.BoundType).Generation(vmip.Generation)
 	defer func() { conditions.SetCondition(cb, &vmip.Status.Conditions) }()
 
-	lease, err := h.ipService.GetLease(ctx, vmip)
+	families, err := h.ipService.Families(vmip)
+	if err != nil {
+		cb.
+			Status(metav1.ConditionFalse).
+			Reason(vmipcondition.VirtualMachineIPAddressLeaseNotReady).
+			Message(service.CapitalizeFirstLetter(err.Error()) + ".")
+		return reconcile.Result{}, nil
+	}
+
+	// The vmip gets a separate Lease for each IP family: the primary one is processed first,
+	// and the next one is processed only after the previous Lease has been bound.
+	for _, family := range families {
+		var res reconcile.Result
+		res, err = h.handleFamily(ctx, vmip, family, cb)
+		if err != nil || cb.Condition().Status != metav1.ConditionTrue {
+			return res, err
+		}
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (h *BoundHandler) handleFamily(ctx context.Context, vmip *v1alpha2.VirtualMachineIPAddress, family corev1.IPFamily, cb *conditions.ConditionBuilder) (reconcile.Result, error) {
+	lease, err := h.ipService.GetLease(ctx, vmip, family)
 	if err != nil {
 		err = fmt.Errorf("error occurred: %w", err)
 		cb.
@@ -70,14 +94,15 @@ func (h *BoundHandler) Handle(ctx context.Context, vmip *v1alpha2.VirtualMachine
 		return reconcile.Result{}, err
 	}
 
+	log := logger.FromContext(ctx).With("ipFamily", family)
 	if lease != nil {
-		log := logger.FromContext(ctx).With("leaseName", lease.Name)
-		ctx = logger.ToContext(ctx, log)
+		log = log.With("leaseName", lease.Name)
 	}
+	ctx = logger.ToContext(ctx, log)
 
 	return steptaker.NewStepTakers[*v1alpha2.VirtualMachineIPAddress](
 		step.NewBindStep(lease, cb),
 		step.NewTakeLeaseStep(lease, h.client, cb, h.recorder),
-		step.NewCreateLeaseStep(lease, h.ipService, h.client, cb, h.recorder),
+		step.NewCreateLeaseStep(lease, family, h.ipService, h.client, cb, h.recorder),
 	).Run(ctx, vmip)
 }
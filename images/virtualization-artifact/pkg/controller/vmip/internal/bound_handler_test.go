@@ -18,9 +18,11 @@ package internal
 
 import (
 	"context"
+	"errors"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
@@ -79,13 +81,16 @@ var _ = Describe("BoundHandler", func() {
 		}
 
 		svc = &IPAddressServiceMock{
-			GetLeaseFunc: func(ctx context.Context, vmip *v1alpha2.VirtualMachineIPAddress) (*v1alpha2.VirtualMachineIPAddressLease, error) {
+			GetLeaseFunc: func(ctx context.Context, vmip *v1alpha2.VirtualMachineIPAddress, _ corev1.IPFamily) (*v1alpha2.VirtualMachineIPAddressLease, error) {
 				return nil, nil
 			},
 			GetAllocatedIPsFunc: func(ctx context.Context) (ip.AllocatedIPs, error) {
 				return nil, nil
 			},
-			AllocateNewIPFunc: func(_ ip.AllocatedIPs) (string, error) {
+			FamiliesFunc: func(_ *v1alpha2.VirtualMachineIPAddress) ([]corev1.IPFamily, error) {
+				return []corev1.IPFamily{corev1.IPv4Protocol}, nil
+			},
+			AllocateNewIPFunc: func(_ ip.AllocatedIPs, _ corev1.IPFamily) (string, error) {
 				return ipAddress, nil
 			},
 			IsInsideOfRangeFunc: func(_ string) error {
@@ -130,7 +135,7 @@ var _ = Describe("BoundHandler", func() {
 
 		It("takes existing released lease", func() {
 			var leaseUpdated bool
-			svc.GetLeaseFunc = func(_ context.Context, _ *v1alpha2.VirtualMachineIPAddress) (*v1alpha2.VirtualMachineIPAddressLease, error) {
+			svc.GetLeaseFunc = func(_ context.Context, _ *v1alpha2.VirtualMachineIPAddress, _ corev1.IPFamily) (*v1alpha2.VirtualMachineIPAddressLease, error) {
 				lease.Spec.VirtualMachineIPAddressRef = nil
 				return lease, nil
 			}
@@ -159,7 +164,7 @@ var _ = Describe("BoundHandler", func() {
 		})
 
 		It("cannot take existing lease: it's bound to another vmip", func() {
-			svc.GetLeaseFunc = func(_ context.Context, _ *v1alpha2.VirtualMachineIPAddress) (*v1alpha2.VirtualMachineIPAddressLease, error) {
+			svc.GetLeaseFunc = func(_ context.Context, _ *v1alpha2.VirtualMachineIPAddress, _ corev1.IPFamily) (*v1alpha2.VirtualMachineIPAddressLease, error) {
 				lease.Spec.VirtualMachineIPAddressRef = &v1alpha2.VirtualMachineIPAddressLeaseIpAddressRef{
 					Namespace: vmip.Namespace,
 					Name:      "another-vmip",
@@ -176,7 +181,7 @@ var _ = Describe("BoundHandler", func() {
 		})
 
 		It("cannot take existing lease: it belongs to different namespace", func() {
-			svc.GetLeaseFunc = func(_ context.Context, _ *v1alpha2.VirtualMachineIPAddress) (*v1alpha2.VirtualMachineIPAddressLease, error) {
+			svc.GetLeaseFunc = func(_ context.Context, _ *v1alpha2.VirtualMachineIPAddress, _ corev1.IPFamily) (*v1alpha2.VirtualMachineIPAddressLease, error) {
 				lease.Spec.VirtualMachineIPAddressRef = &v1alpha2.VirtualMachineIPAddressLeaseIpAddressRef{
 					Namespace: vmip.Namespace + "-different",
 				}
@@ -192,7 +197,7 @@ var _ = Describe("BoundHandler", func() {
 		})
 
 		It("is lost", func() {
-			svc.GetLeaseFunc = func(_ context.Context, _ *v1alpha2.VirtualMachineIPAddress) (*v1alpha2.VirtualMachineIPAddressLease, error) {
+			svc.GetLeaseFunc = func(_ context.Context, _ *v1alpha2.VirtualMachineIPAddress, _ corev1.IPFamily) (*v1alpha2.VirtualMachineIPAddressLease, error) {
 				return nil, nil
 			}
 			h := NewBoundHandler(svc, nil, recorderMock)
@@ -207,7 +212,7 @@ var _ = Describe("BoundHandler", func() {
 
 	Context("Binding", func() {
 		It("has non-bound lease with ref", func() {
-			svc.GetLeaseFunc = func(_ context.Context, _ *v1alpha2.VirtualMachineIPAddress) (*v1alpha2.VirtualMachineIPAddressLease, error) {
+			svc.GetLeaseFunc = func(_ context.Context, _ *v1alpha2.VirtualMachineIPAddress, _ corev1.IPFamily) (*v1alpha2.VirtualMachineIPAddressLease, error) {
 				lease.Spec.VirtualMachineIPAddressRef = &v1alpha2.VirtualMachineIPAddressLeaseIpAddressRef{
 					Namespace: vmip.Namespace,
 					Name:      vmip.Name,
@@ -225,7 +230,7 @@ var _ = Describe("BoundHandler", func() {
 		})
 
 		It("has bound lease", func() {
-			svc.GetLeaseFunc = func(_ context.Context, _ *v1alpha2.VirtualMachineIPAddress) (*v1alpha2.VirtualMachineIPAddressLease, error) {
+			svc.GetLeaseFunc = func(_ context.Context, _ *v1alpha2.VirtualMachineIPAddress, _ corev1.IPFamily) (*v1alpha2.VirtualMachineIPAddressLease, error) {
 				lease.Spec.VirtualMachineIPAddressRef = &v1alpha2.VirtualMachineIPAddressLeaseIpAddressRef{
 					Namespace: vmip.Namespace,
 					Name:      vmip.Name,
@@ -248,6 +253,103 @@ var _ = Describe("BoundHandler", func() {
 			Expect(vmip.Status.Address).To(Equal(ipAddress))
 		})
 	})
+
+	Context("Dual-stack", func() {
+		const ipv6Address = "fd00::10"
+
+		var leases map[corev1.IPFamily]*v1alpha2.VirtualMachineIPAddressLease
+
+		newBoundLease := func(address string) *v1alpha2.VirtualMachineIPAddressLease {
+			return &v1alpha2.VirtualMachineIPAddressLease{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       ip.IPToLeaseName(address),
+					Generation: 1,
+				},
+				Spec: v1alpha2.VirtualMachineIPAddressLeaseSpec{
+					VirtualMachineIPAddressRef: &v1alpha2.VirtualMachineIPAddressLeaseIpAddressRef{
+						Namespace: vmip.Namespace,
+						Name:      vmip.Name,
+					},
+				},
+				Status: v1alpha2.VirtualMachineIPAddressLeaseStatus{
+					Conditions: []metav1.Condition{{
+						Type:               vmiplcondition.BoundType.String(),
+						Status:             metav1.ConditionTrue,
+						Reason:             vmiplcondition.Bound.String(),
+						ObservedGeneration: 1,
+					}},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			vmip.Spec.IPFamilyPolicy = v1alpha2.VirtualMachineIPAddressFamilyPolicyRequireDualStack
+			leases = make(map[corev1.IPFamily]*v1alpha2.VirtualMachineIPAddressLease)
+
+			svc.FamiliesFunc = func(_ *v1alpha2.VirtualMachineIPAddress) ([]corev1.IPFamily, error) {
+				return []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}, nil
+			}
+			svc.GetLeaseFunc = func(_ context.Context, _ *v1alpha2.VirtualMachineIPAddress, family corev1.IPFamily) (*v1alpha2.VirtualMachineIPAddressLease, error) {
+				return leases[family], nil
+			}
+			svc.AllocateNewIPFunc = func(_ ip.AllocatedIPs, family corev1.IPFamily) (string, error) {
+				if family == corev1.IPv6Protocol {
+					return ipv6Address, nil
+				}
+				return ipAddress, nil
+			}
+		})
+
+		It("creates the lease of the secondary family after the primary one is bound", func() {
+			leases[corev1.IPv4Protocol] = newBoundLease(ipAddress)
+
+			var createdLease string
+			k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects().
+				WithInterceptorFuncs(interceptor.Funcs{
+					Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+						createdLease = obj.GetName()
+						return nil
+					},
+				}).Build()
+
+			h := NewBoundHandler(svc, k8sClient, recorderMock)
+			res, err := h.Handle(ctx, vmip)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.IsZero()).To(BeTrue())
+
+			ExpectCondition(vmip, metav1.ConditionFalse, vmipcondition.VirtualMachineIPAddressLeaseNotReady, true)
+			Expect(createdLease).To(Equal(ip.IPToLeaseName(ipv6Address)))
+			Expect(vmip.Status.Address).To(Equal(ipAddress))
+			Expect(vmip.Status.Addresses).To(Equal([]string{ipAddress}))
+		})
+
+		It("is bound when the leases of both families are bound", func() {
+			leases[corev1.IPv4Protocol] = newBoundLease(ipAddress)
+			leases[corev1.IPv6Protocol] = newBoundLease(ipv6Address)
+
+			h := NewBoundHandler(svc, nil, recorderMock)
+			res, err := h.Handle(ctx, vmip)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.IsZero()).To(BeTrue())
+
+			ExpectCondition(vmip, metav1.ConditionTrue, vmipcondition.Bound, false)
+			Expect(vmip.Status.Address).To(Equal(ipAddress))
+			Expect(vmip.Status.Addresses).To(Equal([]string{ipAddress, ipv6Address}))
+		})
+
+		It("is not bound if the IP families are not available", func() {
+			svc.FamiliesFunc = func(_ *v1alpha2.VirtualMachineIPAddress) ([]corev1.IPFamily, error) {
+				return nil, errors.New("no virtualMachineCIDRs are configured for the IP family IPv6")
+			}
+
+			h := NewBoundHandler(svc, nil, recorderMock)
+			res, err := h.Handle(ctx, vmip)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.IsZero()).To(BeTrue())
+
+			ExpectCondition(vmip, metav1.ConditionFalse, vmipcondition.VirtualMachineIPAddressLeaseNotReady, true)
+		})
+	})
 })
 
 func ExpectCondition(vmip *v1alpha2.VirtualMachineIPAddress, status metav1.ConditionStatus, reason vmipcondition.BoundReason, msgExists bool) {
@@ -19,6 +19,8 @@ package internal
 import (
 	"context"
 
+	corev1 "k8s.io/api/core/v1"
+
 	"github.com/deckhouse/virtualization-controller/pkg/controller/vmip/internal/step"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 )
@@ -26,7 +28,8 @@ import (
 //go:generate go tool moq -rm -out mock.go . IPAddressService
 
 type IPAddressService interface {
-	GetLease(ctx context.Context, vmip *v1alpha2.VirtualMachineIPAddress) (*v1alpha2.VirtualMachineIPAddressLease, error)
+	Families(vmip *v1alpha2.VirtualMachineIPAddress) ([]corev1.IPFamily, error)
+	GetLease(ctx context.Context, vmip *v1alpha2.VirtualMachineIPAddress, family corev1.IPFamily) (*v1alpha2.VirtualMachineIPAddressLease, error)
 
 	step.Allocator
 }
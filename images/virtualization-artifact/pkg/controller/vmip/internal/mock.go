@@ -7,6 +7,7 @@ import (
 	"context"
 	"github.com/deckhouse/virtualization-controller/pkg/common/ip"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
 	"sync"
 )
 
@@ -20,13 +21,16 @@ var _ IPAddressService = &IPAddressServiceMock{}
 //
 //		// make and configure a mocked IPAddressService
 //		mockedIPAddressService := &IPAddressServiceMock{
-//			AllocateNewIPFunc: func(allocatedIPs ip.AllocatedIPs) (string, error) {
+//			AllocateNewIPFunc: func(allocatedIPs ip.AllocatedIPs, family corev1.IPFamily) (string, error) {
 //				panic("mock out the AllocateNewIP method")
 //			},
+//			FamiliesFunc: func(vmip *v1alpha2.VirtualMachineIPAddress) ([]corev1.IPFamily, error) {
+//				panic("mock out the Families method")
+//			},
 //			GetAllocatedIPsFunc: func(ctx context.Context) (ip.AllocatedIPs, error) {
 //				panic("mock out the GetAllocatedIPs method")
 //			},
-//			GetLeaseFunc: func(ctx context.Context, vmip *v1alpha2.VirtualMachineIPAddress) (*v1alpha2.VirtualMachineIPAddressLease, error) {
+//			GetLeaseFunc: func(ctx context.Context, vmip *v1alpha2.VirtualMachineIPAddress, family corev1.IPFamily) (*v1alpha2.VirtualMachineIPAddressLease, error) {
 //				panic("mock out the GetLease method")
 //			},
 //			IsInsideOfRangeFunc: func(address string) error {
@@ -40,13 +44,16 @@ var _ IPAddressService = &IPAddressServiceMock{}
 //	}
 type IPAddressServiceMock struct {
 	// AllocateNewIPFunc mocks the AllocateNewIP method.
-	AllocateNewIPFunc func(allocatedIPs ip.AllocatedIPs) (string, error)
+	AllocateNewIPFunc func(allocatedIPs ip.AllocatedIPs, family corev1.IPFamily) (string, error)
+
+	// FamiliesFunc mocks the Families method.
+	FamiliesFunc func(vmip *v1alpha2.VirtualMachineIPAddress) ([]corev1.IPFamily, error)
 
 	// GetAllocatedIPsFunc mocks the GetAllocatedIPs method.
 	GetAllocatedIPsFunc func(ctx context.Context) (ip.AllocatedIPs, error)
 
 	// GetLeaseFunc mocks the GetLease method.
-	GetLeaseFunc func(ctx context.Context, vmip *v1alpha2.VirtualMachineIPAddress) (*v1alpha2.VirtualMachineIPAddressLease, error)
+	GetLeaseFunc func(ctx context.Context, vmip *v1alpha2.VirtualMachineIPAddress, family corev1.IPFamily) (*v1alpha2.VirtualMachineIPAddressLease, error)
 
 	// IsInsideOfRangeFunc mocks the IsInsideOfRange method.
 	IsInsideOfRangeFunc func(address string) error
@@ -57,6 +64,13 @@ type IPAddressServiceMock struct {
 		AllocateNewIP []struct {
 			// AllocatedIPs is the allocatedIPs argument value.
 			AllocatedIPs ip.AllocatedIPs
+			// Family is the family argument value.
+			Family corev1.IPFamily
+		}
+		// Families holds details about calls to the Families method.
+		Families []struct {
+			// Vmip is the vmip argument value.
+			Vmip *v1alpha2.VirtualMachineIPAddress
 		}
 		// GetAllocatedIPs holds details about calls to the GetAllocatedIPs method.
 		GetAllocatedIPs []struct {
@@ -69,6 +83,8 @@ type IPAddressServiceMock struct {
 			Ctx context.Context
 			// Vmip is the vmip argument value.
 			Vmip *v1alpha2.VirtualMachineIPAddress
+			// Family is the family argument value.
+			Family corev1.IPFamily
 		}
 		// IsInsideOfRange holds details about calls to the IsInsideOfRange method.
 		IsInsideOfRange []struct {
@@ -77,25 +93,28 @@ type IPAddressServiceMock struct {
 		}
 	}
 	lockAllocateNewIP   sync.RWMutex
+	lockFamilies        sync.RWMutex
 	lockGetAllocatedIPs sync.RWMutex
 	lockGetLease        sync.RWMutex
 	lockIsInsideOfRange sync.RWMutex
 }
 
 // AllocateNewIP calls AllocateNewIPFunc.
-func (mock *IPAddressServiceMock) AllocateNewIP(allocatedIPs ip.AllocatedIPs) (string, error) {
+func (mock *IPAddressServiceMock) AllocateNewIP(allocatedIPs ip.AllocatedIPs, family corev1.IPFamily) (string, error) {
 	if mock.AllocateNewIPFunc == nil {
 		panic("IPAddressServiceMock.AllocateNewIPFunc: method is nil but IPAddressService.AllocateNewIP was just called")
 	}
 	callInfo := struct {
 		AllocatedIPs ip.AllocatedIPs
+		Family       corev1.IPFamily
 	}{
 		AllocatedIPs: allocatedIPs,
+		Family:       family,
 	}
 	mock.lockAllocateNewIP.Lock()
 	mock.calls.AllocateNewIP = append(mock.calls.AllocateNewIP, callInfo)
 	mock.lockAllocateNewIP.Unlock()
-	return mock.AllocateNewIPFunc(allocatedIPs)
+	return mock.AllocateNewIPFunc(allocatedIPs, family)
 }
 
 // AllocateNewIPCalls gets all the calls that were made to AllocateNewIP.
@@ -104,9 +123,11 @@ func (mock *IPAddressServiceMock) AllocateNewIP(allocatedIPs ip.AllocatedIPs) (s
 //	len(mockedIPAddressService.AllocateNewIPCalls())
 func (mock *IPAddressServiceMock) AllocateNewIPCalls() []struct {
 	AllocatedIPs ip.AllocatedIPs
+	Family       corev1.IPFamily
 } {
 	var calls []struct {
 		AllocatedIPs ip.AllocatedIPs
+		Family       corev1.IPFamily
 	}
 	mock.lockAllocateNewIP.RLock()
 	calls = mock.calls.AllocateNewIP
@@ -114,6 +135,38 @@ func (mock *IPAddressServiceMock) AllocateNewIPCalls() []struct {
 	return calls
 }
 
+// Families calls FamiliesFunc.
+func (mock *IPAddressServiceMock) Families(vmip *v1alpha2.VirtualMachineIPAddress) ([]corev1.IPFamily, error) {
+	if mock.FamiliesFunc == nil {
+		panic("IPAddressServiceMock.FamiliesFunc: method is nil but IPAddressService.Families was just called")
+	}
+	callInfo := struct {
+		Vmip *v1alpha2.VirtualMachineIPAddress
+	}{
+		Vmip: vmip,
+	}
+	mock.lockFamilies.Lock()
+	mock.calls.Families = append(mock.calls.Families, callInfo)
+	mock.lockFamilies.Unlock()
+	return mock.FamiliesFunc(vmip)
+}
+
+// FamiliesCalls gets all the calls that were made to Families.
+// Check the length with:
+//
+//	len(mockedIPAddressService.FamiliesCalls())
+func (mock *IPAddressServiceMock) FamiliesCalls() []struct {
+	Vmip *v1alpha2.VirtualMachineIPAddress
+} {
+	var calls []struct {
+		Vmip *v1alpha2.VirtualMachineIPAddress
+	}
+	mock.lockFamilies.RLock()
+	calls = mock.calls.Families
+	mock.lockFamilies.RUnlock()
+	return calls
+}
+
 // GetAllocatedIPs calls GetAllocatedIPsFunc.
 func (mock *IPAddressServiceMock) GetAllocatedIPs(ctx context.Context) (ip.AllocatedIPs, error) {
 	if mock.GetAllocatedIPsFunc == nil {
@@ -147,21 +200,23 @@ func (mock *IPAddressServiceMock) GetAllocatedIPsCalls() []struct {
 }
 
 // GetLease calls GetLeaseFunc.
-func (mock *IPAddressServiceMock) GetLease(ctx context.Context, vmip *v1alpha2.VirtualMachineIPAddress) (*v1alpha2.VirtualMachineIPAddressLease, error) {
+func (mock *IPAddressServiceMock) GetLease(ctx context.Context, vmip *v1alpha2.VirtualMachineIPAddress, family corev1.IPFamily) (*v1alpha2.VirtualMachineIPAddressLease, error) {
 	if mock.GetLeaseFunc == nil {
 		panic("IPAddressServiceMock.GetLeaseFunc: method is nil but IPAddressService.GetLease was just called")
 	}
 	callInfo := struct {
-		Ctx  context.Context
-		Vmip *v1alpha2.VirtualMachineIPAddress
+		Ctx    context.Context
+		Vmip   *v1alpha2.VirtualMachineIPAddress
+		Family corev1.IPFamily
 	}{
-		Ctx:  ctx,
-		Vmip: vmip,
+		Ctx:    ctx,
+		Vmip:   vmip,
+		Family: family,
 	}
 	mock.lockGetLease.Lock()
 	mock.calls.GetLease = append(mock.calls.GetLease, callInfo)
 	mock.lockGetLease.Unlock()
-	return mock.GetLeaseFunc(ctx, vmip)
+	return mock.GetLeaseFunc(ctx, vmip, family)
 }
 
 // GetLeaseCalls gets all the calls that were made to GetLease.
@@ -169,12 +224,14 @@ func (mock *IPAddressServiceMock) GetLease(ctx context.Context, vmip *v1alpha2.V
 //
 //	len(mockedIPAddressService.GetLeaseCalls())
 func (mock *IPAddressServiceMock) GetLeaseCalls() []struct {
-	Ctx  context.Context
-	Vmip *v1alpha2.VirtualMachineIPAddress
+	Ctx    context.Context
+	Vmip   *v1alpha2.VirtualMachineIPAddress
+	Family corev1.IPFamily
 } {
 	var calls []struct {
-		Ctx  context.Context
-		Vmip *v1alpha2.VirtualMachineIPAddress
+		Ctx    context.Context
+		Vmip   *v1alpha2.VirtualMachineIPAddress
+		Family corev1.IPFamily
 	}
 	mock.lockGetLease.RLock()
 	calls = mock.calls.GetLease
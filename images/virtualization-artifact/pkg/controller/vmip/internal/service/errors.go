@@ -21,4 +21,5 @@ import "errors"
 var (
 	ErrIPAddressAlreadyExist = errors.New("the IP address is already allocated")
 	ErrIPAddressOutOfRange   = errors.New("the IP address is out of range")
+	ErrIPFamilyNotAvailable  = errors.New("no virtualMachineCIDRs are configured for the IP family")
 )
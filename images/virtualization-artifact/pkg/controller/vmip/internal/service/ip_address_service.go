@@ -22,7 +22,9 @@ import (
 	"fmt"
 	"net"
 	"net/netip"
+	"slices"
 
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -90,8 +92,63 @@ func (s IPAddressService) IsInsideOfRange(address string) error {
 	return ErrIPAddressOutOfRange
 }
 
-func (s IPAddressService) AllocateNewIP(allocatedIPs ip.AllocatedIPs) (string, error) {
+// Families returns the IP families of the addresses the vmip should have, the primary family comes first.
+// The Static vmip gets the families of the requested addresses, and the Auto vmip gets the families
+// according to its ipFamilyPolicy: the primary family is the family of the first virtualMachineCIDR.
+func (s IPAddressService) Families(vmip *v1alpha2.VirtualMachineIPAddress) ([]corev1.IPFamily, error) {
+	if vmip.Spec.Type == v1alpha2.VirtualMachineIPAddressTypeStatic {
+		var families []corev1.IPFamily
+		for _, address := range GetStaticIPs(vmip) {
+			families = append(families, ip.Family(address))
+		}
+		return families, nil
+	}
+
+	if len(s.parsedCIDRs) == 0 {
+		return nil, ErrIPFamilyNotAvailable
+	}
+
+	// The address of the primary family cannot be changed once assigned.
+	primary := ip.PrefixFamily(s.parsedCIDRs[0])
+	if vmip.Status.Address != "" {
+		primary = ip.Family(vmip.Status.Address)
+	}
+
+	secondary := corev1.IPv6Protocol
+	if primary == corev1.IPv6Protocol {
+		secondary = corev1.IPv4Protocol
+	}
+
+	switch vmip.Spec.IPFamilyPolicy {
+	case v1alpha2.VirtualMachineIPAddressFamilyPolicyRequireDualStack:
+		for _, family := range []corev1.IPFamily{primary, secondary} {
+			if !s.hasFamily(family) {
+				return nil, fmt.Errorf("%w %s", ErrIPFamilyNotAvailable, family)
+			}
+		}
+		return []corev1.IPFamily{primary, secondary}, nil
+	case v1alpha2.VirtualMachineIPAddressFamilyPolicyPreferDualStack:
+		if s.hasFamily(secondary) {
+			return []corev1.IPFamily{primary, secondary}, nil
+		}
+		return []corev1.IPFamily{primary}, nil
+	default:
+		return []corev1.IPFamily{primary}, nil
+	}
+}
+
+func (s IPAddressService) hasFamily(family corev1.IPFamily) bool {
+	return slices.ContainsFunc(s.parsedCIDRs, func(cidr netip.Prefix) bool {
+		return ip.PrefixFamily(cidr) == family
+	})
+}
+
+func (s IPAddressService) AllocateNewIP(allocatedIPs ip.AllocatedIPs, family corev1.IPFamily) (string, error) {
 	for _, cidr := range s.parsedCIDRs {
+		if ip.PrefixFamily(cidr) != family {
+			continue
+		}
+
 		for addr := cidr.Addr(); cidr.Contains(addr); addr = addr.Next() {
 			if k8snet.RangeSize(toIPNet(cidr)) != 1 {
 				isFirstLast, err := isFirstLastIP(addr, cidr)
@@ -127,16 +184,16 @@ func (s IPAddressService) GetAllocatedIPs(ctx context.Context) (ip.AllocatedIPs,
 	return allocatedIPs, nil
 }
 
-func (s IPAddressService) GetLease(ctx context.Context, vmip *v1alpha2.VirtualMachineIPAddress) (*v1alpha2.VirtualMachineIPAddressLease, error) {
+func (s IPAddressService) GetLease(ctx context.Context, vmip *v1alpha2.VirtualMachineIPAddress, family corev1.IPFamily) (*v1alpha2.VirtualMachineIPAddressLease, error) {
 	// The IP address cannot be changed for a vmip. Once it has been assigned, it will remain the same.
-	ipAddress := getAssignedIPAddress(vmip)
+	ipAddress := getAssignedIPAddress(vmip, family)
 	if ipAddress != "" {
 		return s.getLeaseByIPAddress(ctx, ipAddress)
 	}
 
 	// Either the Lease hasn't been created yet, or the address hasn't been set yet.
 	// We need to make sure the Lease doesn't exist in the cluster by searching for it by label.
-	return s.getLeaseByLabel(ctx, vmip)
+	return s.getLeaseByLabel(ctx, vmip, family)
 }
 
 func (s IPAddressService) getLeaseByIPAddress(ctx context.Context, ipAddress string) (*v1alpha2.VirtualMachineIPAddressLease, error) {
@@ -164,7 +221,7 @@ func (s IPAddressService) getLeaseByIPAddress(ctx context.Context, ipAddress str
 	}
 }
 
-func (s IPAddressService) getLeaseByLabel(ctx context.Context, vmip *v1alpha2.VirtualMachineIPAddress) (*v1alpha2.VirtualMachineIPAddressLease, error) {
+func (s IPAddressService) getLeaseByLabel(ctx context.Context, vmip *v1alpha2.VirtualMachineIPAddress, family corev1.IPFamily) (*v1alpha2.VirtualMachineIPAddressLease, error) {
 	// 1. Trying to find the Lease in the local cache.
 	{
 		leases := &v1alpha2.VirtualMachineIPAddressLeaseList{}
@@ -175,6 +232,8 @@ func (s IPAddressService) getLeaseByLabel(ctx context.Context, vmip *v1alpha2.Vi
 			return nil, fmt.Errorf("list leases in local cache: %w", err)
 		}
 
+		leases.Items = filterLeasesByFamily(leases.Items, family)
+
 		switch {
 		case len(leases.Items) == 0:
 			// Not found.
@@ -195,6 +254,8 @@ func (s IPAddressService) getLeaseByLabel(ctx context.Context, vmip *v1alpha2.Vi
 			return nil, fmt.Errorf("list leases via direct request to kubeapi: %w", err)
 		}
 
+		leases.Items = filterLeasesByFamily(leases.Items, family)
+
 		switch {
 		case len(leases.Items) == 0:
 			return nil, nil
@@ -235,14 +296,75 @@ func isFirstLastIP(ip netip.Addr, cidr netip.Prefix) (bool, error) {
 	return last.Equal(ip.AsSlice()), nil
 }
 
-func getAssignedIPAddress(vmip *v1alpha2.VirtualMachineIPAddress) string {
+func filterLeasesByFamily(leases []v1alpha2.VirtualMachineIPAddressLease, family corev1.IPFamily) []v1alpha2.VirtualMachineIPAddressLease {
+	return slices.DeleteFunc(leases, func(lease v1alpha2.VirtualMachineIPAddressLease) bool {
+		return ip.Family(ip.LeaseNameToIP(lease.Name)) != family
+	})
+}
+
+func getAssignedIPAddress(vmip *v1alpha2.VirtualMachineIPAddress, family corev1.IPFamily) string {
+	if address := GetStaticIP(vmip, family); address != "" {
+		return address
+	}
+
+	return GetAddress(vmip, family)
+}
+
+// GetStaticIPs returns the requested static addresses of the vmip, the primary address comes first.
+func GetStaticIPs(vmip *v1alpha2.VirtualMachineIPAddress) []string {
+	if len(vmip.Spec.StaticIPs) > 0 {
+		return vmip.Spec.StaticIPs
+	}
+
 	if vmip.Spec.StaticIP != "" {
-		return vmip.Spec.StaticIP
+		return []string{vmip.Spec.StaticIP}
 	}
 
-	if vmip.Status.Address != "" {
-		return vmip.Status.Address
+	return nil
+}
+
+// GetStaticIP returns the requested static address of the IP family.
+func GetStaticIP(vmip *v1alpha2.VirtualMachineIPAddress, family corev1.IPFamily) string {
+	for _, address := range GetStaticIPs(vmip) {
+		if ip.Family(address) == family {
+			return address
+		}
 	}
 
 	return ""
 }
+
+// GetAddresses returns the assigned addresses of the vmip, the primary address comes first.
+func GetAddresses(vmip *v1alpha2.VirtualMachineIPAddress) []string {
+	return ip.Addresses(vmip)
+}
+
+// GetAddress returns the assigned address of the IP family.
+func GetAddress(vmip *v1alpha2.VirtualMachineIPAddress, family corev1.IPFamily) string {
+	for _, address := range GetAddresses(vmip) {
+		if ip.Family(address) == family {
+			return address
+		}
+	}
+
+	return ""
+}
+
+// SetAddress sets the assigned address of its IP family in the vmip status.
+// The first assigned address is the primary one and is duplicated in the status.address field.
+func SetAddress(vmip *v1alpha2.VirtualMachineIPAddress, address string) {
+	addresses := slices.Clone(GetAddresses(vmip))
+
+	family := ip.Family(address)
+	i := slices.IndexFunc(addresses, func(a string) bool {
+		return ip.Family(a) == family
+	})
+	if i >= 0 {
+		addresses[i] = address
+	} else {
+		addresses = append(addresses, address)
+	}
+
+	vmip.Status.Addresses = addresses
+	vmip.Status.Address = addresses[0]
+}
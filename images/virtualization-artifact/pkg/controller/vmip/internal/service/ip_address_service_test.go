@@ -21,8 +21,10 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/deckhouse/virtualization-controller/pkg/common/ip"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 )
 
 var _ = Describe("IsInsideOfRange", func() {
@@ -74,7 +76,7 @@ var _ = Describe("AllocateNewIP", func() {
 
 	Context("when there are available IP addresses in the range", func() {
 		It("should allocate a new IP address", func() {
-			ip, err := ipService.AllocateNewIP(allocatedIPs)
+			ip, err := ipService.AllocateNewIP(allocatedIPs, corev1.IPv4Protocol)
 			Expect(err).To(BeNil())
 			Expect(ip).ToNot(BeEmpty())
 			Expect(netip.MustParseAddr(ip).IsValid()).To(BeTrue())
@@ -86,8 +88,83 @@ var _ = Describe("AllocateNewIP", func() {
 			virtualMachineCIDRs := []string{"192.168.1.0/31"}
 			ipService, err := NewIPAddressService(virtualMachineCIDRs, nil, nil)
 			Expect(err).To(BeNil())
-			_, err = ipService.AllocateNewIP(allocatedIPs)
+			_, err = ipService.AllocateNewIP(allocatedIPs, corev1.IPv4Protocol)
 			Expect(err).To(MatchError("no remaining ips"))
 		})
 	})
 })
+
+var _ = Describe("Dual-stack", func() {
+	var ipService *IPAddressService
+
+	BeforeEach(func() {
+		var err error
+		ipService, err = NewIPAddressService([]string{"fd00::/120", "192.168.1.0/24"}, nil, nil)
+		Expect(err).To(BeNil())
+	})
+
+	It("should allocate an IP address of the requested family", func() {
+		address, err := ipService.AllocateNewIP(ip.AllocatedIPs{"fd00::1": {}}, corev1.IPv6Protocol)
+		Expect(err).To(BeNil())
+		Expect(address).To(Equal("fd00::2"))
+		Expect(ipService.IsInsideOfRange(address)).To(Succeed())
+
+		address, err = ipService.AllocateNewIP(nil, corev1.IPv4Protocol)
+		Expect(err).To(BeNil())
+		Expect(address).To(Equal("192.168.1.1"))
+	})
+
+	DescribeTable("Families",
+		func(cidrs []string, spec v1alpha2.VirtualMachineIPAddressSpec, expected []corev1.IPFamily, expectErr bool) {
+			svc, err := NewIPAddressService(cidrs, nil, nil)
+			Expect(err).To(BeNil())
+
+			families, err := svc.Families(&v1alpha2.VirtualMachineIPAddress{Spec: spec})
+			if expectErr {
+				Expect(err).To(MatchError(ErrIPFamilyNotAvailable))
+				return
+			}
+			Expect(err).To(BeNil())
+			Expect(families).To(Equal(expected))
+		},
+		Entry("single-stack uses the family of the first CIDR",
+			[]string{"fd00::/120", "192.168.1.0/24"},
+			v1alpha2.VirtualMachineIPAddressSpec{Type: v1alpha2.VirtualMachineIPAddressTypeAuto},
+			[]corev1.IPFamily{corev1.IPv6Protocol}, false,
+		),
+		Entry("prefer dual-stack with both families",
+			[]string{"192.168.1.0/24", "fd00::/120"},
+			v1alpha2.VirtualMachineIPAddressSpec{Type: v1alpha2.VirtualMachineIPAddressTypeAuto, IPFamilyPolicy: v1alpha2.VirtualMachineIPAddressFamilyPolicyPreferDualStack},
+			[]corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}, false,
+		),
+		Entry("prefer dual-stack with a single family",
+			[]string{"192.168.1.0/24"},
+			v1alpha2.VirtualMachineIPAddressSpec{Type: v1alpha2.VirtualMachineIPAddressTypeAuto, IPFamilyPolicy: v1alpha2.VirtualMachineIPAddressFamilyPolicyPreferDualStack},
+			[]corev1.IPFamily{corev1.IPv4Protocol}, false,
+		),
+		Entry("require dual-stack with a single family",
+			[]string{"192.168.1.0/24"},
+			v1alpha2.VirtualMachineIPAddressSpec{Type: v1alpha2.VirtualMachineIPAddressTypeAuto, IPFamilyPolicy: v1alpha2.VirtualMachineIPAddressFamilyPolicyRequireDualStack},
+			nil, true,
+		),
+		Entry("static uses the families of the requested addresses",
+			[]string{"192.168.1.0/24"},
+			v1alpha2.VirtualMachineIPAddressSpec{Type: v1alpha2.VirtualMachineIPAddressTypeStatic, StaticIPs: []string{"fd00::10", "192.168.1.10"}},
+			[]corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol}, false,
+		),
+	)
+
+	It("should keep the primary address first in the status", func() {
+		vmip := &v1alpha2.VirtualMachineIPAddress{
+			Status: v1alpha2.VirtualMachineIPAddressStatus{Address: "192.168.1.10"},
+		}
+
+		SetAddress(vmip, "fd00::10")
+		Expect(vmip.Status.Addresses).To(Equal([]string{"192.168.1.10", "fd00::10"}))
+		Expect(vmip.Status.Address).To(Equal("192.168.1.10"))
+		Expect(GetAddress(vmip, corev1.IPv6Protocol)).To(Equal("fd00::10"))
+
+		SetAddress(vmip, "192.168.1.10")
+		Expect(vmip.Status.Addresses).To(Equal([]string{"192.168.1.10", "fd00::10"}))
+	})
+})
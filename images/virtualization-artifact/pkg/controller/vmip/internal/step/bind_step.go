@@ -49,7 +49,7 @@ func NewBindStep(
 func (s BindStep) Take(_ context.Context, vmip *v1alpha2.VirtualMachineIPAddress) (*reconcile.Result, error) {
 	// 1. The required Lease already exists; set its address in the vmip status.
 	if s.lease != nil {
-		vmip.Status.Address = ip.LeaseNameToIP(s.lease.Name)
+		intsvc.SetAddress(vmip, ip.LeaseNameToIP(s.lease.Name))
 	}
 
 	// 2. The vmip can be Bound only if the assigned Lease has a fully populated and matching reference.
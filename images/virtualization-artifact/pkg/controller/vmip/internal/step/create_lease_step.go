@@ -41,12 +41,13 @@ import (
 
 type Allocator interface {
 	GetAllocatedIPs(ctx context.Context) (ip.AllocatedIPs, error)
-	AllocateNewIP(allocatedIPs ip.AllocatedIPs) (string, error)
+	AllocateNewIP(allocatedIPs ip.AllocatedIPs, family corev1.IPFamily) (string, error)
 	IsInsideOfRange(address string) error
 }
 
 type CreateLeaseStep struct {
 	lease     *v1alpha2.VirtualMachineIPAddressLease
+	family    corev1.IPFamily
 	allocator Allocator
 	client    client.Client
 	cb        *conditions.ConditionBuilder
@@ -55,6 +56,7 @@ type CreateLeaseStep struct {
 
 func NewCreateLeaseStep(
 	lease *v1alpha2.VirtualMachineIPAddressLease,
+	family corev1.IPFamily,
 	allocator Allocator,
 	client client.Client,
 	cb *conditions.ConditionBuilder,
@@ -62,6 +64,7 @@ func NewCreateLeaseStep(
 ) *CreateLeaseStep {
 	return &CreateLeaseStep{
 		lease:     lease,
+		family:    family,
 		allocator: allocator,
 		client:    client,
 		cb:        cb,
@@ -80,12 +83,12 @@ func (s CreateLeaseStep) Take(ctx context.Context, vmip *v1alpha2.VirtualMachine
 	}
 
 	// 1. Check if IP address has been already allocated but lost.
-	if vmip.Status.Address != "" {
+	if address := intsvc.GetAddress(vmip, s.family); address != "" {
 		s.cb.
 			Status(metav1.ConditionFalse).
 			Reason(vmipcondition.VirtualMachineIPAddressLeaseLost).
-			Message(fmt.Sprintf("The VirtualMachineIPAddressLease %q doesn't exist.", ip.IPToLeaseName(vmip.Status.Address)))
-		s.recorder.Event(vmip, corev1.EventTypeWarning, v1alpha2.ReasonFailed, fmt.Sprintf("The VirtualMachineIPAddressLease %q is lost.", ip.IPToLeaseName(vmip.Status.Address)))
+			Message(fmt.Sprintf("The VirtualMachineIPAddressLease %q doesn't exist.", ip.IPToLeaseName(address)))
+		s.recorder.Event(vmip, corev1.EventTypeWarning, v1alpha2.ReasonFailed, fmt.Sprintf("The VirtualMachineIPAddressLease %q is lost.", ip.IPToLeaseName(address)))
 		return &reconcile.Result{}, nil
 	}
 
@@ -102,9 +105,9 @@ func (s CreateLeaseStep) Take(ctx context.Context, vmip *v1alpha2.VirtualMachine
 	// 2. Allocate a new IP address or use the IP address provided in the spec.
 	var ipAddress string
 	if vmip.Spec.Type == v1alpha2.VirtualMachineIPAddressTypeStatic {
-		ipAddress = vmip.Spec.StaticIP
+		ipAddress = intsvc.GetStaticIP(vmip, s.family)
 	} else {
-		ipAddress, err = s.allocator.AllocateNewIP(allocatedIPs)
+		ipAddress, err = s.allocator.AllocateNewIP(allocatedIPs, s.family)
 		if err != nil {
 			err = fmt.Errorf("failed to allocate new IP address: %w", err)
 			s.cb.
@@ -119,7 +122,7 @@ func (s CreateLeaseStep) Take(ctx context.Context, vmip *v1alpha2.VirtualMachine
 	err = s.allocator.IsInsideOfRange(ipAddress)
 	if err != nil {
 		if errors.Is(err, intsvc.ErrIPAddressOutOfRange) {
-			msg := fmt.Sprintf("The IP address %q is out of the valid range.", vmip.Spec.StaticIP)
+			msg := fmt.Sprintf("The IP address %q is out of the valid range.", ipAddress)
 			s.cb.
 				Status(metav1.ConditionFalse).
 				Reason(vmipcondition.VirtualMachineIPAddressIsOutOfTheValidRange).
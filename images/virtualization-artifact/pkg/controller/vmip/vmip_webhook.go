@@ -21,10 +21,15 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"reflect"
+	"slices"
+	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/component-base/featuregate"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
@@ -33,17 +38,19 @@ import (
 	"github.com/deckhouse/virtualization-controller/pkg/common/object"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/vmip/internal/service"
+	"github.com/deckhouse/virtualization-controller/pkg/featuregates"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2/vmipcondition"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2/vmiplcondition"
 )
 
-func NewValidator(log *log.Logger, client client.Client, ipAddressService *service.IPAddressService, virtualMachineCIDRs []string) *Validator {
+func NewValidator(log *log.Logger, client client.Client, ipAddressService *service.IPAddressService, virtualMachineCIDRs []string, featureGate featuregate.FeatureGate) *Validator {
 	return &Validator{
 		log:                 log.With("webhook", "validation"),
 		client:              client,
 		ipService:           ipAddressService,
 		virtualMachineCIDRs: virtualMachineCIDRs,
+		featureGate:         featureGate,
 	}
 }
 
@@ -52,6 +59,7 @@ type Validator struct {
 	client              client.Client
 	ipService           *service.IPAddressService
 	virtualMachineCIDRs []string
+	featureGate         featuregate.FeatureGate
 }
 
 func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
@@ -72,13 +80,18 @@ func (v *Validator) ValidateCreate(ctx context.Context, obj runtime.Object) (adm
 		return nil, err
 	}
 
-	if vmip.Spec.StaticIP != "" {
-		err = v.validateAllocatedIPAddresses(ctx, vmip.Spec.StaticIP)
+	err = v.validateIPv6Allowed(vmip)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, staticIP := range service.GetStaticIPs(vmip) {
+		err = v.validateAllocatedIPAddresses(ctx, staticIP)
 		switch {
 		case err == nil:
 			// OK.
 		case errors.Is(err, service.ErrIPAddressOutOfRange):
-			return nil, fmt.Errorf("the requested address %s is out of the valid range", vmip.Spec.StaticIP)
+			return nil, fmt.Errorf("the requested address %s is out of the valid range", staticIP)
 		default:
 			return nil, err
 		}
@@ -105,11 +118,16 @@ func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.O
 
 	// Forbid spec changes and allow changing metadata and status
 	// if ModuleConfig has no spec.settings.virtualMachineCIDRs field.
-	if oldVmip.Spec != newVmip.Spec {
+	if !reflect.DeepEqual(oldVmip.Spec, newVmip.Spec) {
 		err := v.validateVirtualMachineCIDRsConfigured()
 		if err != nil {
 			return nil, err
 		}
+
+		err = v.validateIPv6Allowed(newVmip)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	err := v.validateSpecFields(newVmip)
@@ -119,13 +137,19 @@ func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.O
 
 	var warnings admission.Warnings
 
-	if newVmip.Spec.StaticIP != "" && oldVmip.Spec.StaticIP != newVmip.Spec.StaticIP {
-		err = v.validateAllocatedIPAddresses(ctx, newVmip.Spec.StaticIP)
+	oldStaticIPs := service.GetStaticIPs(oldVmip)
+	newStaticIPs := service.GetStaticIPs(newVmip)
+	for _, staticIP := range newStaticIPs {
+		if slices.Contains(oldStaticIPs, staticIP) {
+			continue
+		}
+
+		err = v.validateAllocatedIPAddresses(ctx, staticIP)
 		switch {
 		case err == nil:
 			// OK.
 		case errors.Is(err, service.ErrIPAddressOutOfRange):
-			warnings = append(warnings, fmt.Sprintf("The requested address %s is out of the valid range", newVmip.Spec.StaticIP))
+			warnings = append(warnings, fmt.Sprintf("The requested address %s is out of the valid range", staticIP))
 		default:
 			return nil, err
 		}
@@ -134,9 +158,10 @@ func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.O
 	boundCondition, _ := conditions.GetCondition(vmipcondition.BoundType, oldVmip.Status.Conditions)
 	if boundCondition.Status == metav1.ConditionTrue {
 		if oldVmip.Spec.Type == v1alpha2.VirtualMachineIPAddressTypeAuto && newVmip.Spec.Type == v1alpha2.VirtualMachineIPAddressTypeStatic {
-			v.log.Info("Change the VirtualMachineIP address type to 'Auto' from 'Static'", "ipAddress", newVmip.Spec.StaticIP)
-			if newVmip.Spec.StaticIP != newVmip.Status.Address {
-				return nil, fmt.Errorf("only type change Auto->Static is allowed: can't change current IP %s to the specified %s", newVmip.Status.Address, newVmip.Spec.StaticIP)
+			v.log.Info("Change the VirtualMachineIP address type to 'Auto' from 'Static'", "ipAddresses", newStaticIPs)
+			addresses := service.GetAddresses(newVmip)
+			if !slices.Equal(newStaticIPs, addresses) {
+				return nil, fmt.Errorf("only type change Auto->Static is allowed: can't change current IP %s to the specified %s", strings.Join(addresses, ", "), strings.Join(newStaticIPs, ", "))
 			}
 
 			return nil, nil
@@ -146,9 +171,13 @@ func (v *Validator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.O
 			return nil, errors.New("the VirtualMachineIPAddress is in 'Bound' state -> type cannot be changed")
 		}
 
-		if newVmip.Spec.Type == v1alpha2.VirtualMachineIPAddressTypeStatic && oldVmip.Spec.StaticIP != newVmip.Spec.StaticIP {
+		if newVmip.Spec.Type == v1alpha2.VirtualMachineIPAddressTypeStatic && !slices.Equal(oldStaticIPs, newStaticIPs) {
 			return nil, errors.New("the VirtualMachineIPAddress is in 'Bound' state -> static IP cannot be changed")
 		}
+
+		if newVmip.Spec.Type == v1alpha2.VirtualMachineIPAddressTypeAuto && getIPFamilyPolicy(oldVmip) != getIPFamilyPolicy(newVmip) {
+			return nil, errors.New("the VirtualMachineIPAddress is in 'Bound' state -> IP family policy cannot be changed")
+		}
 	}
 
 	return warnings, nil
@@ -163,15 +192,30 @@ func (v *Validator) ValidateDelete(_ context.Context, _ runtime.Object) (admissi
 func (v *Validator) validateSpecFields(vmip *v1alpha2.VirtualMachineIPAddress) error {
 	switch vmip.Spec.Type {
 	case v1alpha2.VirtualMachineIPAddressTypeStatic:
-		if vmip.Spec.StaticIP == "" {
+		staticIPs := service.GetStaticIPs(vmip)
+		if len(staticIPs) == 0 {
 			return errors.New("the 'Static IP' field should be set for the IP address with the 'Static' type")
 		}
 
-		if !isValidAddressFormat(vmip.Spec.StaticIP) {
-			return fmt.Errorf("the specified static IP address %q is not a valid textual representation of an IP address", vmip.Spec.StaticIP)
+		if vmip.Spec.StaticIP != "" && len(vmip.Spec.StaticIPs) > 0 && vmip.Spec.StaticIPs[0] != vmip.Spec.StaticIP {
+			return fmt.Errorf("the specified static IP address %q should be the first of the 'Static IPs'", vmip.Spec.StaticIP)
+		}
+
+		if len(staticIPs) > 2 {
+			return fmt.Errorf("the 'Static IPs' field can contain at most one IPv4 and one IPv6 address, got %d", len(staticIPs))
+		}
+
+		for _, staticIP := range staticIPs {
+			if !isValidAddressFormat(staticIP) {
+				return fmt.Errorf("the specified static IP address %q is not a valid textual representation of an IP address", staticIP)
+			}
+		}
+
+		if len(staticIPs) == 2 && ip.Family(staticIPs[0]) == ip.Family(staticIPs[1]) {
+			return fmt.Errorf("the specified static IP addresses %q and %q should belong to different IP families", staticIPs[0], staticIPs[1])
 		}
 	case v1alpha2.VirtualMachineIPAddressTypeAuto:
-		if vmip.Spec.StaticIP != "" {
+		if vmip.Spec.StaticIP != "" || len(vmip.Spec.StaticIPs) > 0 {
 			return fmt.Errorf("the VirtualMachineIPAddress cannot be created: The 'Static IP' field is set for the %s IP address with the 'Auto' type", vmip.Name)
 		}
 	default:
@@ -218,6 +262,32 @@ func (v *Validator) validateVirtualMachineCIDRsConfigured() error {
 	return nil
 }
 
+func (v *Validator) validateIPv6Allowed(vmip *v1alpha2.VirtualMachineIPAddress) error {
+	if v.featureGate.Enabled(featuregates.IPv6Addresses) {
+		return nil
+	}
+
+	for _, staticIP := range service.GetStaticIPs(vmip) {
+		if ip.Family(staticIP) == corev1.IPv6Protocol {
+			return fmt.Errorf("the static IPv6 address %q requires the %s feature gate", staticIP, featuregates.IPv6Addresses)
+		}
+	}
+
+	if getIPFamilyPolicy(vmip) != v1alpha2.VirtualMachineIPAddressFamilyPolicySingleStack {
+		return fmt.Errorf("the IP family policy %q requires the %s feature gate", vmip.Spec.IPFamilyPolicy, featuregates.IPv6Addresses)
+	}
+
+	return nil
+}
+
+func getIPFamilyPolicy(vmip *v1alpha2.VirtualMachineIPAddress) v1alpha2.VirtualMachineIPAddressFamilyPolicy {
+	if vmip.Spec.IPFamilyPolicy == "" {
+		return v1alpha2.VirtualMachineIPAddressFamilyPolicySingleStack
+	}
+
+	return vmip.Spec.IPFamilyPolicy
+}
+
 func isValidAddressFormat(address string) bool {
 	return net.ParseIP(address) != nil
 }
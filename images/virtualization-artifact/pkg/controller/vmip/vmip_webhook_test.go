@@ -24,6 +24,7 @@ import (
 
 	"github.com/deckhouse/deckhouse/pkg/log"
 	"github.com/deckhouse/virtualization-controller/pkg/controller/vmip/internal/service"
+	"github.com/deckhouse/virtualization-controller/pkg/featuregates"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 )
 
@@ -39,7 +40,7 @@ func TestValidatorValidateCreateRequiresCIDRs(t *testing.T) {
 		if err != nil {
 			t.Fatalf("NewIPAddressService: %v", err)
 		}
-		return NewValidator(log.NewNop(), cli, ipService, virtualMachineCIDRs)
+		return NewValidator(log.NewNop(), cli, ipService, virtualMachineCIDRs, featuregates.Default())
 	}
 
 	vmip := &v1alpha2.VirtualMachineIPAddress{Spec: v1alpha2.VirtualMachineIPAddressSpec{Type: v1alpha2.VirtualMachineIPAddressTypeAuto}}
@@ -50,3 +51,120 @@ func TestValidatorValidateCreateRequiresCIDRs(t *testing.T) {
 		t.Fatalf("expected success with CIDRs, got: %v", err)
 	}
 }
+
+func TestValidatorValidateCreateStaticIPs(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := v1alpha2.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme v1alpha2: %v", err)
+	}
+
+	virtualMachineCIDRs := []string{"10.0.0.0/24", "fd00::/120"}
+	cli := fake.NewClientBuilder().WithScheme(scheme).Build()
+	ipService, err := service.NewIPAddressService(virtualMachineCIDRs, cli, nil)
+	if err != nil {
+		t.Fatalf("NewIPAddressService: %v", err)
+	}
+	gate, setFromMap, err := featuregates.NewUnlocked()
+	if err != nil {
+		t.Fatalf("NewUnlocked: %v", err)
+	}
+	if err = setFromMap(map[string]bool{string(featuregates.IPv6Addresses): true}); err != nil {
+		t.Fatalf("setFromMap: %v", err)
+	}
+	validator := NewValidator(log.NewNop(), cli, ipService, virtualMachineCIDRs, gate)
+
+	tests := []struct {
+		name      string
+		spec      v1alpha2.VirtualMachineIPAddressSpec
+		expectErr bool
+	}{
+		{
+			name: "dual-stack static addresses",
+			spec: v1alpha2.VirtualMachineIPAddressSpec{Type: v1alpha2.VirtualMachineIPAddressTypeStatic, StaticIP: "10.0.0.10", StaticIPs: []string{"10.0.0.10", "fd00::10"}},
+		},
+		{
+			name: "static IPv6 address",
+			spec: v1alpha2.VirtualMachineIPAddressSpec{Type: v1alpha2.VirtualMachineIPAddressTypeStatic, StaticIPs: []string{"fd00::10"}},
+		},
+		{
+			name:      "static addresses of the same family",
+			spec:      v1alpha2.VirtualMachineIPAddressSpec{Type: v1alpha2.VirtualMachineIPAddressTypeStatic, StaticIPs: []string{"10.0.0.10", "10.0.0.11"}},
+			expectErr: true,
+		},
+		{
+			name:      "static IP is not the first of the static addresses",
+			spec:      v1alpha2.VirtualMachineIPAddressSpec{Type: v1alpha2.VirtualMachineIPAddressTypeStatic, StaticIP: "10.0.0.10", StaticIPs: []string{"fd00::10", "10.0.0.10"}},
+			expectErr: true,
+		},
+		{
+			name:      "static addresses out of range",
+			spec:      v1alpha2.VirtualMachineIPAddressSpec{Type: v1alpha2.VirtualMachineIPAddressTypeStatic, StaticIPs: []string{"10.0.0.10", "fd01::10"}},
+			expectErr: true,
+		},
+		{
+			name:      "static addresses with the Auto type",
+			spec:      v1alpha2.VirtualMachineIPAddressSpec{Type: v1alpha2.VirtualMachineIPAddressTypeAuto, StaticIPs: []string{"fd00::10"}},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validator.ValidateCreate(t.Context(), &v1alpha2.VirtualMachineIPAddress{Spec: tt.spec})
+			if tt.expectErr && err == nil {
+				t.Fatalf("expected error")
+			}
+			if !tt.expectErr && err != nil {
+				t.Fatalf("expected success, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidatorValidateIPv6RequiresFeatureGate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := v1alpha2.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme v1alpha2: %v", err)
+	}
+
+	virtualMachineCIDRs := []string{"10.0.0.0/24", "fd00::/120"}
+	cli := fake.NewClientBuilder().WithScheme(scheme).Build()
+	ipService, err := service.NewIPAddressService(virtualMachineCIDRs, cli, nil)
+	if err != nil {
+		t.Fatalf("NewIPAddressService: %v", err)
+	}
+	validator := NewValidator(log.NewNop(), cli, ipService, virtualMachineCIDRs, featuregates.Default())
+
+	tests := []struct {
+		name      string
+		spec      v1alpha2.VirtualMachineIPAddressSpec
+		expectErr bool
+	}{
+		{
+			name: "static IPv4 address",
+			spec: v1alpha2.VirtualMachineIPAddressSpec{Type: v1alpha2.VirtualMachineIPAddressTypeStatic, StaticIP: "10.0.0.10"},
+		},
+		{
+			name:      "static IPv6 address",
+			spec:      v1alpha2.VirtualMachineIPAddressSpec{Type: v1alpha2.VirtualMachineIPAddressTypeStatic, StaticIPs: []string{"fd00::10"}},
+			expectErr: true,
+		},
+		{
+			name:      "dual-stack auto addresses",
+			spec:      v1alpha2.VirtualMachineIPAddressSpec{Type: v1alpha2.VirtualMachineIPAddressTypeAuto, IPFamilyPolicy: v1alpha2.VirtualMachineIPAddressFamilyPolicyPreferDualStack},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validator.ValidateCreate(t.Context(), &v1alpha2.VirtualMachineIPAddress{Spec: tt.spec})
+			if tt.expectErr && err == nil {
+				t.Fatalf("expected error")
+			}
+			if !tt.expectErr && err != nil {
+				t.Fatalf("expected success, got: %v", err)
+			}
+		})
+	}
+}
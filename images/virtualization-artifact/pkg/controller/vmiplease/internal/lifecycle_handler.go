@@ -19,6 +19,7 @@ package internal
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -103,7 +104,9 @@ func isBound(lease *v1alpha2.VirtualMachineIPAddressLease, vmip *v1alpha2.Virtua
 		return false
 	}
 
-	if vmip.Status.Address != "" && vmip.Status.Address != ip.LeaseNameToIP(lease.Name) {
+	// The vmip has a lease per IP family, and the lease must hold one of its addresses.
+	addresses := ip.Addresses(vmip)
+	if len(addresses) > 0 && !slices.Contains(addresses, ip.LeaseNameToIP(lease.Name)) {
 		return false
 	}
 
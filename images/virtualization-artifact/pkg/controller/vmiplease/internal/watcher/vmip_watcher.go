@@ -66,8 +66,8 @@ func (w VirtualMachineIPAddressWatcher) Watch(mgr manager.Manager, ctr controlle
 func (w VirtualMachineIPAddressWatcher) enqueueRequests(ctx context.Context, vmip *v1alpha2.VirtualMachineIPAddress) (requests []reconcile.Request) {
 	requestMap := make(map[string]struct{})
 
-	if vmip.Status.Address != "" {
-		leaseName := ip.IPToLeaseName(vmip.Status.Address)
+	for _, address := range append([]string{vmip.Status.Address}, vmip.Status.Addresses...) {
+		leaseName := ip.IPToLeaseName(address)
 		if leaseName != "" {
 			requestMap[leaseName] = struct{}{}
 		}
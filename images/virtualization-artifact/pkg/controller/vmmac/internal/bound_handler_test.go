@@ -83,7 +83,7 @@ var _ = Describe("BoundHandler", func() {
 			GetAllocatedAddressesFunc: func(ctx context.Context) (mac.AllocatedMACs, error) {
 				return nil, nil
 			},
-			AllocateNewAddressFunc: func(_ mac.AllocatedMACs) (string, error) {
+			AllocateNewAddressFunc: func(_ context.Context, _ *v1alpha2.VirtualMachineMACAddress, _ mac.AllocatedMACs) (string, error) {
 				return macAddress, nil
 			},
 		}
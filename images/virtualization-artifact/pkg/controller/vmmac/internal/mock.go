@@ -20,7 +20,7 @@ var _ MACAddressService = &MACAddressServiceMock{}
 //
 //		// make and configure a mocked MACAddressService
 //		mockedMACAddressService := &MACAddressServiceMock{
-//			AllocateNewAddressFunc: func(allocatedMACs mac.AllocatedMACs) (string, error) {
+//			AllocateNewAddressFunc: func(ctx context.Context, vmmac *v1alpha2.VirtualMachineMACAddress, allocatedMACs mac.AllocatedMACs) (string, error) {
 //				panic("mock out the AllocateNewAddress method")
 //			},
 //			GetAllocatedAddressesFunc: func(ctx context.Context) (mac.AllocatedMACs, error) {
@@ -37,7 +37,7 @@ var _ MACAddressService = &MACAddressServiceMock{}
 //	}
 type MACAddressServiceMock struct {
 	// AllocateNewAddressFunc mocks the AllocateNewAddress method.
-	AllocateNewAddressFunc func(allocatedMACs mac.AllocatedMACs) (string, error)
+	AllocateNewAddressFunc func(ctx context.Context, vmmac *v1alpha2.VirtualMachineMACAddress, allocatedMACs mac.AllocatedMACs) (string, error)
 
 	// GetAllocatedAddressesFunc mocks the GetAllocatedAddresses method.
 	GetAllocatedAddressesFunc func(ctx context.Context) (mac.AllocatedMACs, error)
@@ -49,6 +49,10 @@ type MACAddressServiceMock struct {
 	calls struct {
 		// AllocateNewAddress holds details about calls to the AllocateNewAddress method.
 		AllocateNewAddress []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Vmmac is the vmmac argument value.
+			Vmmac *v1alpha2.VirtualMachineMACAddress
 			// AllocatedMACs is the allocatedMACs argument value.
 			AllocatedMACs mac.AllocatedMACs
 		}
@@ -71,19 +75,23 @@ type MACAddressServiceMock struct {
 }
 
 // AllocateNewAddress calls AllocateNewAddressFunc.
-func (mock *MACAddressServiceMock) AllocateNewAddress(allocatedMACs mac.AllocatedMACs) (string, error) {
+func (mock *MACAddressServiceMock) AllocateNewAddress(ctx context.Context, vmmac *v1alpha2.VirtualMachineMACAddress, allocatedMACs mac.AllocatedMACs) (string, error) {
 	if mock.AllocateNewAddressFunc == nil {
 		panic("MACAddressServiceMock.AllocateNewAddressFunc: method is nil but MACAddressService.AllocateNewAddress was just called")
 	}
 	callInfo := struct {
+		Ctx           context.Context
+		Vmmac         *v1alpha2.VirtualMachineMACAddress
 		AllocatedMACs mac.AllocatedMACs
 	}{
+		Ctx:           ctx,
+		Vmmac:         vmmac,
 		AllocatedMACs: allocatedMACs,
 	}
 	mock.lockAllocateNewAddress.Lock()
 	mock.calls.AllocateNewAddress = append(mock.calls.AllocateNewAddress, callInfo)
 	mock.lockAllocateNewAddress.Unlock()
-	return mock.AllocateNewAddressFunc(allocatedMACs)
+	return mock.AllocateNewAddressFunc(ctx, vmmac, allocatedMACs)
 }
 
 // AllocateNewAddressCalls gets all the calls that were made to AllocateNewAddress.
@@ -91,9 +99,13 @@ func (mock *MACAddressServiceMock) AllocateNewAddress(allocatedMACs mac.Allocate
 //
 //	len(mockedMACAddressService.AllocateNewAddressCalls())
 func (mock *MACAddressServiceMock) AllocateNewAddressCalls() []struct {
+	Ctx           context.Context
+	Vmmac         *v1alpha2.VirtualMachineMACAddress
 	AllocatedMACs mac.AllocatedMACs
 } {
 	var calls []struct {
+		Ctx           context.Context
+		Vmmac         *v1alpha2.VirtualMachineMACAddress
 		AllocatedMACs mac.AllocatedMACs
 	}
 	mock.lockAllocateNewAddress.RLock()
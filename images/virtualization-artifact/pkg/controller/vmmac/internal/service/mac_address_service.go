@@ -22,9 +22,11 @@ import (
 	"fmt"
 	"math/rand"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -33,6 +35,7 @@ import (
 
 	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
 	"github.com/deckhouse/virtualization-controller/pkg/common/mac"
+	"github.com/deckhouse/virtualization-controller/pkg/common/network"
 	"github.com/deckhouse/virtualization-controller/pkg/common/object"
 	"github.com/deckhouse/virtualization-controller/pkg/logger"
 	"github.com/deckhouse/virtualization/api/client/kubeclient"
@@ -135,7 +138,28 @@ func formatOUI(prefix string) (string, error) {
 	return fmt.Sprintf("%s:%s:%s", matches[0], matches[1], matches[2]), nil
 }
 
-func (s MACAddressService) AllocateNewAddress(allocatedMACs mac.AllocatedMACs) (string, error) {
+// AllocateNewAddress allocates a free address for the VirtualMachineMACAddress from the MACAddressPool applied to it,
+// or from the OUI derived from the cluster UUID if no pool applies.
+func (s MACAddressService) AllocateNewAddress(ctx context.Context, vmmac *v1alpha2.VirtualMachineMACAddress, allocatedMACs mac.AllocatedMACs) (string, error) {
+	pool, err := s.findPool(ctx, vmmac)
+	if err != nil {
+		return "", err
+	}
+
+	if pool != nil {
+		p, err := mac.NewPool(pool.Spec.OUI, pool.Spec.Ranges)
+		if err != nil {
+			return "", fmt.Errorf("MACAddressPool %q: %w", pool.Name, err)
+		}
+
+		address, err := p.Allocate(allocatedMACs)
+		if err != nil {
+			return "", fmt.Errorf("MACAddressPool %q: %w", pool.Name, err)
+		}
+
+		return address, nil
+	}
+
 	prefix, err := formatOUI(s.oui)
 	if err != nil {
 		return "", err
@@ -156,6 +180,75 @@ func (s MACAddressService) AllocateNewAddress(allocatedMACs mac.AllocatedMACs) (
 	return "", errors.New("no remaining MAC addresses")
 }
 
+// findPool returns the MACAddressPool applied to the VirtualMachineMACAddress, or nil if no pool applies.
+// A pool bound to the network of the address takes precedence over a pool bound only to the namespaces,
+// which in turn takes precedence over a pool applied to all namespaces. Pools of the same precedence are ordered by name.
+func (s MACAddressService) findPool(ctx context.Context, vmmac *v1alpha2.VirtualMachineMACAddress) (*v1alpha2.MACAddressPool, error) {
+	var pools v1alpha2.MACAddressPoolList
+	err := s.client.List(ctx, &pools)
+	if err != nil {
+		return nil, fmt.Errorf("list mac address pools: %w", err)
+	}
+
+	if len(pools.Items) == 0 {
+		return nil, nil
+	}
+
+	ns, err := object.FetchObject(ctx, types.NamespacedName{Name: vmmac.Namespace}, s.client, &corev1.Namespace{})
+	if err != nil {
+		return nil, fmt.Errorf("fetch namespace: %w", err)
+	}
+
+	var nsLabels labels.Set
+	if ns != nil {
+		nsLabels = ns.Labels
+	}
+	networkKey := vmmac.GetAnnotations()[annotations.AnnMACAddressNetwork]
+
+	var (
+		found     *v1alpha2.MACAddressPool
+		bestScore int
+	)
+	for i := range pools.Items {
+		pool := &pools.Items[i]
+
+		score, ok := poolScore(pool, nsLabels, networkKey)
+		if !ok {
+			continue
+		}
+
+		if found == nil || score > bestScore || (score == bestScore && pool.Name < found.Name) {
+			found, bestScore = pool, score
+		}
+	}
+
+	return found, nil
+}
+
+// poolScore reports whether the pool applies to the namespace with the labels and the network, and how specific the pool is.
+func poolScore(pool *v1alpha2.MACAddressPool, nsLabels labels.Set, networkKey string) (int, bool) {
+	var score int
+
+	if pool.Spec.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(pool.Spec.NamespaceSelector)
+		if err != nil || !selector.Matches(nsLabels) {
+			return 0, false
+		}
+		score++
+	}
+
+	if len(pool.Spec.Networks) > 0 {
+		if !slices.ContainsFunc(pool.Spec.Networks, func(n v1alpha2.MACAddressPoolNetwork) bool {
+			return network.SpecKey(v1alpha2.NetworksSpec{Type: n.Type, Name: n.Name}) == networkKey
+		}) {
+			return 0, false
+		}
+		score += 2
+	}
+
+	return score, true
+}
+
 func (s MACAddressService) GetAllocatedAddresses(ctx context.Context) (mac.AllocatedMACs, error) {
 	var leases v1alpha2.VirtualMachineMACAddressLeaseList
 
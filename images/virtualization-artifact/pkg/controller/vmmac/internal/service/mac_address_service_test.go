@@ -17,22 +17,42 @@ limitations under the License.
 package service
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
 	"github.com/deckhouse/virtualization-controller/pkg/common/mac"
+	"github.com/deckhouse/virtualization-controller/pkg/common/testutil"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 )
 
 var _ = Describe("MACAddressService", func() {
 	var (
+		ctx           context.Context
 		service       *MACAddressService
 		allocatedMACs mac.AllocatedMACs
+		vmmac         *v1alpha2.VirtualMachineMACAddress
 	)
 
+	newService := func(objs ...client.Object) *MACAddressService {
+		GinkgoHelper()
+		fakeClient, err := testutil.NewFakeClientWithObjects(objs...)
+		Expect(err).NotTo(HaveOccurred())
+		return NewMACAddressService("17eb5ee6-192c-4048-b79e-a21eaf8b7121", fakeClient, nil)
+	}
+
 	BeforeEach(func() {
+		ctx = testutil.ContextBackgroundWithNoOpLogger()
 		allocatedMACs = make(mac.AllocatedMACs)
-		service = NewMACAddressService("17eb5ee6-192c-4048-b79e-a21eaf8b7121", nil, nil)
+		service = newService()
+		vmmac = &v1alpha2.VirtualMachineMACAddress{
+			ObjectMeta: metav1.ObjectMeta{Name: "vmmac", Namespace: "team-a"},
+		}
 	})
 
 	Context("generateOUI", func() {
@@ -96,10 +116,71 @@ var _ = Describe("MACAddressService", func() {
 
 		Context("AllocateNewAddress", func() {
 			It("should allocate a new unique MAC address with format oui xx-xx-xx-xx", func() {
-				address, err := service.AllocateNewAddress(allocatedMACs)
+				address, err := service.AllocateNewAddress(ctx, vmmac, allocatedMACs)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(address).To(HavePrefix("5e:e6:19"))
 			})
+
+			Context("with MAC address pools", func() {
+				newPool := func(name, oui string, mutate func(spec *v1alpha2.MACAddressPoolSpec)) *v1alpha2.MACAddressPool {
+					pool := &v1alpha2.MACAddressPool{
+						ObjectMeta: metav1.ObjectMeta{Name: name},
+						Spec:       v1alpha2.MACAddressPoolSpec{OUI: oui},
+					}
+					if mutate != nil {
+						mutate(&pool.Spec)
+					}
+					return pool
+				}
+
+				namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+					Name:   "team-a",
+					Labels: map[string]string{"team": "a"},
+				}}
+
+				It("should allocate from the pool applied to all namespaces", func() {
+					service = newService(namespace, newPool("default", "00:1a:2b", nil))
+
+					address, err := service.AllocateNewAddress(ctx, vmmac, allocatedMACs)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(address).To(HavePrefix("00:1a:2b:"))
+				})
+
+				It("should prefer the pool bound to the namespace and then to the network", func() {
+					byNamespace := func(spec *v1alpha2.MACAddressPoolSpec) {
+						spec.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+					}
+					service = newService(namespace,
+						newPool("default", "00:1a:2b", nil),
+						newPool("team-a", "00:1a:2c", byNamespace),
+						newPool("team-b", "00:1a:2d", func(spec *v1alpha2.MACAddressPoolSpec) {
+							spec.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}}
+						}),
+						newPool("corp-net", "00:1a:2e", func(spec *v1alpha2.MACAddressPoolSpec) {
+							spec.Networks = []v1alpha2.MACAddressPoolNetwork{{Type: v1alpha2.NetworksTypeClusterNetwork, Name: "corp-net"}}
+						}),
+					)
+
+					address, err := service.AllocateNewAddress(ctx, vmmac, allocatedMACs)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(address).To(HavePrefix("00:1a:2c:"))
+
+					vmmac.Annotations = map[string]string{annotations.AnnMACAddressNetwork: "ClusterNetwork/corp-net"}
+					address, err = service.AllocateNewAddress(ctx, vmmac, allocatedMACs)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(address).To(HavePrefix("00:1a:2e:"))
+				})
+
+				It("should fail when the pool is exhausted", func() {
+					service = newService(namespace, newPool("small", "00:1a:2b", func(spec *v1alpha2.MACAddressPoolSpec) {
+						spec.Ranges = []v1alpha2.MACAddressPoolRange{{Start: "00:00:01", End: "00:00:01"}}
+					}))
+					allocatedMACs["00:1a:2b:00:00:01"] = &v1alpha2.VirtualMachineMACAddressLease{}
+
+					_, err := service.AllocateNewAddress(ctx, vmmac, allocatedMACs)
+					Expect(err).To(MatchError(mac.ErrPoolExhausted))
+				})
+			})
 		})
 	})
 })
@@ -39,7 +39,7 @@ import (
 
 type Allocator interface {
 	GetAllocatedAddresses(ctx context.Context) (mac.AllocatedMACs, error)
-	AllocateNewAddress(allocatedMACs mac.AllocatedMACs) (string, error)
+	AllocateNewAddress(ctx context.Context, vmmac *v1alpha2.VirtualMachineMACAddress, allocatedMACs mac.AllocatedMACs) (string, error)
 }
 
 type CreateLeaseStep struct {
@@ -92,7 +92,7 @@ func (s CreateLeaseStep) Take(ctx context.Context, vmmac *v1alpha2.VirtualMachin
 	if vmmac.Spec.Address != "" {
 		macAddress = vmmac.Spec.Address
 	} else {
-		macAddress, err = s.allocator.AllocateNewAddress(allocatedAddresses)
+		macAddress, err = s.allocator.AllocateNewAddress(ctx, vmmac, allocatedAddresses)
 		if err != nil {
 			err = fmt.Errorf("failed to allocate new MAC address: %w", err)
 			s.cb.
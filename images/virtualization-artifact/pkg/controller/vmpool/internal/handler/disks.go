@@ -79,8 +79,9 @@ func (h *DisksHandler) Handle(ctx context.Context, pool *v1alpha2.VirtualMachine
 		return reconcile.Result{}, errs
 	}
 
-	// Grow existing disks to the template's requested size (increase only).
-	if err := h.reconcileDiskSizes(ctx, pool); err != nil {
+	// Grow existing disks to the template's requested size (increase only) and
+	// follow the template's update policy.
+	if err := h.reconcileDiskSpecs(ctx, pool); err != nil {
 		errs = errors.Join(errs, err)
 	}
 
@@ -212,17 +213,17 @@ func (h *DisksHandler) pruneRemovedTemplates(ctx context.Context, pool *v1alpha2
 	return errs
 }
 
-// reconcileDiskSizes grows every managed disk of a still-present template to the
-// template's requested size. Increase only: storage cannot shrink, so a template
-// size smaller than an existing disk is ignored.
-func (h *DisksHandler) reconcileDiskSizes(ctx context.Context, pool *v1alpha2.VirtualMachinePool) error {
+// reconcileDiskSpecs applies the mutable part of the template spec to every
+// managed disk of a still-present template:
+//   - the size grows to the template's requested size. Increase only: storage
+//     cannot shrink, so a template size smaller than an existing disk is ignored;
+//   - the update policy follows the template, so switching a template to
+//     RecreateOnStop rebuilds the disks of existing members on their next stop.
+func (h *DisksHandler) reconcileDiskSpecs(ctx context.Context, pool *v1alpha2.VirtualMachinePool) error {
 	var errs error
 	for i := range pool.Spec.VirtualDiskTemplates {
 		diskTemplate := pool.Spec.VirtualDiskTemplates[i]
 		want := diskTemplate.Spec.PersistentVolumeClaim.Size
-		if want == nil {
-			continue
-		}
 		var list v1alpha2.VirtualDiskList
 		if err := h.client.List(ctx, &list,
 			client.InNamespace(pool.GetNamespace()),
@@ -233,15 +234,24 @@ func (h *DisksHandler) reconcileDiskSizes(ctx context.Context, pool *v1alpha2.Vi
 		}
 		for i := range list.Items {
 			d := &list.Items[i]
-			if have := d.Spec.PersistentVolumeClaim.Size; have != nil && want.Cmp(*have) <= 0 {
-				continue // already at or above the requested size
-			}
 			patched := d.DeepCopy()
-			size := want.DeepCopy()
-			patched.Spec.PersistentVolumeClaim.Size = &size
-			logf.FromContext(ctx).Info("resizing disk", "disk", d.Name, "diskTemplate", diskTemplate.Name, "to", want.String())
+			changed := false
+			if have := d.Spec.PersistentVolumeClaim.Size; want != nil && (have == nil || want.Cmp(*have) > 0) {
+				size := want.DeepCopy()
+				patched.Spec.PersistentVolumeClaim.Size = &size
+				changed = true
+				logf.FromContext(ctx).Info("resizing disk", "disk", d.Name, "diskTemplate", diskTemplate.Name, "to", want.String())
+			}
+			if d.Spec.UpdatePolicy != diskTemplate.Spec.UpdatePolicy {
+				patched.Spec.UpdatePolicy = diskTemplate.Spec.UpdatePolicy
+				changed = true
+				logf.FromContext(ctx).Info("changing disk update policy", "disk", d.Name, "diskTemplate", diskTemplate.Name, "to", diskTemplate.Spec.UpdatePolicy)
+			}
+			if !changed {
+				continue
+			}
 			if err := h.client.Update(ctx, patched); err != nil {
-				errs = errors.Join(errs, fmt.Errorf("resize disk %s: %w", d.Name, err))
+				errs = errors.Join(errs, fmt.Errorf("update disk %s: %w", d.Name, err))
 			}
 		}
 	}
@@ -488,6 +488,29 @@ var _ = Describe("DisksHandler", func() {
 		})
 	})
 
+	Context("disk update policy", func() {
+		It("applies the template's update policy to existing disks", func() {
+			pool := newPool(1)
+			pool.Spec.VirtualDiskTemplates = []v1alpha2.VirtualDiskTemplateSpec{{
+				Name:    "system",
+				Reclaim: v1alpha2.VirtualDiskReclaim{OnScaleDown: v1alpha2.VirtualDiskReclaimDelete},
+				Spec:    v1alpha2.VirtualDiskSpec{UpdatePolicy: v1alpha2.VirtualDiskUpdatePolicyRecreateOnStop},
+			}}
+			m := newMemberVM(pool, "web-a", v1alpha2.MachineRunning, referenceTime, false)
+			m.Spec.BlockDeviceRefs = []v1alpha2.BlockDeviceSpecRef{{Kind: v1alpha2.DiskDevice, Name: "web-a-system"}}
+			disk := labeledDisk(pool, "web-a-system", "system")
+			c, err := testutil.NewFakeClientWithObjects(pool, m, disk)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = NewDisksHandler(c).Handle(ctx, pool)
+			Expect(err).NotTo(HaveOccurred())
+
+			vd, ok := diskExists(ctx, c, "web-a-system")
+			Expect(ok).To(BeTrue())
+			Expect(vd.Spec.UpdatePolicy).To(Equal(v1alpha2.VirtualDiskUpdatePolicyRecreateOnStop))
+		})
+	})
+
 	Context("removed disk template", func() {
 		It("deletes a free reuse disk whose template was removed from the spec", func() {
 			pool := newPool(0) // spec.virtualDiskTemplates is now empty
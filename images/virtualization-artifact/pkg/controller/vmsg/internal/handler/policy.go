@@ -0,0 +1,174 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"fmt"
+	"net/netip"
+
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+// launcherAppLabelValue is the value of the kubevirt.io label of the virt-launcher pods.
+// Labels of a virtual machine are propagated to its virt-launcher pods, so a selector of
+// virtual machines becomes a selector of their pods once restricted to virt-launcher pods.
+const launcherAppLabelValue = "virt-launcher"
+
+// buildNetworkPolicySpec compiles the rules of the group into a NetworkPolicy spec
+// for the virt-launcher pods of the selected virtual machines.
+func buildNetworkPolicySpec(sg *v1alpha2.VirtualMachineSecurityGroup) (netv1.NetworkPolicySpec, error) {
+	podSelector, err := launcherSelector(&sg.Spec.VirtualMachineSelector)
+	if err != nil {
+		return netv1.NetworkPolicySpec{}, fmt.Errorf("virtualMachineSelector: %w", err)
+	}
+
+	spec := netv1.NetworkPolicySpec{
+		PodSelector: *podSelector,
+		PolicyTypes: policyTypes(sg),
+	}
+
+	for i, rule := range sg.Spec.Ingress {
+		from, err := buildPeers(rule.From)
+		if err != nil {
+			return netv1.NetworkPolicySpec{}, fmt.Errorf("ingress[%d]: %w", i, err)
+		}
+		spec.Ingress = append(spec.Ingress, netv1.NetworkPolicyIngressRule{
+			From:  from,
+			Ports: buildPorts(rule.Ports),
+		})
+	}
+
+	for i, rule := range sg.Spec.Egress {
+		to, err := buildPeers(rule.To)
+		if err != nil {
+			return netv1.NetworkPolicySpec{}, fmt.Errorf("egress[%d]: %w", i, err)
+		}
+		spec.Egress = append(spec.Egress, netv1.NetworkPolicyEgressRule{
+			To:    to,
+			Ports: buildPorts(rule.Ports),
+		})
+	}
+
+	return spec, nil
+}
+
+// policyTypes returns the restricted directions. Without explicit types, the defaults of NetworkPolicy
+// are made explicit: Ingress is restricted always and Egress only if there are egress rules.
+func policyTypes(sg *v1alpha2.VirtualMachineSecurityGroup) []netv1.PolicyType {
+	if len(sg.Spec.PolicyTypes) == 0 {
+		types := []netv1.PolicyType{netv1.PolicyTypeIngress}
+		if len(sg.Spec.Egress) > 0 {
+			types = append(types, netv1.PolicyTypeEgress)
+		}
+		return types
+	}
+
+	types := make([]netv1.PolicyType, 0, len(sg.Spec.PolicyTypes))
+	for _, t := range sg.Spec.PolicyTypes {
+		types = append(types, netv1.PolicyType(t))
+	}
+	return types
+}
+
+func buildPeers(peers []v1alpha2.SecurityGroupPeer) ([]netv1.NetworkPolicyPeer, error) {
+	var result []netv1.NetworkPolicyPeer
+	for i, peer := range peers {
+		var np netv1.NetworkPolicyPeer
+
+		switch {
+		case peer.IPBlock != nil:
+			if _, err := netip.ParsePrefix(peer.IPBlock.CIDR); err != nil {
+				return nil, fmt.Errorf("peer %d: invalid cidr %q", i, peer.IPBlock.CIDR)
+			}
+			for _, except := range peer.IPBlock.Except {
+				if _, err := netip.ParsePrefix(except); err != nil {
+					return nil, fmt.Errorf("peer %d: invalid except cidr %q", i, except)
+				}
+			}
+			np.IPBlock = &netv1.IPBlock{
+				CIDR:   peer.IPBlock.CIDR,
+				Except: peer.IPBlock.Except,
+			}
+		case peer.VirtualMachineSelector == nil && peer.NamespaceSelector == nil:
+			// An empty peer would become an empty NetworkPolicyPeer, which the API server rejects.
+			return nil, fmt.Errorf("peer %d: one of virtualMachineSelector, namespaceSelector or ipBlock must be specified", i)
+		default:
+			if peer.VirtualMachineSelector != nil {
+				selector, err := launcherSelector(peer.VirtualMachineSelector)
+				if err != nil {
+					return nil, fmt.Errorf("peer %d: virtualMachineSelector: %w", i, err)
+				}
+				np.PodSelector = selector
+			}
+			if peer.NamespaceSelector != nil {
+				if _, err := metav1.LabelSelectorAsSelector(peer.NamespaceSelector); err != nil {
+					return nil, fmt.Errorf("peer %d: namespaceSelector: %w", i, err)
+				}
+				np.NamespaceSelector = peer.NamespaceSelector.DeepCopy()
+			}
+		}
+
+		result = append(result, np)
+	}
+
+	return result, nil
+}
+
+func buildPorts(ports []v1alpha2.SecurityGroupPort) []netv1.NetworkPolicyPort {
+	var result []netv1.NetworkPolicyPort
+	for _, port := range ports {
+		protocol := port.Protocol
+		if protocol == "" {
+			protocol = corev1.ProtocolTCP
+		}
+
+		np := netv1.NetworkPolicyPort{
+			Protocol: &protocol,
+			EndPort:  port.EndPort,
+		}
+		if port.Port != nil {
+			p := intstr.FromInt32(*port.Port)
+			np.Port = &p
+		}
+
+		result = append(result, np)
+	}
+
+	return result
+}
+
+// launcherSelector turns a selector of virtual machines into a selector of their virt-launcher pods.
+func launcherSelector(vmSelector *metav1.LabelSelector) (*metav1.LabelSelector, error) {
+	if _, err := metav1.LabelSelectorAsSelector(vmSelector); err != nil {
+		return nil, err
+	}
+
+	selector := vmSelector.DeepCopy()
+	selector.MatchExpressions = append(selector.MatchExpressions, metav1.LabelSelectorRequirement{
+		Key:      virtv1.AppLabel,
+		Operator: metav1.LabelSelectorOpIn,
+		Values:   []string{launcherAppLabelValue},
+	})
+
+	return selector, nil
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/object"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/service"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/supplements"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2/vmsgcondition"
+)
+
+// SyncHandler keeps the NetworkPolicy of the group in line with its rules
+// and reports the virtual machines the group applies to.
+type SyncHandler struct {
+	client client.Client
+}
+
+func NewSyncHandler(client client.Client) *SyncHandler {
+	return &SyncHandler{
+		client: client,
+	}
+}
+
+func (h SyncHandler) Handle(ctx context.Context, sg *v1alpha2.VirtualMachineSecurityGroup) (reconcile.Result, error) {
+	if !sg.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	cb := conditions.NewConditionBuilder(vmsgcondition.ReadyType).Generation(sg.Generation)
+
+	spec, err := buildNetworkPolicySpec(sg)
+	if err != nil {
+		// The last applied NetworkPolicy stays in place: deleting it would open the traffic
+		// of the virtual machines until the group is fixed. The virtual machines it applies
+		// to are kept in the status as well.
+		msg := service.CapitalizeFirstLetter(err.Error()) + "."
+
+		np, err := h.fetchNetworkPolicy(ctx, sg)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if np != nil {
+			msg += " The last applied rules stay in effect for the virtual machines listed in the status."
+		} else {
+			sg.Status.VirtualMachines = nil
+		}
+
+		cb.Status(metav1.ConditionFalse).Reason(vmsgcondition.InvalidSpec).Message(msg)
+		conditions.SetCondition(cb, &sg.Status.Conditions)
+		return reconcile.Result{}, nil
+	}
+
+	err = h.syncNetworkPolicy(ctx, sg, spec)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	vms, err := h.listVirtualMachines(ctx, sg)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	sg.Status.VirtualMachines = vms
+
+	cb.Status(metav1.ConditionTrue).Reason(vmsgcondition.Applied).Message("")
+	conditions.SetCondition(cb, &sg.Status.Conditions)
+
+	return reconcile.Result{}, nil
+}
+
+func (h SyncHandler) syncNetworkPolicy(ctx context.Context, sg *v1alpha2.VirtualMachineSecurityGroup, spec netv1.NetworkPolicySpec) error {
+	name := NetworkPolicyName(sg)
+
+	np, err := h.fetchNetworkPolicy(ctx, sg)
+	if err != nil {
+		return err
+	}
+
+	if np == nil {
+		np = &netv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name.Name,
+				Namespace: name.Namespace,
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(sg, v1alpha2.SchemeGroupVersion.WithKind(v1alpha2.VirtualMachineSecurityGroupKind)),
+				},
+			},
+			Spec: spec,
+		}
+
+		err = h.client.Create(ctx, np)
+		if err != nil {
+			return fmt.Errorf("create network policy: %w", err)
+		}
+
+		return nil
+	}
+
+	if equality.Semantic.DeepEqual(np.Spec, spec) {
+		return nil
+	}
+
+	np.Spec = spec
+	err = h.client.Update(ctx, np)
+	if err != nil {
+		return fmt.Errorf("update network policy: %w", err)
+	}
+
+	return nil
+}
+
+func (h SyncHandler) fetchNetworkPolicy(ctx context.Context, sg *v1alpha2.VirtualMachineSecurityGroup) (*netv1.NetworkPolicy, error) {
+	np, err := object.FetchObject(ctx, NetworkPolicyName(sg), h.client, &netv1.NetworkPolicy{})
+	if err != nil {
+		return nil, fmt.Errorf("fetch network policy: %w", err)
+	}
+
+	return np, nil
+}
+
+func (h SyncHandler) listVirtualMachines(ctx context.Context, sg *v1alpha2.VirtualMachineSecurityGroup) ([]string, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&sg.Spec.VirtualMachineSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	var vms v1alpha2.VirtualMachineList
+	err = h.client.List(ctx, &vms, client.InNamespace(sg.Namespace), client.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("list virtual machines: %w", err)
+	}
+
+	names := make([]string, 0, len(vms.Items))
+	for _, vm := range vms.Items {
+		names = append(names, vm.Name)
+	}
+	slices.Sort(names)
+
+	return names, nil
+}
+
+// NetworkPolicyName returns the name of the NetworkPolicy the group is compiled into.
+func NetworkPolicyName(sg *v1alpha2.VirtualMachineSecurityGroup) types.NamespacedName {
+	return supplements.NewGenerator("vmsg", sg.Name, sg.Namespace, sg.UID).NetworkPolicy()
+}
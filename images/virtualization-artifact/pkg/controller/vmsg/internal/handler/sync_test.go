@@ -0,0 +1,195 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	virtv1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/virtualization-controller/pkg/common/testutil"
+	"github.com/deckhouse/virtualization-controller/pkg/controller/conditions"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2/vmsgcondition"
+)
+
+var _ = Describe("SyncHandler", func() {
+	var (
+		ctx context.Context
+		sg  *v1alpha2.VirtualMachineSecurityGroup
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.ContextBackgroundWithNoOpLogger()
+		sg = &v1alpha2.VirtualMachineSecurityGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "uid", Generation: 1},
+			Spec: v1alpha2.VirtualMachineSecurityGroupSpec{
+				VirtualMachineSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+				Ingress: []v1alpha2.SecurityGroupIngressRule{{
+					From: []v1alpha2.SecurityGroupPeer{{
+						VirtualMachineSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "lb"}},
+					}},
+					Ports: []v1alpha2.SecurityGroupPort{{Port: ptr.To[int32](443)}},
+				}},
+			},
+		}
+	})
+
+	newVM := func(name string, labels map[string]string) *v1alpha2.VirtualMachine {
+		return &v1alpha2.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels}}
+	}
+
+	handle := func(objs ...client.Object) client.Client {
+		GinkgoHelper()
+		fakeClient, err := testutil.NewFakeClientWithObjects(objs...)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = NewSyncHandler(fakeClient).Handle(ctx, sg)
+		Expect(err).NotTo(HaveOccurred())
+
+		return fakeClient
+	}
+
+	getPolicy := func(c client.Client) *netv1.NetworkPolicy {
+		GinkgoHelper()
+		var np netv1.NetworkPolicy
+		Expect(c.Get(ctx, NetworkPolicyName(sg), &np)).To(Succeed())
+		return &np
+	}
+
+	launcherRequirement := metav1.LabelSelectorRequirement{
+		Key:      virtv1.AppLabel,
+		Operator: metav1.LabelSelectorOpIn,
+		Values:   []string{"virt-launcher"},
+	}
+
+	It("should compile the rules into a network policy for the launcher pods", func() {
+		c := handle(
+			newVM("web-1", map[string]string{"app": "web"}),
+			newVM("web-0", map[string]string{"app": "web"}),
+			newVM("db", map[string]string{"app": "db"}),
+		)
+
+		np := getPolicy(c)
+		Expect(np.OwnerReferences).To(HaveLen(1))
+		Expect(np.OwnerReferences[0].Kind).To(Equal(v1alpha2.VirtualMachineSecurityGroupKind))
+		Expect(np.Spec.PodSelector.MatchLabels).To(HaveKeyWithValue("app", "web"))
+		Expect(np.Spec.PodSelector.MatchExpressions).To(ConsistOf(launcherRequirement))
+		Expect(np.Spec.PolicyTypes).To(Equal([]netv1.PolicyType{netv1.PolicyTypeIngress}))
+
+		Expect(np.Spec.Ingress).To(HaveLen(1))
+		Expect(np.Spec.Ingress[0].From).To(HaveLen(1))
+		Expect(np.Spec.Ingress[0].From[0].PodSelector.MatchLabels).To(HaveKeyWithValue("app", "lb"))
+		Expect(np.Spec.Ingress[0].From[0].PodSelector.MatchExpressions).To(ConsistOf(launcherRequirement))
+		Expect(np.Spec.Ingress[0].Ports).To(HaveLen(1))
+		Expect(*np.Spec.Ingress[0].Ports[0].Protocol).To(Equal(corev1.ProtocolTCP))
+		Expect(np.Spec.Ingress[0].Ports[0].Port.IntValue()).To(Equal(443))
+
+		Expect(sg.Status.VirtualMachines).To(Equal([]string{"web-0", "web-1"}))
+		ready, _ := conditions.GetCondition(vmsgcondition.ReadyType, sg.Status.Conditions)
+		Expect(ready.Status).To(Equal(metav1.ConditionTrue))
+		Expect(ready.Reason).To(Equal(vmsgcondition.Applied.String()))
+	})
+
+	It("should restrict egress when there are egress rules", func() {
+		sg.Spec.Egress = []v1alpha2.SecurityGroupEgressRule{{
+			To: []v1alpha2.SecurityGroupPeer{{
+				IPBlock: &v1alpha2.SecurityGroupIPBlock{CIDR: "10.0.0.0/8", Except: []string{"10.1.0.0/16"}},
+			}},
+		}}
+
+		np := getPolicy(handle())
+		Expect(np.Spec.PolicyTypes).To(Equal([]netv1.PolicyType{netv1.PolicyTypeIngress, netv1.PolicyTypeEgress}))
+		Expect(np.Spec.Egress).To(HaveLen(1))
+		Expect(np.Spec.Egress[0].To[0].IPBlock).To(Equal(&netv1.IPBlock{CIDR: "10.0.0.0/8", Except: []string{"10.1.0.0/16"}}))
+	})
+
+	It("should keep the explicit policy types", func() {
+		sg.Spec.PolicyTypes = []v1alpha2.SecurityGroupPolicyType{v1alpha2.SecurityGroupPolicyTypeEgress}
+
+		np := getPolicy(handle())
+		Expect(np.Spec.PolicyTypes).To(Equal([]netv1.PolicyType{netv1.PolicyTypeEgress}))
+	})
+
+	It("should update the network policy when the rules change", func() {
+		c := handle()
+		np := getPolicy(c)
+
+		sg.Spec.Ingress[0].Ports[0].Port = ptr.To[int32](8443)
+		_, err := NewSyncHandler(c).Handle(ctx, sg)
+		Expect(err).NotTo(HaveOccurred())
+
+		np = getPolicy(c)
+		Expect(np.Spec.Ingress[0].Ports[0].Port.IntValue()).To(Equal(8443))
+	})
+
+	It("should report an invalid cidr", func() {
+		sg.Spec.Egress = []v1alpha2.SecurityGroupEgressRule{{
+			To: []v1alpha2.SecurityGroupPeer{{IPBlock: &v1alpha2.SecurityGroupIPBlock{CIDR: "10.0.0.0"}}},
+		}}
+
+		c := handle()
+
+		var nps netv1.NetworkPolicyList
+		Expect(c.List(ctx, &nps)).To(Succeed())
+		Expect(nps.Items).To(BeEmpty())
+
+		ready, _ := conditions.GetCondition(vmsgcondition.ReadyType, sg.Status.Conditions)
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal(vmsgcondition.InvalidSpec.String()))
+	})
+
+	It("should report an empty peer", func() {
+		sg.Spec.Ingress = []v1alpha2.SecurityGroupIngressRule{{
+			From: []v1alpha2.SecurityGroupPeer{{}},
+		}}
+
+		c := handle()
+
+		var nps netv1.NetworkPolicyList
+		Expect(c.List(ctx, &nps)).To(Succeed())
+		Expect(nps.Items).To(BeEmpty())
+
+		ready, _ := conditions.GetCondition(vmsgcondition.ReadyType, sg.Status.Conditions)
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal(vmsgcondition.InvalidSpec.String()))
+	})
+
+	It("should keep the last applied network policy when the group becomes invalid", func() {
+		c := handle(newVM("web-0", map[string]string{"app": "web"}))
+
+		sg.Spec.Ingress[0].From = []v1alpha2.SecurityGroupPeer{{}}
+		_, err := NewSyncHandler(c).Handle(ctx, sg)
+		Expect(err).NotTo(HaveOccurred())
+
+		np := getPolicy(c)
+		Expect(np.Spec.Ingress[0].From[0].PodSelector.MatchLabels).To(HaveKeyWithValue("app", "lb"))
+		Expect(sg.Status.VirtualMachines).To(Equal([]string{"web-0"}))
+
+		ready, _ := conditions.GetCondition(vmsgcondition.ReadyType, sg.Status.Conditions)
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal(vmsgcondition.InvalidSpec.String()))
+		Expect(ready.Message).To(ContainSubstring("The last applied rules stay in effect"))
+	})
+})
@@ -0,0 +1,62 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watcher
+
+import (
+	"fmt"
+
+	netv1 "k8s.io/api/networking/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+func NewNetworkPolicyWatcher() *NetworkPolicyWatcher {
+	return &NetworkPolicyWatcher{}
+}
+
+// NetworkPolicyWatcher restores the NetworkPolicy of a group after it has been changed or deleted by hand.
+type NetworkPolicyWatcher struct{}
+
+func (w NetworkPolicyWatcher) Watch(mgr manager.Manager, ctr controller.Controller) error {
+	if err := ctr.Watch(
+		source.Kind(
+			mgr.GetCache(),
+			&netv1.NetworkPolicy{},
+			handler.TypedEnqueueRequestForOwner[*netv1.NetworkPolicy](
+				mgr.GetScheme(),
+				mgr.GetRESTMapper(),
+				&v1alpha2.VirtualMachineSecurityGroup{},
+				handler.OnlyControllerOwner(),
+			),
+			predicate.TypedFuncs[*netv1.NetworkPolicy]{
+				CreateFunc: func(e event.TypedCreateEvent[*netv1.NetworkPolicy]) bool { return false },
+				UpdateFunc: func(e event.TypedUpdateEvent[*netv1.NetworkPolicy]) bool {
+					return e.ObjectOld.Generation != e.ObjectNew.Generation
+				},
+			},
+		),
+	); err != nil {
+		return fmt.Errorf("error setting watch on NetworkPolicy: %w", err)
+	}
+	return nil
+}
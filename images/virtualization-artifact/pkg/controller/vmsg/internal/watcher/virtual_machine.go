@@ -0,0 +1,76 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+func NewVirtualMachineWatcher() *VirtualMachineWatcher {
+	return &VirtualMachineWatcher{}
+}
+
+// VirtualMachineWatcher keeps the list of the virtual machines in the status of the groups up to date.
+// All groups of the namespace are enqueued: a virtual machine whose labels have changed may leave
+// a group it no longer matches.
+type VirtualMachineWatcher struct{}
+
+func (w VirtualMachineWatcher) Watch(mgr manager.Manager, ctr controller.Controller) error {
+	c := mgr.GetClient()
+	if err := ctr.Watch(
+		source.Kind(mgr.GetCache(), &v1alpha2.VirtualMachine{},
+			handler.TypedEnqueueRequestsFromMapFunc(func(ctx context.Context, vm *v1alpha2.VirtualMachine) []reconcile.Request {
+				return namespaceGroups(ctx, c, vm.Namespace)
+			}),
+			predicate.TypedFuncs[*v1alpha2.VirtualMachine]{
+				UpdateFunc: func(e event.TypedUpdateEvent[*v1alpha2.VirtualMachine]) bool {
+					return !maps.Equal(e.ObjectOld.Labels, e.ObjectNew.Labels)
+				},
+			},
+		),
+	); err != nil {
+		return fmt.Errorf("error setting watch on VirtualMachine: %w", err)
+	}
+	return nil
+}
+
+func namespaceGroups(ctx context.Context, c client.Client, namespace string) []reconcile.Request {
+	var groups v1alpha2.VirtualMachineSecurityGroupList
+	if err := c.List(ctx, &groups, client.InNamespace(namespace)); err != nil {
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(groups.Items))
+	for _, sg := range groups.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&sg)})
+	}
+
+	return requests
+}
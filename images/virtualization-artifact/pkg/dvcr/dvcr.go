@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/deckhouse/virtualization-controller/pkg/dvcr/registrytoken"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 	dvcrrepo "github.com/deckhouse/virtualization/api/dvcr"
 )
 
@@ -50,6 +51,13 @@ type Settings struct {
 	ImageMonitorSchedule string
 	// GCSchedule is a cron formatted schedule to periodically run a garbage collection.
 	GCSchedule string
+	// Compression is the default compression of the images stored for ClusterVirtualImages and VirtualImages.
+	Compression string
+	// PullThroughCache enables the digest-keyed cache of the ContainerImage data sources in DVCR.
+	PullThroughCache bool
+	// SignatureTrustedKeys are the PEM-encoded keys every image imported from a ContainerImage data source
+	// must be signed with. Empty if the module sets no signature verification policy.
+	SignatureTrustedKeys string
 	// TokenSigner mints the scoped per-namespace DVCR tokens: importer and uploader
 	// Pods authenticate with a token minted for the single repository they use,
 	// instead of the shared read-write credential, which is then no longer copied
@@ -81,8 +89,29 @@ const (
 	VMIImageTmpl      = "vi/%s/%s:%s"
 	VMDImageTmpl      = "vd/%s/%s:%s"
 	DefaultGCSchedule = "0 2 * * *" // Run DVCR garbage collect on 2:00 am every day.
+	CacheImageTmpl    = "cache/%s/%s@%s"
 )
 
+// ImageCompression returns the compression requested for the image or the default one if not requested.
+func (s *Settings) ImageCompression(compression v1alpha2.ImageCompression) string {
+	if compression != "" {
+		return string(compression)
+	}
+	return s.Compression
+}
+
+// ImageSignatureVerification returns the signature verification of the image: the policy of the module if it is set,
+// otherwise the verification requested for the image.
+func (s *Settings) ImageSignatureVerification(verification *v1alpha2.ImageSignatureVerification) *v1alpha2.ImageSignatureVerification {
+	if s.SignatureTrustedKeys != "" {
+		return &v1alpha2.ImageSignatureVerification{
+			Required:    true,
+			TrustedKeys: []string{s.SignatureTrustedKeys},
+		}
+	}
+	return verification
+}
+
 // RegistryImageForCVI returns image name for CVI.
 func (s *Settings) RegistryImageForCVI(obj client.Object) string {
 	imgPath := path.Clean(fmt.Sprintf(CVMIImageTmpl, dvcrrepo.ClusterImageRepoName(s.RegistryURL, obj.GetName()), obj.GetUID()))
@@ -101,6 +130,13 @@ func (s *Settings) RegistryImageForVD(obj client.Object) string {
 	return path.Join(s.RegistryURL, imgPath)
 }
 
+// RegistryImageForCache returns the pull-through cache image name for the container image digest.
+// The image is referenced by digest, so a cache entry cannot be replaced by a different content.
+func (s *Settings) RegistryImageForCache(digest string) string {
+	alg, hex, _ := strings.Cut(digest, ":")
+	return path.Join(s.RegistryURL, fmt.Sprintf(CacheImageTmpl, alg, hex, digest))
+}
+
 // RepoPath extracts the repository path (e.g. "vi/ns/name", "cvi/name") from a
 // DVCR image reference by stripping the optional docker:// scheme, the registry
 // host and the tag/digest. It is the name used in a scoped token's access claim.
@@ -30,6 +30,36 @@ import (
 
 const registryURL = "dvcr.d8-virtualization.svc"
 
+var _ = Describe("ImageCompression", func() {
+	DescribeTable("should prefer the compression requested for the image",
+		func(defaultCompression string, requested v1alpha2.ImageCompression, want string) {
+			s := &Settings{Compression: defaultCompression}
+			Expect(s.ImageCompression(requested)).To(Equal(want))
+		},
+		Entry("nothing set", "", v1alpha2.ImageCompression(""), ""),
+		Entry("module default", "Zstd", v1alpha2.ImageCompression(""), "Zstd"),
+		Entry("requested overrides default", "Zstd", v1alpha2.ImageCompressionNone, "None"),
+		Entry("requested without default", "", v1alpha2.ImageCompressionZstdSeekable, "ZstdSeekable"),
+	)
+})
+
+var _ = Describe("ImageSignatureVerification", func() {
+	requested := &v1alpha2.ImageSignatureVerification{Required: false, TrustedKeys: []string{"user-key"}}
+
+	It("should use the verification requested for the image without a module policy", func() {
+		s := &Settings{}
+		Expect(s.ImageSignatureVerification(requested)).To(Equal(requested))
+		Expect(s.ImageSignatureVerification(nil)).To(BeNil())
+	})
+
+	It("should enforce the module policy over the verification requested for the image", func() {
+		s := &Settings{SignatureTrustedKeys: "policy-key"}
+		want := &v1alpha2.ImageSignatureVerification{Required: true, TrustedKeys: []string{"policy-key"}}
+		Expect(s.ImageSignatureVerification(requested)).To(Equal(want))
+		Expect(s.ImageSignatureVerification(nil)).To(Equal(want))
+	})
+})
+
 var _ = Describe("RegistryImage", func() {
 	var (
 		s       *Settings
@@ -77,6 +107,15 @@ var _ = Describe("RegistryImage", func() {
 			func() string { return s.RegistryImageForVD(objectWithName(longNS, maxName)) },
 			func() string { return "vd/" + longNS + "/" + dvcrrepo.DiskRepoName(registryURL, longNS, maxName) }),
 	)
+
+	// The dvcr-cleaner expires the pull-through cache entries found under "cache/<algorithm>/<hex>".
+	It("should keep the cache image under the digest repository", func() {
+		digest := "sha256:" + strings.Repeat("a", 64)
+		image := s.RegistryImageForCache(digest)
+
+		Expect(image).To(Equal(registryURL + "/cache/sha256/" + strings.Repeat("a", 64) + "@" + digest))
+		Expect(s.RepoPath(image)).To(Equal("cache/sha256/" + strings.Repeat("a", 64)))
+	})
 })
 
 func objectWithName(namespace, name string) *v1alpha2.VirtualImage {
@@ -38,6 +38,7 @@ import (
 // ImageChecker provides functionality to check if images exist in a registry.
 type ImageChecker interface {
 	CheckImageExists(ctx context.Context, imageURL string) (bool, error)
+	GetImageDigest(ctx context.Context, imageURL string) (string, error)
 }
 
 // DefaultImageChecker implements ImageChecker using go-containerregistry.
@@ -84,6 +85,30 @@ func (c *DefaultImageChecker) CheckImageExists(ctx context.Context, imageURL str
 	return true, nil
 }
 
+// GetImageDigest returns the digest of the image manifest by performing a lightweight HEAD request.
+func (c *DefaultImageChecker) GetImageDigest(ctx context.Context, imageURL string) (string, error) {
+	if imageURL == "" {
+		return "", fmt.Errorf("image URL is empty")
+	}
+
+	ref, err := name.ParseReference(imageURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse image reference %q: %w", imageURL, err)
+	}
+
+	opts, err := c.remoteOptions(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	desc, err := remote.Head(ref, opts...)
+	if err != nil {
+		return "", fmt.Errorf("failed to get image digest for %q: %w", imageURL, err)
+	}
+
+	return desc.Digest.String(), nil
+}
+
 // remoteOptions returns the remote options for registry operations.
 func (c *DefaultImageChecker) remoteOptions(ctx context.Context) ([]remote.Option, error) {
 	opts := []remote.Option{
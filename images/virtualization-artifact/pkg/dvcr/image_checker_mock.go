@@ -21,6 +21,9 @@ var _ ImageChecker = &ImageCheckerMock{}
 //			CheckImageExistsFunc: func(ctx context.Context, imageURL string) (bool, error) {
 //				panic("mock out the CheckImageExists method")
 //			},
+//			GetImageDigestFunc: func(ctx context.Context, imageURL string) (string, error) {
+//				panic("mock out the GetImageDigest method")
+//			},
 //		}
 //
 //		// use mockedImageChecker in code that requires ImageChecker
@@ -31,6 +34,9 @@ type ImageCheckerMock struct {
 	// CheckImageExistsFunc mocks the CheckImageExists method.
 	CheckImageExistsFunc func(ctx context.Context, imageURL string) (bool, error)
 
+	// GetImageDigestFunc mocks the GetImageDigest method.
+	GetImageDigestFunc func(ctx context.Context, imageURL string) (string, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// CheckImageExists holds details about calls to the CheckImageExists method.
@@ -40,8 +46,16 @@ type ImageCheckerMock struct {
 			// ImageURL is the imageURL argument value.
 			ImageURL string
 		}
+		// GetImageDigest holds details about calls to the GetImageDigest method.
+		GetImageDigest []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ImageURL is the imageURL argument value.
+			ImageURL string
+		}
 	}
 	lockCheckImageExists sync.RWMutex
+	lockGetImageDigest   sync.RWMutex
 }
 
 // CheckImageExists calls CheckImageExistsFunc.
@@ -79,3 +93,39 @@ func (mock *ImageCheckerMock) CheckImageExistsCalls() []struct {
 	mock.lockCheckImageExists.RUnlock()
 	return calls
 }
+
+// GetImageDigest calls GetImageDigestFunc.
+func (mock *ImageCheckerMock) GetImageDigest(ctx context.Context, imageURL string) (string, error) {
+	if mock.GetImageDigestFunc == nil {
+		panic("ImageCheckerMock.GetImageDigestFunc: method is nil but ImageChecker.GetImageDigest was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		ImageURL string
+	}{
+		Ctx:      ctx,
+		ImageURL: imageURL,
+	}
+	mock.lockGetImageDigest.Lock()
+	mock.calls.GetImageDigest = append(mock.calls.GetImageDigest, callInfo)
+	mock.lockGetImageDigest.Unlock()
+	return mock.GetImageDigestFunc(ctx, imageURL)
+}
+
+// GetImageDigestCalls gets all the calls that were made to GetImageDigest.
+// Check the length with:
+//
+//	len(mockedImageChecker.GetImageDigestCalls())
+func (mock *ImageCheckerMock) GetImageDigestCalls() []struct {
+	Ctx      context.Context
+	ImageURL string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		ImageURL string
+	}
+	mock.lockGetImageDigest.RLock()
+	calls = mock.calls.GetImageDigest
+	mock.lockGetImageDigest.RUnlock()
+	return calls
+}
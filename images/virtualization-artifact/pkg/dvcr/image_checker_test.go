@@ -207,4 +207,46 @@ var _ = Describe("ImageChecker", func() {
 			Expect(exists).To(BeFalse())
 		})
 	})
+
+	Describe("GetImageDigest", func() {
+		It("should return the digest of the image manifest", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.URL.Path == "/v2/":
+					w.WriteHeader(http.StatusOK)
+				case strings.HasPrefix(r.URL.Path, "/v2/") && strings.Contains(r.URL.Path, "/manifests/"):
+					w.Header().Set("Docker-Content-Digest", "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855")
+					w.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+					w.Header().Set("Content-Length", "123")
+					w.WriteHeader(http.StatusOK)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			settings := &Settings{
+				InsecureTLS: "true",
+			}
+			checker := NewImageChecker(client, settings)
+
+			registryHost := strings.TrimPrefix(server.URL, "http://")
+			imageURL := fmt.Sprintf("%s/cvi/test:abc123", registryHost)
+
+			digest, err := checker.GetImageDigest(context.Background(), imageURL)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(digest).To(Equal("sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"))
+		})
+
+		It("should return error when image URL is empty", func() {
+			client := fake.NewClientBuilder().WithScheme(scheme).Build()
+			checker := NewImageChecker(client, &Settings{})
+
+			_, err := checker.GetImageDigest(context.Background(), "")
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
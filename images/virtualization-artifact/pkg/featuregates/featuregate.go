@@ -37,6 +37,8 @@ const (
 	VirtualMachinePool                   featuregate.Feature = "VirtualMachinePool"
 	UploadViaAPIGateway                  featuregate.Feature = "UploadViaAPIGateway"
 	VerticalVirtualMachineAutoscaler     featuregate.Feature = "VerticalVirtualMachineAutoscaler"
+	IPv6Addresses                        featuregate.Feature = "IPv6Addresses"
+	AdditionalNetworkBandwidth           featuregate.Feature = "AdditionalNetworkBandwidth"
 )
 
 var featureSpecs = map[featuregate.Feature]featuregate.FeatureSpec{
@@ -100,6 +102,22 @@ var featureSpecs = map[featuregate.Feature]featuregate.FeatureSpec{
 		LockToDefault: true,
 		PreRelease:    featuregate.Alpha,
 	},
+	// IPv6Addresses allows IPv6 subnets in virtualMachineCIDRs and IPv6 or dual-stack
+	// VirtualMachineIPAddresses. The requested IPv6 address reaches the CNI in the
+	// cni.cilium.io/ipv6Address pod annotation, which the bundled Cilium does not read yet,
+	// so the pod would get a random IPv6 address. The gate stays disabled until Cilium
+	// assigns the requested address; vm-route-forge already programs the IPv6 routes.
+	IPv6Addresses: {
+		Default:    false,
+		PreRelease: featuregate.Alpha,
+	},
+	// AdditionalNetworkBandwidth passes the bandwidth limits of additional networks to virt-launcher
+	// in the interface bandwidth annotation. The bundled KubeVirt does not read that annotation yet,
+	// so the gate stays disabled and the webhooks reject the limits until it does.
+	AdditionalNetworkBandwidth: {
+		Default:    false,
+		PreRelease: featuregate.Alpha,
+	},
 }
 
 var (
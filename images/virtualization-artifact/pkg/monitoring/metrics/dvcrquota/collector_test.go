@@ -0,0 +1,103 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dvcrquota
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/deckhouse/deckhouse/pkg/log"
+	"github.com/deckhouse/virtualization-controller/pkg/common/annotations"
+	"github.com/deckhouse/virtualization-controller/pkg/monitoring/metrics"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+func fqName(metric string) string {
+	return metrics.MetricNamespace + "_" + metric
+}
+
+func newVI(namespace, name string, storage v1alpha2.StorageType, storedBytes string) *v1alpha2.VirtualImage {
+	return &v1alpha2.VirtualImage{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       v1alpha2.VirtualImageSpec{Storage: storage},
+		Status: v1alpha2.VirtualImageStatus{
+			Size: v1alpha2.ImageStatusSize{StoredBytes: storedBytes},
+		},
+	}
+}
+
+func newNamespace(name, quota string) *corev1.Namespace {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if quota != "" {
+		ns.Annotations = map[string]string{annotations.AnnDVCRSoftQuota: quota}
+	}
+	return ns
+}
+
+func collectorOf(objs ...client.Object) Collector {
+	scheme := runtime.NewScheme()
+	Expect(v1alpha2.AddToScheme(scheme)).To(Succeed())
+	Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+	return Collector{
+		log:      log.NewNop(),
+		iterator: newUnsafeIterator(fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()),
+	}
+}
+
+var _ = Describe("Collector", func() {
+	It("sums the DVCR usage per namespace and reports the quota where it is set", func() {
+		c := collectorOf(
+			newNamespace("team-a", "10Gi"),
+			newNamespace("team-b", ""),
+			newNamespace("team-c", "1Gi"),
+			newVI("team-a", "ubuntu", v1alpha2.StorageContainerRegistry, "1000"),
+			newVI("team-a", "debian", v1alpha2.StorageContainerRegistry, "2000"),
+			newVI("team-a", "on-pvc", v1alpha2.StoragePersistentVolumeClaim, "5000"),
+			newVI("team-b", "alpine", v1alpha2.StorageContainerRegistry, "300"),
+			newVI("team-b", "importing", v1alpha2.StorageContainerRegistry, ""),
+		)
+
+		expected := `
+# HELP d8_virtualization_dvcr_namespace_soft_quota_bytes The DVCR soft quota of the namespace in bytes.
+# TYPE d8_virtualization_dvcr_namespace_soft_quota_bytes gauge
+d8_virtualization_dvcr_namespace_soft_quota_bytes{namespace="team-a"} 1.073741824e+10
+d8_virtualization_dvcr_namespace_soft_quota_bytes{namespace="team-c"} 1.073741824e+09
+# HELP d8_virtualization_dvcr_namespace_used_bytes The number of bytes the virtualimages of the namespace occupy in DVCR.
+# TYPE d8_virtualization_dvcr_namespace_used_bytes gauge
+d8_virtualization_dvcr_namespace_used_bytes{namespace="team-a"} 3000
+d8_virtualization_dvcr_namespace_used_bytes{namespace="team-b"} 300
+d8_virtualization_dvcr_namespace_used_bytes{namespace="team-c"} 0
+`
+		Expect(testutil.CollectAndCompare(c, strings.NewReader(expected))).To(Succeed())
+	})
+
+	It("ignores a malformed quota annotation", func() {
+		c := collectorOf(newNamespace("team-a", "lots"))
+
+		Expect(testutil.CollectAndCount(c, fqName(MetricDVCRNamespaceSoftQuotaBytes))).To(BeZero())
+		Expect(testutil.CollectAndCount(c, fqName(MetricDVCRNamespaceUsedBytes))).To(BeZero())
+	})
+})
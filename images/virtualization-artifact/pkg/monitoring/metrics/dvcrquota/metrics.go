@@ -0,0 +1,59 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dvcrquota
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/deckhouse/virtualization-controller/pkg/monitoring/metrics"
+)
+
+const (
+	MetricDVCRNamespaceUsedBytes      = "dvcr_namespace_used_bytes"
+	MetricDVCRNamespaceSoftQuotaBytes = "dvcr_namespace_soft_quota_bytes"
+)
+
+var baseLabels = []string{"namespace"}
+
+func WithBaseLabels(labels ...string) []string {
+	return append(baseLabels, labels...)
+}
+
+func WithBaseLabelsByMetric(m *dataMetric, labels ...string) []string {
+	var base []string
+	if m != nil {
+		base = []string{
+			m.Namespace,
+		}
+	}
+	return append(base, labels...)
+}
+
+var dvcrQuotaMetrics = map[string]metrics.MetricInfo{
+	MetricDVCRNamespaceUsedBytes: metrics.NewMetricInfo(MetricDVCRNamespaceUsedBytes,
+		"The number of bytes the virtualimages of the namespace occupy in DVCR.",
+		prometheus.GaugeValue,
+		WithBaseLabels(),
+		nil),
+	// Reported only for namespaces with a quota: the absence of the series means "no limit",
+	// so used/quota ratios are not polluted with a fake zero quota.
+	MetricDVCRNamespaceSoftQuotaBytes: metrics.NewMetricInfo(MetricDVCRNamespaceSoftQuotaBytes,
+		"The DVCR soft quota of the namespace in bytes.",
+		prometheus.GaugeValue,
+		WithBaseLabels(),
+		nil),
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dvcrquota
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/deckhouse/deckhouse/pkg/log"
+)
+
+func newScraper(ch chan<- prometheus.Metric, log *log.Logger) *scraper {
+	return &scraper{ch: ch, log: log}
+}
+
+type scraper struct {
+	ch  chan<- prometheus.Metric
+	log *log.Logger
+}
+
+func (s *scraper) Report(m *dataMetric) {
+	s.defaultUpdate(MetricDVCRNamespaceUsedBytes, float64(m.UsedBytes), m)
+	if m.Limited {
+		s.defaultUpdate(MetricDVCRNamespaceSoftQuotaBytes, float64(m.QuotaBytes), m)
+	}
+}
+
+func (s *scraper) defaultUpdate(descName string, value float64, m *dataMetric, labels ...string) {
+	info := dvcrQuotaMetrics[descName]
+	metric, err := prometheus.NewConstMetric(
+		info.Desc,
+		prometheus.GaugeValue,
+		value,
+		WithBaseLabelsByMetric(m, labels...)...,
+	)
+	if err != nil {
+		s.log.Warn(fmt.Sprintf("Error creating the new const dataMetric for %s: %s", info.Desc, err))
+		return
+	}
+	s.ch <- metric
+}
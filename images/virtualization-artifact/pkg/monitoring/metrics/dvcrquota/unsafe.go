@@ -0,0 +1,99 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dvcrquota
+
+import (
+	"context"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/deckhouse/virtualization-controller/pkg/controller/service"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+func newUnsafeIterator(reader client.Reader) *iterator {
+	return &iterator{
+		reader: reader,
+	}
+}
+
+type iterator struct {
+	reader client.Reader
+}
+
+// Iter sums the DVCR usage of VirtualImages per namespace and creates a DTO for every namespace
+// that stores images in DVCR or has a DVCR soft quota.
+// DO NOT mutate VirtualImages and Namespaces!
+func (l *iterator) Iter(ctx context.Context, h handler) error {
+	vis := v1alpha2.VirtualImageList{}
+	if err := l.reader.List(ctx, &vis, client.UnsafeDisableDeepCopy); err != nil {
+		return err
+	}
+
+	byNamespace := make(map[string]*dataMetric)
+	get := func(namespace string) *dataMetric {
+		m, ok := byNamespace[namespace]
+		if !ok {
+			m = &dataMetric{Namespace: namespace}
+			byNamespace[namespace] = m
+		}
+		return m
+	}
+
+	for i := range vis.Items {
+		if vis.Items[i].Spec.Storage != v1alpha2.StorageContainerRegistry {
+			continue
+		}
+		get(vis.Items[i].Namespace).UsedBytes += service.DVCRStoredBytes(&vis.Items[i])
+	}
+
+	namespaces := corev1.NamespaceList{}
+	if err := l.reader.List(ctx, &namespaces, client.UnsafeDisableDeepCopy); err != nil {
+		return err
+	}
+
+	for i := range namespaces.Items {
+		quota, limited, err := service.ParseDVCRSoftQuota(&namespaces.Items[i])
+		if err != nil || !limited {
+			continue
+		}
+		m := get(namespaces.Items[i].Name)
+		m.QuotaBytes = quota
+		m.Limited = true
+	}
+
+	names := make([]string, 0, len(byNamespace))
+	for name := range byNamespace {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if stop := h(byNamespace[name]); stop {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+			continue
+		}
+	}
+	return nil
+}
@@ -5,11 +5,16 @@
 #include <bpf/bpf_tracing.h>
 #include <linux/ptrace.h>
 
+#define AF_INET 2
+#define AF_INET6 10
+
 struct route_event {
     u32 action; // 0 - add, 1 - delete
     u32 table;
-    __be32 dst;
-    __be32 src;
+    u32 family; // AF_INET or AF_INET6
+    // IPv4 addresses occupy the first 4 bytes, IPv6 addresses use all 16 bytes.
+    u8 dst[16];
+    u8 src[16];
 };
 
 // Force emitting struct event into the ELF.
@@ -28,6 +33,7 @@ struct {
 static inline int insert_event(struct pt_regs *ctx, u32 action) {
     struct route_event evt = {
         .action = action,
+        .family = AF_INET,
     };
     struct fib_table *tb;
     struct fib_config *cfg;
@@ -58,7 +64,7 @@ static inline int insert_event(struct pt_regs *ctx, u32 action) {
     }
 
     // Save the dst address from the cfg to the evt.
-    ret = bpf_probe_read_kernel(&evt.dst, sizeof(evt.dst), &cfg->fc_dst);
+    ret = bpf_probe_read_kernel(&evt.dst, sizeof(cfg->fc_dst), &cfg->fc_dst);
     if (ret < 0) {
         static const char msg[] = "Failed to read dst: %d";
         bpf_trace_printk(msg, sizeof(msg), ret);
@@ -66,7 +72,7 @@ static inline int insert_event(struct pt_regs *ctx, u32 action) {
     }
 
     // Save the src address from the cfg to the evt.
-    ret = bpf_probe_read_kernel(&evt.src, sizeof(evt.src), &cfg->fc_prefsrc);
+    ret = bpf_probe_read_kernel(&evt.src, sizeof(cfg->fc_prefsrc), &cfg->fc_prefsrc);
     if (ret < 0) {
         static const char msg[] = "Failed to read src: %d";
         bpf_trace_printk(msg, sizeof(msg), ret);
@@ -96,4 +102,73 @@ int fib_table_delete(struct pt_regs *ctx) {
     return 0;
 }
 
+static inline int insert_event6(u32 action, void *rt_ptr) {
+    struct route_event evt = {
+        .action = action,
+        .family = AF_INET6,
+    };
+    struct fib6_info *rt;
+    struct fib6_table *tb;
+    int ret;
+
+    // Save the fib6_info from the ctx to the rt.
+    ret = bpf_probe_read_kernel(&rt, sizeof(rt), rt_ptr);
+    if (!rt) {
+        static const char msg[] = "Failed to read fib6_info pointer: %d";
+        bpf_trace_printk(msg, sizeof(msg), ret);
+        return ret;
+    }
+
+    // Save the fib6_table from the rt to the tb.
+    ret = bpf_probe_read_kernel(&tb, sizeof(tb), &rt->fib6_table);
+    if (!tb) {
+        static const char msg[] = "Failed to read fib6_table pointer: %d";
+        bpf_trace_printk(msg, sizeof(msg), ret);
+        return ret;
+    }
+
+    // Save the table id from the tb to the evt.
+    ret = bpf_probe_read_kernel(&evt.table, sizeof(evt.table), &tb->tb6_id);
+    if (ret < 0) {
+        static const char msg[] = "Failed to read tb6_id: %d";
+        bpf_trace_printk(msg, sizeof(msg), ret);
+        return ret;
+    }
+
+    // Save the dst address from the rt to the evt.
+    ret = bpf_probe_read_kernel(&evt.dst, sizeof(evt.dst), &rt->fib6_dst.addr);
+    if (ret < 0) {
+        static const char msg[] = "Failed to read dst6: %d";
+        bpf_trace_printk(msg, sizeof(msg), ret);
+        return ret;
+    }
+
+    // Save the preferred src address from the rt to the evt.
+    ret = bpf_probe_read_kernel(&evt.src, sizeof(evt.src), &rt->fib6_prefsrc.addr);
+    if (ret < 0) {
+        static const char msg[] = "Failed to read src6: %d";
+        bpf_trace_printk(msg, sizeof(msg), ret);
+        return ret;
+    }
+
+    return bpf_map_push_elem(&route_events_map, &evt, BPF_ANY);
+}
+
+SEC("kprobe/fib6_add")
+// int fib6_add(struct fib6_node *root, struct fib6_info *rt,
+//   	     struct nl_info *info, struct netlink_ext_ack *extack);
+// The fib6_info of the added route is the second parameter.
+int fib6_add(struct pt_regs *ctx) {
+    insert_event6(0, (void *)&PT_REGS_PARM2(ctx));
+    return 0;
+}
+
+SEC("kprobe/fib6_del")
+// int fib6_del(struct fib6_info *rt, struct nl_info *info);
+// The fib6_info of the deleted route is the first parameter.
+int fib6_del(struct pt_regs *ctx) {
+    insert_event6(1, (void *)&PT_REGS_PARM1(ctx));
+    return 0;
+}
+
 char LICENSE[] SEC("license") = "GPL";
@@ -25,6 +25,7 @@ import (
 
 	"vm-route-forge/internal/controller/route"
 	"vm-route-forge/internal/netutil"
+	"vm-route-forge/internal/nexthop"
 )
 
 type Options struct {
@@ -35,9 +36,13 @@ type Options struct {
 	DryRun           bool
 	ProbeAddr        string
 	PprofAddr        string
+	MetricsAddr      string
 	NodeName         string
 	RouteTableID     string
+	RulePriority     string
 	KindRouteWatcher string
+	NextHopSource    string
+	LocalLink        string
 }
 
 const (
@@ -45,19 +50,27 @@ const (
 	flagDryRun, flagDryRunShort       = "dry-run", "d"
 	flagProbeAddr                     = "health-probe-bind-address"
 	flagPprofAddr                     = "pprof-bind-address"
+	flagMetricsAddr                   = "metrics-bind-address"
 	flagVerbosity, flagVerbosityShort = "verbosity", "v"
 	flagNodeName, flagNodeNameShort   = "nodeName", "n"
 	flagTableId, flagTableIdShort     = "tableId", "t"
+	flagRulePriority                  = "rule-priority"
 	flagKindRouteWatcher              = "kind-route-watcher"
+	flagNextHopSource                 = "next-hop-source"
+	flagLocalLink                     = "local-link"
 
 	defaultVerbosity = 1
 
 	HealthProbeBindAddressEnv = "HEALTH_PROBE_BIND_ADDRESS"
 	PprofBindAddressEnv       = "PPROF_BIND_ADDRESS"
+	MetricsBindAddressEnv     = "METRICS_BIND_ADDRESS"
 	VerbosityEnv              = "VERBOSITY"
 	NodeNameEnv               = "NODE_NAME"
 	RouteTableIDEnv           = "ROUTE_TABLE_ID"
+	RulePriorityEnv           = "RULE_PRIORITY"
 	KindRouteWatcherEnv       = "KIND_ROUTE_WATCHER"
+	NextHopSourceEnv          = "NEXT_HOP_SOURCE"
+	LocalLinkEnv              = "LOCAL_LINK"
 )
 
 func NewOptions() Options {
@@ -69,14 +82,18 @@ func NewOptions() Options {
 }
 
 func (o *Options) Flags(fs *pflag.FlagSet) {
-	fs.StringSliceVarP((*[]string)(&o.Cidrs), flagCidr, flagCidrShort, []string{}, "CIDRs enabled to route (multiple flags allowed).")
+	fs.StringSliceVarP((*[]string)(&o.Cidrs), flagCidr, flagCidrShort, []string{}, "IPv4 or IPv6 CIDRs enabled to route (multiple flags allowed).")
 	fs.BoolVarP(&o.DryRun, flagDryRun, flagDryRunShort, false, "Don't perform any changes on the node.")
 	fs.StringVar(&o.ProbeAddr, flagProbeAddr, os.Getenv(HealthProbeBindAddressEnv), "The address the probe endpoint binds to.")
 	fs.StringVar(&o.PprofAddr, flagPprofAddr, os.Getenv(PprofBindAddressEnv), "The address the pprof endpoint binds to.")
+	fs.StringVar(&o.MetricsAddr, flagMetricsAddr, os.Getenv(MetricsBindAddressEnv), "The address the metrics and routes debug endpoints bind to.")
 	fs.StringVarP(&o.NodeName, flagNodeName, flagNodeNameShort, os.Getenv(NodeNameEnv), "The name of the node.")
 	fs.StringVarP(&o.RouteTableID, flagTableId, flagTableIdShort, os.Getenv(RouteTableIDEnv), "The id of the table.")
+	fs.StringVar(&o.RulePriority, flagRulePriority, os.Getenv(RulePriorityEnv), "The priority of the rules for CIDRs, the id of the table by default.")
 	fs.IntVarP(&o.Verbosity, flagVerbosity, flagVerbosityShort, getDefaultVerbosity(), "Verbosity of output.")
 	fs.StringVar(&o.KindRouteWatcher, flagKindRouteWatcher, getEnvWithDefault(KindRouteWatcherEnv, string(route.NetlinkTickerKind)), "Kind of route watcher.")
+	fs.StringVar(&o.NextHopSource, flagNextHopSource, getEnvWithDefault(NextHopSourceEnv, string(nexthop.CiliumSource)), "Source of next-hop addresses of nodes: cilium (CiliumNode internal IPs) or node (Node internal IPs).")
+	fs.StringVar(&o.LocalLink, flagLocalLink, os.Getenv(LocalLinkEnv), "The link to route traffic to VMs on the current node, used with the node next-hop source.")
 }
 
 func getEnvWithDefault(env string, defaultValue string) string {
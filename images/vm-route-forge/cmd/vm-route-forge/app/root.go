@@ -37,6 +37,7 @@ import (
 	"vm-route-forge/internal/informer"
 	"vm-route-forge/internal/netlinkmanager"
 	"vm-route-forge/internal/netlinkwrap"
+	"vm-route-forge/internal/nexthop"
 	"vm-route-forge/internal/runnablegroup"
 	"vm-route-forge/internal/server"
 )
@@ -117,6 +118,24 @@ func run(opts options.Options) error {
 	}
 	log.Info(fmt.Sprintf("Use route table id %d", routeTableID))
 
+	rulePriority := routeTableID
+	if opts.RulePriority != "" {
+		priority, err := strconv.ParseInt(opts.RulePriority, 10, 32)
+		if err != nil {
+			log.Error(err, "failed to parse rule priority, should be integer")
+			return err
+		}
+		rulePriority = int(priority)
+	}
+	log.Info(fmt.Sprintf("Use rule priority %d", rulePriority))
+
+	nextHopSource := nexthop.Source(opts.NextHopSource)
+	if err := nexthop.ValidateSource(nextHopSource); err != nil {
+		log.Error(err, "failed to parse next hop source")
+		return err
+	}
+	log.Info(fmt.Sprintf("Use next hop source %s", nextHopSource))
+
 	// Load configuration to connect to Kubernetes API Server.
 	kubeCfg, err := config.GetConfig()
 	if err != nil {
@@ -138,12 +157,21 @@ func run(opts options.Options) error {
 	}
 	go vmSharedInformerFactory.Virtualization().V1alpha2().VirtualMachines().Informer().Run(ctx.Done())
 
-	ciliumSharedInformerFactory, err := informer.CiliumInformerFactory(kubeCfg)
-	if err != nil {
-		log.Error(err, "Failed to create cilium shared factory")
-		return err
+	var nextHop nexthop.Discoverer
+	switch nextHopSource {
+	case nexthop.CiliumSource:
+		ciliumSharedInformerFactory, err := informer.CiliumInformerFactory(kubeCfg)
+		if err != nil {
+			log.Error(err, "Failed to create cilium shared factory")
+			return err
+		}
+		nextHop = nexthop.NewCiliumDiscoverer(ciliumSharedInformerFactory.Cilium().V2().CiliumNodes())
+		go ciliumSharedInformerFactory.Cilium().V2().CiliumNodes().Informer().Run(ctx.Done())
+	case nexthop.NodeSource:
+		kubeSharedInformerFactory := informer.KubeInformerFactory(kubeClient)
+		nextHop = nexthop.NewNodeDiscoverer(kubeSharedInformerFactory.Core().V1().Nodes(), opts.LocalLink)
+		go kubeSharedInformerFactory.Core().V1().Nodes().Informer().Run(ctx.Done())
 	}
-	go ciliumSharedInformerFactory.Cilium().V2().CiliumNodes().Informer().Run(ctx.Done())
 
 	sharedCache := vmipcache.NewCache()
 
@@ -155,7 +183,9 @@ func run(opts options.Options) error {
 	netMgr := netlinkmanager.New(sharedCache,
 		log,
 		routeTableID,
+		rulePriority,
 		parsedCIDRs,
+		nextHop.LocalLinkName(),
 		nlWrapper,
 	)
 
@@ -176,7 +206,7 @@ func run(opts options.Options) error {
 
 	routeCtrl, err := route.NewController(
 		vmSharedInformerFactory.Virtualization().V1alpha2().VirtualMachines(),
-		ciliumSharedInformerFactory.Cilium().V2().CiliumNodes(),
+		nextHop,
 		routeWatcher,
 		netMgr,
 		log,
@@ -189,6 +219,8 @@ func run(opts options.Options) error {
 	serverOptions := server.Options{
 		HealthProbeBindAddress: opts.ProbeAddr,
 		PprofBindAddress:       opts.PprofAddr,
+		MetricsBindAddress:     opts.MetricsAddr,
+		RoutesHandler:          netMgr.RoutesDiffHandler(),
 	}
 	srv, err := server.NewServer(
 		kubeClient,
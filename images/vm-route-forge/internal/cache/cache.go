@@ -24,34 +24,51 @@ import (
 )
 
 type Cache interface {
-	GetAddresses(k types.NamespacedName) (Addresses, bool)
+	GetAddresses(k types.NamespacedName) ([]Addresses, bool)
+	GetAddressesByIP(ip net.IP) (Addresses, bool)
 	GetName(ip net.IP) (types.NamespacedName, bool)
-	Set(k types.NamespacedName, addrs Addresses)
+	Set(k types.NamespacedName, addrs []Addresses)
 	DeleteByKey(k types.NamespacedName)
 	DeleteByIP(ip net.IP)
-	Iterate(fn func(key types.NamespacedName, v Addresses) (next bool))
+	Iterate(fn func(key types.NamespacedName, v []Addresses) (next bool))
 }
 
 func NewCache() Cache {
 	return &defaultCache{
-		vmAddr: make(map[types.NamespacedName]Addresses),
+		vmAddr: make(map[types.NamespacedName][]Addresses),
 		addrVm: make(map[string]types.NamespacedName),
 	}
 }
 
+// defaultCache keeps one Addresses entry per IP family of the VM.
 type defaultCache struct {
 	mu     sync.RWMutex
-	vmAddr map[types.NamespacedName]Addresses
+	vmAddr map[types.NamespacedName][]Addresses
 	addrVm map[string]types.NamespacedName
 }
 
-func (c *defaultCache) GetAddresses(k types.NamespacedName) (Addresses, bool) {
+func (c *defaultCache) GetAddresses(k types.NamespacedName) ([]Addresses, bool) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 	res, ok := c.vmAddr[k]
 	return res, ok
 }
 
+func (c *defaultCache) GetAddressesByIP(ip net.IP) (Addresses, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	k, ok := c.addrVm[ip.String()]
+	if !ok {
+		return Addresses{}, false
+	}
+	for _, addrs := range c.vmAddr[k] {
+		if addrs.VMIP.NetIP().Equal(ip) {
+			return addrs, true
+		}
+	}
+	return Addresses{}, false
+}
+
 func (c *defaultCache) GetName(ip net.IP) (types.NamespacedName, bool) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -59,19 +76,23 @@ func (c *defaultCache) GetName(ip net.IP) (types.NamespacedName, bool) {
 	return res, ok
 }
 
-func (c *defaultCache) Set(k types.NamespacedName, addrs Addresses) {
+func (c *defaultCache) Set(k types.NamespacedName, addrs []Addresses) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	for _, old := range c.vmAddr[k] {
+		delete(c.addrVm, old.VMIP.NetIP().String())
+	}
 	c.vmAddr[k] = addrs
-	c.addrVm[addrs.VMIP.String()] = k
+	for _, a := range addrs {
+		c.addrVm[a.VMIP.NetIP().String()] = k
+	}
 }
 
 func (c *defaultCache) DeleteByKey(k types.NamespacedName) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	addrs, ok := c.vmAddr[k]
-	if ok {
-		delete(c.addrVm, addrs.VMIP.String())
+	for _, addrs := range c.vmAddr[k] {
+		delete(c.addrVm, addrs.VMIP.NetIP().String())
 	}
 	delete(c.vmAddr, k)
 }
@@ -80,13 +101,24 @@ func (c *defaultCache) DeleteByIP(ip net.IP) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	k, ok := c.addrVm[ip.String()]
-	if ok {
+	delete(c.addrVm, ip.String())
+	if !ok {
+		return
+	}
+	var rest []Addresses
+	for _, addrs := range c.vmAddr[k] {
+		if !addrs.VMIP.NetIP().Equal(ip) {
+			rest = append(rest, addrs)
+		}
+	}
+	if len(rest) == 0 {
 		delete(c.vmAddr, k)
+		return
 	}
-	delete(c.addrVm, ip.String())
+	c.vmAddr[k] = rest
 }
 
-func (c *defaultCache) Iterate(fn func(k types.NamespacedName, v Addresses) (next bool)) {
+func (c *defaultCache) Iterate(fn func(k types.NamespacedName, v []Addresses) (next bool)) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 	for k, v := range c.vmAddr {
@@ -18,7 +18,6 @@ package route
 
 import (
 	"context"
-	"encoding/binary"
 	"errors"
 	"fmt"
 	"net"
@@ -29,9 +28,11 @@ import (
 	"github.com/cilium/ebpf/link"
 	"github.com/cilium/ebpf/rlimit"
 	"github.com/go-logr/logr"
+	"golang.org/x/sys/unix"
 	"k8s.io/apimachinery/pkg/types"
 
 	vmipcache "vm-route-forge/internal/cache"
+	"vm-route-forge/internal/metrics"
 	"vm-route-forge/internal/netlinkwrap"
 )
 
@@ -41,6 +42,8 @@ const (
 
 	KprobeFibTableInsert = "fib_table_insert"
 	KprobeFibTableDelete = "fib_table_delete"
+	KprobeFib6Add        = "fib6_add"
+	KprobeFib6Del        = "fib6_del"
 )
 
 func NewEbpfWatcher(ctx context.Context,
@@ -135,30 +138,32 @@ func (w *EbpfWatcher) loadEbpf() (*ebpf.Map, ebpfCloseFuncs, error) {
 
 	// Open a Kprobe at the entry point of the kernel function and attach the
 	// pre-compiled program. Each time the kernel function enters, the program
-	// will emit an event containing pid and command of the execved task.
-	kpFibTableInsert, err := link.Kprobe(KprobeFibTableInsert, objs.FibTableInsert, nil)
-	if err != nil {
-		return nil, closeFuncs, fmt.Errorf("opening kprobe: %w", err)
+	// will emit a route event: fib_table_* functions for IPv4 routes,
+	// fib6_* functions for IPv6 routes.
+	kprobes := []struct {
+		symbol string
+		prog   *ebpf.Program
+	}{
+		{KprobeFibTableInsert, objs.FibTableInsert},
+		{KprobeFibTableDelete, objs.FibTableDelete},
+		{KprobeFib6Add, objs.Fib6Add},
+		{KprobeFib6Del, objs.Fib6Del},
 	}
-	closeFuncs.Add(func() {
-		if err = kpFibTableInsert.Close(); err != nil {
-			w.log.Error(err, "failed to close kprobe link", "symbol", KprobeFibTableInsert)
+	for _, kp := range kprobes {
+		kpLink, err := link.Kprobe(kp.symbol, kp.prog, nil)
+		if err != nil {
+			return nil, closeFuncs, fmt.Errorf("opening kprobe %s: %w", kp.symbol, err)
 		}
-	})
-
-	KpFibTableDelete, err := link.Kprobe(KprobeFibTableDelete, objs.FibTableDelete, nil)
-	if err != nil {
-		return nil, closeFuncs, fmt.Errorf("opening kprobe: %w", err)
+		closeFuncs.Add(func() {
+			if err := kpLink.Close(); err != nil {
+				w.log.Error(err, "failed to close kprobe link", "symbol", kp.symbol)
+			}
+		})
 	}
-	closeFuncs.Add(func() {
-		if err = KpFibTableDelete.Close(); err != nil {
-			w.log.Error(err, "failed to close kprobe link", "symbol", KprobeFibTableDelete)
-		}
-	})
 
 	bpfMap := objs.RouteEventsMap
 	closeFuncs.Add(func() {
-		if err = bpfMap.Close(); err != nil {
+		if err := bpfMap.Close(); err != nil {
 			w.log.Error(err, "failed to close bpf map", "type", bpfMap.Type(), "name", "RouteEventsMap")
 		}
 	})
@@ -188,6 +193,7 @@ func (w *EbpfWatcher) watch() {
 				}
 				w.log.V(7).Info("Received a new ebpf event", "event", event)
 				if err := w.sync(event); err != nil {
+					metrics.SyncErrorsTotal.WithLabelValues(string(EbpfKind)).Inc()
 					w.log.Error(err, "Failed to sync ebpf event.", "event", event)
 				}
 			}
@@ -196,11 +202,18 @@ func (w *EbpfWatcher) watch() {
 }
 
 func (w *EbpfWatcher) sync(event ebpfRouteEvent) error {
-	if event.Table != uint32(w.routeTableID) || event.Dst == 0 {
+	if event.Table != uint32(w.routeTableID) {
 		return nil
 	}
 
-	vmIP := ipUint32ToNetIP(event.Dst)
+	vmIP, err := eventIP(event.Family, event.Dst)
+	if err != nil {
+		return err
+	}
+	metrics.EbpfEventsTotal.WithLabelValues(eventActionName(event.Action), metrics.Family(vmIP.To4() == nil)).Inc()
+	if vmIP.IsUnspecified() {
+		return nil
+	}
 	isManaged, err := isManagedIP(vmIP, w.cidrs)
 	if err != nil {
 		return err
@@ -222,16 +235,19 @@ func (w *EbpfWatcher) sync(event ebpfRouteEvent) error {
 
 	switch event.Action {
 	case ActionAdd:
-		addrs, found := w.cache.GetAddresses(key)
+		addrs, found := w.cache.GetAddressesByIP(vmIP)
 		if !found {
 			log.Info("The route was added, but there are no addresses in the cache. Add the VM to the queue.")
-			w.enqueueKey(key)
+			w.enqueueKey(key, metrics.ReasonMismatch)
 			break
 		}
-		if event.Src == 0 {
+		ciliumInternalIP, err := eventIP(event.Family, event.Src)
+		if err != nil {
+			return err
+		}
+		if ciliumInternalIP.IsUnspecified() {
 			return fmt.Errorf("wrong src in ebpf event")
 		}
-		ciliumInternalIP := ipUint32ToNetIP(event.Src)
 
 		routes, err := w.nlWrapper.RouteGet(addrs.NodeIP.NetIP())
 		if err != nil || len(routes) == 0 {
@@ -246,24 +262,44 @@ func (w *EbpfWatcher) sync(event ebpfRouteEvent) error {
 				"ciliumInternalIP", ciliumInternalIP.String(),
 				"ciliumInternalIPByNodeIP", ciliumInternalIPByNodeIP.String(),
 			)
-			w.enqueueKey(key)
+			w.enqueueKey(key, metrics.ReasonMismatch)
 		}
 	case ActionDelete:
 		log.Info("The route was deleted but not deleted from the cache. Add the VM to the queue.")
-		w.enqueueKey(key)
+		w.enqueueKey(key, metrics.ReasonMissing)
 	default:
 		return fmt.Errorf("invalid action: %v", event.Action)
 	}
 	return nil
 }
 
-func (w *EbpfWatcher) enqueueKey(key types.NamespacedName) {
+func (w *EbpfWatcher) enqueueKey(key types.NamespacedName, reason string) {
+	metrics.RouteCorrectionsTotal.WithLabelValues(string(EbpfKind), reason).Inc()
 	w.result <- key
 }
 
-// Converts uint32 IP address in little-endian format to a human-readable string format
-func ipUint32ToNetIP(ip uint32) net.IP {
-	ipBytes := make([]byte, 4)
-	binary.LittleEndian.PutUint32(ipBytes, ip)
-	return ipBytes
+func eventActionName(action uint32) string {
+	switch action {
+	case ActionAdd:
+		return "add"
+	case ActionDelete:
+		return "delete"
+	default:
+		return "unknown"
+	}
+}
+
+// eventIP converts an address from the route event to net.IP.
+// IPv4 addresses occupy the first 4 bytes of the array in network byte order.
+func eventIP(family uint32, addr [16]uint8) (net.IP, error) {
+	switch family {
+	case unix.AF_INET:
+		return net.IPv4(addr[0], addr[1], addr[2], addr[3]), nil
+	case unix.AF_INET6:
+		ip := make(net.IP, net.IPv6len)
+		copy(ip, addr[:])
+		return ip, nil
+	default:
+		return nil, fmt.Errorf("invalid address family in ebpf event: %d", family)
+	}
 }
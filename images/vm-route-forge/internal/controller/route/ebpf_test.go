@@ -0,0 +1,73 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package route
+
+import (
+	"net"
+	"testing"
+
+	"github.com/cilium/ebpf"
+	"golang.org/x/sys/unix"
+)
+
+func TestEbpfSpec(t *testing.T) {
+	spec, err := loadEbpf()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var specs ebpfSpecs
+	if err = spec.Assign(&specs); err != nil {
+		t.Fatalf("bindings do not match the object: %v", err)
+	}
+	for _, p := range []*ebpf.ProgramSpec{specs.FibTableInsert, specs.FibTableDelete, specs.Fib6Add, specs.Fib6Del} {
+		if p.Type != ebpf.Kprobe {
+			t.Errorf("program %s has type %s, expected %s", p.Name, p.Type, ebpf.Kprobe)
+		}
+	}
+	if size := specs.RouteEventsMap.ValueSize; size != 44 {
+		t.Errorf("route event size is %d, expected 44", size)
+	}
+}
+
+func TestEventIP(t *testing.T) {
+	var v4, v6 [16]uint8
+	copy(v4[:], net.ParseIP("10.66.10.1").To4())
+	copy(v6[:], net.ParseIP("fd00:10::1"))
+
+	for _, tc := range []struct {
+		family   uint32
+		addr     [16]uint8
+		expected string
+	}{
+		{unix.AF_INET, v4, "10.66.10.1"},
+		{unix.AF_INET6, v6, "fd00:10::1"},
+		{unix.AF_INET, [16]uint8{}, "0.0.0.0"},
+	} {
+		ip, err := eventIP(tc.family, tc.addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ip.Equal(net.ParseIP(tc.expected)) {
+			t.Errorf("expected %s, got %s", tc.expected, ip)
+		}
+	}
+
+	if _, err := eventIP(0, v4); err == nil {
+		t.Errorf("expected error for unknown family")
+	}
+}
@@ -15,8 +15,9 @@ import (
 type ebpfRouteEvent struct {
 	Action uint32
 	Table  uint32
-	Dst    uint32
-	Src    uint32
+	Family uint32
+	Dst    [16]uint8
+	Src    [16]uint8
 }
 
 // loadEbpf returns the embedded CollectionSpec for ebpf.
@@ -61,6 +62,8 @@ type ebpfSpecs struct {
 //
 // It can be passed ebpf.CollectionSpec.Assign.
 type ebpfProgramSpecs struct {
+	Fib6Add        *ebpf.ProgramSpec `ebpf:"fib6_add"`
+	Fib6Del        *ebpf.ProgramSpec `ebpf:"fib6_del"`
 	FibTableDelete *ebpf.ProgramSpec `ebpf:"fib_table_delete"`
 	FibTableInsert *ebpf.ProgramSpec `ebpf:"fib_table_insert"`
 }
@@ -119,12 +122,16 @@ type ebpfVariables struct {
 //
 // It can be passed to loadEbpfObjects or ebpf.CollectionSpec.LoadAndAssign.
 type ebpfPrograms struct {
+	Fib6Add        *ebpf.Program `ebpf:"fib6_add"`
+	Fib6Del        *ebpf.Program `ebpf:"fib6_del"`
 	FibTableDelete *ebpf.Program `ebpf:"fib_table_delete"`
 	FibTableInsert *ebpf.Program `ebpf:"fib_table_insert"`
 }
 
 func (p *ebpfPrograms) Close() error {
 	return _EbpfClose(
+		p.Fib6Add,
+		p.Fib6Del,
 		p.FibTableDelete,
 		p.FibTableInsert,
 	)
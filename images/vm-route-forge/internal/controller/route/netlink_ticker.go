@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	vmipcache "vm-route-forge/internal/cache"
+	"vm-route-forge/internal/metrics"
 	"vm-route-forge/internal/netlinkwrap"
 )
 
@@ -90,6 +91,7 @@ func (w *NetlinkTickerWatcher) watch() {
 			return
 		case <-ticker.C:
 			if err := w.sync(); err != nil {
+				metrics.SyncErrorsTotal.WithLabelValues(string(NetlinkTickerKind)).Inc()
 				w.log.Error(err, "failed to sync routes")
 			}
 		}
@@ -103,20 +105,27 @@ func (w *NetlinkTickerWatcher) sync() error {
 	}
 	routeMap := make(map[string]*netlink.Route, len(routes))
 	for _, route := range routes {
+		if route.Dst == nil {
+			continue
+		}
 		routeMap[route.Dst.IP.String()] = &route
 	}
 
 	// enqueue vm with missing routes
-	w.cache.Iterate(func(k types.NamespacedName, v vmipcache.Addresses) (next bool) {
-		if _, found := routeMap[v.VMIP.String()]; !found {
-			w.log.Info(fmt.Sprintf("Missing route. Add the VM %q to the queue.", k))
-			w.enqueueKey(k)
+	w.cache.Iterate(func(k types.NamespacedName, v []vmipcache.Addresses) (next bool) {
+		for _, addrs := range v {
+			if _, found := routeMap[addrs.VMIP.NetIP().String()]; !found {
+				w.log.Info(fmt.Sprintf("Missing route for %s. Add the VM %q to the queue.", addrs.VMIP, k))
+				w.enqueueKey(k, metrics.ReasonMissing)
+				break
+			}
 		}
 		return true
 	})
 
 	for vmIP, route := range routeMap {
 		if err = w.syncRoute(route); err != nil {
+			metrics.SyncErrorsTotal.WithLabelValues(string(NetlinkTickerKind)).Inc()
 			w.log.Error(err, "failed to sync route", "vmIP", vmIP)
 		}
 	}
@@ -150,10 +159,10 @@ func (w *NetlinkTickerWatcher) syncRoute(route *netlink.Route) error {
 		"inHostVMIP", vmIP.String(),
 		"virtualMachine", key)
 
-	addrs, found := w.cache.GetAddresses(key)
+	addrs, found := w.cache.GetAddressesByIP(vmIP)
 	if !found {
 		log.Info("The route was added, but there are no addresses in the cache. Add the VM to the queue.")
-		w.enqueueKey(key)
+		w.enqueueKey(key, metrics.ReasonMismatch)
 		return nil
 	}
 	routes, err := w.nlWrapper.RouteGet(addrs.NodeIP.NetIP())
@@ -167,11 +176,12 @@ func (w *NetlinkTickerWatcher) syncRoute(route *netlink.Route) error {
 			"inCacheVMIP", addrs.VMIP.String(),
 			"ciliumInternalIPByNodeIP", ciliumInternalIPByNodeIP.String(),
 		)
-		w.enqueueKey(key)
+		w.enqueueKey(key, metrics.ReasonMismatch)
 	}
 	return nil
 }
 
-func (w *NetlinkTickerWatcher) enqueueKey(key types.NamespacedName) {
+func (w *NetlinkTickerWatcher) enqueueKey(key types.NamespacedName, reason string) {
+	metrics.RouteCorrectionsTotal.WithLabelValues(string(NetlinkTickerKind), reason).Inc()
 	w.result <- key
 }
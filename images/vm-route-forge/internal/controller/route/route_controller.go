@@ -19,12 +19,10 @@ package route
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
-	ciliumv2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
-	ciliumv2Informers "github.com/cilium/cilium/pkg/k8s/client/informers/externalversions/cilium.io/v2"
-	"github.com/cilium/cilium/pkg/node/addressing"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -36,7 +34,9 @@ import (
 	virtinformers "github.com/deckhouse/virtualization/api/client/generated/informers/externalversions/core/v1alpha2"
 	virtlisters "github.com/deckhouse/virtualization/api/client/generated/listers/core/v1alpha2"
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"vm-route-forge/internal/metrics"
 	"vm-route-forge/internal/netlinkmanager"
+	"vm-route-forge/internal/nexthop"
 )
 
 const controllerName = "routeController"
@@ -51,7 +51,7 @@ type Runnable interface {
 
 func NewController(
 	vmInformer virtinformers.VirtualMachineInformer,
-	cnInformer ciliumv2Informers.CiliumNodeInformer,
+	nextHop nexthop.Discoverer,
 	routeWatcher Watcher,
 	netlinkMgr *netlinkmanager.Manager,
 	logger logr.Logger,
@@ -61,6 +61,7 @@ func NewController(
 	log := logger.WithValues("controller", controllerName)
 	routeController := &Controller{
 		queue:        queue,
+		nextHop:      nextHop,
 		routeWatcher: routeWatcher,
 		netlinkMgr:   netlinkMgr,
 		log:          log,
@@ -74,19 +75,13 @@ func NewController(
 	if err != nil {
 		return nil, err
 	}
-	_, err = cnInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    routeController.addCiliumNode,
-		DeleteFunc: routeController.deleteCiliumNode,
-		UpdateFunc: routeController.updateCiliumNode,
-	})
-	if err != nil {
+	if err = nextHop.AddEventHandler(routeController.enqueueNodeVirtualMachines); err != nil {
 		return nil, err
 	}
 	routeController.vmIndexer = vmInformer.Informer().GetIndexer()
 	routeController.vmLister = vmInformer.Lister()
-	routeController.cnIndexer = cnInformer.Informer().GetIndexer()
 	routeController.hasSynced = func() bool {
-		return vmInformer.Informer().HasSynced() && cnInformer.Informer().HasSynced()
+		return vmInformer.Informer().HasSynced() && nextHop.HasSynced()
 	}
 
 	return routeController, nil
@@ -94,8 +89,8 @@ func NewController(
 
 type Controller struct {
 	vmIndexer    cache.Indexer
-	cnIndexer    cache.Indexer
 	vmLister     virtlisters.VirtualMachineLister
+	nextHop      nexthop.Discoverer
 	routeWatcher Watcher
 	hasSynced    cache.InformerSynced
 	queue        workqueue.RateLimitingInterface
@@ -127,49 +122,15 @@ func (c *Controller) updateVirtualMachine(oldObj interface{}, newObj interface{}
 	if !ok {
 		return
 	}
-	if oldVm.Status.IPAddress != newVm.Status.IPAddress || oldVm.Status.Node != newVm.Status.Node {
+	if oldVm.Status.IPAddress != newVm.Status.IPAddress ||
+		!slices.Equal(oldVm.Status.Addresses, newVm.Status.Addresses) ||
+		oldVm.Status.Node != newVm.Status.Node {
 		c.enqueueVirtualMachine(newVm)
 	}
 }
 
-func (c *Controller) addCiliumNode(_ interface{}) {
-	// Do nothing
-}
-
-func (c *Controller) deleteCiliumNode(obj interface{}) {
-	node, ok := obj.(*ciliumv2.CiliumNode)
-	if !ok {
-		return
-	}
-	vms, err := c.vmLister.List(labels.Everything())
-	if err != nil {
-		c.log.Error(err, "failed to list virtual machines")
-		return
-	}
-
-	for _, vm := range vms {
-		if vm.Status.Node == node.Name {
-			c.enqueueVirtualMachine(vm)
-		}
-	}
-
-}
-func (c *Controller) updateCiliumNode(oldObj interface{}, newObj interface{}) {
-	oldNode, ok := oldObj.(*ciliumv2.CiliumNode)
-	if !ok {
-		return
-	}
-	newNode, ok := newObj.(*ciliumv2.CiliumNode)
-	if !ok {
-		return
-	}
-
-	oldIP := c.getCiliumInternalIP(oldNode)
-	newIP := c.getCiliumInternalIP(newNode)
-
-	if oldIP == newIP {
-		return
-	}
+// enqueueNodeVirtualMachines enqueues VMs running on the node, e.g. when next-hop addresses of the node change.
+func (c *Controller) enqueueNodeVirtualMachines(nodeName string) {
 	vms, err := c.vmLister.List(labels.Everything())
 	if err != nil {
 		c.log.Error(err, "failed to list virtual machines")
@@ -177,21 +138,12 @@ func (c *Controller) updateCiliumNode(oldObj interface{}, newObj interface{}) {
 	}
 
 	for _, vm := range vms {
-		if vm.Status.Node == newNode.Name {
+		if vm.Status.Node == nodeName {
 			c.enqueueVirtualMachine(vm)
 		}
 	}
 }
 
-func (c *Controller) getCiliumInternalIP(node *ciliumv2.CiliumNode) string {
-	for _, addr := range node.Spec.Addresses {
-		if addr.Type == addressing.NodeCiliumInternalIP {
-			return addr.IP
-		}
-	}
-	return ""
-}
-
 func (c *Controller) enqueueVirtualMachine(vm *v1alpha2.VirtualMachine) {
 	key, err := KeyFunc(vm)
 	if err != nil {
@@ -257,7 +209,16 @@ func (c *Controller) worker(ctx context.Context) {
 		}
 		defer c.queue.Done(key)
 
-		if err := c.sync(key.(string)); err != nil {
+		start := time.Now()
+		err := c.sync(key.(string))
+		result := metrics.ResultSuccess
+		if err != nil {
+			result = metrics.ResultError
+		}
+		metrics.ReconcileDuration.WithLabelValues(result).Observe(time.Since(start).Seconds())
+
+		if err != nil {
+			metrics.SyncErrorsTotal.WithLabelValues(controllerName).Inc()
 			c.log.Error(err, fmt.Sprintf("re-enqueuing VirtualMachine %v", key))
 			c.queue.AddRateLimited(key)
 		} else {
@@ -286,7 +247,7 @@ func (c *Controller) sync(key string) error {
 	ns, name, _ := strings.Cut(key, "/")
 	k := types.NamespacedName{Name: name, Namespace: ns}
 	if !exists {
-		if err = c.netlinkMgr.DeleteRoute(k, ""); err != nil {
+		if err = c.netlinkMgr.DeleteRoute(k); err != nil {
 			return fmt.Errorf("failed to delete route: %w", err)
 		}
 		return nil
@@ -295,7 +256,7 @@ func (c *Controller) sync(key string) error {
 	vm := originalVM.DeepCopy()
 
 	if vm.GetDeletionTimestamp() != nil {
-		if err = c.netlinkMgr.DeleteRoute(k, vm.Status.IPAddress); err != nil {
+		if err = c.netlinkMgr.DeleteRoute(k, netlinkmanager.GetVMIPAddresses(vm)...); err != nil {
 			return fmt.Errorf("failed to delete route: %w", err)
 		}
 		return nil
@@ -307,17 +268,13 @@ func (c *Controller) sync(key string) error {
 		return nil
 	}
 
-	// Retrieve a Cilium Node by VMs node name.
-	var ciliumNode *ciliumv2.CiliumNode
-	obj, exists, err = c.cnIndexer.GetByKey(vm.Status.Node)
+	// Retrieve next-hop addresses by VMs node name.
+	nodeIPs, err := c.nextHop.NodeIPs(vm.Status.Node)
 	if err != nil {
-		return fmt.Errorf("failed to get cilium node for vm: %w", err)
-	}
-	if exists {
-		ciliumNode = obj.(*ciliumv2.CiliumNode)
+		return fmt.Errorf("failed to get next hop addresses for vm: %w", err)
 	}
 
-	if err = c.netlinkMgr.UpdateRoute(vm, ciliumNode); err != nil {
+	if err = c.netlinkMgr.UpdateRoute(vm, nodeIPs); err != nil {
 		return fmt.Errorf("failed to update route: %w", err)
 	}
 	return nil
@@ -21,6 +21,8 @@ import (
 
 	ciliumClient "github.com/cilium/cilium/pkg/k8s/client/clientset/versioned"
 	ciliumInformers "github.com/cilium/cilium/pkg/k8s/client/informers/externalversions"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
 	virtClient "github.com/deckhouse/virtualization/api/client/generated/clientset/versioned"
@@ -49,3 +51,7 @@ func CiliumInformerFactory(restConfig *rest.Config) (ciliumInformers.SharedInfor
 	}
 	return ciliumInformers.NewSharedInformerFactory(client, defaultResync), nil
 }
+
+func KubeInformerFactory(client kubernetes.Interface) informers.SharedInformerFactory {
+	return informers.NewSharedInformerFactory(client, defaultResync)
+}
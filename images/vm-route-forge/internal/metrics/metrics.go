@@ -0,0 +1,96 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+)
+
+const (
+	MetricNamespace = "d8_virtualization"
+	metricSubsystem = "route_forge"
+
+	ResultSuccess = "success"
+	ResultError   = "error"
+
+	ReasonMissing  = "missing"
+	ReasonMismatch = "mismatch"
+)
+
+var (
+	// ManagedRoutes is the number of VM routes the node should have in the route table.
+	ManagedRoutes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: MetricNamespace,
+		Subsystem: metricSubsystem,
+		Name:      "managed_routes",
+		Help:      "The number of routes to virtual machines managed on the node.",
+	}, []string{"family"})
+
+	SyncErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricNamespace,
+		Subsystem: metricSubsystem,
+		Name:      "sync_errors_total",
+		Help:      "The number of failed route synchronizations.",
+	}, []string{"component"})
+
+	EbpfEventsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricNamespace,
+		Subsystem: metricSubsystem,
+		Name:      "ebpf_events_total",
+		Help:      "The number of route events in the route table received from the eBPF watcher.",
+	}, []string{"action", "family"})
+
+	// RouteCorrectionsTotal is the number of VMs enqueued by route watchers because of missing or mismatched routes.
+	RouteCorrectionsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: MetricNamespace,
+		Subsystem: metricSubsystem,
+		Name:      "route_corrections_total",
+		Help:      "The number of route corrections requested by route watchers.",
+	}, []string{"watcher", "reason"})
+
+	ReconcileDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: MetricNamespace,
+		Subsystem: metricSubsystem,
+		Name:      "reconcile_duration_seconds",
+		Help:      "The duration of virtual machine route reconciliation.",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 14),
+	}, []string{"result"})
+)
+
+// Registry is the registry of vm-route-forge metrics.
+var Registry = prometheus.NewRegistry()
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		ManagedRoutes,
+		SyncErrorsTotal,
+		EbpfEventsTotal,
+		RouteCorrectionsTotal,
+		ReconcileDuration,
+	)
+}
+
+// Family returns the value of the family label.
+func Family(isIPv6 bool) string {
+	if isIPv6 {
+		return "ipv6"
+	}
+	return "ipv4"
+}
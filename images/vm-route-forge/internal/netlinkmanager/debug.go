@@ -0,0 +1,179 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package netlinkmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+	"k8s.io/apimachinery/pkg/types"
+
+	vmipcache "vm-route-forge/internal/cache"
+	"vm-route-forge/internal/netutil"
+)
+
+// RouteInfo describes a route to the VM in the route table.
+type RouteInfo struct {
+	VirtualMachine string `json:"virtualMachine,omitempty"`
+	Dst            string `json:"dst"`
+	Src            string `json:"src,omitempty"`
+	NodeIP         string `json:"nodeIP,omitempty"`
+	Gateway        string `json:"gateway,omitempty"`
+	Link           string `json:"link,omitempty"`
+	Type           string `json:"type,omitempty"`
+}
+
+// RoutesDiff compares desired routes for known VMs with actual routes in the route table of the node.
+type RoutesDiff struct {
+	TableID int         `json:"tableID"`
+	Desired []RouteInfo `json:"desired"`
+	Actual  []RouteInfo `json:"actual"`
+	// Missing are desired routes absent in the route table.
+	Missing []RouteInfo `json:"missing"`
+	// Mismatched are actual routes with the source address different from the desired one.
+	Mismatched []RouteInfo `json:"mismatched"`
+	// Stale are actual routes to managed IPs not assigned to known VMs.
+	Stale []RouteInfo `json:"stale"`
+}
+
+// RoutesDiff returns desired and actual routes for the node.
+func (m *Manager) RoutesDiff() (*RoutesDiff, error) {
+	routes, err := m.nlWrapper.RouteListFiltered(netlink.FAMILY_ALL, &netlink.Route{Table: m.routeTableID}, netlink.RT_FILTER_TABLE)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list routes: %w", err)
+	}
+
+	diff := &RoutesDiff{
+		TableID:    m.routeTableID,
+		Desired:    []RouteInfo{},
+		Actual:     []RouteInfo{},
+		Missing:    []RouteInfo{},
+		Mismatched: []RouteInfo{},
+		Stale:      []RouteInfo{},
+	}
+
+	actual := make(map[string]RouteInfo, len(routes))
+	for _, route := range routes {
+		if route.Dst == nil {
+			continue
+		}
+		info := RouteInfo{
+			Dst:  route.Dst.String(),
+			Type: routeTypeName(route.Type),
+		}
+		if route.Src != nil {
+			info.Src = route.Src.String()
+		}
+		if route.Gw != nil {
+			info.Gateway = route.Gw.String()
+		}
+		if route.LinkIndex > 0 {
+			if link, err := netlink.LinkByIndex(route.LinkIndex); err == nil {
+				info.Link = link.Attrs().Name
+			}
+		}
+		diff.Actual = append(diff.Actual, info)
+		if route.Type == unix.RTN_UNICAST {
+			actual[info.Dst] = info
+		}
+	}
+
+	desired := make(map[string]struct{})
+	m.cache.Iterate(func(k types.NamespacedName, v []vmipcache.Addresses) bool {
+		for _, addr := range v {
+			_, vmRouteDst, err := net.ParseCIDR(netutil.AppendHostNetmask(addr.VMIP.String()))
+			if err != nil {
+				continue
+			}
+			info := RouteInfo{
+				VirtualMachine: k.String(),
+				Dst:            vmRouteDst.String(),
+				NodeIP:         addr.NodeIP.String(),
+			}
+			// The route to the VM repeats the route to the node.
+			if nodeRoutes, err := m.nlWrapper.RouteGet(addr.NodeIP.NetIP()); err == nil && len(nodeRoutes) > 0 && nodeRoutes[0].Src != nil {
+				info.Src = nodeRoutes[0].Src.String()
+			}
+			diff.Desired = append(diff.Desired, info)
+			desired[info.Dst] = struct{}{}
+
+			route, found := actual[info.Dst]
+			switch {
+			case !found:
+				diff.Missing = append(diff.Missing, info)
+			case info.Src != "" && route.Src != info.Src:
+				diff.Mismatched = append(diff.Mismatched, route)
+			}
+		}
+		return true
+	})
+
+	for dst, route := range actual {
+		if _, found := desired[dst]; found {
+			continue
+		}
+		ip, _, err := net.ParseCIDR(dst)
+		if err != nil {
+			continue
+		}
+		if isManaged, _ := m.isManagedIP(ip.String()); isManaged {
+			diff.Stale = append(diff.Stale, route)
+		}
+	}
+
+	for _, routes := range [][]RouteInfo{diff.Desired, diff.Actual, diff.Missing, diff.Mismatched, diff.Stale} {
+		sort.Slice(routes, func(i, j int) bool { return routes[i].Dst < routes[j].Dst })
+	}
+	return diff, nil
+}
+
+// RoutesDiffHandler serves RoutesDiff in JSON.
+func (m *Manager) RoutesDiffHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		diff, err := m.RoutesDiff()
+		w.Header().Set("Content-Type", "application/json")
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		enc.Encode(diff)
+	})
+}
+
+func routeTypeName(t int) string {
+	switch t {
+	case unix.RTN_UNICAST:
+		return "unicast"
+	case unix.RTN_BLACKHOLE:
+		return "blackhole"
+	case unix.RTN_LOCAL:
+		return "local"
+	case unix.RTN_UNREACHABLE:
+		return "unreachable"
+	default:
+		return fmt.Sprintf("%d", t)
+	}
+}
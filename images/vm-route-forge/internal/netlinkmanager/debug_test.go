@@ -0,0 +1,116 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package netlinkmanager
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/vishvananda/netlink"
+	"k8s.io/apimachinery/pkg/types"
+
+	"vm-route-forge/internal/metrics"
+)
+
+func TestRoutesDiff(t *testing.T) {
+	setupNetNS(t)
+
+	m := newTestManager(t, DefaultCiliumRouteTable, "10.66.10.0/24", "fd00:10::/64")
+	if err := m.AddSubnetsRoutesToBlackHole(); err != nil {
+		t.Fatalf("AddSubnetsRoutesToBlackHole: %v", err)
+	}
+	vm1 := newTestVM("10.66.10.1", "fd00:10::1")
+	if err := m.UpdateRoute(vm1, []string{testNodeIPv4, testNodeIPv6}); err != nil {
+		t.Fatalf("UpdateRoute: %v", err)
+	}
+	vm2 := newTestVM("10.66.10.2")
+	vm2.Name = "vm2"
+	if err := m.UpdateRoute(vm2, []string{testNodeIPv4}); err != nil {
+		t.Fatalf("UpdateRoute: %v", err)
+	}
+
+	if v := testutil.ToFloat64(metrics.ManagedRoutes.WithLabelValues("ipv4")); v != 2 {
+		t.Errorf("expected 2 managed IPv4 routes, got %v", v)
+	}
+	if v := testutil.ToFloat64(metrics.ManagedRoutes.WithLabelValues("ipv6")); v != 1 {
+		t.Errorf("expected 1 managed IPv6 route, got %v", v)
+	}
+
+	diff, err := m.RoutesDiff()
+	if err != nil {
+		t.Fatalf("RoutesDiff: %v", err)
+	}
+	if len(diff.Desired) != 3 || len(diff.Missing) != 0 || len(diff.Mismatched) != 0 || len(diff.Stale) != 0 {
+		t.Fatalf("expected 3 desired routes without differences, got %+v", diff)
+	}
+	// 3 routes to VMs and 2 blackhole routes for CIDRs.
+	if len(diff.Actual) != 5 {
+		t.Errorf("expected 5 actual routes, got %+v", diff.Actual)
+	}
+
+	// Break routes: remove the route to vm2, add a route for an unknown IP, change src of the IPv6 route.
+	delRoute := func(dst string) {
+		t.Helper()
+		ipNet, _ := netlink.ParseIPNet(dst)
+		if err := netlink.RouteDel(&netlink.Route{Dst: ipNet, Table: DefaultCiliumRouteTable}); err != nil {
+			t.Fatalf("failed to delete route %s: %v", dst, err)
+		}
+	}
+	link, err := netlink.LinkByName(testLocalLinkName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	delRoute("10.66.10.2/32")
+	stale, _ := netlink.ParseIPNet("10.66.10.3/32")
+	if err = netlink.RouteAdd(&netlink.Route{Dst: stale, LinkIndex: link.Attrs().Index, Table: DefaultCiliumRouteTable}); err != nil {
+		t.Fatal(err)
+	}
+	ipv6Dst, _ := netlink.ParseIPNet("fd00:10::1/128")
+	if err = netlink.RouteReplace(&netlink.Route{Dst: ipv6Dst, LinkIndex: link.Attrs().Index, Table: DefaultCiliumRouteTable}); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := httptest.NewRecorder()
+	m.RoutesDiffHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/routes", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("unexpected status %d: %s", rec.Code, rec.Body.String())
+	}
+	diff = &RoutesDiff{}
+	if err = json.Unmarshal(rec.Body.Bytes(), diff); err != nil {
+		t.Fatal(err)
+	}
+
+	vm2Key := types.NamespacedName{Namespace: vm2.Namespace, Name: vm2.Name}.String()
+	if len(diff.Missing) != 1 || diff.Missing[0].Dst != "10.66.10.2/32" || diff.Missing[0].VirtualMachine != vm2Key {
+		t.Errorf("expected missing route to %s, got %+v", vm2Key, diff.Missing)
+	}
+	if len(diff.Stale) != 1 || diff.Stale[0].Dst != "10.66.10.3/32" {
+		t.Errorf("expected stale route to 10.66.10.3/32, got %+v", diff.Stale)
+	}
+	if len(diff.Mismatched) != 1 || diff.Mismatched[0].Dst != "fd00:10::1/128" {
+		t.Errorf("expected mismatched route to fd00:10::1/128, got %+v", diff.Mismatched)
+	}
+	for _, route := range diff.Desired {
+		if route.Dst == "fd00:10::1/128" && !net.ParseIP(route.Src).Equal(net.ParseIP(testNodeIPv6)) {
+			t.Errorf("expected desired src %s, got %s", testNodeIPv6, route.Src)
+		}
+	}
+}
@@ -21,23 +21,22 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"slices"
 	"vm-route-forge/internal/netlinkwrap"
 	"vm-route-forge/internal/netutil"
 
-	ciliumv2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
-	"github.com/cilium/cilium/pkg/node/addressing"
 	"github.com/go-logr/logr"
 	"github.com/vishvananda/netlink"
 	"golang.org/x/sys/unix"
 	"k8s.io/apimachinery/pkg/types"
 
 	vmipcache "vm-route-forge/internal/cache"
+	"vm-route-forge/internal/metrics"
 
 	"github.com/deckhouse/virtualization/api/core/v1alpha2"
 )
 
 const (
-	CiliumIfaceName         = "cilium_host"
 	DefaultCiliumRouteTable = 1490
 	LocalRouteTable         = 255
 	netlinkManager          = "netlinkManager"
@@ -46,26 +45,35 @@ const (
 )
 
 type Manager struct {
-	log          logr.Logger
-	nlWrapper    *netlinkwrap.Funcs
-	routeTableID int
-	cidrs        []*net.IPNet
-	nodeName     string
-	cache        vmipcache.Cache
+	log           logr.Logger
+	nlWrapper     *netlinkwrap.Funcs
+	routeTableID  int
+	rulePriority  int
+	cidrs         []*net.IPNet
+	nodeName      string
+	localLinkName string
+	cache         vmipcache.Cache
 }
 
+// New returns the Manager of routes in the routeTableID table.
+// Rules for cidrs are added with the rulePriority priority.
+// Routes to VMs on the current node are sent to the localLinkName link, if it is not empty.
 func New(cache vmipcache.Cache,
 	log logr.Logger,
 	routeTableID int,
+	rulePriority int,
 	cidrs []*net.IPNet,
+	localLinkName string,
 	nlWrapper *netlinkwrap.Funcs,
 ) *Manager {
 	return &Manager{
-		log:          log.WithValues("manager", netlinkManager),
-		routeTableID: routeTableID,
-		cidrs:        cidrs,
-		nlWrapper:    nlWrapper,
-		cache:        cache,
+		log:           log.WithValues("manager", netlinkManager),
+		routeTableID:  routeTableID,
+		rulePriority:  rulePriority,
+		cidrs:         cidrs,
+		localLinkName: localLinkName,
+		nlWrapper:     nlWrapper,
+		cache:         cache,
 	}
 }
 
@@ -100,7 +108,7 @@ func (m *Manager) SyncRules() error {
 	for _, cidr := range m.cidrs {
 		rule := netlink.NewRule()
 		rule.Table = m.routeTableID
-		rule.Priority = m.routeTableID
+		rule.Priority = m.rulePriority
 		rule.Dst = cidr
 		cidrIdx[cidr.String()] = struct{}{}
 		if err = m.nlWrapper.RuleAdd(rule); err != nil && !os.IsExist(err) {
@@ -115,13 +123,13 @@ func (m *Manager) SyncRules() error {
 		if rule.Dst == nil {
 			continue
 		}
-		// Ignore rules for configured CIDRs.
+		// Ignore rules for configured CIDRs with configured priority.
 		cidr := rule.Dst.String()
-		if _, ok := cidrIdx[cidr]; ok {
+		if _, ok := cidrIdx[cidr]; ok && rule.Priority == m.rulePriority {
 			continue
 		}
 
-		// Dst is not for the configured CIDR, remove it.
+		// Dst is not for the configured CIDR or priority has changed, remove it.
 		err = m.nlWrapper.RuleDel(&rule)
 		if err != nil {
 			return fmt.Errorf("failed to deleted rule %s: %w", rule.String(), err)
@@ -149,48 +157,83 @@ func (m *Manager) isManagedIP(ip string) (bool, error) {
 	return false, nil
 }
 
-// UpdateRoute updates route for a single VirtualMachine.
-func (m *Manager) UpdateRoute(vm *v1alpha2.VirtualMachine, ciliumNode *ciliumv2.CiliumNode) error {
+// UpdateRoute updates routes for a single VirtualMachine, one route per IP family of the VM.
+// nodeIPs are next-hop addresses of the VM node.
+func (m *Manager) UpdateRoute(vm *v1alpha2.VirtualMachine, nodeIPs []string) error {
 	// TODO Add cleanup if node was lost?
 	// TODO What about migration? Is nodeName just changed to new node or we need some workarounds when 2 Pods are running?
 	if vm == nil {
 		return nil
 	}
-	nodeIP := getCiliumInternalIPAddress(ciliumNode)
-	if nodeIP == "" {
-		return fmt.Errorf("ciliumNode has no %s specified", addressing.NodeCiliumInternalIP)
+	vmIPs := GetVMIPAddresses(vm)
+	if len(vmIPs) == 0 {
+		// VM has no IP address assigned
+		return nil
 	}
-	nodeIPx := net.ParseIP(nodeIP)
-	if len(nodeIPx) == 0 {
-		return fmt.Errorf("invalid IP address %s", nodeIP)
+
+	vmKey := types.NamespacedName{Name: vm.GetName(), Namespace: vm.GetNamespace()}
+	var addrs []vmipcache.Addresses
+	for _, vmIP := range vmIPs {
+		isManaged, err := m.isManagedIP(vmIP)
+		if err != nil {
+			return fmt.Errorf("failed to parse IP address in VM status: %w", err)
+		}
+		if !isManaged {
+			m.log.Info(fmt.Sprintf("Ignore not managed IP %s assigned to VM/%s", vmIP, vm.GetName()))
+			continue
+		}
+
+		isIPv6 := net.ParseIP(vmIP).To4() == nil
+		nodeIP := getNodeIPAddress(nodeIPs, isIPv6)
+		if nodeIP == "" {
+			return fmt.Errorf("node %s has no %s next hop address", vm.Status.Node, ipFamilyName(isIPv6))
+		}
+		addrs = append(addrs, vmipcache.Addresses{VMIP: vmipcache.IP(vmIP), NodeIP: vmipcache.IP(nodeIP)})
 	}
 
-	vmIP := vm.Status.IPAddress
-	if vmIP == "" {
-		// VM has no IP address assigned
+	// Remove routes for IPs that are no longer assigned to the VM.
+	if cached, found := m.cache.GetAddresses(vmKey); found {
+		for _, c := range cached {
+			if slices.ContainsFunc(addrs, func(a vmipcache.Addresses) bool { return a.VMIP.NetIP().Equal(c.VMIP.NetIP()) }) {
+				continue
+			}
+			if err := m.deleteRoute(vmKey, c.VMIP.String()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(addrs) == 0 {
+		m.cache.DeleteByKey(vmKey)
+		m.updateManagedRoutesMetric()
 		return nil
 	}
 
-	isManaged, err := m.isManagedIP(vmIP)
-	if err != nil {
-		return fmt.Errorf("failed to parse IP address in VM status: %w", err)
+	// Save IPs to the in-memory cache to restore IPs later.
+	m.cache.Set(vmKey, addrs)
+	m.updateManagedRoutesMetric()
+
+	for _, addr := range addrs {
+		if err := m.updateRoute(vmKey, addr); err != nil {
+			return err
+		}
 	}
-	if !isManaged {
-		m.log.Info(fmt.Sprintf("Ignore not managed IP %s assigned to VM/%s", vmIP, vm.GetName()))
-		return nil
+	return nil
+}
+
+func (m *Manager) updateRoute(vmKey types.NamespacedName, addr vmipcache.Addresses) error {
+	nodeIPx := addr.NodeIP.NetIP()
+	if len(nodeIPx) == 0 {
+		return fmt.Errorf("invalid IP address %s", addr.NodeIP)
 	}
 
 	// Prepare ip with the mask to use as the route destination.
-	vmIPWithNetmask := netutil.AppendHostNetmask(vmIP)
-	_, vmRouteDst, err := net.ParseCIDR(netutil.AppendHostNetmask(vmIP))
+	vmIPWithNetmask := netutil.AppendHostNetmask(addr.VMIP.String())
+	_, vmRouteDst, err := net.ParseCIDR(vmIPWithNetmask)
 	if err != nil {
-		return fmt.Errorf("failed to parse IP with netmask %s for vm/%s: %w", vmIPWithNetmask, vm.GetName(), err)
+		return fmt.Errorf("failed to parse IP with netmask %s for vm/%s: %w", vmIPWithNetmask, vmKey.Name, err)
 	}
 
-	// Save IP to the in-memory cache to restore IP later.
-	vmKey := types.NamespacedName{Name: vm.GetName(), Namespace: vm.GetNamespace()}
-	m.cache.Set(vmKey, vmipcache.Addresses{VMIP: vmipcache.IP(vmIP), NodeIP: vmipcache.IP(nodeIP)})
-
 	// Get route for specific nodeIP and create similar for our Virtual Machine.
 	routes, err := m.nlWrapper.RouteGet(nodeIPx)
 	if err != nil || len(routes) == 0 {
@@ -199,13 +242,13 @@ func (m *Manager) UpdateRoute(vm *v1alpha2.VirtualMachine, ciliumNode *ciliumv2.
 	origRoute := routes[0]
 	route := routes[0]
 
-	// Change iface to cilium if route already exists in local table.
-	if route.Table == LocalRouteTable {
-		iface, err := netlink.LinkByName(CiliumIfaceName)
+	// Change iface to the local link (e.g. cilium_host) if route already exists in local table.
+	if route.Table == LocalRouteTable && m.localLinkName != "" {
+		iface, err := netlink.LinkByName(m.localLinkName)
 		if err != nil {
-			return fmt.Errorf("failed to get cilium interface %s: %w", CiliumIfaceName, err)
+			return fmt.Errorf("failed to get local interface %s: %w", m.localLinkName, err)
 		}
-		// Overwrite `lo` interface with `cilium_host`
+		// Overwrite `lo` interface with the local link.
 		route.LinkIndex = iface.Attrs().Index
 	}
 
@@ -215,37 +258,85 @@ func (m *Manager) UpdateRoute(vm *v1alpha2.VirtualMachine, ciliumNode *ciliumv2.
 	route.Priority = routePriority
 
 	if err = m.nlWrapper.RouteReplace(&route); err != nil {
-		m.log.Error(err, fmt.Sprintf("failed to update route %q to %q for VM %s/%s", fmtRoute(origRoute), fmtRoute(route), vm.GetNamespace(), vm.GetName()))
+		m.log.Error(err, fmt.Sprintf("failed to update route %q to %q for VM %s", fmtRoute(origRoute), fmtRoute(route), vmKey))
 		return fmt.Errorf("failed to update route: %w", err)
 	}
-	m.log.Info(fmt.Sprintf("route %q updated for VM %s/%s", fmtRoute(route), vm.GetNamespace(), vm.GetName()))
+	m.log.Info(fmt.Sprintf("route %q updated for VM %s", fmtRoute(route), vmKey))
 	return nil
 }
 
-func getCiliumInternalIPAddress(node *ciliumv2.CiliumNode) string {
-	if node == nil {
-		return ""
+// GetVMIPAddresses returns IP addresses of the VM, one per IP family.
+func GetVMIPAddresses(vm *v1alpha2.VirtualMachine) []string {
+	if len(vm.Status.Addresses) > 0 {
+		return vm.Status.Addresses
 	}
-	for _, address := range node.Spec.Addresses {
-		if address.Type == addressing.NodeCiliumInternalIP {
-			return address.IP
+	if vm.Status.IPAddress != "" {
+		return []string{vm.Status.IPAddress}
+	}
+	return nil
+}
+
+// getNodeIPAddress returns the node address of the requested IP family.
+func getNodeIPAddress(nodeIPs []string, isIPv6 bool) string {
+	for _, nodeIP := range nodeIPs {
+		ip := net.ParseIP(nodeIP)
+		if ip != nil && (ip.To4() == nil) == isIPv6 {
+			return nodeIP
 		}
 	}
 	return ""
 }
 
-func (m *Manager) DeleteRoute(vmKey types.NamespacedName, vmIP string) error {
-	if vmIP == "" {
-		// Try to recover IP from the cache.
-		if addr, found := m.cache.GetAddresses(vmKey); found {
-			vmIP = addr.VMIP.String()
+func ipFamilyName(isIPv6 bool) string {
+	if isIPv6 {
+		return "IPv6"
+	}
+	return "IPv4"
+}
+
+// DeleteRoute deletes routes for all IPs of the VM.
+// IPs are recovered from the cache if they are not passed.
+func (m *Manager) DeleteRoute(vmKey types.NamespacedName, vmIPs ...string) error {
+	if len(vmIPs) == 0 {
+		// Try to recover IPs from the cache.
+		if addrs, found := m.cache.GetAddresses(vmKey); found {
+			for _, addr := range addrs {
+				vmIPs = append(vmIPs, addr.VMIP.String())
+			}
 		}
 	}
-	if vmIP == "" {
+	if len(vmIPs) == 0 {
 		m.log.Info(fmt.Sprintf("Can't retrieve IP for VM %q, it may lead to stale routes.", vmKey.String()))
 		return nil
 	}
 
+	for _, vmIP := range vmIPs {
+		if err := m.deleteRoute(vmKey, vmIP); err != nil {
+			return err
+		}
+	}
+
+	// Delete IPs from the cache.
+	m.cache.DeleteByKey(vmKey)
+	m.updateManagedRoutesMetric()
+	return nil
+}
+
+// updateManagedRoutesMetric counts routes in the cache by IP family.
+func (m *Manager) updateManagedRoutesMetric() {
+	counts := map[bool]int{}
+	m.cache.Iterate(func(_ types.NamespacedName, v []vmipcache.Addresses) bool {
+		for _, addr := range v {
+			counts[addr.VMIP.NetIP().To4() == nil]++
+		}
+		return true
+	})
+	for _, isIPv6 := range []bool{false, true} {
+		metrics.ManagedRoutes.WithLabelValues(metrics.Family(isIPv6)).Set(float64(counts[isIPv6]))
+	}
+}
+
+func (m *Manager) deleteRoute(vmKey types.NamespacedName, vmIP string) error {
 	// Prepare ip with the mask to use as the route destination.
 	vmIPWithNetmask := netutil.AppendHostNetmask(vmIP)
 	_, vmRouteDst, err := net.ParseCIDR(vmIPWithNetmask)
@@ -262,9 +353,6 @@ func (m *Manager) DeleteRoute(vmKey types.NamespacedName, vmIP string) error {
 		return fmt.Errorf("failed to delete route: %w", err)
 	}
 	m.log.Info(fmt.Sprintf("route %s deleted for VM %q", fmtRoute(route), vmKey))
-
-	// Delete IP from the cache.
-	m.cache.DeleteByKey(vmKey)
 	return nil
 }
 
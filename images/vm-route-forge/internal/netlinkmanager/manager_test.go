@@ -0,0 +1,250 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package netlinkmanager
+
+import (
+	"net"
+	"os"
+	"runtime"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netns"
+	"golang.org/x/sys/unix"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	vmipcache "vm-route-forge/internal/cache"
+	"vm-route-forge/internal/netlinkwrap"
+
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+)
+
+const (
+	testLocalLinkName = "cilium_host"
+	testNodeIPv4      = "10.0.0.1"
+	testNodeIPv6      = "fd00::1"
+)
+
+// setupNetNS moves the test into a new network namespace with the cilium_host
+// interface holding the CiliumInternalIPs of both families.
+func setupNetNS(t *testing.T) {
+	t.Helper()
+	if os.Geteuid() != 0 {
+		t.Skip("creating a network namespace requires root")
+	}
+
+	runtime.LockOSThread()
+	origin, err := netns.Get()
+	if err != nil {
+		runtime.UnlockOSThread()
+		t.Fatalf("failed to get current network namespace: %v", err)
+	}
+	ns, err := netns.New()
+	if err != nil {
+		origin.Close()
+		runtime.UnlockOSThread()
+		t.Skipf("failed to create network namespace: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = netns.Set(origin)
+		ns.Close()
+		origin.Close()
+		runtime.UnlockOSThread()
+	})
+
+	lo, err := netlink.LinkByName("lo")
+	if err != nil {
+		t.Fatalf("failed to get lo: %v", err)
+	}
+	if err = netlink.LinkSetUp(lo); err != nil {
+		t.Fatalf("failed to set lo up: %v", err)
+	}
+
+	// Cilium creates cilium_host as a veth pair with cilium_net.
+	ciliumHost := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: testLocalLinkName}, PeerName: "cilium_net"}
+	if err = netlink.LinkAdd(ciliumHost); err != nil {
+		t.Fatalf("failed to add %s: %v", testLocalLinkName, err)
+	}
+	for _, addr := range []string{testNodeIPv4 + "/32", testNodeIPv6 + "/128"} {
+		ipNet, err := netlink.ParseIPNet(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = netlink.AddrAdd(ciliumHost, &netlink.Addr{IPNet: ipNet, Flags: unix.IFA_F_NODAD}); err != nil {
+			t.Fatalf("failed to add address %s: %v", addr, err)
+		}
+	}
+	if err = netlink.LinkSetUp(ciliumHost); err != nil {
+		t.Fatalf("failed to set %s up: %v", testLocalLinkName, err)
+	}
+}
+
+func newTestManager(t *testing.T, rulePriority int, cidrs ...string) *Manager {
+	t.Helper()
+	var parsed []*net.IPNet
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsed = append(parsed, ipNet)
+	}
+	return New(vmipcache.NewCache(), logr.Discard(), DefaultCiliumRouteTable, rulePriority, parsed, testLocalLinkName, netlinkwrap.NewFuncs())
+}
+
+func newTestVM(addresses ...string) *v1alpha2.VirtualMachine {
+	vm := &v1alpha2.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: "vm", Namespace: "default"}}
+	vm.Status.Node = "node-1"
+	vm.Status.Addresses = addresses
+	if len(addresses) > 0 {
+		vm.Status.IPAddress = addresses[0]
+	}
+	return vm
+}
+
+func listTableRoutes(t *testing.T, family int) map[string]netlink.Route {
+	t.Helper()
+	routes, err := netlink.RouteListFiltered(family, &netlink.Route{Table: DefaultCiliumRouteTable}, netlink.RT_FILTER_TABLE)
+	if err != nil {
+		t.Fatalf("failed to list routes: %v", err)
+	}
+	res := make(map[string]netlink.Route, len(routes))
+	for _, route := range routes {
+		if route.Dst != nil {
+			res[route.Dst.String()] = route
+		}
+	}
+	return res
+}
+
+func TestUpdateRouteDualStack(t *testing.T) {
+	setupNetNS(t)
+
+	m := newTestManager(t, DefaultCiliumRouteTable, "10.66.10.0/24", "fd00:10::/64")
+	vm := newTestVM("10.66.10.1", "fd00:10::1")
+	vmKey := types.NamespacedName{Name: vm.Name, Namespace: vm.Namespace}
+
+	if err := m.UpdateRoute(vm, []string{testNodeIPv4, testNodeIPv6}); err != nil {
+		t.Fatalf("UpdateRoute: %v", err)
+	}
+
+	for family, expected := range map[int]struct{ dst, src string }{
+		netlink.FAMILY_V4: {"10.66.10.1/32", testNodeIPv4},
+		netlink.FAMILY_V6: {"fd00:10::1/128", testNodeIPv6},
+	} {
+		route, found := listTableRoutes(t, family)[expected.dst]
+		if !found {
+			t.Fatalf("route to %s not found in table %d", expected.dst, DefaultCiliumRouteTable)
+		}
+		if !route.Src.Equal(net.ParseIP(expected.src)) {
+			t.Errorf("route to %s has src %s, expected %s", expected.dst, route.Src, expected.src)
+		}
+	}
+
+	addrs, found := m.cache.GetAddresses(vmKey)
+	if !found || len(addrs) != 2 {
+		t.Fatalf("expected 2 cached addresses, got %v", addrs)
+	}
+	key, found := m.cache.GetName(net.ParseIP("fd00:10::1"))
+	if !found || key != vmKey {
+		t.Errorf("expected %s by IPv6 address, got %s", vmKey, key)
+	}
+
+	// Drop the IPv6 address, its route should be removed.
+	vm.Status.Addresses = []string{"10.66.10.1"}
+	if err := m.UpdateRoute(vm, []string{testNodeIPv4, testNodeIPv6}); err != nil {
+		t.Fatalf("UpdateRoute: %v", err)
+	}
+	if _, found = listTableRoutes(t, netlink.FAMILY_V6)["fd00:10::1/128"]; found {
+		t.Errorf("stale IPv6 route was not removed")
+	}
+	if _, found = listTableRoutes(t, netlink.FAMILY_V4)["10.66.10.1/32"]; !found {
+		t.Errorf("IPv4 route was removed")
+	}
+
+	if err := m.DeleteRoute(vmKey); err != nil {
+		t.Fatalf("DeleteRoute: %v", err)
+	}
+	if routes := listTableRoutes(t, netlink.FAMILY_ALL); len(routes) != 0 {
+		t.Errorf("expected no routes after DeleteRoute, got %v", routes)
+	}
+	if _, found = m.cache.GetAddresses(vmKey); found {
+		t.Errorf("expected cache to be cleaned")
+	}
+}
+
+func TestUpdateRouteNoNodeIPv6(t *testing.T) {
+	setupNetNS(t)
+
+	m := newTestManager(t, DefaultCiliumRouteTable, "fd00:10::/64")
+	vm := newTestVM("fd00:10::1")
+
+	if err := m.UpdateRoute(vm, []string{testNodeIPv4}); err == nil {
+		t.Fatalf("expected error for node without IPv6 address")
+	}
+	if routes := listTableRoutes(t, netlink.FAMILY_V6); len(routes) != 0 {
+		t.Errorf("expected no routes, got %v", routes)
+	}
+}
+
+func TestSyncRulesIPv6(t *testing.T) {
+	setupNetNS(t)
+
+	m := newTestManager(t, DefaultCiliumRouteTable, "10.66.10.0/24", "fd00:10::/64")
+	if err := m.SyncRules(); err != nil {
+		t.Fatalf("SyncRules: %v", err)
+	}
+	if err := m.AddSubnetsRoutesToBlackHole(); err != nil {
+		t.Fatalf("AddSubnetsRoutesToBlackHole: %v", err)
+	}
+
+	rules, err := netlink.RuleListFiltered(netlink.FAMILY_V6, &netlink.Rule{Table: DefaultCiliumRouteTable}, netlink.RT_FILTER_TABLE)
+	if err != nil {
+		t.Fatalf("failed to list rules: %v", err)
+	}
+	if len(rules) != 1 || rules[0].Dst == nil || rules[0].Dst.String() != "fd00:10::/64" {
+		t.Errorf("expected rule for fd00:10::/64, got %v", rules)
+	}
+
+	route, found := listTableRoutes(t, netlink.FAMILY_V6)["fd00:10::/64"]
+	if !found || route.Type != unix.RTN_BLACKHOLE {
+		t.Errorf("expected blackhole route for fd00:10::/64, got %v", route)
+	}
+}
+
+func TestSyncRulesPriority(t *testing.T) {
+	setupNetNS(t)
+
+	if err := newTestManager(t, DefaultCiliumRouteTable, "10.66.10.0/24").SyncRules(); err != nil {
+		t.Fatalf("SyncRules: %v", err)
+	}
+
+	// Rules with the previous priority should be replaced.
+	if err := newTestManager(t, 1000, "10.66.10.0/24").SyncRules(); err != nil {
+		t.Fatalf("SyncRules: %v", err)
+	}
+
+	rules, err := netlink.RuleListFiltered(netlink.FAMILY_V4, &netlink.Rule{Table: DefaultCiliumRouteTable}, netlink.RT_FILTER_TABLE)
+	if err != nil {
+		t.Fatalf("failed to list rules: %v", err)
+	}
+	if len(rules) != 1 || rules[0].Priority != 1000 {
+		t.Errorf("expected a single rule with priority 1000, got %v", rules)
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nexthop
+
+import (
+	"fmt"
+	"slices"
+
+	ciliumv2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	ciliumv2Informers "github.com/cilium/cilium/pkg/k8s/client/informers/externalversions/cilium.io/v2"
+	"github.com/cilium/cilium/pkg/node/addressing"
+	"k8s.io/client-go/tools/cache"
+)
+
+// CiliumHostLinkName is the Cilium link that delivers traffic to endpoints on the current node.
+const CiliumHostLinkName = "cilium_host"
+
+// CiliumDiscoverer uses CiliumInternalIP addresses of CiliumNodes as next hops.
+type CiliumDiscoverer struct {
+	informer cache.SharedIndexInformer
+}
+
+func NewCiliumDiscoverer(cnInformer ciliumv2Informers.CiliumNodeInformer) *CiliumDiscoverer {
+	return &CiliumDiscoverer{informer: cnInformer.Informer()}
+}
+
+func (d *CiliumDiscoverer) NodeIPs(nodeName string) ([]string, error) {
+	obj, exists, err := d.informer.GetIndexer().GetByKey(nodeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cilium node %s: %w", nodeName, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	return getCiliumInternalIPs(obj.(*ciliumv2.CiliumNode)), nil
+}
+
+func (d *CiliumDiscoverer) LocalLinkName() string {
+	return CiliumHostLinkName
+}
+
+func (d *CiliumDiscoverer) AddEventHandler(handler func(nodeName string)) error {
+	_, err := d.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldNode, ok := oldObj.(*ciliumv2.CiliumNode)
+			if !ok {
+				return
+			}
+			newNode, ok := newObj.(*ciliumv2.CiliumNode)
+			if !ok {
+				return
+			}
+			if !slices.Equal(getCiliumInternalIPs(oldNode), getCiliumInternalIPs(newNode)) {
+				handler(newNode.Name)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if node, ok := obj.(*ciliumv2.CiliumNode); ok {
+				handler(node.Name)
+			}
+		},
+	})
+	return err
+}
+
+func (d *CiliumDiscoverer) HasSynced() bool {
+	return d.informer.HasSynced()
+}
+
+func getCiliumInternalIPs(node *ciliumv2.CiliumNode) []string {
+	var ips []string
+	for _, addr := range node.Spec.Addresses {
+		if addr.Type == addressing.NodeCiliumInternalIP {
+			ips = append(ips, addr.IP)
+		}
+	}
+	return firstPerFamily(ips)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nexthop
+
+import (
+	"fmt"
+	"net"
+)
+
+type Source string
+
+const (
+	// CiliumSource discovers next hops from CiliumInternalIP addresses of CiliumNodes.
+	CiliumSource Source = "cilium"
+	// NodeSource discovers next hops from InternalIP addresses of Nodes, it does not require Cilium.
+	NodeSource Source = "node"
+)
+
+// Discoverer discovers next-hop addresses to reach VMs running on cluster nodes.
+type Discoverer interface {
+	// NodeIPs returns next-hop addresses of the node, at most one per IP family.
+	NodeIPs(nodeName string) ([]string, error)
+	// LocalLinkName returns the name of the link to route traffic to VMs running on the current node.
+	// Empty name means the route to the node address is used as is.
+	LocalLinkName() string
+	// AddEventHandler registers the handler called with the node name when next-hop addresses of the node change.
+	AddEventHandler(handler func(nodeName string)) error
+	HasSynced() bool
+}
+
+func ValidateSource(source Source) error {
+	switch source {
+	case CiliumSource, NodeSource:
+		return nil
+	default:
+		return fmt.Errorf("unknown next hop source %q, should be one of: %s, %s", source, CiliumSource, NodeSource)
+	}
+}
+
+// firstPerFamily keeps the first valid IP address of each IP family.
+func firstPerFamily(ips []string) []string {
+	var res []string
+	var hasIPv4, hasIPv6 bool
+	for _, ip := range ips {
+		parsed := net.ParseIP(ip)
+		switch {
+		case parsed == nil:
+			continue
+		case parsed.To4() != nil && !hasIPv4:
+			hasIPv4 = true
+		case parsed.To4() == nil && !hasIPv6:
+			hasIPv6 = true
+		default:
+			continue
+		}
+		res = append(res, ip)
+	}
+	return res
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nexthop
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	ciliumv2 "github.com/cilium/cilium/pkg/k8s/apis/cilium.io/v2"
+	ciliumfake "github.com/cilium/cilium/pkg/k8s/client/clientset/versioned/fake"
+	ciliumInformers "github.com/cilium/cilium/pkg/k8s/client/informers/externalversions"
+	"github.com/cilium/cilium/pkg/node/addressing"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+func waitForSync(t *testing.T, ctx context.Context, d Discoverer) {
+	t.Helper()
+	if !cache.WaitForCacheSync(ctx.Done(), d.HasSynced) {
+		t.Fatal("informer is not synced")
+	}
+}
+
+func waitForHandler(t *testing.T, ch <-chan string, expected string) {
+	t.Helper()
+	select {
+	case name := <-ch:
+		if name != expected {
+			t.Errorf("expected handler call for %s, got %s", expected, name)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("handler was not called for %s", expected)
+	}
+}
+
+func TestCiliumDiscoverer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node := &ciliumv2.CiliumNode{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Spec: ciliumv2.NodeSpec{Addresses: []ciliumv2.NodeAddress{
+			{Type: addressing.NodeInternalIP, IP: "192.168.0.1"},
+			{Type: addressing.NodeCiliumInternalIP, IP: "10.0.0.1"},
+			{Type: addressing.NodeCiliumInternalIP, IP: "10.0.0.2"},
+			{Type: addressing.NodeCiliumInternalIP, IP: "fd00::1"},
+		}},
+	}
+	client := ciliumfake.NewSimpleClientset(node)
+	factory := ciliumInformers.NewSharedInformerFactory(client, 0)
+	d := NewCiliumDiscoverer(factory.Cilium().V2().CiliumNodes())
+
+	changed := make(chan string, 1)
+	if err := d.AddEventHandler(func(nodeName string) { changed <- nodeName }); err != nil {
+		t.Fatal(err)
+	}
+	factory.Start(ctx.Done())
+	waitForSync(t, ctx, d)
+
+	ips, err := d.NodeIPs("node-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"10.0.0.1", "fd00::1"}; !slices.Equal(ips, expected) {
+		t.Errorf("expected %v, got %v", expected, ips)
+	}
+	if ips, _ = d.NodeIPs("node-2"); len(ips) != 0 {
+		t.Errorf("expected no addresses for unknown node, got %v", ips)
+	}
+	if d.LocalLinkName() != CiliumHostLinkName {
+		t.Errorf("expected %s local link, got %s", CiliumHostLinkName, d.LocalLinkName())
+	}
+
+	node = node.DeepCopy()
+	node.Spec.Addresses[1].IP = "10.0.0.3"
+	if _, err = client.CiliumV2().CiliumNodes().Update(ctx, node, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	waitForHandler(t, changed, "node-1")
+}
+
+func TestNodeDiscoverer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Status: corev1.NodeStatus{Addresses: []corev1.NodeAddress{
+			{Type: corev1.NodeHostName, Address: "node-1"},
+			{Type: corev1.NodeExternalIP, Address: "203.0.113.1"},
+			{Type: corev1.NodeInternalIP, Address: "192.168.0.1"},
+			{Type: corev1.NodeInternalIP, Address: "fd00::1"},
+		}},
+	}
+	client := fake.NewClientset(node)
+	factory := informers.NewSharedInformerFactory(client, 0)
+	d := NewNodeDiscoverer(factory.Core().V1().Nodes(), "cni0")
+
+	changed := make(chan string, 1)
+	if err := d.AddEventHandler(func(nodeName string) { changed <- nodeName }); err != nil {
+		t.Fatal(err)
+	}
+	factory.Start(ctx.Done())
+	waitForSync(t, ctx, d)
+
+	ips, err := d.NodeIPs("node-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := []string{"192.168.0.1", "fd00::1"}; !slices.Equal(ips, expected) {
+		t.Errorf("expected %v, got %v", expected, ips)
+	}
+	if d.LocalLinkName() != "cni0" {
+		t.Errorf("expected cni0 local link, got %s", d.LocalLinkName())
+	}
+
+	// Changes of other addresses are ignored.
+	node = node.DeepCopy()
+	node.Status.Addresses[1].Address = "203.0.113.2"
+	if _, err = client.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	node = node.DeepCopy()
+	node.Status.Addresses[2].Address = "192.168.0.2"
+	if _, err = client.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	waitForHandler(t, changed, "node-1")
+	select {
+	case name := <-changed:
+		t.Errorf("unexpected handler call for %s", name)
+	default:
+	}
+
+	if err = client.CoreV1().Nodes().Delete(ctx, node.Name, metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	waitForHandler(t, changed, "node-1")
+}
+
+func TestValidateSource(t *testing.T) {
+	for _, source := range []Source{CiliumSource, NodeSource} {
+		if err := ValidateSource(source); err != nil {
+			t.Errorf("unexpected error for %s: %v", source, err)
+		}
+	}
+	if err := ValidateSource("calico"); err == nil {
+		t.Errorf("expected error for unknown source")
+	}
+}
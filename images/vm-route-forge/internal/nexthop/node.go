@@ -0,0 +1,96 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nexthop
+
+import (
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// NodeDiscoverer uses InternalIP addresses of Nodes as next hops.
+// It works with any CNI that routes VM traffic between nodes by node addresses.
+type NodeDiscoverer struct {
+	informer      cache.SharedIndexInformer
+	localLinkName string
+}
+
+func NewNodeDiscoverer(nodeInformer coreinformers.NodeInformer, localLinkName string) *NodeDiscoverer {
+	return &NodeDiscoverer{
+		informer:      nodeInformer.Informer(),
+		localLinkName: localLinkName,
+	}
+}
+
+func (d *NodeDiscoverer) NodeIPs(nodeName string) ([]string, error) {
+	obj, exists, err := d.informer.GetIndexer().GetByKey(nodeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get node %s: %w", nodeName, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	return getNodeInternalIPs(obj.(*corev1.Node)), nil
+}
+
+func (d *NodeDiscoverer) LocalLinkName() string {
+	return d.localLinkName
+}
+
+func (d *NodeDiscoverer) AddEventHandler(handler func(nodeName string)) error {
+	_, err := d.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldNode, ok := oldObj.(*corev1.Node)
+			if !ok {
+				return
+			}
+			newNode, ok := newObj.(*corev1.Node)
+			if !ok {
+				return
+			}
+			if !slices.Equal(getNodeInternalIPs(oldNode), getNodeInternalIPs(newNode)) {
+				handler(newNode.Name)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if node, ok := obj.(*corev1.Node); ok {
+				handler(node.Name)
+			}
+		},
+	})
+	return err
+}
+
+func (d *NodeDiscoverer) HasSynced() bool {
+	return d.informer.HasSynced()
+}
+
+func getNodeInternalIPs(node *corev1.Node) []string {
+	var ips []string
+	for _, addr := range node.Status.Addresses {
+		if addr.Type == corev1.NodeInternalIP {
+			ips = append(ips, addr.Address)
+		}
+	}
+	return firstPerFamily(ips)
+}
@@ -25,8 +25,10 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"k8s.io/client-go/kubernetes"
 
+	"vm-route-forge/internal/metrics"
 	"vm-route-forge/internal/runnablegroup"
 )
 
@@ -34,6 +36,8 @@ const (
 	defaultGracefulShutdownPeriod = 30 * time.Second
 	defaultReadinessEndpoint      = "/readyz"
 	defaultLivenessEndpoint       = "/healthz"
+	defaultMetricsEndpoint        = "/metrics"
+	defaultRoutesEndpoint         = "/debug/routes"
 )
 
 type Server struct {
@@ -41,6 +45,8 @@ type Server struct {
 	gracefulShutdownTimeout time.Duration
 	healthProbeListener     net.Listener
 	pprofListener           net.Listener
+	metricsListener         net.Listener
+	routesHandler           http.Handler
 	readyzHandler           http.Handler
 	healthzHandler          http.Handler
 	readinessEndpointRoute  string
@@ -57,6 +63,9 @@ func (s *Server) Run(ctx context.Context) error {
 	if s.pprofListener != nil {
 		s.addPprofServer()
 	}
+	if s.metricsListener != nil {
+		s.addMetricsServer()
+	}
 	return s.runnableGroup.Run(ctx)
 }
 
@@ -100,6 +109,25 @@ func (s *Server) addPprofServer() {
 	})
 }
 
+// addMetricsServer serves Prometheus metrics and the routes debug endpoint.
+func (s *Server) addMetricsServer() {
+	mux := http.NewServeMux()
+	srv := NewHTTPServer(mux)
+
+	mux.Handle(defaultMetricsEndpoint, promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{}))
+	if s.routesHandler != nil {
+		mux.Handle(defaultRoutesEndpoint, s.routesHandler)
+	}
+
+	s.Add(&httpServer{
+		name:                    "metrics",
+		gracefulShutdownTimeout: s.gracefulShutdownTimeout,
+		server:                  srv,
+		log:                     s.log,
+		listener:                s.metricsListener,
+	})
+}
+
 func (s *Server) Add(r runnablegroup.Runnable) {
 	s.runnableGroup.Add(r)
 }
@@ -107,11 +135,14 @@ func (s *Server) Add(r runnablegroup.Runnable) {
 type Options struct {
 	HealthProbeBindAddress  string
 	PprofBindAddress        string
+	MetricsBindAddress      string
 	ReadinessEndpointRoute  string
 	LivenessEndpointRoute   string
 	GracefulShutdownTimeout *time.Duration
 	ReadyzHandler           http.Handler
 	HealthzHandler          http.Handler
+	// RoutesHandler serves desired and actual routes on the metrics address.
+	RoutesHandler http.Handler
 }
 
 func setOptionsDefault(options Options) Options {
@@ -141,10 +172,16 @@ func NewServer(client kubernetes.Interface, options Options, log logr.Logger) (*
 	if err != nil {
 		return nil, fmt.Errorf("failed to create new pprof listener: %w", err)
 	}
+	metricsListener, err := defaultListener(options.MetricsBindAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create new metrics listener: %w", err)
+	}
 
 	return &Server{
 		healthProbeListener:     healthProbeListener,
 		pprofListener:           pprofListener,
+		metricsListener:         metricsListener,
+		routesHandler:           options.RoutesHandler,
 		gracefulShutdownTimeout: *options.GracefulShutdownTimeout,
 		readinessEndpointRoute:  options.ReadinessEndpointRoute,
 		livenessEndpointRoute:   options.LivenessEndpointRoute,
@@ -0,0 +1,347 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disk
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+
+	"github.com/deckhouse/virtualization/api/client/kubeclient"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"github.com/deckhouse/virtualization/src/cli/internal/clientconfig"
+	"github.com/deckhouse/virtualization/src/cli/internal/templates"
+)
+
+const (
+	outputFlag, outputFlagShort = "output", "o"
+	snapshotFlag                = "snapshot"
+	formatFlag                  = "format"
+	compressionFlag             = "compression"
+	ttlFlag                     = "ttl"
+	timeoutFlag                 = "timeout"
+	inClusterFlag               = "in-cluster"
+	insecureFlag                = "insecure"
+	keepFlag                    = "keep"
+)
+
+type DownloadOptions struct {
+	Output      string
+	Snapshot    bool
+	Format      string
+	Compression string
+	TTL         time.Duration
+	Timeout     time.Duration
+	InCluster   bool
+	Insecure    bool
+	Keep        bool
+}
+
+func DefaultDownloadOptions() DownloadOptions {
+	return DownloadOptions{
+		Format:      string(v1alpha2.VirtualDiskExportFormatRaw),
+		Compression: string(v1alpha2.VirtualDiskExportCompressionNone),
+		TTL:         time.Hour,
+		Timeout:     10 * time.Minute,
+	}
+}
+
+func NewDownloadCommand() *cobra.Command {
+	d := &Download{opts: DefaultDownloadOptions()}
+	cmd := &cobra.Command{
+		Use:     "download (VirtualDisk)",
+		Short:   "Download the contents of a stopped virtual disk or of a virtual disk snapshot.",
+		Example: downloadUsage(),
+		Args:    templates.ExactArgs("download", 1),
+		RunE:    d.Run,
+	}
+
+	AddDownloadCommandLineArgs(cmd.Flags(), &d.opts)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func AddDownloadCommandLineArgs(flagset *pflag.FlagSet, opts *DownloadOptions) {
+	flagset.StringVarP(&opts.Output, outputFlag, outputFlagShort, opts.Output,
+		"File to write the image to. Use '-' for stdout. Defaults to the file name of the export in the current directory.")
+	flagset.BoolVar(&opts.Snapshot, snapshotFlag, opts.Snapshot,
+		"Treat the argument as a VirtualDiskSnapshot name instead of a VirtualDisk name.")
+	flagset.StringVar(&opts.Format, formatFlag, opts.Format,
+		"Image format: Raw or Qcow2.")
+	flagset.StringVar(&opts.Compression, compressionFlag, opts.Compression,
+		"Image compression: None or Gzip.")
+	flagset.DurationVar(&opts.TTL, ttlFlag, opts.TTL,
+		"Time the download link stays available. The export is deleted when it expires.")
+	flagset.DurationVar(&opts.Timeout, timeoutFlag, opts.Timeout,
+		"Time to wait for the export to become ready.")
+	flagset.BoolVar(&opts.InCluster, inClusterFlag, opts.InCluster,
+		"Download using the in-cluster URL, e.g. when running inside a Pod.")
+	flagset.BoolVar(&opts.Insecure, insecureFlag, opts.Insecure,
+		"Skip the TLS certificate verification of the download URL.")
+	flagset.BoolVar(&opts.Keep, keepFlag, opts.Keep,
+		"Do not delete the VirtualDiskExport after the download: it is removed when its TTL expires.")
+}
+
+func downloadUsage() string {
+	return `  # Download VirtualDisk 'mydisk' as a raw image to 'mydisk.raw':
+  {{ProgramName}} disk download mydisk
+  {{ProgramName}} disk download mydisk.mynamespace
+  {{ProgramName}} disk download mydisk -n mynamespace
+  # Download a compressed QCOW2 image:
+  {{ProgramName}} disk download mydisk --format=Qcow2 --compression=Gzip -o mydisk.qcow2.gz
+  # Download VirtualDiskSnapshot 'mysnapshot':
+  {{ProgramName}} disk download --snapshot mysnapshot`
+}
+
+type Download struct {
+	opts DownloadOptions
+}
+
+func (d *Download) Run(cmd *cobra.Command, args []string) error {
+	client, defaultNamespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	namespace, name, err := templates.ParseTarget(args[0])
+	if err != nil {
+		return err
+	}
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	export, err := d.newExport(name, namespace)
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+
+	export, err = client.VirtualDiskExports(namespace).Create(ctx, export, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create VirtualDiskExport: %w", err)
+	}
+
+	if !d.opts.Keep {
+		defer func() {
+			// The command context may be already canceled by a signal.
+			err := client.VirtualDiskExports(namespace).Delete(context.Background(), export.Name, metav1.DeleteOptions{})
+			if err != nil {
+				cmd.PrintErrf("Warning: failed to delete VirtualDiskExport %q: %s\n", export.Name, err)
+			}
+		}()
+	}
+
+	cmd.PrintErrf("Waiting for VirtualDiskExport %q to become ready\n", export.Name)
+
+	waitCtx, cancel := context.WithTimeout(ctx, d.opts.Timeout)
+	defer cancel()
+
+	export, err = waitReady(waitCtx, client, export.Name, namespace)
+	if err != nil {
+		return err
+	}
+
+	url, err := d.downloadURL(export)
+	if err != nil {
+		return err
+	}
+
+	output := d.opts.Output
+	if output == "" {
+		output = export.Status.FileName
+	}
+
+	n, err := d.download(ctx, url, output, cmd.OutOrStdout())
+	if err != nil {
+		return err
+	}
+
+	if output != "-" {
+		cmd.PrintErrf("Downloaded %d bytes to %s\n", n, output)
+	}
+
+	return nil
+}
+
+func (d *Download) newExport(name, namespace string) (*v1alpha2.VirtualDiskExport, error) {
+	kind := v1alpha2.VirtualDiskExportSourceKindVirtualDisk
+	if d.opts.Snapshot {
+		kind = v1alpha2.VirtualDiskExportSourceKindVirtualDiskSnapshot
+	}
+
+	format := v1alpha2.VirtualDiskExportFormat(d.opts.Format)
+	switch format {
+	case v1alpha2.VirtualDiskExportFormatRaw, v1alpha2.VirtualDiskExportFormatQcow2:
+	default:
+		return nil, fmt.Errorf("invalid --%s %q: expected %s or %s", formatFlag, d.opts.Format, v1alpha2.VirtualDiskExportFormatRaw, v1alpha2.VirtualDiskExportFormatQcow2)
+	}
+
+	compression := v1alpha2.VirtualDiskExportCompression(d.opts.Compression)
+	switch compression {
+	case v1alpha2.VirtualDiskExportCompressionNone, v1alpha2.VirtualDiskExportCompressionGzip:
+	default:
+		return nil, fmt.Errorf("invalid --%s %q: expected %s or %s", compressionFlag, d.opts.Compression, v1alpha2.VirtualDiskExportCompressionNone, v1alpha2.VirtualDiskExportCompressionGzip)
+	}
+
+	return &v1alpha2.VirtualDiskExport{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1alpha2.VirtualDiskExportKind,
+			APIVersion: v1alpha2.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: name + "-",
+			Namespace:    namespace,
+		},
+		Spec: v1alpha2.VirtualDiskExportSpec{
+			Source: v1alpha2.VirtualDiskExportSource{
+				Kind: kind,
+				Name: name,
+			},
+			Format:      format,
+			Compression: compression,
+			TTL:         &metav1.Duration{Duration: d.opts.TTL},
+		},
+	}, nil
+}
+
+func waitReady(ctx context.Context, client kubeclient.Client, name, namespace string) (*v1alpha2.VirtualDiskExport, error) {
+	selector, err := fields.ParseSelector(fmt.Sprintf("metadata.name=%s", name))
+	if err != nil {
+		return nil, err
+	}
+
+	watcher, err := client.VirtualDiskExports(namespace).Watch(ctx, metav1.ListOptions{FieldSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	defer watcher.Stop()
+
+	var last *v1alpha2.VirtualDiskExport
+	for event := range watcher.ResultChan() {
+		export, ok := event.Object.(*v1alpha2.VirtualDiskExport)
+		if !ok {
+			continue
+		}
+		last = export
+
+		switch export.Status.Phase {
+		case v1alpha2.VirtualDiskExportPhaseReady:
+			return export, nil
+		case v1alpha2.VirtualDiskExportPhaseFailed:
+			return nil, fmt.Errorf("VirtualDiskExport %q has failed: %s", name, conditionMessage(export))
+		}
+	}
+
+	if last != nil {
+		if msg := conditionMessage(last); msg != "" {
+			return nil, fmt.Errorf("VirtualDiskExport %q is not ready: %s", name, msg)
+		}
+	}
+
+	return nil, fmt.Errorf("timed out waiting for VirtualDiskExport %q to become ready", name)
+}
+
+// conditionMessage returns the message of the first condition explaining why
+// the export is not ready.
+func conditionMessage(export *v1alpha2.VirtualDiskExport) string {
+	for _, cond := range export.Status.Conditions {
+		if cond.Status != metav1.ConditionTrue && cond.Message != "" {
+			return cond.Message
+		}
+	}
+	return ""
+}
+
+func (d *Download) downloadURL(export *v1alpha2.VirtualDiskExport) (string, error) {
+	urls := export.Status.DownloadURLs
+	if urls == nil {
+		return "", fmt.Errorf("VirtualDiskExport %q has no download URLs", export.Name)
+	}
+
+	if d.opts.InCluster {
+		if urls.InCluster == "" {
+			return "", fmt.Errorf("VirtualDiskExport %q has no in-cluster download URL", export.Name)
+		}
+		return urls.InCluster, nil
+	}
+
+	if urls.External == "" {
+		return "", fmt.Errorf("VirtualDiskExport %q has no external download URL: use --%s to download from inside the cluster", export.Name, inClusterFlag)
+	}
+
+	return urls.External, nil
+}
+
+func (d *Download) download(ctx context.Context, url, output string, stdout io.Writer) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if d.opts.Insecure {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec // Requested explicitly by the user.
+	}
+
+	resp, err := (&http.Client{Transport: transport}).Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to download the image: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return 0, fmt.Errorf("failed to download the image: %s: %s", resp.Status, body)
+	}
+
+	if output == "-" {
+		return io.Copy(stdout, resp.Body)
+	}
+
+	f, err := os.Create(output)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := io.Copy(f, resp.Body)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return n, errors.Join(fmt.Errorf("failed to write %s", output), err)
+	}
+
+	// A streamed image has no Content-Length: a broken connection is only
+	// detected by the short body, which the server reports as an error.
+	if resp.ContentLength >= 0 && n != resp.ContentLength {
+		return n, fmt.Errorf("incomplete download: got %d of %d bytes", n, resp.ContentLength)
+	}
+
+	return n, nil
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/deckhouse/virtualization/src/cli/internal/cmd/ansibleinventory"
 	"github.com/deckhouse/virtualization/src/cli/internal/cmd/collectdebuginfo"
 	"github.com/deckhouse/virtualization/src/cli/internal/cmd/console"
+	"github.com/deckhouse/virtualization/src/cli/internal/cmd/disk"
 	"github.com/deckhouse/virtualization/src/cli/internal/cmd/lifecycle"
 	"github.com/deckhouse/virtualization/src/cli/internal/cmd/portforward"
 	"github.com/deckhouse/virtualization/src/cli/internal/cmd/scp"
@@ -101,6 +102,7 @@ func NewCommand(programName string) *cobra.Command {
 		lifecycle.NewRestartCommand(),
 		lifecycle.NewEvictCommand(),
 		lifecycle.NewMigrateCommand(),
+		disk.NewCommand(),
 		optionsCmd,
 	)
 
@@ -16,6 +16,8 @@ Port map:
   vm-route-forge:
   4105       vm-route-forge: liveness and readiness probes (HEALTH_PROBE_BIND_ADDRESS).
   4106       vm-route-forge: pprof (PPROF_BIND_ADDRESS), debug mode only.
+  4108       vm-route-forge: kube-rbac-proxy for Prometheus metrics.
+  4109       vm-route-forge: Prometheus metrics and /debug/routes on localhost (METRICS_BIND_ADDRESS).
 
   virtualization-dra:
   4107       virtualization-dra: gRPC liveness and readiness probes.
@@ -32,6 +34,8 @@ Port map:
 {{- /* vm-route-forge */ -}}
 {{- define "vm_route_forge.health_port" -}}4105{{- end -}}
 {{- define "vm_route_forge.pprof_port" -}}4106{{- end -}}
+{{- define "vm_route_forge.https_metrics_port" -}}4108{{- end -}}
+{{- define "vm_route_forge.metrics_port" -}}4109{{- end -}}
 
 {{- /* virtualization-dra */ -}}
 {{- define "virtualization_dra.health_port" -}}4107{{- end -}}
@@ -8,6 +8,13 @@ true
 {{- .Values.virtualization.internal | dig "dvcr" "garbageCollectionModeEnabled" "false" | default "false" -}}
 {{- end }}
 
+{{- /* DVCR notifies the dvcr-cleaner about pulls, the dvcr-cleaner records them to the storage for the retention policy. */}}
+{{- define "dvcr.isPullRecording" -}}
+{{- if and (eq (.Values.virtualization.internal.moduleConfig | dig "dvcr" "storage" "type" "") "PersistentVolumeClaim") (ne (include "dvcr.isGarbageCollection" .) "true") -}}
+true
+{{- end -}}
+{{- end -}}
+
 {{- define "dvcr.envs" -}}
 - name: REGISTRY_HTTP_TLS_CERTIFICATE
   value: /etc/ssl/docker/tls.crt
@@ -26,6 +33,34 @@ true
 {{- end }}
 {{- end }}
 
+{{- define "dvcr.isReplication" -}}
+{{- if and (.Values.virtualization.internal.moduleConfig | dig "dvcr" "replication" "registry" "") (ne (include "dvcr.isGarbageCollection" .) "true") -}}
+true
+{{- end }}
+{{- end }}
+
+{{- define "dvcr.envs.replication" -}}
+- name: DVCR_USERNAME
+  value: admin
+- name: DVCR_PASSWORD
+  valueFrom:
+    secretKeyRef:
+      name: dvcr-secrets
+      key: passwordRW
+{{- with .Values.virtualization.internal.moduleConfig | dig "dvcr" "replication" "authSecretName" "" }}
+- name: MIRROR_USERNAME
+  valueFrom:
+    secretKeyRef:
+      name: {{ . }}
+      key: username
+- name: MIRROR_PASSWORD
+  valueFrom:
+    secretKeyRef:
+      name: {{ . }}
+      key: password
+{{- end }}
+{{- end }}
+
 {{- define "dvcr.envs.garbageCollection" -}}
 {{- if eq (.Values.virtualization.internal.moduleConfig | dig "dvcr" "storage" "type" "") "PersistentVolumeClaim" }}
 - name: REGISTRY_STORAGE_FILESYSTEM_ROOTDIRECTORY
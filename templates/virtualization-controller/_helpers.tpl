@@ -30,6 +30,8 @@ true
   value: {{ include "helm_lib_module_image" (list . "dvcrUploader") }}
 - name: BOUNDER_IMAGE
   value: {{ include "helm_lib_module_image" (list . "bounder") }}
+- name: EXPORTER_IMAGE
+  value: {{ include "helm_lib_module_image" (list . "dvcrExporter") }}
 - name: DVCR_AUTH_SECRET
   value: dvcr-dockercfg-rw
 - name: DVCR_CERTS_SECRET
@@ -42,6 +44,14 @@ true
   value: "*/5 * * * *"
 - name: DVCR_GC_SCHEDULE
   value: "{{ .Values.virtualization.internal.moduleConfig | dig "dvcr" "gc" "schedule" "" }}"
+- name: DVCR_COMPRESSION
+  value: "{{ .Values.virtualization.internal.moduleConfig | dig "dvcr" "compression" "" }}"
+- name: DVCR_PULL_THROUGH_CACHE
+  value: "{{ .Values.virtualization.internal.moduleConfig | dig "dvcr" "pullThroughCache" "enabled" false }}"
+{{- with .Values.virtualization.internal.moduleConfig | dig "dvcr" "signatureVerification" "trustedKeys" list }}
+- name: DVCR_SIGNATURE_TRUSTED_KEYS
+  value: {{ join "\n" . | quote }}
+{{- end }}
 - name: DVCR_TOKEN_PRIVATE_KEY
   valueFrom:
     secretKeyRef:
@@ -119,6 +129,8 @@ true
   value: "24h"
 - name: GC_VM_POD_SCHEDULE
   value: "0 0 * * *"
+- name: GC_VDEXPORT_SCHEDULE
+  value: "*/5 * * * *"
 {{- $liveMigration := (.Values.virtualization | default dict).liveMigration | default dict }}
 {{- $migrationNetwork := $liveMigration.network | default dict }}
 {{- if eq $migrationNetwork.type "SystemNetwork" }}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 Flant JSC
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package blockdevice
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	vdbuilder "github.com/deckhouse/virtualization-controller/pkg/builder/vd"
+	vibuilder "github.com/deckhouse/virtualization-controller/pkg/builder/vi"
+	"github.com/deckhouse/virtualization/api/core/v1alpha2"
+	"github.com/deckhouse/virtualization/test/e2e/internal/framework"
+	"github.com/deckhouse/virtualization/test/e2e/internal/label"
+	"github.com/deckhouse/virtualization/test/e2e/internal/object"
+	"github.com/deckhouse/virtualization/test/e2e/internal/precheck"
+)
+
+var _ = Describe("VirtualImageCustomization", Label(
+	label.SIGStorage,
+	precheck.PrecheckDefaultStorageClass,
+), func() {
+	const (
+		customizedFilePath    = "/etc/d8-customized"
+		customizedFileContent = "customized"
+	)
+
+	var f *framework.Framework
+
+	BeforeEach(func(ctx context.Context) {
+		f = framework.NewFramework("")
+		f.Before()
+		DeferCleanup(f.After)
+		setupProject(ctx, f, "vi-customization")
+	})
+
+	// The importer customizes the image in the libguestfs appliance: the spec checks that the
+	// appliance boots in the importer Pod, without root and without /dev/kvm, and that the
+	// virtual machine sees the file written into the image.
+	It("writes a file into the image on import", func(ctx context.Context) {
+		vi := newVirtualImageOnDVCR("vi-customized",
+			vibuilder.WithDataSourceHTTP(object.ImageURLCustomBIOS, nil, nil),
+		)
+		vi.Spec.Customization = &v1alpha2.ImageCustomization{
+			Files: []v1alpha2.ImageCustomizationFile{
+				{Path: customizedFilePath, Content: customizedFileContent + "\n"},
+			},
+		}
+		// The importer reports no progress while the image is customized.
+		createVirtualImageAndWait(ctx, f, vi, withMinimalProgress())
+
+		vd := object.NewVDFromVI("vd-from-"+vi.Name, f.Namespace().Name, vi,
+			vdbuilder.WithStorageClass(defaultStorageClass()),
+		)
+		obs := startVirtualDisk(ctx, f, vd, withIntermediateProgress())
+		vm := runVirtualMachineFromDisks(ctx, f, observedDisk{vd: vd, obs: obs})
+
+		By("Checking the file written by the customization", func() {
+			Expect(guestReadFile(f, vm, customizedFilePath)).To(Equal(customizedFileContent))
+		})
+	})
+})